@@ -0,0 +1,91 @@
+# coding: utf-8
+
+"""
+    Kubeflow Katib OpenAPI Spec
+
+    No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+
+    The version of the OpenAPI document: unversioned
+    Generated by OpenAPI Generator (https://openapi-generator.tech)
+
+    Do not edit the class manually.
+"""  # noqa: E501
+
+
+from __future__ import annotations
+import pprint
+import re  # noqa: F401
+import json
+
+from pydantic import BaseModel, ConfigDict, Field, StrictInt, StrictStr
+from typing import Any, ClassVar, Dict, List, Optional
+from typing import Optional, Set
+from typing_extensions import Self
+
+class V1beta1AdaptiveParallelismSpec(BaseModel):
+    """
+    AdaptiveParallelismSpec describes the bounds of the parallel trials. Parallelism is raised while the trials start running promptly and lowered when the trials stay pending longer than the pending timeout.
+    """ # noqa: E501
+    max_parallel_trial_count: Optional[StrictInt] = Field(default=None, description="Max number of trials which run in parallel.", alias="maxParallelTrialCount")
+    min_parallel_trial_count: Optional[StrictInt] = Field(default=None, description="Min number of trials which run in parallel.", alias="minParallelTrialCount")
+    pending_timeout: Optional[StrictStr] = Field(default=None, description="How long the trial can be pending before the parallelism is lowered. It is also the minimal interval between the parallelism changes. Default value is 5m.", alias="pendingTimeout")
+    __properties: ClassVar[List[str]] = ["maxParallelTrialCount", "minParallelTrialCount", "pendingTimeout"]
+
+    model_config = ConfigDict(
+        populate_by_name=True,
+        validate_assignment=True,
+        protected_namespaces=(),
+    )
+
+
+    def to_str(self) -> str:
+        """Returns the string representation of the model using alias"""
+        return pprint.pformat(self.model_dump(by_alias=True))
+
+    def to_json(self) -> str:
+        """Returns the JSON representation of the model using alias"""
+        # TODO: pydantic v2: use .model_dump_json(by_alias=True, exclude_unset=True) instead
+        return json.dumps(self.to_dict())
+
+    @classmethod
+    def from_json(cls, json_str: str) -> Optional[Self]:
+        """Create an instance of V1beta1AdaptiveParallelismSpec from a JSON string"""
+        return cls.from_dict(json.loads(json_str))
+
+    def to_dict(self) -> Dict[str, Any]:
+        """Return the dictionary representation of the model using alias.
+
+        This has the following differences from calling pydantic's
+        `self.model_dump(by_alias=True)`:
+
+        * `None` is only added to the output dict for nullable fields that
+          were set at model initialization. Other fields with value `None`
+          are ignored.
+        """
+        excluded_fields: Set[str] = set([
+        ])
+
+        _dict = self.model_dump(
+            by_alias=True,
+            exclude=excluded_fields,
+            exclude_none=True,
+        )
+        return _dict
+
+    @classmethod
+    def from_dict(cls, obj: Optional[Dict[str, Any]]) -> Optional[Self]:
+        """Create an instance of V1beta1AdaptiveParallelismSpec from a dict"""
+        if obj is None:
+            return None
+
+        if not isinstance(obj, dict):
+            return cls.model_validate(obj)
+
+        _obj = cls.model_validate({
+            "maxParallelTrialCount": obj.get("maxParallelTrialCount"),
+            "minParallelTrialCount": obj.get("minParallelTrialCount"),
+            "pendingTimeout": obj.get("pendingTimeout")
+        })
+        return _obj
+
+
@@ -0,0 +1,89 @@
+# coding: utf-8
+
+"""
+    Kubeflow Katib OpenAPI Spec
+
+    No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+
+    The version of the OpenAPI document: unversioned
+    Generated by OpenAPI Generator (https://openapi-generator.tech)
+
+    Do not edit the class manually.
+"""  # noqa: E501
+
+
+from __future__ import annotations
+import pprint
+import re  # noqa: F401
+import json
+
+from pydantic import BaseModel, ConfigDict, Field, StrictFloat, StrictInt, StrictStr
+from typing import Any, ClassVar, Dict, List, Optional, Union
+from typing import Optional, Set
+from typing_extensions import Self
+
+class V1beta1DuplicateSuggestionSpec(BaseModel):
+    """
+    DuplicateSuggestionSpec describes how the duplicated parameter assignments are detected and handled
+    """ # noqa: E501
+    policy: Optional[StrictStr] = Field(default=None, description="Policy for the parameter assignments which have already been suggested.")
+    tolerance: Optional[Union[StrictFloat, StrictInt]] = Field(default=None, description="Tolerance to compare the values of the int and double parameters, relative to the range of the feasible space. For example, with tolerance 0.01 and feasible space [0, 10], values 1.05 and 1.1 are the same. Values of the categorical and discrete parameters are compared exactly. Defaults to 0, i.e. assignments are duplicated only if all values are equal.")
+    __properties: ClassVar[List[str]] = ["policy", "tolerance"]
+
+    model_config = ConfigDict(
+        populate_by_name=True,
+        validate_assignment=True,
+        protected_namespaces=(),
+    )
+
+
+    def to_str(self) -> str:
+        """Returns the string representation of the model using alias"""
+        return pprint.pformat(self.model_dump(by_alias=True))
+
+    def to_json(self) -> str:
+        """Returns the JSON representation of the model using alias"""
+        # TODO: pydantic v2: use .model_dump_json(by_alias=True, exclude_unset=True) instead
+        return json.dumps(self.to_dict())
+
+    @classmethod
+    def from_json(cls, json_str: str) -> Optional[Self]:
+        """Create an instance of V1beta1DuplicateSuggestionSpec from a JSON string"""
+        return cls.from_dict(json.loads(json_str))
+
+    def to_dict(self) -> Dict[str, Any]:
+        """Return the dictionary representation of the model using alias.
+
+        This has the following differences from calling pydantic's
+        `self.model_dump(by_alias=True)`:
+
+        * `None` is only added to the output dict for nullable fields that
+          were set at model initialization. Other fields with value `None`
+          are ignored.
+        """
+        excluded_fields: Set[str] = set([
+        ])
+
+        _dict = self.model_dump(
+            by_alias=True,
+            exclude=excluded_fields,
+            exclude_none=True,
+        )
+        return _dict
+
+    @classmethod
+    def from_dict(cls, obj: Optional[Dict[str, Any]]) -> Optional[Self]:
+        """Create an instance of V1beta1DuplicateSuggestionSpec from a dict"""
+        if obj is None:
+            return None
+
+        if not isinstance(obj, dict):
+            return cls.model_validate(obj)
+
+        _obj = cls.model_validate({
+            "policy": obj.get("policy"),
+            "tolerance": obj.get("tolerance")
+        })
+        return _obj
+
+
@@ -17,15 +17,22 @@ import pprint
 import re  # noqa: F401
 import json
 
-from pydantic import BaseModel, ConfigDict, Field, StrictInt, StrictStr
+from pydantic import BaseModel, ConfigDict, Field, StrictBool, StrictInt, StrictStr
 from typing import Any, ClassVar, Dict, List, Optional
+from kubeflow_katib_api.models.v1beta1_adaptive_parallelism_spec import V1beta1AdaptiveParallelismSpec
 from kubeflow_katib_api.models.v1beta1_algorithm_spec import V1beta1AlgorithmSpec
+from kubeflow_katib_api.models.v1beta1_convergence_spec import V1beta1ConvergenceSpec
+from kubeflow_katib_api.models.v1beta1_duplicate_suggestion_spec import V1beta1DuplicateSuggestionSpec
 from kubeflow_katib_api.models.v1beta1_early_stopping_spec import V1beta1EarlyStoppingSpec
 from kubeflow_katib_api.models.v1beta1_metrics_collector_spec import V1beta1MetricsCollectorSpec
 from kubeflow_katib_api.models.v1beta1_nas_config import V1beta1NasConfig
 from kubeflow_katib_api.models.v1beta1_objective_spec import V1beta1ObjectiveSpec
 from kubeflow_katib_api.models.v1beta1_parameter_spec import V1beta1ParameterSpec
+from kubeflow_katib_api.models.v1beta1_queueing_spec import V1beta1QueueingSpec
+from kubeflow_katib_api.models.v1beta1_resource_usage_spec import V1beta1ResourceUsageSpec
+from kubeflow_katib_api.models.v1beta1_trial_cache_spec import V1beta1TrialCacheSpec
 from kubeflow_katib_api.models.v1beta1_trial_template import V1beta1TrialTemplate
+from kubeflow_katib_api.models.v1beta1_warm_start_spec import V1beta1WarmStartSpec
 from typing import Optional, Set
 from typing_extensions import Self
 
@@ -33,18 +40,30 @@ class V1beta1ExperimentSpec(BaseModel):
     """
     ExperimentSpec is the specification of an Experiment.
     """ # noqa: E501
+    adaptive_parallelism: Optional[V1beta1AdaptiveParallelismSpec] = Field(default=None, description="Describes how the number of trials which run in parallel follows the cluster capacity. If it is set, parallelTrialCount is the initial number of parallel trials.", alias="adaptiveParallelism")
     algorithm: Optional[V1beta1AlgorithmSpec] = Field(default=None, description="Describes the suggestion algorithm.")
+    budget_policy: Optional[StrictStr] = Field(default=None, description="Describes what happens to the active trials once max duration or max resource usage is reached. Default value is Finish.", alias="budgetPolicy")
+    convergence: Optional[V1beta1ConvergenceSpec] = Field(default=None, description="Describes when the experiment is succeeded because the best objective value has plateaued.")
+    duplicate_suggestion: Optional[V1beta1DuplicateSuggestionSpec] = Field(default=None, description="Describes how the parameter assignments which have already been suggested are handled. If it is not set, duplicated assignments are not detected.", alias="duplicateSuggestion")
     early_stopping: Optional[V1beta1EarlyStoppingSpec] = Field(default=None, description="Describes the early stopping algorithm.", alias="earlyStopping")
+    max_duration: Optional[StrictStr] = Field(default=None, description="Max duration of the Experiment since its start time to mark experiment as succeeded.", alias="maxDuration")
     max_failed_trial_count: Optional[StrictInt] = Field(default=None, description="Max failed trials to mark experiment as failed.", alias="maxFailedTrialCount")
+    max_resource_usage: Optional[V1beta1ResourceUsageSpec] = Field(default=None, description="Max cumulative resource usage of the trials to mark experiment as succeeded.", alias="maxResourceUsage")
     max_trial_count: Optional[StrictInt] = Field(default=None, description="Max completed trials to mark experiment as succeeded", alias="maxTrialCount")
     metrics_collector_spec: Optional[V1beta1MetricsCollectorSpec] = Field(default=None, description="Describes the specification of the metrics collector", alias="metricsCollectorSpec")
     nas_config: Optional[V1beta1NasConfig] = Field(default=None, alias="nasConfig")
     objective: Optional[V1beta1ObjectiveSpec] = Field(default=None, description="Describes the objective of the experiment.")
     parallel_trial_count: Optional[StrictInt] = Field(default=None, description="How many trials can be processed in parallel. Defaults to 3", alias="parallelTrialCount")
+    parameter_constraints: Optional[List[StrictStr]] = Field(default=None, description="List of constraints for the parameter assignments, for example \"batch_size * grad_accum <= 512\". Each constraint is the boolean expression in the Go syntax over the parameter names. Parameters which names are not valid identifiers can be referenced as params[\"num-layers\"]. Trials are not created for the assignments which violate any constraint.", alias="parameterConstraints")
     parameters: Optional[List[V1beta1ParameterSpec]] = Field(default=None, description="List of hyperparameter configurations.")
+    queueing: Optional[V1beta1QueueingSpec] = Field(default=None, description="Describes how the Experiment shares the trial slots of the ExperimentQueues in its namespace.")
     resume_policy: Optional[StrictStr] = Field(default=None, description="Describes resuming policy which usually take effect after experiment terminated. Default value is Never.", alias="resumePolicy")
+    suspend: Optional[StrictBool] = Field(default=None, description="Whether the Experiment is suspended. Suspended Experiment doesn't create new trials and its suggestion deployment is scaled down. Setting it back to false resumes the Experiment.")
+    suspend_policy: Optional[StrictStr] = Field(default=None, description="Describes what happens to the active trials once the Experiment is suspended. Default value is Finish.", alias="suspendPolicy")
+    trial_cache: Optional[V1beta1TrialCacheSpec] = Field(default=None, description="Describes how the results of the earlier Trials with the same run spec and parameter assignments are reused, e.g. from the other Experiments. If it is not set, each Trial creates the Trial run.", alias="trialCache")
     trial_template: Optional[V1beta1TrialTemplate] = Field(default=None, description="Template for each run of the trial.", alias="trialTemplate")
-    __properties: ClassVar[List[str]] = ["algorithm", "earlyStopping", "maxFailedTrialCount", "maxTrialCount", "metricsCollectorSpec", "nasConfig", "objective", "parallelTrialCount", "parameters", "resumePolicy", "trialTemplate"]
+    warm_start: Optional[V1beta1WarmStartSpec] = Field(default=None, description="Describes the prior Trials to warm-start the suggestion algorithm. Succeeded Trials from the sources are sent to the algorithm as the history, Trials are not created for them.", alias="warmStart")
+    __properties: ClassVar[List[str]] = ["adaptiveParallelism", "algorithm", "budgetPolicy", "convergence", "duplicateSuggestion", "earlyStopping", "maxDuration", "maxFailedTrialCount", "maxResourceUsage", "maxTrialCount", "metricsCollectorSpec", "nasConfig", "objective", "parallelTrialCount", "parameterConstraints", "parameters", "queueing", "resumePolicy", "suspend", "suspendPolicy", "trialCache", "trialTemplate", "warmStart"]
 
     model_config = ConfigDict(
         populate_by_name=True,
@@ -85,12 +104,24 @@ class V1beta1ExperimentSpec(BaseModel):
             exclude=excluded_fields,
             exclude_none=True,
         )
+        # override the default output from pydantic by calling `to_dict()` of adaptive_parallelism
+        if self.adaptive_parallelism:
+            _dict['adaptiveParallelism'] = self.adaptive_parallelism.to_dict()
         # override the default output from pydantic by calling `to_dict()` of algorithm
         if self.algorithm:
             _dict['algorithm'] = self.algorithm.to_dict()
+        # override the default output from pydantic by calling `to_dict()` of convergence
+        if self.convergence:
+            _dict['convergence'] = self.convergence.to_dict()
+        # override the default output from pydantic by calling `to_dict()` of duplicate_suggestion
+        if self.duplicate_suggestion:
+            _dict['duplicateSuggestion'] = self.duplicate_suggestion.to_dict()
         # override the default output from pydantic by calling `to_dict()` of early_stopping
         if self.early_stopping:
             _dict['earlyStopping'] = self.early_stopping.to_dict()
+        # override the default output from pydantic by calling `to_dict()` of max_resource_usage
+        if self.max_resource_usage:
+            _dict['maxResourceUsage'] = self.max_resource_usage.to_dict()
         # override the default output from pydantic by calling `to_dict()` of metrics_collector_spec
         if self.metrics_collector_spec:
             _dict['metricsCollectorSpec'] = self.metrics_collector_spec.to_dict()
@@ -107,9 +138,18 @@ class V1beta1ExperimentSpec(BaseModel):
                 if _item_parameters:
                     _items.append(_item_parameters.to_dict())
             _dict['parameters'] = _items
+        # override the default output from pydantic by calling `to_dict()` of queueing
+        if self.queueing:
+            _dict['queueing'] = self.queueing.to_dict()
+        # override the default output from pydantic by calling `to_dict()` of trial_cache
+        if self.trial_cache:
+            _dict['trialCache'] = self.trial_cache.to_dict()
         # override the default output from pydantic by calling `to_dict()` of trial_template
         if self.trial_template:
             _dict['trialTemplate'] = self.trial_template.to_dict()
+        # override the default output from pydantic by calling `to_dict()` of warm_start
+        if self.warm_start:
+            _dict['warmStart'] = self.warm_start.to_dict()
         return _dict
 
     @classmethod
@@ -122,17 +162,29 @@ class V1beta1ExperimentSpec(BaseModel):
             return cls.model_validate(obj)
 
         _obj = cls.model_validate({
+            "adaptiveParallelism": V1beta1AdaptiveParallelismSpec.from_dict(obj["adaptiveParallelism"]) if obj.get("adaptiveParallelism") is not None else None,
             "algorithm": V1beta1AlgorithmSpec.from_dict(obj["algorithm"]) if obj.get("algorithm") is not None else None,
+            "budgetPolicy": obj.get("budgetPolicy"),
+            "convergence": V1beta1ConvergenceSpec.from_dict(obj["convergence"]) if obj.get("convergence") is not None else None,
+            "duplicateSuggestion": V1beta1DuplicateSuggestionSpec.from_dict(obj["duplicateSuggestion"]) if obj.get("duplicateSuggestion") is not None else None,
             "earlyStopping": V1beta1EarlyStoppingSpec.from_dict(obj["earlyStopping"]) if obj.get("earlyStopping") is not None else None,
+            "maxDuration": obj.get("maxDuration"),
             "maxFailedTrialCount": obj.get("maxFailedTrialCount"),
+            "maxResourceUsage": V1beta1ResourceUsageSpec.from_dict(obj["maxResourceUsage"]) if obj.get("maxResourceUsage") is not None else None,
             "maxTrialCount": obj.get("maxTrialCount"),
             "metricsCollectorSpec": V1beta1MetricsCollectorSpec.from_dict(obj["metricsCollectorSpec"]) if obj.get("metricsCollectorSpec") is not None else None,
             "nasConfig": V1beta1NasConfig.from_dict(obj["nasConfig"]) if obj.get("nasConfig") is not None else None,
             "objective": V1beta1ObjectiveSpec.from_dict(obj["objective"]) if obj.get("objective") is not None else None,
             "parallelTrialCount": obj.get("parallelTrialCount"),
+            "parameterConstraints": obj.get("parameterConstraints"),
             "parameters": [V1beta1ParameterSpec.from_dict(_item) for _item in obj["parameters"]] if obj.get("parameters") is not None else None,
+            "queueing": V1beta1QueueingSpec.from_dict(obj["queueing"]) if obj.get("queueing") is not None else None,
             "resumePolicy": obj.get("resumePolicy"),
-            "trialTemplate": V1beta1TrialTemplate.from_dict(obj["trialTemplate"]) if obj.get("trialTemplate") is not None else None
+            "suspend": obj.get("suspend"),
+            "suspendPolicy": obj.get("suspendPolicy"),
+            "trialCache": V1beta1TrialCacheSpec.from_dict(obj["trialCache"]) if obj.get("trialCache") is not None else None,
+            "trialTemplate": V1beta1TrialTemplate.from_dict(obj["trialTemplate"]) if obj.get("trialTemplate") is not None else None,
+            "warmStart": V1beta1WarmStartSpec.from_dict(obj["warmStart"]) if obj.get("warmStart") is not None else None
         })
         return _obj
 
@@ -18,10 +18,12 @@ import re  # noqa: F401
 import json
 
 from datetime import datetime
-from pydantic import BaseModel, ConfigDict, Field, StrictInt, StrictStr
-from typing import Any, ClassVar, Dict, List, Optional
+from pydantic import BaseModel, ConfigDict, Field, StrictFloat, StrictInt, StrictStr
+from typing import Any, ClassVar, Dict, List, Optional, Union
+from kubeflow_katib_api.models.v1beta1_convergence_status import V1beta1ConvergenceStatus
 from kubeflow_katib_api.models.v1beta1_experiment_condition import V1beta1ExperimentCondition
 from kubeflow_katib_api.models.v1beta1_optimal_trial import V1beta1OptimalTrial
+from kubeflow_katib_api.models.v1beta1_parallelism_status import V1beta1ParallelismStatus
 from typing import Optional, Set
 from typing_extensions import Self
 
@@ -31,13 +33,17 @@ class V1beta1ExperimentStatus(BaseModel):
     """ # noqa: E501
     completion_time: Optional[datetime] = Field(default=None, description="Represents time when the Experiment was completed. It is not guaranteed to be set in happens-before order across separate operations. It is represented in RFC3339 form and is in UTC.", alias="completionTime")
     conditions: Optional[List[V1beta1ExperimentCondition]] = Field(default=None, description="List of observed runtime conditions for this Experiment.")
+    convergence: Optional[V1beta1ConvergenceStatus] = Field(default=None, description="Statistics of the best objective value plateau. It is set only if spec.convergence is specified.")
     current_optimal_trial: Optional[V1beta1OptimalTrial] = Field(default=None, description="Current optimal trial parameters and observations.", alias="currentOptimalTrial")
     early_stopped_trial_list: Optional[List[StrictStr]] = Field(default=None, description="List of trial names which have been early stopped.", alias="earlyStoppedTrialList")
     failed_trial_list: Optional[List[StrictStr]] = Field(default=None, description="List of trial names which have already failed.", alias="failedTrialList")
     killed_trial_list: Optional[List[StrictStr]] = Field(default=None, description="List of trial names which have been killed.", alias="killedTrialList")
     last_reconcile_time: Optional[datetime] = Field(default=None, description="Represents last time when the Experiment was reconciled. It is not guaranteed to be set in happens-before order across separate operations. It is represented in RFC3339 form and is in UTC.", alias="lastReconcileTime")
     metrics_unavailable_trial_list: Optional[List[StrictStr]] = Field(default=None, description="List of trial names which have been metrics unavailable", alias="metricsUnavailableTrialList")
+    parallelism: Optional[V1beta1ParallelismStatus] = Field(default=None, description="Current parallelism of the trials. It is set only if spec.adaptiveParallelism is specified.")
+    pareto_optimal_trials: Optional[List[V1beta1OptimalTrial]] = Field(default=None, description="Trials on the Pareto front of the multi-objective Experiment. Trial is on the Pareto front if no other Trial is better in all objectives. It is set only if spec.objective.additionalObjectives is not empty.", alias="paretoOptimalTrials")
     pending_trial_list: Optional[List[StrictStr]] = Field(default=None, description="List of trial names which are pending.", alias="pendingTrialList")
+    resource_usage: Optional[Union[StrictFloat, StrictInt]] = Field(default=None, description="Cumulative resource usage of the trials in resource-hours. It is set only if spec.maxResourceUsage is specified.", alias="resourceUsage")
     running_trial_list: Optional[List[StrictStr]] = Field(default=None, description="List of trial names which are running.", alias="runningTrialList")
     start_time: Optional[datetime] = Field(default=None, description="Represents time when the Experiment was acknowledged by the Experiment controller. It is not guaranteed to be set in happens-before order across separate operations. It is represented in RFC3339 form and is in UTC.", alias="startTime")
     succeeded_trial_list: Optional[List[StrictStr]] = Field(default=None, description="List of trial names which have already succeeded.", alias="succeededTrialList")
@@ -47,9 +53,10 @@ class V1beta1ExperimentStatus(BaseModel):
     trials_failed: Optional[StrictInt] = Field(default=None, description="How many trials have failed.", alias="trialsFailed")
     trials_killed: Optional[StrictInt] = Field(default=None, description="How many trials have been killed.", alias="trialsKilled")
     trials_pending: Optional[StrictInt] = Field(default=None, description="How many trials are currently pending.", alias="trialsPending")
+    trials_queued: Optional[StrictInt] = Field(default=None, description="How many trials are waiting for the free trial slots of the ExperimentQueues.", alias="trialsQueued")
     trials_running: Optional[StrictInt] = Field(default=None, description="How many trials are currently running.", alias="trialsRunning")
     trials_succeeded: Optional[StrictInt] = Field(default=None, description="How many trials have succeeded.", alias="trialsSucceeded")
-    __properties: ClassVar[List[str]] = ["completionTime", "conditions", "currentOptimalTrial", "earlyStoppedTrialList", "failedTrialList", "killedTrialList", "lastReconcileTime", "metricsUnavailableTrialList", "pendingTrialList", "runningTrialList", "startTime", "succeededTrialList", "trialMetricsUnavailable", "trials", "trialsEarlyStopped", "trialsFailed", "trialsKilled", "trialsPending", "trialsRunning", "trialsSucceeded"]
+    __properties: ClassVar[List[str]] = ["completionTime", "conditions", "convergence", "currentOptimalTrial", "earlyStoppedTrialList", "failedTrialList", "killedTrialList", "lastReconcileTime", "metricsUnavailableTrialList", "parallelism", "paretoOptimalTrials", "pendingTrialList", "resourceUsage", "runningTrialList", "startTime", "succeededTrialList", "trialMetricsUnavailable", "trials", "trialsEarlyStopped", "trialsFailed", "trialsKilled", "trialsPending", "trialsQueued", "trialsRunning", "trialsSucceeded"]
 
     model_config = ConfigDict(
         populate_by_name=True,
@@ -97,9 +104,22 @@ class V1beta1ExperimentStatus(BaseModel):
                 if _item_conditions:
                     _items.append(_item_conditions.to_dict())
             _dict['conditions'] = _items
+        # override the default output from pydantic by calling `to_dict()` of convergence
+        if self.convergence:
+            _dict['convergence'] = self.convergence.to_dict()
         # override the default output from pydantic by calling `to_dict()` of current_optimal_trial
         if self.current_optimal_trial:
             _dict['currentOptimalTrial'] = self.current_optimal_trial.to_dict()
+        # override the default output from pydantic by calling `to_dict()` of parallelism
+        if self.parallelism:
+            _dict['parallelism'] = self.parallelism.to_dict()
+        # override the default output from pydantic by calling `to_dict()` of each item in pareto_optimal_trials (list)
+        _items = []
+        if self.pareto_optimal_trials:
+            for _item_pareto_optimal_trials in self.pareto_optimal_trials:
+                if _item_pareto_optimal_trials:
+                    _items.append(_item_pareto_optimal_trials.to_dict())
+            _dict['paretoOptimalTrials'] = _items
         return _dict
 
     @classmethod
@@ -114,13 +134,17 @@ class V1beta1ExperimentStatus(BaseModel):
         _obj = cls.model_validate({
             "completionTime": obj.get("completionTime"),
             "conditions": [V1beta1ExperimentCondition.from_dict(_item) for _item in obj["conditions"]] if obj.get("conditions") is not None else None,
+            "convergence": V1beta1ConvergenceStatus.from_dict(obj["convergence"]) if obj.get("convergence") is not None else None,
             "currentOptimalTrial": V1beta1OptimalTrial.from_dict(obj["currentOptimalTrial"]) if obj.get("currentOptimalTrial") is not None else None,
             "earlyStoppedTrialList": obj.get("earlyStoppedTrialList"),
             "failedTrialList": obj.get("failedTrialList"),
             "killedTrialList": obj.get("killedTrialList"),
             "lastReconcileTime": obj.get("lastReconcileTime"),
             "metricsUnavailableTrialList": obj.get("metricsUnavailableTrialList"),
+            "parallelism": V1beta1ParallelismStatus.from_dict(obj["parallelism"]) if obj.get("parallelism") is not None else None,
+            "paretoOptimalTrials": [V1beta1OptimalTrial.from_dict(_item) for _item in obj["paretoOptimalTrials"]] if obj.get("paretoOptimalTrials") is not None else None,
             "pendingTrialList": obj.get("pendingTrialList"),
+            "resourceUsage": obj.get("resourceUsage"),
             "runningTrialList": obj.get("runningTrialList"),
             "startTime": obj.get("startTime"),
             "succeededTrialList": obj.get("succeededTrialList"),
@@ -130,6 +154,7 @@ class V1beta1ExperimentStatus(BaseModel):
             "trialsFailed": obj.get("trialsFailed"),
             "trialsKilled": obj.get("trialsKilled"),
             "trialsPending": obj.get("trialsPending"),
+            "trialsQueued": obj.get("trialsQueued"),
             "trialsRunning": obj.get("trialsRunning"),
             "trialsSucceeded": obj.get("trialsSucceeded")
         })
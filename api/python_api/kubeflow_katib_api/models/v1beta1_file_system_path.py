@@ -17,7 +17,7 @@ import pprint
 import re  # noqa: F401
 import json
 
-from pydantic import BaseModel, ConfigDict, StrictStr
+from pydantic import BaseModel, ConfigDict, Field, StrictStr
 from typing import Any, ClassVar, Dict, List, Optional
 from typing import Optional, Set
 from typing_extensions import Self
@@ -26,10 +26,14 @@ class V1beta1FileSystemPath(BaseModel):
     """
     V1beta1FileSystemPath
     """ # noqa: E501
+    aggregation: Optional[StrictStr] = Field(default=None, description="Aggregation of the metrics across the metrics files which match the pattern. Aggregated metrics are reported only when the training is completed, not periodically during the training, and the aggregation other than None can't be used with early stopping. Defaults to None")
     format: Optional[StrictStr] = None
     kind: Optional[StrictStr] = None
     path: Optional[StrictStr] = None
-    __properties: ClassVar[List[str]] = ["format", "kind", "path"]
+    pattern: Optional[StrictStr] = Field(default=None, description="Glob pattern of the metrics files in the directory when the kind is Directory, e.g. \"metrics-*.log\". Files which are created after the training starts are collected as well. Each metric is tagged with the name of its file as the source")
+    timestamp_format: Optional[StrictStr] = Field(default=None, description="Go layout of the metrics timestamp string when the format is JSON, e.g. \"2006-01-02 15:04:05\". Defaults to RFC3339. Numeric timestamps are parsed as Unix time in seconds", alias="timestampFormat")
+    timestamp_key: Optional[StrictStr] = Field(default=None, description="Key of the metrics timestamp when the format is JSON, nested keys are separated by dots, e.g. \"meta.time\". Defaults to \"timestamp\"", alias="timestampKey")
+    __properties: ClassVar[List[str]] = ["aggregation", "format", "kind", "path", "pattern", "timestampFormat", "timestampKey"]
 
     model_config = ConfigDict(
         populate_by_name=True,
@@ -82,9 +86,13 @@ class V1beta1FileSystemPath(BaseModel):
             return cls.model_validate(obj)
 
         _obj = cls.model_validate({
+            "aggregation": obj.get("aggregation"),
             "format": obj.get("format"),
             "kind": obj.get("kind"),
-            "path": obj.get("path")
+            "path": obj.get("path"),
+            "pattern": obj.get("pattern"),
+            "timestampFormat": obj.get("timestampFormat"),
+            "timestampKey": obj.get("timestampKey")
         })
         return _obj
 
@@ -19,6 +19,7 @@ import json
 
 from pydantic import BaseModel, ConfigDict, Field, StrictFloat, StrictInt, StrictStr
 from typing import Any, ClassVar, Dict, List, Optional, Union
+from kubeflow_katib_api.models.v1beta1_additional_objective import V1beta1AdditionalObjective
 from kubeflow_katib_api.models.v1beta1_metric_strategy import V1beta1MetricStrategy
 from typing import Optional, Set
 from typing_extensions import Self
@@ -28,11 +29,12 @@ class V1beta1ObjectiveSpec(BaseModel):
     ObjectiveSpec represents Experiment's objective specification.
     """ # noqa: E501
     additional_metric_names: Optional[List[StrictStr]] = Field(default=None, description="AdditionalMetricNames represents metrics that should be collected from Trials. This can be empty if we only care about the objective metric. Note: If we adopt a push instead of pull mechanism, this can be omitted completely.", alias="additionalMetricNames")
+    additional_objectives: Optional[List[V1beta1AdditionalObjective]] = Field(default=None, description="AdditionalObjectives represents metrics that are optimized together with the objective metric in the multi-objective Experiment. Each metric has its own optimization type. Additional objectives are collected from Trials as the additional metrics.", alias="additionalObjectives")
     goal: Optional[Union[StrictFloat, StrictInt]] = Field(default=None, description="Goal is the Experiment's objective goal that should be reached. In case of empty goal, Experiment is running until MaxTrialCount = TrialsSucceeded.")
     metric_strategies: Optional[List[V1beta1MetricStrategy]] = Field(default=None, description="MetricStrategies defines various rules (min, max or latest) to extract metrics values. This field is allowed to missing, experiment defaulter (webhook) will fill it.", alias="metricStrategies")
     objective_metric_name: Optional[StrictStr] = Field(default=None, description="ObjectiveMetricName represents primary Experiment's metric to optimize.", alias="objectiveMetricName")
     type: Optional[StrictStr] = Field(default=None, description="Type for Experiment optimization.")
-    __properties: ClassVar[List[str]] = ["additionalMetricNames", "goal", "metricStrategies", "objectiveMetricName", "type"]
+    __properties: ClassVar[List[str]] = ["additionalMetricNames", "additionalObjectives", "goal", "metricStrategies", "objectiveMetricName", "type"]
 
     model_config = ConfigDict(
         populate_by_name=True,
@@ -73,6 +75,13 @@ class V1beta1ObjectiveSpec(BaseModel):
             exclude=excluded_fields,
             exclude_none=True,
         )
+        # override the default output from pydantic by calling `to_dict()` of each item in additional_objectives (list)
+        _items = []
+        if self.additional_objectives:
+            for _item_additional_objectives in self.additional_objectives:
+                if _item_additional_objectives:
+                    _items.append(_item_additional_objectives.to_dict())
+            _dict['additionalObjectives'] = _items
         # override the default output from pydantic by calling `to_dict()` of each item in metric_strategies (list)
         _items = []
         if self.metric_strategies:
@@ -93,6 +102,7 @@ class V1beta1ObjectiveSpec(BaseModel):
 
         _obj = cls.model_validate({
             "additionalMetricNames": obj.get("additionalMetricNames"),
+            "additionalObjectives": [V1beta1AdditionalObjective.from_dict(_item) for _item in obj["additionalObjectives"]] if obj.get("additionalObjectives") is not None else None,
             "goal": obj.get("goal"),
             "metricStrategies": [V1beta1MetricStrategy.from_dict(_item) for _item in obj["metricStrategies"]] if obj.get("metricStrategies") is not None else None,
             "objectiveMetricName": obj.get("objectiveMetricName"),
@@ -20,6 +20,7 @@ import json
 from pydantic import BaseModel, ConfigDict, Field, StrictStr
 from typing import Any, ClassVar, Dict, List, Optional
 from kubeflow_katib_api.models.v1beta1_feasible_space import V1beta1FeasibleSpace
+from kubeflow_katib_api.models.v1beta1_parameter_condition import V1beta1ParameterCondition
 from typing import Optional, Set
 from typing_extensions import Self
 
@@ -27,10 +28,11 @@ class V1beta1ParameterSpec(BaseModel):
     """
     V1beta1ParameterSpec
     """ # noqa: E501
+    condition: Optional[V1beta1ParameterCondition] = Field(default=None, description="Condition makes the parameter active only when the parent parameter has one of the given values. Inactive parameters are not sampled and not assigned to the Trial, their placeholders in the Trial template are replaced with empty values. If it is not set, the parameter is always active.")
     feasible_space: Optional[V1beta1FeasibleSpace] = Field(default=None, alias="feasibleSpace")
     name: Optional[StrictStr] = None
     parameter_type: Optional[StrictStr] = Field(default=None, alias="parameterType")
-    __properties: ClassVar[List[str]] = ["feasibleSpace", "name", "parameterType"]
+    __properties: ClassVar[List[str]] = ["condition", "feasibleSpace", "name", "parameterType"]
 
     model_config = ConfigDict(
         populate_by_name=True,
@@ -71,6 +73,9 @@ class V1beta1ParameterSpec(BaseModel):
             exclude=excluded_fields,
             exclude_none=True,
         )
+        # override the default output from pydantic by calling `to_dict()` of condition
+        if self.condition:
+            _dict['condition'] = self.condition.to_dict()
         # override the default output from pydantic by calling `to_dict()` of feasible_space
         if self.feasible_space:
             _dict['feasibleSpace'] = self.feasible_space.to_dict()
@@ -86,6 +91,7 @@ class V1beta1ParameterSpec(BaseModel):
             return cls.model_validate(obj)
 
         _obj = cls.model_validate({
+            "condition": V1beta1ParameterCondition.from_dict(obj["condition"]) if obj.get("condition") is not None else None,
             "feasibleSpace": V1beta1FeasibleSpace.from_dict(obj["feasibleSpace"]) if obj.get("feasibleSpace") is not None else None,
             "name": obj.get("name"),
             "parameterType": obj.get("parameterType")
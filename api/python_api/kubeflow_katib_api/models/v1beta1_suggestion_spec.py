@@ -17,7 +17,7 @@ import pprint
 import re  # noqa: F401
 import json
 
-from pydantic import BaseModel, ConfigDict, Field, StrictInt, StrictStr
+from pydantic import BaseModel, ConfigDict, Field, StrictBool, StrictInt, StrictStr
 from typing import Any, ClassVar, Dict, List, Optional
 from kubeflow_katib_api.models.v1beta1_algorithm_spec import V1beta1AlgorithmSpec
 from kubeflow_katib_api.models.v1beta1_early_stopping_spec import V1beta1EarlyStoppingSpec
@@ -32,7 +32,8 @@ class V1beta1SuggestionSpec(BaseModel):
     early_stopping: Optional[V1beta1EarlyStoppingSpec] = Field(default=None, description="EarlyStopping describes early stopping algorithm that suggestion is used.", alias="earlyStopping")
     requests: Optional[StrictInt] = Field(default=None, description="Number of suggestions requested.")
     resume_policy: Optional[StrictStr] = Field(default=None, description="ResumePolicy describes resuming policy which usually take effect after experiment terminated. Default value is Never.", alias="resumePolicy")
-    __properties: ClassVar[List[str]] = ["algorithm", "earlyStopping", "requests", "resumePolicy"]
+    suspend: Optional[StrictBool] = Field(default=None, description="Suspend indicates that the Experiment is suspended. Suggestion deployment is scaled down and no assignments are requested while it is true.")
+    __properties: ClassVar[List[str]] = ["algorithm", "earlyStopping", "requests", "resumePolicy", "suspend"]
 
     model_config = ConfigDict(
         populate_by_name=True,
@@ -94,7 +95,8 @@ class V1beta1SuggestionSpec(BaseModel):
             "algorithm": V1beta1AlgorithmSpec.from_dict(obj["algorithm"]) if obj.get("algorithm") is not None else None,
             "earlyStopping": V1beta1EarlyStoppingSpec.from_dict(obj["earlyStopping"]) if obj.get("earlyStopping") is not None else None,
             "requests": obj.get("requests"),
-            "resumePolicy": obj.get("resumePolicy")
+            "resumePolicy": obj.get("resumePolicy"),
+            "suspend": obj.get("suspend")
         })
         return _obj
 
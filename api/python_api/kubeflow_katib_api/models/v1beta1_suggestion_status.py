@@ -34,10 +34,12 @@ class V1beta1SuggestionStatus(BaseModel):
     completion_time: Optional[datetime] = Field(default=None, description="Represents time when the Suggestion was completed. It is not guaranteed to be set in happens-before order across separate operations. It is represented in RFC3339 form and is in UTC.", alias="completionTime")
     conditions: Optional[List[V1beta1SuggestionCondition]] = Field(default=None, description="List of observed runtime conditions for this Suggestion.")
     last_reconcile_time: Optional[datetime] = Field(default=None, description="Represents last time when the Suggestion was reconciled. It is not guaranteed to be set in happens-before order across separate operations. It is represented in RFC3339 form and is in UTC.", alias="lastReconcileTime")
+    rejected_round_count: Optional[StrictInt] = Field(default=None, description="Number of consecutive rounds in which all suggested parameter assignments were rejected. Suggestion is exhausted when it reaches the limit, since the algorithm may never report the exhausted search space, e.g. random with the small discrete space.", alias="rejectedRoundCount")
+    rejected_suggestion_count: Optional[StrictInt] = Field(default=None, description="Number of suggested parameter assignments which were rejected, because they violate the parameter constraints or duplicate the earlier assignments. They are counted in the total request number, so algorithms which enumerate the search space, e.g. grid, don't suggest them again.", alias="rejectedSuggestionCount")
     start_time: Optional[datetime] = Field(default=None, description="Represents time when the Suggestion was acknowledged by the Suggestion controller. It is not guaranteed to be set in happens-before order across separate operations. It is represented in RFC3339 form and is in UTC.", alias="startTime")
     suggestion_count: Optional[StrictInt] = Field(default=None, description="Number of suggestion results", alias="suggestionCount")
     suggestions: Optional[List[V1beta1TrialAssignment]] = Field(default=None, description="Suggestion results")
-    __properties: ClassVar[List[str]] = ["algorithmSettings", "completionTime", "conditions", "lastReconcileTime", "startTime", "suggestionCount", "suggestions"]
+    __properties: ClassVar[List[str]] = ["algorithmSettings", "completionTime", "conditions", "lastReconcileTime", "rejectedRoundCount", "rejectedSuggestionCount", "startTime", "suggestionCount", "suggestions"]
 
     model_config = ConfigDict(
         populate_by_name=True,
@@ -115,6 +117,8 @@ class V1beta1SuggestionStatus(BaseModel):
             "completionTime": obj.get("completionTime"),
             "conditions": [V1beta1SuggestionCondition.from_dict(_item) for _item in obj["conditions"]] if obj.get("conditions") is not None else None,
             "lastReconcileTime": obj.get("lastReconcileTime"),
+            "rejectedRoundCount": obj.get("rejectedRoundCount"),
+            "rejectedSuggestionCount": obj.get("rejectedSuggestionCount"),
             "startTime": obj.get("startTime"),
             "suggestionCount": obj.get("suggestionCount"),
             "suggestions": [V1beta1TrialAssignment.from_dict(_item) for _item in obj["suggestions"]] if obj.get("suggestions") is not None else None
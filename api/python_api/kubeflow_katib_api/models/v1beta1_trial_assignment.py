@@ -28,11 +28,13 @@ class V1beta1TrialAssignment(BaseModel):
     """
     TrialAssignment is the assignment for one trial.
     """ # noqa: E501
+    budget: Optional[StrictStr] = Field(default=None, description="Budget of the Trial for multi-fidelity algorithms, e.g. number of epochs or dataset fraction. It is not a hyperparameter and it is referenced in the Trial template by ${trialSpec.Budget}.")
+    duplicate_of: Optional[StrictStr] = Field(default=None, description="Name of the earlier suggestion with the same parameter assignments. It is set only if the duplicated assignments are reused, see Experiment spec.duplicateSuggestion.", alias="duplicateOf")
     early_stopping_rules: Optional[List[V1beta1EarlyStoppingRule]] = Field(default=None, description="Rules for early stopping techniques Contains rule name, value and comparison type", alias="earlyStoppingRules")
     labels: Optional[Dict[str, StrictStr]] = Field(default=None, description="Suggestion label metadata to attach to Trial job")
     name: Optional[StrictStr] = Field(default=None, description="Name of the suggestion")
     parameter_assignments: Optional[List[V1beta1ParameterAssignment]] = Field(default=None, description="Suggestion results with Trial parameters", alias="parameterAssignments")
-    __properties: ClassVar[List[str]] = ["earlyStoppingRules", "labels", "name", "parameterAssignments"]
+    __properties: ClassVar[List[str]] = ["budget", "duplicateOf", "earlyStoppingRules", "labels", "name", "parameterAssignments"]
 
     model_config = ConfigDict(
         populate_by_name=True,
@@ -99,6 +101,8 @@ class V1beta1TrialAssignment(BaseModel):
             return cls.model_validate(obj)
 
         _obj = cls.model_validate({
+            "budget": obj.get("budget"),
+            "duplicateOf": obj.get("duplicateOf"),
             "earlyStoppingRules": [V1beta1EarlyStoppingRule.from_dict(_item) for _item in obj["earlyStoppingRules"]] if obj.get("earlyStoppingRules") is not None else None,
             "labels": obj.get("labels"),
             "name": obj.get("name"),
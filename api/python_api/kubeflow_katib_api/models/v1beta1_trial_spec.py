@@ -17,12 +17,14 @@ import pprint
 import re  # noqa: F401
 import json
 
-from pydantic import BaseModel, ConfigDict, Field, StrictBool, StrictStr
+from pydantic import BaseModel, ConfigDict, Field, StrictBool, StrictInt, StrictStr
 from typing import Any, ClassVar, Dict, List, Optional
 from kubeflow_katib_api.models.v1beta1_early_stopping_rule import V1beta1EarlyStoppingRule
 from kubeflow_katib_api.models.v1beta1_metrics_collector_spec import V1beta1MetricsCollectorSpec
 from kubeflow_katib_api.models.v1beta1_objective_spec import V1beta1ObjectiveSpec
 from kubeflow_katib_api.models.v1beta1_parameter_assignment import V1beta1ParameterAssignment
+from kubeflow_katib_api.models.v1beta1_retry_policy import V1beta1RetryPolicy
+from kubeflow_katib_api.models.v1beta1_trial_cache_spec import V1beta1TrialCacheSpec
 from typing import Optional, Set
 from typing_extensions import Self
 
@@ -30,18 +32,24 @@ class V1beta1TrialSpec(BaseModel):
     """
     TrialSpec is the specification of a Trial.
     """ # noqa: E501
+    active_deadline_seconds: Optional[StrictInt] = Field(default=None, description="Duration in seconds relative to the Trial run creation time that the run may be active.", alias="activeDeadlineSeconds")
+    budget: Optional[StrictStr] = Field(default=None, description="Budget of the Trial for multi-fidelity algorithms, e.g. number of epochs or dataset fraction.")
+    cache: Optional[V1beta1TrialCacheSpec] = Field(default=None, description="Describes how the results of the earlier Trials with the same fingerprint are reused. If it is set and the succeeded Trial with the same fingerprint exists, the Trial run is not created and the observation log of that Trial is copied.")
     early_stopping_rules: Optional[List[V1beta1EarlyStoppingRule]] = Field(default=None, description="Rules for early stopping techniques. Each rule should be met to early stop Trial.", alias="earlyStoppingRules")
     failure_condition: Optional[StrictStr] = Field(default=None, description="Condition when trial custom resource is failed. Condition must be in GJSON format, ref https://github.com/tidwall/gjson. For example for BatchJob: status.conditions.#(type==\"Failed\")#|#(status==\"True\")#", alias="failureCondition")
     labels: Optional[Dict[str, StrictStr]] = Field(default=None, description="Labels that provide additional metadata for services (e.g. Suggestions tracking)")
     metrics_collector: Optional[V1beta1MetricsCollectorSpec] = Field(default=None, description="Describes how metrics will be collected", alias="metricsCollector")
     objective: Optional[V1beta1ObjectiveSpec] = Field(default=None, description="Describes the objective of the experiment.")
     parameter_assignments: Optional[List[V1beta1ParameterAssignment]] = Field(default=None, description="Key-value pairs for hyperparameters and assignment values.", alias="parameterAssignments")
+    pending_deadline_seconds: Optional[StrictInt] = Field(default=None, description="Duration in seconds relative to the Trial run creation time that the run may wait until any of its pods is running.", alias="pendingDeadlineSeconds")
     primary_container_name: Optional[StrictStr] = Field(default=None, description="Name of training container where actual model training is running", alias="primaryContainerName")
     primary_pod_labels: Optional[Dict[str, StrictStr]] = Field(default=None, description="Label that determines if pod needs to be injected by Katib sidecar container", alias="primaryPodLabels")
     retain_run: Optional[StrictBool] = Field(default=None, description="Whether to retain the trial run object after completed.", alias="retainRun")
+    retry_policy: Optional[V1beta1RetryPolicy] = Field(default=None, description="Describes how the failed Trial run is retried.", alias="retryPolicy")
+    reuse_observation_from: Optional[StrictStr] = Field(default=None, description="Name of the Trial in the same namespace which results are reused. If it is set, the Trial run is not created and the Trial is completed with the observation of the referenced Trial once it is completed.", alias="reuseObservationFrom")
     run_spec: Optional[Dict[str, Any]] = Field(default=None, description="Raw text for the trial run spec. This can be any generic Kubernetes runtime object. The trial operator should create the resource as written, and let the corresponding resource controller (e.g. Kubeflow Training Operator) handle the rest.", alias="runSpec")
     success_condition: Optional[StrictStr] = Field(default=None, description="Condition when trial custom resource is succeeded. Condition must be in GJSON format, ref https://github.com/tidwall/gjson. For example for BatchJob: status.conditions.#(type==\"Complete\")#|#(status==\"True\")#", alias="successCondition")
-    __properties: ClassVar[List[str]] = ["earlyStoppingRules", "failureCondition", "labels", "metricsCollector", "objective", "parameterAssignments", "primaryContainerName", "primaryPodLabels", "retainRun", "runSpec", "successCondition"]
+    __properties: ClassVar[List[str]] = ["activeDeadlineSeconds", "budget", "cache", "earlyStoppingRules", "failureCondition", "labels", "metricsCollector", "objective", "parameterAssignments", "pendingDeadlineSeconds", "primaryContainerName", "primaryPodLabels", "retainRun", "retryPolicy", "reuseObservationFrom", "runSpec", "successCondition"]
 
     model_config = ConfigDict(
         populate_by_name=True,
@@ -82,6 +90,9 @@ class V1beta1TrialSpec(BaseModel):
             exclude=excluded_fields,
             exclude_none=True,
         )
+        # override the default output from pydantic by calling `to_dict()` of cache
+        if self.cache:
+            _dict['cache'] = self.cache.to_dict()
         # override the default output from pydantic by calling `to_dict()` of each item in early_stopping_rules (list)
         _items = []
         if self.early_stopping_rules:
@@ -102,6 +113,9 @@ class V1beta1TrialSpec(BaseModel):
                 if _item_parameter_assignments:
                     _items.append(_item_parameter_assignments.to_dict())
             _dict['parameterAssignments'] = _items
+        # override the default output from pydantic by calling `to_dict()` of retry_policy
+        if self.retry_policy:
+            _dict['retryPolicy'] = self.retry_policy.to_dict()
         return _dict
 
     @classmethod
@@ -114,15 +128,21 @@ class V1beta1TrialSpec(BaseModel):
             return cls.model_validate(obj)
 
         _obj = cls.model_validate({
+            "activeDeadlineSeconds": obj.get("activeDeadlineSeconds"),
+            "budget": obj.get("budget"),
+            "cache": V1beta1TrialCacheSpec.from_dict(obj["cache"]) if obj.get("cache") is not None else None,
             "earlyStoppingRules": [V1beta1EarlyStoppingRule.from_dict(_item) for _item in obj["earlyStoppingRules"]] if obj.get("earlyStoppingRules") is not None else None,
             "failureCondition": obj.get("failureCondition"),
             "labels": obj.get("labels"),
             "metricsCollector": V1beta1MetricsCollectorSpec.from_dict(obj["metricsCollector"]) if obj.get("metricsCollector") is not None else None,
             "objective": V1beta1ObjectiveSpec.from_dict(obj["objective"]) if obj.get("objective") is not None else None,
             "parameterAssignments": [V1beta1ParameterAssignment.from_dict(_item) for _item in obj["parameterAssignments"]] if obj.get("parameterAssignments") is not None else None,
+            "pendingDeadlineSeconds": obj.get("pendingDeadlineSeconds"),
             "primaryContainerName": obj.get("primaryContainerName"),
             "primaryPodLabels": obj.get("primaryPodLabels"),
             "retainRun": obj.get("retainRun"),
+            "retryPolicy": V1beta1RetryPolicy.from_dict(obj["retryPolicy"]) if obj.get("retryPolicy") is not None else None,
+            "reuseObservationFrom": obj.get("reuseObservationFrom"),
             "runSpec": obj.get("runSpec"),
             "successCondition": obj.get("successCondition")
         })
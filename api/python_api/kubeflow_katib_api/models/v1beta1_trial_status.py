@@ -21,6 +21,7 @@ from datetime import datetime
 from pydantic import BaseModel, ConfigDict, Field
 from typing import Any, ClassVar, Dict, List, Optional
 from kubeflow_katib_api.models.v1beta1_observation import V1beta1Observation
+from kubeflow_katib_api.models.v1beta1_trial_attempt import V1beta1TrialAttempt
 from kubeflow_katib_api.models.v1beta1_trial_condition import V1beta1TrialCondition
 from typing import Optional, Set
 from typing_extensions import Self
@@ -29,12 +30,13 @@ class V1beta1TrialStatus(BaseModel):
     """
     TrialStatus is the current status of a Trial.
     """ # noqa: E501
+    attempts: Optional[List[V1beta1TrialAttempt]] = Field(default=None, description="History of the failed Trial runs which have been retried.")
     completion_time: Optional[datetime] = Field(default=None, description="Represents time when the Trial was completed. It is not guaranteed to be set in happens-before order across separate operations. It is represented in RFC3339 form and is in UTC", alias="completionTime")
     conditions: Optional[List[V1beta1TrialCondition]] = Field(default=None, description="List of observed runtime conditions for this Trial.")
     last_reconcile_time: Optional[datetime] = Field(default=None, description="Represents last time when the Trial was reconciled. It is not guaranteed to be set in happens-before order across separate operations. It is represented in RFC3339 form and is in UTC.", alias="lastReconcileTime")
     observation: Optional[V1beta1Observation] = Field(default=None, description="Results of the Trial - objectives and other metrics values.")
     start_time: Optional[datetime] = Field(default=None, description="Represents time when the Trial was acknowledged by the Trial controller. It is not guaranteed to be set in happens-before order across separate operations. It is represented in RFC3339 form and is in UTC", alias="startTime")
-    __properties: ClassVar[List[str]] = ["completionTime", "conditions", "lastReconcileTime", "observation", "startTime"]
+    __properties: ClassVar[List[str]] = ["attempts", "completionTime", "conditions", "lastReconcileTime", "observation", "startTime"]
 
     model_config = ConfigDict(
         populate_by_name=True,
@@ -75,6 +77,13 @@ class V1beta1TrialStatus(BaseModel):
             exclude=excluded_fields,
             exclude_none=True,
         )
+        # override the default output from pydantic by calling `to_dict()` of each item in attempts (list)
+        _items = []
+        if self.attempts:
+            for _item_attempts in self.attempts:
+                if _item_attempts:
+                    _items.append(_item_attempts.to_dict())
+            _dict['attempts'] = _items
         # override the default output from pydantic by calling `to_dict()` of each item in conditions (list)
         _items = []
         if self.conditions:
@@ -97,6 +106,7 @@ class V1beta1TrialStatus(BaseModel):
             return cls.model_validate(obj)
 
         _obj = cls.model_validate({
+            "attempts": [V1beta1TrialAttempt.from_dict(_item) for _item in obj["attempts"]] if obj.get("attempts") is not None else None,
             "completionTime": obj.get("completionTime"),
             "conditions": [V1beta1TrialCondition.from_dict(_item) for _item in obj["conditions"]] if obj.get("conditions") is not None else None,
             "lastReconcileTime": obj.get("lastReconcileTime"),
@@ -17,9 +17,10 @@ import pprint
 import re  # noqa: F401
 import json
 
-from pydantic import BaseModel, ConfigDict, Field, StrictBool, StrictStr
+from pydantic import BaseModel, ConfigDict, Field, StrictBool, StrictInt, StrictStr
 from typing import Any, ClassVar, Dict, List, Optional
 from kubeflow_katib_api.models.v1beta1_config_map_source import V1beta1ConfigMapSource
+from kubeflow_katib_api.models.v1beta1_retry_policy import V1beta1RetryPolicy
 from kubeflow_katib_api.models.v1beta1_trial_parameter_spec import V1beta1TrialParameterSpec
 from typing import Optional, Set
 from typing_extensions import Self
@@ -28,15 +29,18 @@ class V1beta1TrialTemplate(BaseModel):
     """
     TrialTemplate describes structure of trial template
     """ # noqa: E501
+    active_deadline_seconds: Optional[StrictInt] = Field(default=None, description="Duration in seconds relative to the trial run creation time that the run may be active. Once it is exceeded, the run is deleted and the trial is failed with the DeadlineExceeded reason.", alias="activeDeadlineSeconds")
     config_map: Optional[V1beta1ConfigMapSource] = Field(default=None, description="ConfigMap spec represents a reference to ConfigMap", alias="configMap")
     failure_condition: Optional[StrictStr] = Field(default=None, description="Condition when trial custom resource is failed. Condition must be in GJSON format, ref https://github.com/tidwall/gjson. For example for BatchJob: status.conditions.#(type==\"Failed\")#|#(status==\"True\")#", alias="failureCondition")
+    pending_deadline_seconds: Optional[StrictInt] = Field(default=None, description="Duration in seconds relative to the trial run creation time that the run may wait until any of its pods is running. Once it is exceeded, the run is deleted and the trial is failed with the DeadlineExceeded reason.", alias="pendingDeadlineSeconds")
     primary_container_name: Optional[StrictStr] = Field(default=None, description="Name of training container where actual model training is running", alias="primaryContainerName")
     primary_pod_labels: Optional[Dict[str, StrictStr]] = Field(default=None, description="Labels that determines if pod needs to be injected by Katib sidecar container. If PrimaryPodLabels is omitted, metrics collector wraps all Trial's pods.", alias="primaryPodLabels")
     retain: Optional[StrictBool] = Field(default=None, description="Retain indicates that trial resources must be not cleanup")
+    retry_policy: Optional[V1beta1RetryPolicy] = Field(default=None, description="Describes how the failed trial run is retried. If it is not set, the failed trial run is not retried.", alias="retryPolicy")
     success_condition: Optional[StrictStr] = Field(default=None, description="Condition when trial custom resource is succeeded. Condition must be in GJSON format, ref https://github.com/tidwall/gjson. For example for BatchJob: status.conditions.#(type==\"Complete\")#|#(status==\"True\")#", alias="successCondition")
     trial_parameters: Optional[List[V1beta1TrialParameterSpec]] = Field(default=None, description="List of parameters that are used in trial template", alias="trialParameters")
     trial_spec: Optional[Dict[str, Any]] = Field(default=None, description="TrialSpec represents trial template in unstructured format", alias="trialSpec")
-    __properties: ClassVar[List[str]] = ["configMap", "failureCondition", "primaryContainerName", "primaryPodLabels", "retain", "successCondition", "trialParameters", "trialSpec"]
+    __properties: ClassVar[List[str]] = ["activeDeadlineSeconds", "configMap", "failureCondition", "pendingDeadlineSeconds", "primaryContainerName", "primaryPodLabels", "retain", "retryPolicy", "successCondition", "trialParameters", "trialSpec"]
 
     model_config = ConfigDict(
         populate_by_name=True,
@@ -80,6 +84,9 @@ class V1beta1TrialTemplate(BaseModel):
         # override the default output from pydantic by calling `to_dict()` of config_map
         if self.config_map:
             _dict['configMap'] = self.config_map.to_dict()
+        # override the default output from pydantic by calling `to_dict()` of retry_policy
+        if self.retry_policy:
+            _dict['retryPolicy'] = self.retry_policy.to_dict()
         # override the default output from pydantic by calling `to_dict()` of each item in trial_parameters (list)
         _items = []
         if self.trial_parameters:
@@ -99,11 +106,14 @@ class V1beta1TrialTemplate(BaseModel):
             return cls.model_validate(obj)
 
         _obj = cls.model_validate({
+            "activeDeadlineSeconds": obj.get("activeDeadlineSeconds"),
             "configMap": V1beta1ConfigMapSource.from_dict(obj["configMap"]) if obj.get("configMap") is not None else None,
             "failureCondition": obj.get("failureCondition"),
+            "pendingDeadlineSeconds": obj.get("pendingDeadlineSeconds"),
             "primaryContainerName": obj.get("primaryContainerName"),
             "primaryPodLabels": obj.get("primaryPodLabels"),
             "retain": obj.get("retain"),
+            "retryPolicy": V1beta1RetryPolicy.from_dict(obj["retryPolicy"]) if obj.get("retryPolicy") is not None else None,
             "successCondition": obj.get("successCondition"),
             "trialParameters": [V1beta1TrialParameterSpec.from_dict(_item) for _item in obj["trialParameters"]] if obj.get("trialParameters") is not None else None,
             "trialSpec": obj.get("trialSpec")
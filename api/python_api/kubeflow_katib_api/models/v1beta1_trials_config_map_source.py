@@ -0,0 +1,91 @@
+# coding: utf-8
+
+"""
+    Kubeflow Katib OpenAPI Spec
+
+    No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+
+    The version of the OpenAPI document: unversioned
+    Generated by OpenAPI Generator (https://openapi-generator.tech)
+
+    Do not edit the class manually.
+"""  # noqa: E501
+
+
+from __future__ import annotations
+import pprint
+import re  # noqa: F401
+import json
+
+from pydantic import BaseModel, ConfigDict, Field, StrictStr
+from typing import Any, ClassVar, Dict, List, Optional
+from typing import Optional, Set
+from typing_extensions import Self
+
+class V1beta1TrialsConfigMapSource(BaseModel):
+    """
+    TrialsConfigMapSource references the config map where the exported Trials are located
+    """ # noqa: E501
+    config_map_name: Optional[StrictStr] = Field(default=None, description="Name of config map where the Trials are located", alias="configMapName")
+    config_map_namespace: Optional[StrictStr] = Field(default=None, description="Namespace of config map where the Trials are located. Defaults to the Experiment namespace.", alias="configMapNamespace")
+    trials_path: Optional[StrictStr] = Field(default=None, description="Path in config map where the Trials are located. Trials must be in the JSON format of the Trial list, for example the output of \"kubectl get trials -l katib.kubeflow.org/experiment=<name> -o json\".", alias="trialsPath")
+    __properties: ClassVar[List[str]] = ["configMapName", "configMapNamespace", "trialsPath"]
+
+    model_config = ConfigDict(
+        populate_by_name=True,
+        validate_assignment=True,
+        protected_namespaces=(),
+    )
+
+
+    def to_str(self) -> str:
+        """Returns the string representation of the model using alias"""
+        return pprint.pformat(self.model_dump(by_alias=True))
+
+    def to_json(self) -> str:
+        """Returns the JSON representation of the model using alias"""
+        # TODO: pydantic v2: use .model_dump_json(by_alias=True, exclude_unset=True) instead
+        return json.dumps(self.to_dict())
+
+    @classmethod
+    def from_json(cls, json_str: str) -> Optional[Self]:
+        """Create an instance of V1beta1TrialsConfigMapSource from a JSON string"""
+        return cls.from_dict(json.loads(json_str))
+
+    def to_dict(self) -> Dict[str, Any]:
+        """Return the dictionary representation of the model using alias.
+
+        This has the following differences from calling pydantic's
+        `self.model_dump(by_alias=True)`:
+
+        * `None` is only added to the output dict for nullable fields that
+          were set at model initialization. Other fields with value `None`
+          are ignored.
+        """
+        excluded_fields: Set[str] = set([
+        ])
+
+        _dict = self.model_dump(
+            by_alias=True,
+            exclude=excluded_fields,
+            exclude_none=True,
+        )
+        return _dict
+
+    @classmethod
+    def from_dict(cls, obj: Optional[Dict[str, Any]]) -> Optional[Self]:
+        """Create an instance of V1beta1TrialsConfigMapSource from a dict"""
+        if obj is None:
+            return None
+
+        if not isinstance(obj, dict):
+            return cls.model_validate(obj)
+
+        _obj = cls.model_validate({
+            "configMapName": obj.get("configMapName"),
+            "configMapNamespace": obj.get("configMapNamespace"),
+            "trialsPath": obj.get("trialsPath")
+        })
+        return _obj
+
+
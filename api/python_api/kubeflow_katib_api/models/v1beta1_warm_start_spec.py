@@ -0,0 +1,93 @@
+# coding: utf-8
+
+"""
+    Kubeflow Katib OpenAPI Spec
+
+    No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+
+    The version of the OpenAPI document: unversioned
+    Generated by OpenAPI Generator (https://openapi-generator.tech)
+
+    Do not edit the class manually.
+"""  # noqa: E501
+
+
+from __future__ import annotations
+import pprint
+import re  # noqa: F401
+import json
+
+from pydantic import BaseModel, ConfigDict, Field, StrictStr
+from typing import Any, ClassVar, Dict, List, Optional
+from kubeflow_katib_api.models.v1beta1_trials_config_map_source import V1beta1TrialsConfigMapSource
+from typing import Optional, Set
+from typing_extensions import Self
+
+class V1beta1WarmStartSpec(BaseModel):
+    """
+    WarmStartSpec describes the sources of the prior Trials
+    """ # noqa: E501
+    source_experiments: Optional[List[StrictStr]] = Field(default=None, description="Names of the Experiments in the Experiment namespace which Trials are used as the prior history", alias="sourceExperiments")
+    trials_config_map: Optional[V1beta1TrialsConfigMapSource] = Field(default=None, description="Reference to the config map where the exported Trials are located", alias="trialsConfigMap")
+    __properties: ClassVar[List[str]] = ["sourceExperiments", "trialsConfigMap"]
+
+    model_config = ConfigDict(
+        populate_by_name=True,
+        validate_assignment=True,
+        protected_namespaces=(),
+    )
+
+
+    def to_str(self) -> str:
+        """Returns the string representation of the model using alias"""
+        return pprint.pformat(self.model_dump(by_alias=True))
+
+    def to_json(self) -> str:
+        """Returns the JSON representation of the model using alias"""
+        # TODO: pydantic v2: use .model_dump_json(by_alias=True, exclude_unset=True) instead
+        return json.dumps(self.to_dict())
+
+    @classmethod
+    def from_json(cls, json_str: str) -> Optional[Self]:
+        """Create an instance of V1beta1WarmStartSpec from a JSON string"""
+        return cls.from_dict(json.loads(json_str))
+
+    def to_dict(self) -> Dict[str, Any]:
+        """Return the dictionary representation of the model using alias.
+
+        This has the following differences from calling pydantic's
+        `self.model_dump(by_alias=True)`:
+
+        * `None` is only added to the output dict for nullable fields that
+          were set at model initialization. Other fields with value `None`
+          are ignored.
+        """
+        excluded_fields: Set[str] = set([
+        ])
+
+        _dict = self.model_dump(
+            by_alias=True,
+            exclude=excluded_fields,
+            exclude_none=True,
+        )
+        # override the default output from pydantic by calling `to_dict()` of trials_config_map
+        if self.trials_config_map:
+            _dict['trialsConfigMap'] = self.trials_config_map.to_dict()
+        return _dict
+
+    @classmethod
+    def from_dict(cls, obj: Optional[Dict[str, Any]]) -> Optional[Self]:
+        """Create an instance of V1beta1WarmStartSpec from a dict"""
+        if obj is None:
+            return None
+
+        if not isinstance(obj, dict):
+            return cls.model_validate(obj)
+
+        _obj = cls.model_validate({
+            "sourceExperiments": obj.get("sourceExperiments"),
+            "trialsConfigMap": V1beta1TrialsConfigMapSource.from_dict(obj["trialsConfigMap"]) if obj.get("trialsConfigMap") is not None else None
+        })
+        return _obj
+
+
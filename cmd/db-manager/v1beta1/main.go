@@ -49,13 +49,13 @@ type server struct {
 // Katib store every log of metrics.
 // You can see accuracy curve or other metric logs on UI.
 func (s *server) ReportObservationLog(ctx context.Context, in *api_pb.ReportObservationLogRequest) (*api_pb.ReportObservationLogReply, error) {
-	err := dbIf.RegisterObservationLog(in.TrialName, in.ObservationLog)
+	err := dbIf.RegisterObservationLog(in.TrialName, in.ObservationLog, in.IdempotencyKey)
 	return &api_pb.ReportObservationLogReply{}, err
 }
 
 // Get all log of Observations for a Trial.
 func (s *server) GetObservationLog(ctx context.Context, in *api_pb.GetObservationLogRequest) (*api_pb.GetObservationLogReply, error) {
-	ol, err := dbIf.GetObservationLog(in.TrialName, in.MetricName, in.StartTime, in.EndTime)
+	ol, err := dbIf.GetObservationLog(in.TrialName, in.MetricName, in.StartTime, in.EndTime, in.StartStep, in.EndStep)
 	return &api_pb.GetObservationLogReply{
 		ObservationLog: ol,
 	}, err
@@ -69,7 +69,7 @@ func TestReportObservationLog(t *testing.T) {
 			},
 		},
 	}
-	mockDB.EXPECT().RegisterObservationLog(req.TrialName, req.ObservationLog).Return(nil)
+	mockDB.EXPECT().RegisterObservationLog(req.TrialName, req.ObservationLog, req.IdempotencyKey).Return(nil)
 	_, err := s.ReportObservationLog(context.Background(), req)
 	if err != nil {
 		t.Fatalf("ReportObservationLog Error %v", err)
@@ -122,7 +122,7 @@ func TestGetObservationLog(t *testing.T) {
 		},
 	}
 
-	mockDB.EXPECT().GetObservationLog(req.TrialName, req.MetricName, req.StartTime, req.EndTime).Return(obs, nil)
+	mockDB.EXPECT().GetObservationLog(req.TrialName, req.MetricName, req.StartTime, req.EndTime, req.StartStep, req.EndStep).Return(obs, nil)
 	ret, err := s.GetObservationLog(context.Background(), req)
 	if err != nil {
 		t.Fatalf("GetObservationLog Error %v", err)
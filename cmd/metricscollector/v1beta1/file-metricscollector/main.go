@@ -33,15 +33,15 @@ For example, the objective value name is F1 and the metrics are loss, your train
      F1=0.7
      ---
 The metrics collector will collect all logs of metrics.
+The parsed metrics are reported periodically during the training, so the learning curves are available in the DB.
+If the metrics file pattern is set, the metrics are collected from all files in the metrics directory which match the pattern.
 */
 
 package main
 
 import (
 	"context"
-	"encoding/json"
 	"flag"
-	"fmt"
 	"os"
 	"path/filepath"
 	"regexp"
@@ -61,57 +61,24 @@ import (
 	filemc "github.com/kubeflow/katib/pkg/metricscollector/v1beta1/file-metricscollector"
 )
 
-type stopRulesFlag []commonv1beta1.EarlyStoppingRule
-
-func (flag *stopRulesFlag) String() string {
-	stopRuleStrings := []string{}
-	for _, r := range *flag {
-		stopRuleStrings = append(stopRuleStrings, r.Name)
-		stopRuleStrings = append(stopRuleStrings, r.Value)
-		stopRuleStrings = append(stopRuleStrings, string(r.Comparison))
-		stopRuleStrings = append(stopRuleStrings, strconv.Itoa(r.StartStep))
-	}
-	return strings.Join(stopRuleStrings, ";")
-}
-
-func (flag *stopRulesFlag) Set(value string) error {
-	stopRuleParsed := strings.Split(value, ";")
-	if len(stopRuleParsed) != 4 {
-		return fmt.Errorf("Invalid Early Stopping rule: %v", value)
-	}
-
-	// Get int start step.
-	startStep, err := strconv.Atoi(stopRuleParsed[3])
-	if err != nil {
-		klog.Fatalf("Parse start step: %v to int error: %v", stopRuleParsed[3], err)
-	}
-
-	// For each stop rule this order: 1 - metric name, 2 - metric value, 3 - comparison type, 4 - start step.
-	// Start step is equal to 0, if it's not defined.
-	stopRule := commonv1beta1.EarlyStoppingRule{
-		Name:       stopRuleParsed[0],
-		Value:      stopRuleParsed[1],
-		Comparison: commonv1beta1.ComparisonType(stopRuleParsed[2]),
-		StartStep:  startStep,
-	}
-
-	*flag = append(*flag, stopRule)
-	return nil
-}
-
 var (
 	dbManagerServiceAddr = flag.String("s-db", "", "Katib DB Manager service endpoint")
 	earlyStopServiceAddr = flag.String("s-earlystop", "", "Katib Early Stopping service endpoint")
 	trialName            = flag.String("t", "", "Trial Name")
 	metricsFilePath      = flag.String("path", "", "Metrics File Path")
 	metricsFileFormat    = flag.String("format", "", "Metrics File Format")
+	timestampKey         = flag.String("timestamp-key", "", "Key of the metrics timestamp in JSON format, nested keys are separated by dots")
+	timestampFormat      = flag.String("timestamp-format", "", "Go layout of the metrics timestamp string in JSON format")
+	metricsFilePattern   = flag.String("pattern", "", "Glob pattern of the metrics files in the metrics directory")
+	metricsAggregation   = flag.String("aggregation", "", "Aggregation of the metrics across the metrics files")
 	metricNames          = flag.String("m", "", "Metric names")
 	objectiveType        = flag.String("o-type", "", "Objective type")
 	metricFilters        = flag.String("f", "", "Metric filters")
 	pollInterval         = flag.Duration("p", common.DefaultPollInterval, "Poll interval between running processes check")
 	timeout              = flag.Duration("timeout", common.DefaultTimeout, "Timeout before invoke error during running processes check")
 	waitAllProcesses     = flag.String("w", common.DefaultWaitAllProcesses, "Whether wait for all other main process of container exiting")
-	stopRules            stopRulesFlag
+	flushInterval        = flag.Duration("flush-interval", common.DefaultFlushInterval, "Interval between reports of the parsed metrics, set 0 to report metrics only when training is completed")
+	stopRules            common.StopRulesFlag
 	isEarlyStopped       = false
 )
 
@@ -128,44 +95,109 @@ func checkMetricFile(mFile string) {
 	}
 }
 
-func printMetricsFile(mFile string) {
+// checkMetricFiles waits until any file in the metrics directory matches the pattern.
+func checkMetricFiles(mDirPath string, pattern string) {
+	for {
+		fileNames, err := filemc.GlobMetricsFiles(mDirPath, pattern)
+		if err != nil {
+			klog.Fatalf("Could not watch metrics files: %v", err)
+		}
+		if len(fileNames) > 0 {
+			break
+		}
+		time.Sleep(*pollInterval)
+	}
+}
+
+// getMetricsDirPath returns the directory of the metrics files, which contains the process markers.
+func getMetricsDirPath() string {
+	if *metricsFilePattern != "" {
+		return *metricsFilePath
+	}
+	return filepath.Dir(*metricsFilePath)
+}
+
+// metricsLine is the line of the metrics file with the source of its metrics.
+type metricsLine struct {
+	text   string
+	source string
+}
+
+// tailMetricsFile sends the lines of the metrics file to the channel.
+func tailMetricsFile(mFile string, source string, lines chan<- metricsLine) {
 
 	// Check that metric file exists.
 	checkMetricFile(mFile)
 
-	// Print lines from metrics file.
 	t, err := tail.TailFile(mFile, tail.Config{Follow: true, ReOpen: true})
 	if err != nil {
 		klog.Errorf("Failed to open metrics file: %v", err)
+		return
 	}
-
 	for line := range t.Lines {
-		klog.Info(line.Text)
+		lines <- metricsLine{text: line.Text, source: source}
 	}
 }
 
-func watchMetricsFile(mFile string, stopRules stopRulesFlag, filters []string, fileFormat commonv1beta1.FileFormat) {
+// tailMetricsFiles sends the lines of the metrics files in the directory which match the pattern to the channel.
+// The directory is checked on the poll interval, so the files which are created later are tailed as well.
+func tailMetricsFiles(mDirPath string, pattern string, lines chan<- metricsLine) {
+	tailed := map[string]bool{}
+	for {
+		fileNames, err := filemc.GlobMetricsFiles(mDirPath, pattern)
+		if err != nil {
+			klog.Fatalf("Failed to find metrics files: %v", err)
+		}
+		for _, fileName := range fileNames {
+			if tailed[fileName] {
+				continue
+			}
+			tailed[fileName] = true
+			klog.Infof("Collecting metrics from %s", fileName)
+			go tailMetricsFile(fileName, filemc.MetricsSource(mDirPath, fileName), lines)
+		}
+		time.Sleep(*pollInterval)
+	}
+}
+
+// parseMetricsLine returns the metric logs of the metrics line tagged with its source.
+func parseMetricsLine(line metricsLine, metrics []string, metricRegList []*regexp.Regexp, fileFormat commonv1beta1.FileFormat, jsonOpts filemc.JsonFormatOptions) ([]*api.MetricLog, error) {
+	mlogs, err := filemc.ParseLogLine(line.text, metrics, metricRegList, fileFormat, jsonOpts)
+	if err != nil {
+		return nil, err
+	}
+	for _, mlog := range mlogs {
+		mlog.Source = line.source
+	}
+	return mlogs, nil
+}
+
+func printMetricsFile(lines <-chan metricsLine, reporter *common.MetricLogReporter, metrics []string, filters []string, fileFormat commonv1beta1.FileFormat, jsonOpts filemc.JsonFormatOptions) {
 
-	// metricStartStep is the dict where key = metric name, value = start step.
-	// We should apply early stopping rule only if metric is reported at least "start_step" times.
-	metricStartStep := make(map[string]int)
-	for _, stopRule := range stopRules {
-		if stopRule.StartStep != 0 {
-			metricStartStep[stopRule.Name] = stopRule.StartStep
+	// Print lines from metrics files.
+	metricRegList := filemc.GetFilterRegexpList(filters)
+	for line := range lines {
+		klog.Info(line.text)
+		mlogs, err := parseMetricsLine(line, metrics, metricRegList, fileFormat, jsonOpts)
+		if err != nil {
+			klog.Warningf("Failed to parse metrics from log line: %v", err)
+			continue
 		}
+		reporter.Add(mlogs...)
 	}
+}
 
-	// For objective metric we calculate best optimal value from the recorded metrics.
-	// This is workaround for Median Stop algorithm.
-	// TODO (andreyvelich): Think about it, maybe define latest, max or min strategy type in stop-rule as well ?
-	var optimalObjValue *float64
+func watchMetricsFile(lines <-chan metricsLine, reporter *common.MetricLogReporter, stopRules *common.StopRules, metrics []string, filters []string, fileFormat commonv1beta1.FileFormat, jsonOpts filemc.JsonFormatOptions) {
 
-	// Check that metric file exists.
-	checkMetricFile(mFile)
+	// Check that metric files exist.
+	mDirPath := getMetricsDirPath()
+	if *metricsFilePattern != "" {
+		checkMetricFiles(mDirPath, *metricsFilePattern)
+	} else {
+		checkMetricFile(*metricsFilePath)
+	}
 
 	// Get Main process.
-	// Extract the metric file dir path based on the file name.
-	mDirPath, _ := filepath.Split(mFile)
 	_, mainProcPid, err := common.GetMainProcesses(mDirPath)
 	if err != nil {
 		klog.Fatalf("GetMainProcesses failed: %v", err)
@@ -176,222 +208,37 @@ func watchMetricsFile(mFile string, stopRules stopRulesFlag, filters []string, f
 	}
 
 	// Start watch log lines.
-	t, _ := tail.TailFile(mFile, tail.Config{Follow: true})
-	for line := range t.Lines {
-		logText := line.Text
+	metricRegList := filemc.GetFilterRegexpList(filters)
+	for line := range lines {
 		// Print log line
-		klog.Info(logText)
-
-		switch fileFormat {
-		case commonv1beta1.TextFormat:
-			// Get list of regural expressions from filters.
-			var metricRegList []*regexp.Regexp
-			metricRegList = filemc.GetFilterRegexpList(filters)
-
-			// Check if log line contains metric from stop rules.
-			isRuleLine := false
-			for _, rule := range stopRules {
-				if strings.Contains(logText, rule.Name) {
-					isRuleLine = true
-					break
-				}
-			}
-			// If log line doesn't contain appropriate metric, continue track file.
-			if !isRuleLine {
-				continue
-			}
+		klog.Info(line.text)
 
-			// If log line contains appropriate metric, find all submatches from metric filters.
-			for _, metricReg := range metricRegList {
-				matchStrings := metricReg.FindAllStringSubmatch(logText, -1)
-				for _, subMatchList := range matchStrings {
-					if len(subMatchList) < 3 {
-						continue
-					}
-					// Submatch must have metric name and float value
-					metricName := strings.TrimSpace(subMatchList[1])
-					metricValue, err := strconv.ParseFloat(strings.TrimSpace(subMatchList[2]), 64)
-					if err != nil {
-						klog.Fatalf("Unable to parse value %v to float for metric %v", metricValue, metricName)
-					}
-
-					// stopRules contains array of EarlyStoppingRules that has not been reached yet.
-					// After rule is reached we delete appropriate element from the array.
-					for idx, rule := range stopRules {
-						if metricName != rule.Name {
-							continue
-						}
-						stopRules, optimalObjValue = updateStopRules(stopRules, optimalObjValue, metricValue, metricStartStep, rule, idx)
-					}
-				}
-			}
-		case commonv1beta1.JsonFormat:
-			var logJsonObj map[string]interface{}
-			if err = json.Unmarshal([]byte(logText), &logJsonObj); err != nil {
-				klog.Fatalf("Failed to unmarshal logs in %v format, log: %s, error: %v", commonv1beta1.JsonFormat, logText, err)
-			}
-			// Check if log line contains metric from stop rules.
-			isRuleLine := false
-			for _, rule := range stopRules {
-				if _, exist := logJsonObj[rule.Name]; exist {
-					isRuleLine = true
-					break
-				}
-			}
-			// If log line doesn't contain appropriate metric, continue track file.
-			if !isRuleLine {
-				continue
-			}
-
-			// stopRules contains array of EarlyStoppingRules that has not been reached yet.
-			// After rule is reached we delete appropriate element from the array.
-			for idx, rule := range stopRules {
-				value, exist := logJsonObj[rule.Name].(string)
-				if !exist {
-					continue
-				}
-				metricValue, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
-				if err != nil {
-					klog.Fatalf("Unable to parse value %v to float for metric %v", metricValue, rule.Name)
-				}
-				stopRules, optimalObjValue = updateStopRules(stopRules, optimalObjValue, metricValue, metricStartStep, rule, idx)
-			}
-		default:
-			klog.Fatalf("Format must be set to %v or %v", commonv1beta1.TextFormat, commonv1beta1.JsonFormat)
-		}
-
-		// If stopRules array is empty, Trial is early stopped.
-		if len(stopRules) == 0 {
-			klog.Info("Training container is early stopped")
-			isEarlyStopped = true
-
-			// Create ".pid" file with "early-stopped" line.
-			// Which means that training is early stopped and Trial status is updated.
-			markFile := filepath.Join(filepath.Dir(mFile), fmt.Sprintf("%d.pid", mainProcPid))
-			_, err := os.Create(markFile)
-			if err != nil {
-				klog.Fatalf("Create mark file %v error: %v", markFile, err)
-			}
-
-			err = os.WriteFile(markFile, []byte(common.TrainingEarlyStopped), 0)
-			if err != nil {
-				klog.Fatalf("Write to file %v error: %v", markFile, err)
-			}
-
-			// Get child process from main PID.
-			childProc, err := mainProc.Children()
-			if err != nil {
-				klog.Fatalf("Get children proceses for main PID: %v failed: %v", mainProcPid, err)
-			}
-
-			// TODO (andreyvelich): Currently support only single child process.
-			if len(childProc) != 1 {
-				klog.Fatalf("Multiple children processes are not supported. Children processes: %v", childProc)
-			}
-
-			// Terminate the child process.
-			err = childProc[0].Terminate()
-			if err != nil {
-				klog.Fatalf("Unable to terminate child process %v, error: %v", childProc[0], err)
-			}
-
-			// Report metrics to DB.
-			reportMetrics(filters, fileFormat)
-
-			// Wait until main process is completed.
-			timeout := 60 * time.Second
-			endTime := time.Now().Add(timeout)
-			isProcRunning := true
-			for isProcRunning && time.Now().Before(endTime) {
-				isProcRunning, err = mainProc.IsRunning()
-				// Ignore "no such file error". It means that process is complete.
-				if err != nil && !os.IsNotExist(err) {
-					klog.Fatalf("Check process status for main PID: %v failed: %v", mainProcPid, err)
-				}
-			}
-
-			// Create connection and client for Early Stopping service.
-			conn, err := grpc.NewClient(*earlyStopServiceAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
-			if err != nil {
-				klog.Fatalf("Could not connect to Early Stopping service, error: %v", err)
-			}
-			c := api.NewEarlyStoppingClient(conn)
-
-			setTrialStatusReq := &api.SetTrialStatusRequest{
-				TrialName: *trialName,
-			}
-
-			// Send request to change Trial status to early stopped.
-			_, err = c.SetTrialStatus(context.Background(), setTrialStatusReq)
-			if err != nil {
-				klog.Fatalf("Set Trial status error: %v", err)
-			}
-			conn.Close()
-
-			klog.Infof("Trial status is successfully updated")
+		mlogs, err := parseMetricsLine(line, metrics, metricRegList, fileFormat, jsonOpts)
+		if err != nil {
+			klog.Warningf("Failed to parse metrics from log line: %v", err)
+			continue
 		}
-	}
-}
-
-func updateStopRules(
-	stopRules []commonv1beta1.EarlyStoppingRule,
-	optimalObjValue *float64,
-	metricValue float64,
-	metricStartStep map[string]int,
-	rule commonv1beta1.EarlyStoppingRule,
-	ruleIdx int,
-) ([]commonv1beta1.EarlyStoppingRule, *float64) {
-
-	// First metric is objective in metricNames array.
-	objMetric := strings.Split(*metricNames, ";")[0]
-	objType := commonv1beta1.ObjectiveType(*objectiveType)
-
-	// Calculate optimalObjValue.
-	if rule.Name == objMetric {
-		if optimalObjValue == nil {
-			optimalObjValue = &metricValue
-		} else if objType == commonv1beta1.ObjectiveTypeMaximize && metricValue > *optimalObjValue {
-			optimalObjValue = &metricValue
-		} else if objType == commonv1beta1.ObjectiveTypeMinimize && metricValue < *optimalObjValue {
-			optimalObjValue = &metricValue
+		reporter.Add(mlogs...)
+
+		// stopRules contains EarlyStoppingRules that has not been reached yet.
+		// After rule is reached it is deleted from stopRules.
+		// If metric is logged with step, rules are evaluated against the step.
+		// Non-numeric metric values are reported, but they are not evaluated by stop rules.
+		// If all stop rules are reached, Trial is early stopped.
+		stopped, err := stopRules.EarlyStopIfReached(mlogs, common.EarlyStopOpts{
+			MarkDir:              mDirPath,
+			MainProc:             mainProc,
+			ReportMetrics:        func() { reportMetrics(reporter, metrics, filters, fileFormat, jsonOpts) },
+			EarlyStopServiceAddr: *earlyStopServiceAddr,
+			TrialName:            *trialName,
+		})
+		if err != nil {
+			klog.Fatalf("Failed to early stop training: %v", err)
 		}
-		// Assign best optimal value to metric value.
-		metricValue = *optimalObjValue
-	}
-
-	// Reduce steps if appropriate metric is reported.
-	// Once rest steps are empty we apply early stopping rule.
-	if _, ok := metricStartStep[rule.Name]; ok {
-		metricStartStep[rule.Name]--
-		if metricStartStep[rule.Name] != 0 {
-			return stopRules, optimalObjValue
+		if stopped {
+			isEarlyStopped = true
 		}
 	}
-
-	ruleValue, err := strconv.ParseFloat(rule.Value, 64)
-	if err != nil {
-		klog.Fatalf("Unable to parse value %v to float for rule metric %v", rule.Value, rule.Name)
-	}
-
-	// Metric value can be equal, less or greater than stop rule.
-	// Deleting suitable stop rule from the array.
-	if rule.Comparison == commonv1beta1.ComparisonTypeEqual && metricValue == ruleValue {
-		return deleteStopRule(stopRules, ruleIdx), optimalObjValue
-	} else if rule.Comparison == commonv1beta1.ComparisonTypeLess && metricValue < ruleValue {
-		return deleteStopRule(stopRules, ruleIdx), optimalObjValue
-	} else if rule.Comparison == commonv1beta1.ComparisonTypeGreater && metricValue > ruleValue {
-		return deleteStopRule(stopRules, ruleIdx), optimalObjValue
-	}
-	return stopRules, optimalObjValue
-}
-
-func deleteStopRule(stopRules []commonv1beta1.EarlyStoppingRule, idx int) []commonv1beta1.EarlyStoppingRule {
-	if idx >= len(stopRules) {
-		klog.Fatalf("Index %v out of range stopRules: %v", idx, stopRules)
-	}
-	stopRules[idx] = stopRules[len(stopRules)-1]
-	stopRules[len(stopRules)-1] = commonv1beta1.EarlyStoppingRule{}
-	return stopRules[:len(stopRules)-1]
 }
 
 func main() {
@@ -404,13 +251,61 @@ func main() {
 		filters = strings.Split(*metricFilters, ";")
 	}
 
+	var metricList []string
+	if len(*metricNames) != 0 {
+		metricList = strings.Split(*metricNames, ";")
+	}
+
 	fileFormat := commonv1beta1.FileFormat(*metricsFileFormat)
+	jsonOpts := filemc.JsonFormatOptions{
+		TimestampKey:    *timestampKey,
+		TimestampFormat: *timestampFormat,
+	}
+
+	conn, err := grpc.NewClient(*dbManagerServiceAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		klog.Fatalf("Could not connect to DB manager service, error: %v", err)
+	}
+	defer conn.Close()
+	c := api.NewDBManagerClient(conn)
+	// Aggregated metrics are reported only when the training is completed.
+	reportInterval := *flushInterval
+	if *metricsFilePattern != "" && *metricsAggregation != "" && commonv1beta1.MetricsAggregationType(*metricsAggregation) != commonv1beta1.MetricsAggregationNone {
+		reportInterval = 0
+	}
+	reporter := common.NewMetricLogReporter(func(olog *api.ObservationLog, idempotencyKey string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), common.DefaultReportTimeout)
+		defer cancel()
+		_, err := c.ReportObservationLog(ctx, &api.ReportObservationLogRequest{
+			TrialName:      *trialName,
+			ObservationLog: olog,
+			IdempotencyKey: idempotencyKey,
+		})
+		return err
+	}, reportInterval)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		reporter.Run(ctx)
+	}()
+
+	lines := make(chan metricsLine)
+	if *metricsFilePattern != "" {
+		go tailMetricsFiles(*metricsFilePath, *metricsFilePattern, lines)
+	} else {
+		go tailMetricsFile(*metricsFilePath, "", lines)
+	}
 
 	// If stop rule is set we need to parse metrics during run.
 	if len(stopRules) != 0 {
-		go watchMetricsFile(*metricsFilePath, stopRules, filters, fileFormat)
+		// First metric is objective in metricNames array.
+		objMetric := metricList[0]
+		objType := commonv1beta1.ObjectiveType(*objectiveType)
+		go watchMetricsFile(lines, reporter, common.NewStopRules(stopRules, objMetric, objType), metricList, filters, fileFormat, jsonOpts)
 	} else {
-		go printMetricsFile(*metricsFilePath)
+		go printMetricsFile(lines, reporter, metricList, filters, fileFormat, jsonOpts)
 	}
 
 	waitAll, _ := strconv.ParseBool(*waitAllProcesses)
@@ -419,40 +314,34 @@ func main() {
 		PollInterval:           *pollInterval,
 		Timeout:                *timeout,
 		WaitAll:                waitAll,
-		CompletedMarkedDirPath: filepath.Dir(*metricsFilePath),
+		CompletedMarkedDirPath: getMetricsDirPath(),
 	}
 	if err := common.WaitMainProcesses(wopts); err != nil {
 		klog.Fatalf("Failed to wait for worker container: %v", err)
 	}
+	cancel()
+	<-done
 
 	// If training was not early stopped, report the metrics.
 	if !isEarlyStopped {
-		reportMetrics(filters, fileFormat)
+		reportMetrics(reporter, metricList, filters, fileFormat, jsonOpts)
 	}
 }
 
-func reportMetrics(filters []string, fileFormat commonv1beta1.FileFormat) {
-
-	conn, err := grpc.NewClient(*dbManagerServiceAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
-	if err != nil {
-		klog.Fatalf("Could not connect to DB manager service, error: %v", err)
-	}
-	defer conn.Close()
-	c := api.NewDBManagerClient(conn)
-	ctx := context.Background()
-	var metricList []string
-	if len(*metricNames) != 0 {
-		metricList = strings.Split(*metricNames, ";")
+// reportMetrics reports the metrics of the metrics file which have not been reported during the training.
+func reportMetrics(reporter *common.MetricLogReporter, metrics []string, filters []string, fileFormat commonv1beta1.FileFormat, jsonOpts filemc.JsonFormatOptions) {
+	var olog *api.ObservationLog
+	var err error
+	if *metricsFilePattern != "" {
+		aggregation := commonv1beta1.MetricsAggregationType(*metricsAggregation)
+		olog, err = filemc.CollectObservationLogFromDir(*metricsFilePath, *metricsFilePattern, metrics, filters, fileFormat, jsonOpts, aggregation)
+	} else {
+		olog, err = filemc.CollectObservationLog(*metricsFilePath, metrics, filters, fileFormat, jsonOpts)
 	}
-	olog, err := filemc.CollectObservationLog(*metricsFilePath, metricList, filters, fileFormat)
 	if err != nil {
 		klog.Fatalf("Failed to collect logs: %v", err)
 	}
-	reportreq := &api.ReportObservationLogRequest{
-		TrialName:      *trialName,
-		ObservationLog: olog,
-	}
-	_, err = c.ReportObservationLog(ctx, reportreq)
+	olog, err = reporter.ReportRemaining(olog)
 	if err != nil {
 		klog.Fatalf("Failed to Report logs: %v", err)
 	}
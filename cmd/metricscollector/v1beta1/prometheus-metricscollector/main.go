@@ -0,0 +1,182 @@
+/*
+Copyright 2022 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+/*
+PrometheusMetricsCollector is a metricscollector for worker which exposes metrics in Prometheus format.
+It scrapes the metrics endpoint of the training container on an interval.
+Metrics must be exposed in the Prometheus text exposition format with the names equal to Experiment's metric names.
+For example, the objective value name is accuracy and the metrics are loss, the endpoint should return:
+     ---
+     # TYPE accuracy gauge
+     accuracy 0.7
+     # TYPE loss gauge
+     loss 0.2
+     ---
+The metrics collector reports every changed value of the metrics.
+*/
+
+package main
+
+import (
+	"context"
+	"flag"
+	"strconv"
+	"strings"
+	"time"
+
+	psutil "github.com/shirou/gopsutil/v3/process"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"k8s.io/klog/v2"
+
+	commonv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1"
+	api "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+	"github.com/kubeflow/katib/pkg/metricscollector/v1beta1/common"
+	promc "github.com/kubeflow/katib/pkg/metricscollector/v1beta1/prometheus-metricscollector"
+)
+
+var (
+	dbManagerServiceAddr = flag.String("s-db", "", "Katib DB Manager service endpoint")
+	earlyStopServiceAddr = flag.String("s-earlystop", "", "Katib Early Stopping service endpoint")
+	trialName            = flag.String("t", "", "Trial Name")
+	metricsURL           = flag.String("url", "", "Prometheus metrics endpoint URL")
+	markerDirPath        = flag.String("path", commonv1beta1.DefaultPrometheusDirPath, "Directory path for the process markers")
+	metricNames          = flag.String("m", "", "Metric names")
+	objectiveType        = flag.String("o-type", "", "Objective type")
+	scrapeInterval       = flag.Duration("scrape-interval", common.DefaultScrapeInterval, "Interval between metrics endpoint scrapes")
+	scrapeTimeout        = flag.Duration("scrape-timeout", common.DefaultScrapeTimeout, "Timeout for the single metrics endpoint scrape")
+	pollInterval         = flag.Duration("p", common.DefaultPollInterval, "Poll interval between running processes check")
+	timeout              = flag.Duration("timeout", common.DefaultTimeout, "Timeout before invoke error during running processes check")
+	waitAllProcesses     = flag.String("w", common.DefaultWaitAllProcesses, "Whether wait for all other main process of container exiting")
+	stopRules            common.StopRulesFlag
+	isEarlyStopped       = false
+)
+
+func scrapeMetrics(ctx context.Context, collector *promc.Collector, stopRules *common.StopRules, mainProc *psutil.Process) {
+	ticker := time.NewTicker(*scrapeInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		mlogs, err := collector.Collect()
+		if err != nil {
+			// Metrics endpoint might be not ready yet or already stopped.
+			klog.V(4).Infof("Scrape metrics failed: %v", err)
+			continue
+		}
+		if stopRules == nil {
+			continue
+		}
+
+		for _, mlog := range mlogs {
+			klog.Infof("%v=%v", mlog.Metric.Name, mlog.Metric.Value)
+		}
+
+		// stopRules contains EarlyStoppingRules that has not been reached yet.
+		// After rule is reached it is deleted from stopRules.
+		// If all stop rules are reached, Trial is early stopped.
+		stopped, err := stopRules.EarlyStopIfReached(mlogs, common.EarlyStopOpts{
+			MarkDir:              *markerDirPath,
+			MainProc:             mainProc,
+			ReportMetrics:        func() { reportMetrics(collector) },
+			EarlyStopServiceAddr: *earlyStopServiceAddr,
+			TrialName:            *trialName,
+		})
+		if err != nil {
+			klog.Fatalf("Failed to early stop training: %v", err)
+		}
+		if stopped {
+			isEarlyStopped = true
+			return
+		}
+	}
+}
+
+func main() {
+	flag.Var(&stopRules, "stop-rule", "The list of early stopping stop rules")
+	flag.Parse()
+	klog.Infof("Trial Name: %s", *trialName)
+
+	var metricList []string
+	if len(*metricNames) != 0 {
+		metricList = strings.Split(*metricNames, ";")
+	}
+	collector := promc.New(*metricsURL, metricList, *scrapeTimeout)
+
+	// Get Main process to early stop the training.
+	var rules *common.StopRules
+	var mainProc *psutil.Process
+	if len(stopRules) != 0 {
+		_, mainProcPid, err := common.GetMainProcesses(*markerDirPath)
+		if err != nil {
+			klog.Fatalf("GetMainProcesses failed: %v", err)
+		}
+		mainProc, err = psutil.NewProcess(int32(mainProcPid))
+		if err != nil {
+			klog.Fatalf("Failed to create new Process from pid %v, error: %v", mainProcPid, err)
+		}
+		// First metric is objective in metricNames array.
+		rules = common.NewStopRules(stopRules, metricList[0], commonv1beta1.ObjectiveType(*objectiveType))
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		scrapeMetrics(ctx, collector, rules, mainProc)
+	}()
+
+	waitAll, _ := strconv.ParseBool(*waitAllProcesses)
+
+	wopts := common.WaitPidsOpts{
+		PollInterval:           *pollInterval,
+		Timeout:                *timeout,
+		WaitAll:                waitAll,
+		CompletedMarkedDirPath: *markerDirPath,
+	}
+	if err := common.WaitMainProcesses(wopts); err != nil {
+		klog.Fatalf("Failed to wait for worker container: %v", err)
+	}
+	cancel()
+	<-done
+
+	// If training was not early stopped, report the metrics.
+	if !isEarlyStopped {
+		reportMetrics(collector)
+	}
+}
+
+func reportMetrics(collector *promc.Collector) {
+	conn, err := grpc.NewClient(*dbManagerServiceAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		klog.Fatalf("Could not connect to DB manager service, error: %v", err)
+	}
+	defer conn.Close()
+	c := api.NewDBManagerClient(conn)
+	olog := collector.ObservationLog()
+	reportreq := &api.ReportObservationLogRequest{
+		TrialName:      *trialName,
+		ObservationLog: olog,
+	}
+	if _, err = c.ReportObservationLog(context.Background(), reportreq); err != nil {
+		klog.Fatalf("Failed to Report logs: %v", err)
+	}
+	klog.Infof("Metrics reported. :\n%v", olog)
+}
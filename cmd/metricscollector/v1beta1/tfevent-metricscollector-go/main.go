@@ -0,0 +1,206 @@
+/*
+Copyright 2022 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+/*
+TFEventMetricsCollector is a metricscollector for worker which collects metrics from the TensorFlow event files.
+It reads the scalar summaries of the event files in the metrics directory and its subdirectories on the poll interval.
+When the event file is under a directory, e.g. train, the metric name is "{{dirname}}/{{metric name}}", e.g. train/accuracy.
+The collected metrics are reported periodically during the training and evaluated by the early stopping rules.
+It is not the default TensorFlowEvent metrics collector. To use it, set the image of the TensorFlowEvent
+metrics collector in the Katib config to ghcr.io/kubeflow/katib/tfevent-metrics-collector-go.
+*/
+
+package main
+
+import (
+	"context"
+	"flag"
+	"strconv"
+	"strings"
+	"time"
+
+	psutil "github.com/shirou/gopsutil/v3/process"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"k8s.io/klog/v2"
+
+	commonv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1"
+	api "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+	"github.com/kubeflow/katib/pkg/metricscollector/v1beta1/common"
+	tfeventmc "github.com/kubeflow/katib/pkg/metricscollector/v1beta1/tfevent-metricscollector"
+)
+
+var (
+	dbManagerServiceAddr = flag.String("s-db", "", "Katib DB Manager service endpoint")
+	earlyStopServiceAddr = flag.String("s-earlystop", "", "Katib Early Stopping service endpoint")
+	trialName            = flag.String("t", "", "Trial Name")
+	metricsDirPath       = flag.String("path", commonv1beta1.DefaultTensorflowEventDirPath, "Directory path of the TensorFlow event files")
+	metricNames          = flag.String("m", "", "Metric names")
+	objectiveType        = flag.String("o-type", "", "Objective type")
+	metricFilters        = flag.String("f", "", "Metric filters, they are not used for the TensorFlow event files")
+	pollInterval         = flag.Duration("p", common.DefaultPollInterval, "Poll interval between running processes check and event files reads")
+	timeout              = flag.Duration("timeout", common.DefaultTimeout, "Timeout before invoke error during running processes check")
+	waitAllProcesses     = flag.String("w", common.DefaultWaitAllProcesses, "Whether wait for all other main process of container exiting")
+	flushInterval        = flag.Duration("flush-interval", common.DefaultFlushInterval, "Interval between reports of the collected metrics, set 0 to report metrics only when training is completed")
+	stopRules            common.StopRulesFlag
+	isEarlyStopped       = false
+)
+
+// watchEventFiles reads the new events of the event files on the poll interval until the context is done.
+// If the stop rules are set, Trial is early stopped when all of them are reached.
+func watchEventFiles(ctx context.Context, reader *tfeventmc.EventDirReader, reporter *common.MetricLogReporter, stopRules *common.StopRules, metrics []string) {
+
+	// Get Main process to early stop the training.
+	var mainProc *psutil.Process
+	if stopRules != nil {
+		_, mainProcPid, err := common.GetMainProcesses(*metricsDirPath)
+		if err != nil {
+			klog.Fatalf("GetMainProcesses failed: %v", err)
+		}
+		mainProc, err = psutil.NewProcess(int32(mainProcPid))
+		if err != nil {
+			klog.Fatalf("Failed to create new Process from pid %v, error: %v", mainProcPid, err)
+		}
+	}
+
+	ticker := time.NewTicker(*pollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		mlogs, err := reader.Read()
+		if err != nil {
+			// Metrics directory might be not created yet.
+			klog.V(4).Infof("Read event files failed: %v", err)
+			continue
+		}
+		for _, mlog := range mlogs {
+			klog.Infof("%v=%v, step=%v", mlog.Metric.Name, mlog.Metric.Value, mlog.GetStep())
+		}
+		reporter.Add(mlogs...)
+		if stopRules == nil {
+			continue
+		}
+
+		// stopRules contains EarlyStoppingRules that has not been reached yet.
+		// After rule is reached it is deleted from stopRules.
+		// Rules are evaluated against the steps of the events.
+		// If all stop rules are reached, Trial is early stopped.
+		stopped, err := stopRules.EarlyStopIfReached(mlogs, common.EarlyStopOpts{
+			MarkDir:              *metricsDirPath,
+			MainProc:             mainProc,
+			ReportMetrics:        func() { reportMetrics(reporter, metrics) },
+			EarlyStopServiceAddr: *earlyStopServiceAddr,
+			TrialName:            *trialName,
+		})
+		if err != nil {
+			klog.Fatalf("Failed to early stop training: %v", err)
+		}
+		if stopped {
+			isEarlyStopped = true
+			return
+		}
+	}
+}
+
+func main() {
+	flag.Var(&stopRules, "stop-rule", "The list of early stopping stop rules")
+	flag.Parse()
+	klog.Infof("Trial Name: %s", *trialName)
+
+	if len(*metricFilters) != 0 {
+		klog.Warningf("Metric filters are not used for the TensorFlow event files: %s", *metricFilters)
+	}
+
+	var metricList []string
+	if len(*metricNames) != 0 {
+		metricList = strings.Split(*metricNames, ";")
+	}
+
+	conn, err := grpc.NewClient(*dbManagerServiceAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		klog.Fatalf("Could not connect to DB manager service, error: %v", err)
+	}
+	defer conn.Close()
+	c := api.NewDBManagerClient(conn)
+	reporter := common.NewMetricLogReporter(func(olog *api.ObservationLog, idempotencyKey string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), common.DefaultReportTimeout)
+		defer cancel()
+		_, err := c.ReportObservationLog(ctx, &api.ReportObservationLogRequest{
+			TrialName:      *trialName,
+			ObservationLog: olog,
+			IdempotencyKey: idempotencyKey,
+		})
+		return err
+	}, *flushInterval)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	reporterDone := make(chan struct{})
+	go func() {
+		defer close(reporterDone)
+		reporter.Run(ctx)
+	}()
+
+	// If stop rule is set we need to evaluate metrics during run.
+	var rules *common.StopRules
+	if len(stopRules) != 0 {
+		// First metric is objective in metricNames array.
+		rules = common.NewStopRules(stopRules, metricList[0], commonv1beta1.ObjectiveType(*objectiveType))
+	}
+	reader := tfeventmc.NewEventDirReader(*metricsDirPath, metricList)
+	watchDone := make(chan struct{})
+	go func() {
+		defer close(watchDone)
+		watchEventFiles(ctx, reader, reporter, rules, metricList)
+	}()
+
+	waitAll, _ := strconv.ParseBool(*waitAllProcesses)
+
+	wopts := common.WaitPidsOpts{
+		PollInterval:           *pollInterval,
+		Timeout:                *timeout,
+		WaitAll:                waitAll,
+		CompletedMarkedDirPath: *metricsDirPath,
+	}
+	if err := common.WaitMainProcesses(wopts); err != nil {
+		klog.Fatalf("Failed to wait for worker container: %v", err)
+	}
+	cancel()
+	<-watchDone
+	<-reporterDone
+
+	// If training was not early stopped, report the metrics.
+	if !isEarlyStopped {
+		reportMetrics(reporter, metricList)
+	}
+}
+
+// reportMetrics reports the metrics of the event files which have not been reported during the training.
+func reportMetrics(reporter *common.MetricLogReporter, metrics []string) {
+	olog, err := tfeventmc.CollectObservationLog(*metricsDirPath, metrics)
+	if err != nil {
+		klog.Fatalf("Failed to collect logs: %v", err)
+	}
+	olog, err = reporter.ReportRemaining(olog)
+	if err != nil {
+		klog.Fatalf("Failed to Report logs: %v", err)
+	}
+	klog.Infof("Metrics reported. :\n%v", olog)
+}
@@ -18,8 +18,11 @@ package main
 
 import (
 	"context"
+	"flag"
 	"net"
+	"os"
 
+	configv1beta1 "github.com/kubeflow/katib/pkg/apis/config/v1beta1"
 	health_pb "github.com/kubeflow/katib/pkg/apis/manager/health"
 	api_v1_beta1 "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
 	suggestion "github.com/kubeflow/katib/pkg/suggestion/v1beta1/goptuna"
@@ -41,12 +44,26 @@ func (s *healthService) Check(ctx context.Context, in *health_pb.HealthCheckRequ
 }
 
 func main() {
+	var studyDir string
+	flag.StringVar(&studyDir, "study-dir", configv1beta1.DefaultContainerSuggestionVolumeMountPath,
+		"Directory to persist the study. The study is persisted only if the directory exists, e.g. the volume is mounted for ResumePolicy FromVolume.")
+	flag.Parse()
+
+	service := suggestion.NewSuggestionService()
+	if _, err := os.Stat(studyDir); err == nil {
+		service, err = suggestion.NewSuggestionServiceWithStudyDir(studyDir)
+		if err != nil {
+			klog.Fatalf("Failed to load the study from %s: %v", studyDir, err)
+		}
+		klog.Infof("Persist Goptuna study to %s", studyDir)
+	}
+
 	l, err := net.Listen("tcp", address)
 	if err != nil {
 		klog.Fatalf("Failed to listen: %v", err)
 	}
 	srv := grpc.NewServer()
-	api_v1_beta1.RegisterSuggestionServer(srv, suggestion.NewSuggestionService())
+	api_v1_beta1.RegisterSuggestionServer(srv, service)
 	health_pb.RegisterHealthServer(srv, &healthService{})
 
 	klog.Infof("Start Goptuna suggestion service: %s", address)
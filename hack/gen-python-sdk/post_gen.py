@@ -19,6 +19,7 @@ import sys
 IGNORE_LINES = [
     "from kubeflow.katib.models.v1_unstructured_unstructured import V1UnstructuredUnstructured",
     "from kubeflow.katib.models.v1_time import V1Time",
+    "from kubeflow.katib.models.v1_duration import V1Duration",
 ]
 
 
@@ -81,6 +82,7 @@ def update_python_sdk(src, dest, versions=("v1beta1")):
         ),
         # Doc rules.
         lambda line: line.replace("[**datetime**](V1Time.md)", "**datetime**"),
+        lambda line: line.replace("[**str**](V1Duration.md)", "**str**"),
         lambda line: line.replace(
             "[**object**](V1UnstructuredUnstructured.md)", "**object**"
         ),
@@ -18,6 +18,7 @@ package v1beta1
 
 import (
 	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // AlgorithmSpec is the specification for a HP or NAS algorithm.
@@ -112,6 +113,13 @@ type ObjectiveSpec struct {
 	// +listType=set
 	AdditionalMetricNames []string `json:"additionalMetricNames,omitempty"`
 
+	// AdditionalObjectives represents metrics that are optimized together with the
+	// objective metric in the multi-objective Experiment. Each metric has its own optimization type.
+	// Additional objectives are collected from Trials as the additional metrics.
+	// +listType=map
+	// +listMapKey=objectiveMetricName
+	AdditionalObjectives []AdditionalObjective `json:"additionalObjectives,omitempty"`
+
 	// MetricStrategies defines various rules (min, max or latest) to extract metrics values.
 	// This field is allowed to missing, experiment defaulter (webhook) will fill it.
 	// +listType=map
@@ -119,6 +127,15 @@ type ObjectiveSpec struct {
 	MetricStrategies []MetricStrategy `json:"metricStrategies,omitempty"`
 }
 
+// AdditionalObjective represents the additional metric to optimize in the multi-objective Experiment.
+type AdditionalObjective struct {
+	// Type for the metric optimization.
+	Type ObjectiveType `json:"type,omitempty"`
+
+	// ObjectiveMetricName represents the metric name to optimize.
+	ObjectiveMetricName string `json:"objectiveMetricName,omitempty"`
+}
+
 // ObjectiveType is the type of Experiment optimization, one of minimize or maximize.
 type ObjectiveType string
 
@@ -205,11 +222,45 @@ const (
 	JsonFormat FileFormat = "JSON"
 )
 
+// MetricsAggregationType is the type of aggregation of the metrics across the metrics files.
+// Metrics of the files are aggregated by the metric name and the step. If the step is not logged,
+// the n-th metric of every file is aggregated.
+type MetricsAggregationType string
+
+const (
+	// MetricsAggregationNone means that the metrics of every file are reported with their source.
+	MetricsAggregationNone MetricsAggregationType = "None"
+
+	// MetricsAggregationMean means that the mean of the metrics is reported.
+	MetricsAggregationMean MetricsAggregationType = "Mean"
+
+	// MetricsAggregationMin means that the minimum of the metrics is reported.
+	MetricsAggregationMin MetricsAggregationType = "Min"
+
+	// MetricsAggregationMax means that the maximum of the metrics is reported.
+	MetricsAggregationMax MetricsAggregationType = "Max"
+)
+
 // +k8s:deepcopy-gen=true
 type FileSystemPath struct {
 	Path   string         `json:"path,omitempty"`
 	Kind   FileSystemKind `json:"kind,omitempty"`
 	Format FileFormat     `json:"format,omitempty"`
+	// Key of the metrics timestamp when the format is JSON, nested keys are separated by dots, e.g. "meta.time".
+	// Defaults to "timestamp"
+	TimestampKey string `json:"timestampKey,omitempty"`
+	// Go layout of the metrics timestamp string when the format is JSON, e.g. "2006-01-02 15:04:05".
+	// Defaults to RFC3339. Numeric timestamps are parsed as Unix time in seconds
+	TimestampFormat string `json:"timestampFormat,omitempty"`
+	// Glob pattern of the metrics files in the directory when the kind is Directory, e.g. "metrics-*.log".
+	// Files which are created after the training starts are collected as well.
+	// Each metric is tagged with the name of its file as the source
+	Pattern string `json:"pattern,omitempty"`
+	// Aggregation of the metrics across the metrics files which match the pattern.
+	// Aggregated metrics are reported only when the training is completed, not periodically during the training,
+	// and the aggregation other than None can't be used with early stopping.
+	// Defaults to None
+	Aggregation MetricsAggregationType `json:"aggregation,omitempty"`
 }
 
 type CollectorKind string
@@ -226,6 +277,7 @@ const (
 	PrometheusMetricCollector CollectorKind = "PrometheusMetric"
 	DefaultPrometheusPath     string        = "/metrics"
 	DefaultPrometheusPort     int           = 8080
+	DefaultPrometheusDirPath  string        = "/var/log/katib/prometheus/"
 
 	CustomCollector CollectorKind = "Custom"
 
@@ -242,3 +294,43 @@ type CollectorSpec struct {
 	// When kind is "customCollector", this field will be used
 	CustomCollector *v1.Container `json:"customCollector,omitempty"`
 }
+
+// TrialCacheSpec describes how the results of the earlier Trials are reused.
+// Trial is completed with the observation of the succeeded Trial which has the same fingerprint,
+// i.e. the same run spec and parameter assignments, instead of creating the Trial run.
+// +k8s:deepcopy-gen=true
+type TrialCacheSpec struct {
+	// Scope of the Trials which results are reused. Defaults to Namespace.
+	// Only Namespace is supported, so the Trials of the other namespaces are never reused.
+	Scope TrialCacheScopeType `json:"scope,omitempty"`
+}
+
+type TrialCacheScopeType string
+
+const (
+	// TrialCacheScopeNamespace indicates that only the Trials in the same namespace are reused.
+	TrialCacheScopeNamespace TrialCacheScopeType = "Namespace"
+)
+
+// RetryPolicy describes how the failed Trial run is retried.
+// The run is recreated with the same parameter assignments, so the failed attempt
+// doesn't count against the max failed trials of the Experiment.
+// +k8s:deepcopy-gen=true
+type RetryPolicy struct {
+	// Max number of retries of the failed Trial run.
+	MaxRetries int32 `json:"maxRetries,omitempty"`
+
+	// Delay before the first retry. It is doubled for every following retry.
+	// Defaults to 10s.
+	Backoff *metav1.Duration `json:"backoff,omitempty"`
+
+	// Regular expressions for the failure reason of the Trial run.
+	// If any of onReasons and onMessages is set, the run is retried only if
+	// its failure reason or message matches one of them.
+	// +listType=atomic
+	OnReasons []string `json:"onReasons,omitempty"`
+
+	// Regular expressions for the failure message of the Trial run.
+	// +listType=atomic
+	OnMessages []string `json:"onMessages,omitempty"`
+}
@@ -23,8 +23,25 @@ package v1beta1
 
 import (
 	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdditionalObjective) DeepCopyInto(out *AdditionalObjective) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdditionalObjective.
+func (in *AdditionalObjective) DeepCopy() *AdditionalObjective {
+	if in == nil {
+		return nil
+	}
+	out := new(AdditionalObjective)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AlgorithmSetting) DeepCopyInto(out *AlgorithmSetting) {
 	*out = *in
@@ -244,6 +261,11 @@ func (in *ObjectiveSpec) DeepCopyInto(out *ObjectiveSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.AdditionalObjectives != nil {
+		in, out := &in.AdditionalObjectives, &out.AdditionalObjectives
+		*out = make([]AdditionalObjective, len(*in))
+		copy(*out, *in)
+	}
 	if in.MetricStrategies != nil {
 		in, out := &in.MetricStrategies, &out.MetricStrategies
 		*out = make([]MetricStrategy, len(*in))
@@ -299,6 +321,37 @@ func (in *ParameterAssignment) DeepCopy() *ParameterAssignment {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetryPolicy) DeepCopyInto(out *RetryPolicy) {
+	*out = *in
+	if in.Backoff != nil {
+		in, out := &in.Backoff, &out.Backoff
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.OnReasons != nil {
+		in, out := &in.OnReasons, &out.OnReasons
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.OnMessages != nil {
+		in, out := &in.OnMessages, &out.OnMessages
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryPolicy.
+func (in *RetryPolicy) DeepCopy() *RetryPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(RetryPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SourceSpec) DeepCopyInto(out *SourceSpec) {
 	*out = *in
@@ -329,3 +382,19 @@ func (in *SourceSpec) DeepCopy() *SourceSpec {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrialCacheSpec) DeepCopyInto(out *TrialCacheSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrialCacheSpec.
+func (in *TrialCacheSpec) DeepCopy() *TrialCacheSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TrialCacheSpec)
+	in.DeepCopyInto(out)
+	return out
+}
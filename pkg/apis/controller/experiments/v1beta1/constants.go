@@ -16,6 +16,12 @@ limitations under the License.
 
 package v1beta1
 
+import (
+	"time"
+
+	common "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1"
+)
+
 const (
 	// DefaultTrialParallelCount is the default value of spec.parallelTrialCount.
 	DefaultTrialParallelCount = 3
@@ -23,6 +29,24 @@ const (
 	// DefaultResumePolicy is the default value of spec.resumePolicy.
 	DefaultResumePolicy = NeverResume
 
+	// DefaultBudgetPolicy is the default value of spec.budgetPolicy.
+	DefaultBudgetPolicy = FinishActiveTrials
+
+	// DefaultSuspendPolicy is the default value of spec.suspendPolicy.
+	DefaultSuspendPolicy = FinishActiveTrials
+
+	// DefaultPendingTimeout is the default value of spec.adaptiveParallelism.pendingTimeout.
+	DefaultPendingTimeout = 5 * time.Minute
+
+	// DefaultRetryBackoff is the default value of spec.trialTemplate.retryPolicy.backoff.
+	DefaultRetryBackoff = 10 * time.Second
+
+	// DefaultQueueingWeight is the default value of spec.queueing.weight.
+	DefaultQueueingWeight = 1
+
+	// DefaultTrialCacheScope is the default value of spec.trialCache.scope.
+	DefaultTrialCacheScope = common.TrialCacheScopeNamespace
+
 	// DefaultJobSuccessCondition is the default value of spec.trialTemplate.successCondition for Job.
 	DefaultJobSuccessCondition = "status.conditions.#(type==\"Complete\")#|#(status==\"True\")#"
 
@@ -17,7 +17,10 @@ limitations under the License.
 package v1beta1
 
 import (
+	"slices"
+
 	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 
 	common "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1"
@@ -26,11 +29,16 @@ import (
 
 func (e *Experiment) SetDefault() {
 	e.setDefaultParallelTrialCount()
+	e.setDefaultAdaptiveParallelism()
 	e.setDefaultResumePolicy()
+	e.setDefaultBudgetPolicy()
+	e.setDefaultSuspendPolicy()
+	e.setDefaultQueueing()
 	e.setDefaultObjective()
 	e.setDefaultTrialTemplate()
 	e.setDefaultMetricsCollector()
 	e.setDefaultParameterDistribution()
+	e.setDefaultTrialCache()
 }
 
 func (e *Experiment) setDefaultParallelTrialCount() {
@@ -40,12 +48,37 @@ func (e *Experiment) setDefaultParallelTrialCount() {
 	}
 }
 
+func (e *Experiment) setDefaultAdaptiveParallelism() {
+	if e.Spec.AdaptiveParallelism != nil && e.Spec.AdaptiveParallelism.PendingTimeout == nil {
+		e.Spec.AdaptiveParallelism.PendingTimeout = &metav1.Duration{Duration: DefaultPendingTimeout}
+	}
+}
+
 func (e *Experiment) setDefaultResumePolicy() {
 	if e.Spec.ResumePolicy == "" {
 		e.Spec.ResumePolicy = DefaultResumePolicy
 	}
 }
 
+func (e *Experiment) setDefaultBudgetPolicy() {
+	if e.Spec.BudgetPolicy == "" && (e.Spec.MaxDuration != nil || e.Spec.MaxResourceUsage != nil) {
+		e.Spec.BudgetPolicy = DefaultBudgetPolicy
+	}
+}
+
+func (e *Experiment) setDefaultSuspendPolicy() {
+	if e.Spec.SuspendPolicy == "" && e.Spec.Suspend != nil {
+		e.Spec.SuspendPolicy = DefaultSuspendPolicy
+	}
+}
+
+func (e *Experiment) setDefaultQueueing() {
+	if e.Spec.Queueing != nil && e.Spec.Queueing.Weight == nil {
+		e.Spec.Queueing.Weight = new(int32)
+		*e.Spec.Queueing.Weight = DefaultQueueingWeight
+	}
+}
+
 func (e *Experiment) setDefaultObjective() {
 	obj := e.Spec.Objective
 	if obj != nil {
@@ -76,11 +109,25 @@ func (e *Experiment) setDefaultObjective() {
 			obj.MetricStrategies = append(obj.MetricStrategies, strategy)
 		}
 
+		// Additional objectives must be collected from Trials as additional metrics.
+		additionalObjectiveTypes := make(map[string]common.ObjectiveType, len(obj.AdditionalObjectives))
+		for _, additionalObjective := range obj.AdditionalObjectives {
+			additionalObjectiveTypes[additionalObjective.ObjectiveMetricName] = additionalObjective.Type
+			if !slices.Contains(obj.AdditionalMetricNames, additionalObjective.ObjectiveMetricName) {
+				obj.AdditionalMetricNames = append(obj.AdditionalMetricNames, additionalObjective.ObjectiveMetricName)
+			}
+		}
+
 		// Set default strategy of additional metrics to ExtractByLatest.
+		// Default strategy of additional objectives is set according to their ObjectiveType.
 		for _, metricName := range obj.AdditionalMetricNames {
 			if _, ok := metricsWithDefault[metricName]; !ok {
+				objectiveType := e.Spec.Objective.Type
+				if additionalObjectiveType, ok := additionalObjectiveTypes[metricName]; ok {
+					objectiveType = additionalObjectiveType
+				}
 				var strategy common.MetricStrategy
-				switch e.Spec.Objective.Type {
+				switch objectiveType {
 				case common.ObjectiveTypeMinimize:
 					strategy = common.MetricStrategy{Name: metricName, Value: common.ExtractByMin}
 				case common.ObjectiveTypeMaximize:
@@ -133,6 +180,9 @@ func (e *Experiment) setDefaultTrialTemplate() {
 			}
 		}
 	}
+	if t != nil && t.RetryPolicy != nil && t.RetryPolicy.Backoff == nil {
+		t.RetryPolicy.Backoff = &metav1.Duration{Duration: DefaultRetryBackoff}
+	}
 	e.Spec.TrialTemplate = t
 }
 
@@ -175,6 +225,10 @@ func (e *Experiment) setDefaultMetricsCollector() {
 		if e.Spec.MetricsCollectorSpec.Source.FileSystemPath.Format == "" {
 			e.Spec.MetricsCollectorSpec.Source.FileSystemPath.Format = common.TextFormat
 		}
+		if e.Spec.MetricsCollectorSpec.Source.FileSystemPath.Kind == common.DirectoryKind &&
+			e.Spec.MetricsCollectorSpec.Source.FileSystemPath.Aggregation == "" {
+			e.Spec.MetricsCollectorSpec.Source.FileSystemPath.Aggregation = common.MetricsAggregationNone
+		}
 	case common.TfEventCollector:
 		if e.Spec.MetricsCollectorSpec.Source == nil {
 			e.Spec.MetricsCollectorSpec.Source = &common.SourceSpec{}
@@ -198,3 +252,9 @@ func (e *Experiment) setDefaultParameterDistribution() {
 		}
 	}
 }
+
+func (e *Experiment) setDefaultTrialCache() {
+	if e.Spec.TrialCache != nil && e.Spec.TrialCache.Scope == "" {
+		e.Spec.TrialCache.Scope = DefaultTrialCacheScope
+	}
+}
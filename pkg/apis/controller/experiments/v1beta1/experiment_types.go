@@ -30,6 +30,13 @@ type ExperimentSpec struct {
 	// +listMapKey=name
 	Parameters []ParameterSpec `json:"parameters,omitempty"`
 
+	// List of constraints for the parameter assignments, for example "batch_size * grad_accum <= 512".
+	// Each constraint is the boolean expression in the Go syntax over the parameter names.
+	// Parameters which names are not valid identifiers can be referenced as params["num-layers"].
+	// Trials are not created for the assignments which violate any constraint.
+	// +listType=atomic
+	ParameterConstraints []string `json:"parameterConstraints,omitempty"`
+
 	// Describes the objective of the experiment.
 	Objective *common.ObjectiveSpec `json:"objective,omitempty"`
 
@@ -46,12 +53,40 @@ type ExperimentSpec struct {
 	// Defaults to 3
 	ParallelTrialCount *int32 `json:"parallelTrialCount,omitempty"`
 
+	// Describes how the number of trials which run in parallel follows the cluster capacity.
+	// If it is set, parallelTrialCount is the initial number of parallel trials.
+	AdaptiveParallelism *AdaptiveParallelismSpec `json:"adaptiveParallelism,omitempty"`
+
 	// Max completed trials to mark experiment as succeeded
 	MaxTrialCount *int32 `json:"maxTrialCount,omitempty"`
 
 	// Max failed trials to mark experiment as failed.
 	MaxFailedTrialCount *int32 `json:"maxFailedTrialCount,omitempty"`
 
+	// Max duration of the Experiment since its start time to mark experiment as succeeded.
+	MaxDuration *metav1.Duration `json:"maxDuration,omitempty"`
+
+	// Max cumulative resource usage of the trials to mark experiment as succeeded.
+	MaxResourceUsage *ResourceUsageSpec `json:"maxResourceUsage,omitempty"`
+
+	// Describes what happens to the active trials once max duration or max resource usage is reached.
+	// Default value is Finish.
+	BudgetPolicy ActiveTrialsPolicyType `json:"budgetPolicy,omitempty"`
+
+	// Whether the Experiment is suspended. Suspended Experiment doesn't create new trials
+	// and its suggestion deployment is scaled down. Setting it back to false resumes the Experiment.
+	Suspend *bool `json:"suspend,omitempty"`
+
+	// Describes what happens to the active trials once the Experiment is suspended.
+	// Default value is Finish.
+	SuspendPolicy ActiveTrialsPolicyType `json:"suspendPolicy,omitempty"`
+
+	// Describes how the Experiment shares the trial slots of the ExperimentQueues in its namespace.
+	Queueing *QueueingSpec `json:"queueing,omitempty"`
+
+	// Describes when the experiment is succeeded because the best objective value has plateaued.
+	Convergence *ConvergenceSpec `json:"convergence,omitempty"`
+
 	// Describes the specification of the metrics collector
 	MetricsCollectorSpec *common.MetricsCollectorSpec `json:"metricsCollectorSpec,omitempty"`
 
@@ -60,6 +95,19 @@ type ExperimentSpec struct {
 	// Describes resuming policy which usually take effect after experiment terminated.
 	// Default value is Never.
 	ResumePolicy ResumePolicyType `json:"resumePolicy,omitempty"`
+
+	// Describes the prior Trials to warm-start the suggestion algorithm.
+	// Succeeded Trials from the sources are sent to the algorithm as the history,
+	// Trials are not created for them.
+	WarmStart *WarmStartSpec `json:"warmStart,omitempty"`
+
+	// Describes how the parameter assignments which have already been suggested are handled.
+	// If it is not set, duplicated assignments are not detected.
+	DuplicateSuggestion *DuplicateSuggestionSpec `json:"duplicateSuggestion,omitempty"`
+
+	// Describes how the results of the earlier Trials with the same run spec and parameter assignments
+	// are reused, e.g. from the other Experiments. If it is not set, each Trial creates the Trial run.
+	TrialCache *common.TrialCacheSpec `json:"trialCache,omitempty"`
 }
 
 // ExperimentStatus is the current status of an Experiment.
@@ -87,6 +135,12 @@ type ExperimentStatus struct {
 	// Current optimal trial parameters and observations.
 	CurrentOptimalTrial OptimalTrial `json:"currentOptimalTrial,omitempty"`
 
+	// Trials on the Pareto front of the multi-objective Experiment.
+	// Trial is on the Pareto front if no other Trial is better in all objectives.
+	// It is set only if spec.objective.additionalObjectives is not empty.
+	// +listType=atomic
+	ParetoOptimalTrials []OptimalTrial `json:"paretoOptimalTrials,omitempty"`
+
 	// List of trial names which are running.
 	// +listType=set
 	RunningTrialList []string `json:"runningTrialList,omitempty"`
@@ -138,6 +192,21 @@ type ExperimentStatus struct {
 
 	// How many trials are currently metrics unavailable.
 	TrialMetricsUnavailable int32 `json:"trialMetricsUnavailable,omitempty"`
+
+	// How many trials are waiting for the free trial slots of the ExperimentQueues.
+	TrialsQueued int32 `json:"trialsQueued,omitempty"`
+
+	// Cumulative resource usage of the trials in resource-hours.
+	// It is set only if spec.maxResourceUsage is specified.
+	ResourceUsage *float64 `json:"resourceUsage,omitempty"`
+
+	// Statistics of the best objective value plateau.
+	// It is set only if spec.convergence is specified.
+	Convergence *ConvergenceStatus `json:"convergence,omitempty"`
+
+	// Current parallelism of the trials.
+	// It is set only if spec.adaptiveParallelism is specified.
+	Parallelism *ParallelismStatus `json:"parallelism,omitempty"`
 }
 
 // OptimalTrial is the metrics and assignments of the best trial.
@@ -184,6 +253,7 @@ const (
 	ExperimentRestarting ExperimentConditionType = "Restarting"
 	ExperimentSucceeded  ExperimentConditionType = "Succeeded"
 	ExperimentFailed     ExperimentConditionType = "Failed"
+	ExperimentSuspended  ExperimentConditionType = "Suspended"
 )
 
 // ResumePolicyType describes how the experiment should be resumed.
@@ -207,6 +277,23 @@ type ParameterSpec struct {
 	Name          string        `json:"name,omitempty"`
 	ParameterType ParameterType `json:"parameterType,omitempty"`
 	FeasibleSpace FeasibleSpace `json:"feasibleSpace,omitempty"`
+
+	// Condition makes the parameter active only when the parent parameter has one of the given values.
+	// Inactive parameters are not sampled and not assigned to the Trial,
+	// their placeholders in the Trial template are replaced with empty values.
+	// If it is not set, the parameter is always active.
+	Condition *ParameterCondition `json:"condition,omitempty"`
+}
+
+// ParameterCondition describes when the conditional parameter is active.
+type ParameterCondition struct {
+	// Name of the categorical parameter which activates this parameter.
+	// Parent parameter must be defined before this parameter in the list of parameters.
+	Parent string `json:"parent,omitempty"`
+
+	// Values of the parent parameter which activate this parameter.
+	// +listType=set
+	Values []string `json:"values,omitempty"`
 }
 
 type ParameterType string
@@ -268,6 +355,19 @@ type TrialTemplate struct {
 	// Condition must be in GJSON format, ref https://github.com/tidwall/gjson.
 	// For example for BatchJob: status.conditions.#(type=="Failed")#|#(status=="True")#
 	FailureCondition string `json:"failureCondition,omitempty"`
+
+	// Describes how the failed trial run is retried.
+	// If it is not set, the failed trial run is not retried.
+	RetryPolicy *common.RetryPolicy `json:"retryPolicy,omitempty"`
+
+	// Duration in seconds relative to the trial run creation time that the run may be active.
+	// Once it is exceeded, the run is deleted and the trial is failed with the DeadlineExceeded reason.
+	ActiveDeadlineSeconds *int64 `json:"activeDeadlineSeconds,omitempty"`
+
+	// Duration in seconds relative to the trial run creation time that the run may wait
+	// until any of its pods is running.
+	// Once it is exceeded, the run is deleted and the trial is failed with the DeadlineExceeded reason.
+	PendingDeadlineSeconds *int64 `json:"pendingDeadlineSeconds,omitempty"`
 }
 
 // TrialSource represent the source for trial template
@@ -293,6 +393,129 @@ type ConfigMapSource struct {
 	TemplatePath string `json:"templatePath,omitempty"`
 }
 
+// WarmStartSpec describes the sources of the prior Trials
+type WarmStartSpec struct {
+	// Names of the Experiments in the Experiment namespace which Trials are used as the prior history
+	// +listType=set
+	SourceExperiments []string `json:"sourceExperiments,omitempty"`
+
+	// Reference to the config map where the exported Trials are located
+	TrialsConfigMap *TrialsConfigMapSource `json:"trialsConfigMap,omitempty"`
+}
+
+// TrialsConfigMapSource references the config map where the exported Trials are located
+type TrialsConfigMapSource struct {
+	// Name of config map where the Trials are located
+	ConfigMapName string `json:"configMapName,omitempty"`
+
+	// Namespace of config map where the Trials are located.
+	// Defaults to the Experiment namespace.
+	ConfigMapNamespace string `json:"configMapNamespace,omitempty"`
+
+	// Path in config map where the Trials are located.
+	// Trials must be in the JSON format of the Trial list,
+	// for example the output of "kubectl get trials -l katib.kubeflow.org/experiment=<name> -o json".
+	TrialsPath string `json:"trialsPath,omitempty"`
+}
+
+// ResourceUsageSpec describes the cumulative usage of the resource by the trials.
+// Usage of the trial is the request of the resource in the trial run spec multiplied by the hours
+// between the trial start time and completion time, e.g. 2 GPUs for 30 minutes are 1 GPU-hour.
+type ResourceUsageSpec struct {
+	// Name of the resource, for example nvidia.com/gpu or cpu.
+	ResourceName v1.ResourceName `json:"resourceName,omitempty"`
+
+	// Max usage of the resource in resource-hours.
+	Hours float64 `json:"hours,omitempty"`
+}
+
+// ConvergenceSpec describes the plateau of the best objective value to stop the Experiment.
+// Trials are counted in order of the completion time, only trials with the objective metric value are counted.
+type ConvergenceSpec struct {
+	// Number of the completed trials without improvement of the best objective value to mark experiment as succeeded.
+	Patience int32 `json:"patience,omitempty"`
+
+	// Min change of the best objective value to qualify as the improvement.
+	// Defaults to 0, i.e. any better objective value is the improvement.
+	MinDelta *float64 `json:"minDelta,omitempty"`
+}
+
+// ConvergenceStatus is the statistics of the best objective value plateau.
+type ConvergenceStatus struct {
+	// Name of the trial which has improved the best objective value last.
+	BestTrialName string `json:"bestTrialName,omitempty"`
+
+	// Objective value of the trial which has improved the best objective value last.
+	BestObjectiveValue string `json:"bestObjectiveValue,omitempty"`
+
+	// How many trials have been completed since the last improvement of the best objective value.
+	TrialsWithoutImprovement int32 `json:"trialsWithoutImprovement,omitempty"`
+}
+
+// AdaptiveParallelismSpec describes the bounds of the parallel trials.
+// Parallelism is raised while the trials start running promptly
+// and lowered when the trials stay pending longer than the pending timeout.
+type AdaptiveParallelismSpec struct {
+	// Min number of trials which run in parallel.
+	MinParallelTrialCount int32 `json:"minParallelTrialCount,omitempty"`
+
+	// Max number of trials which run in parallel.
+	MaxParallelTrialCount int32 `json:"maxParallelTrialCount,omitempty"`
+
+	// How long the trial can be pending before the parallelism is lowered.
+	// It is also the minimal interval between the parallelism changes.
+	// Default value is 5m.
+	PendingTimeout *metav1.Duration `json:"pendingTimeout,omitempty"`
+}
+
+// ParallelismStatus is the current parallelism of the trials.
+type ParallelismStatus struct {
+	// Number of trials which currently run in parallel.
+	EffectiveParallelTrialCount int32 `json:"effectiveParallelTrialCount,omitempty"`
+
+	// Longest duration for which the active trials have been pending.
+	PendingDuration metav1.Duration `json:"pendingDuration,omitempty"`
+
+	// Last time the effective parallel trial count was changed.
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+}
+
+// ActiveTrialsPolicyType describes what happens to the active trials once the budget of the Experiment is reached
+// or the Experiment is suspended.
+type ActiveTrialsPolicyType string
+
+const (
+	// FinishActiveTrials indicates that the active trials run until they are completed.
+	FinishActiveTrials ActiveTrialsPolicyType = "Finish"
+	// KillActiveTrials indicates that the active trials are killed and their runs are deleted.
+	KillActiveTrials ActiveTrialsPolicyType = "Kill"
+)
+
+// DuplicateSuggestionSpec describes how the duplicated parameter assignments are detected and handled
+type DuplicateSuggestionSpec struct {
+	// Policy for the parameter assignments which have already been suggested.
+	Policy DuplicateSuggestionPolicyType `json:"policy,omitempty"`
+
+	// Tolerance to compare the values of the int and double parameters, relative to the range of the feasible space.
+	// For example, with tolerance 0.01 and feasible space [0, 10], values 1.05 and 1.1 are the same.
+	// Values of the categorical and discrete parameters are compared exactly.
+	// Defaults to 0, i.e. assignments are duplicated only if all values are equal.
+	Tolerance *float64 `json:"tolerance,omitempty"`
+}
+
+// DuplicateSuggestionPolicyType describes how the duplicated parameter assignments are handled.
+type DuplicateSuggestionPolicyType string
+
+const (
+	// ResampleDuplicate indicates that the duplicated assignments are dropped and requested again.
+	// If the Suggestion returns only the duplicated assignments, they are requested again at the next reconcile.
+	// The Suggestion is exhausted after the limit of such consecutive rounds.
+	ResampleDuplicate DuplicateSuggestionPolicyType = "Resample"
+	// ReuseDuplicate indicates that the Trial is created for the duplicated assignments, but the Trial run
+	// is not created. The Trial is completed with the observation of the earlier Trial.
+	ReuseDuplicate DuplicateSuggestionPolicyType = "Reuse"
+)
+
 // TrialParameterSpec describes parameters that must be replaced in trial template
 type TrialParameterSpec struct {
 	// Name of the parameter that must be replaced in trial template
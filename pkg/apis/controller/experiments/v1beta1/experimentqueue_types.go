@@ -0,0 +1,67 @@
+/*
+Copyright 2022 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ExperimentQueueSpec is the specification of an ExperimentQueue.
+type ExperimentQueueSpec struct {
+	// Max number of active trials of all Experiments in the namespace.
+	// The trial slots are given to the Experiments with higher priority first,
+	// and divided by weight between the Experiments with the same priority.
+	MaxActiveTrials int32 `json:"maxActiveTrials"`
+}
+
+// QueueingSpec describes how the Experiment shares the trial slots of the ExperimentQueues.
+type QueueingSpec struct {
+	// Experiments with higher priority get the trial slots first.
+	// Default value is 0.
+	Priority int32 `json:"priority,omitempty"`
+
+	// Share of the trial slots between the Experiments with the same priority.
+	// Default value is 1.
+	Weight *int32 `json:"weight,omitempty"`
+}
+
+// +genclient
+// +genclient:noStatus
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Structure of the ExperimentQueue custom resource.
+// ExperimentQueue caps the total number of active trials of the Experiments in its namespace.
+// +k8s:openapi-gen=true
+type ExperimentQueue struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ExperimentQueueSpec `json:"spec,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ExperimentQueueList contains a list of ExperimentQueues
+type ExperimentQueueList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ExperimentQueue `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ExperimentQueue{}, &ExperimentQueueList{})
+}
@@ -18,9 +18,13 @@ package v1beta1
 
 import (
 	"errors"
+	"slices"
+	"strconv"
 
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	common "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1"
 )
 
 func getCondition(exp *Experiment, condType ExperimentConditionType) *ExperimentCondition {
@@ -86,6 +90,10 @@ func (exp *Experiment) IsRestarting() bool {
 	return hasCondition(exp, ExperimentRestarting)
 }
 
+func (exp *Experiment) IsSuspended() bool {
+	return hasCondition(exp, ExperimentSuspended)
+}
+
 func (exp *Experiment) IsCompleted() bool {
 	return exp.IsSucceeded() || exp.IsFailed()
 }
@@ -158,3 +166,87 @@ func (exp *Experiment) MarkExperimentStatusFailed(reason, message string) {
 	}
 	exp.setCondition(ExperimentFailed, v1.ConditionTrue, reason, message)
 }
+
+func (exp *Experiment) MarkExperimentStatusSuspended(reason, message string) {
+	currentCond := getCondition(exp, ExperimentRunning)
+	if currentCond != nil {
+		exp.setCondition(ExperimentRunning, v1.ConditionFalse, currentCond.Reason, currentCond.Message)
+	}
+	exp.setCondition(ExperimentSuspended, v1.ConditionTrue, reason, message)
+}
+
+func (exp *Experiment) MarkExperimentStatusResumed(reason, message string) {
+	exp.setCondition(ExperimentSuspended, v1.ConditionFalse, reason, message)
+}
+
+// GetActiveParameterAssignments returns assignments of the active Experiment parameters.
+// Conditional parameter is active only if its parent parameter is active and assigned to one of the condition values.
+func (exp *Experiment) GetActiveParameterAssignments(assignments []common.ParameterAssignment) []common.ParameterAssignment {
+	assignmentsMap := make(map[string]string, len(assignments))
+	for _, a := range assignments {
+		assignmentsMap[a.Name] = a.Value
+	}
+	// Parent parameters are always defined before their children, so iterate in the order of parameters.
+	active := make(map[string]bool, len(exp.Spec.Parameters))
+	for _, p := range exp.Spec.Parameters {
+		if _, ok := assignmentsMap[p.Name]; !ok {
+			continue
+		}
+		if p.Condition == nil {
+			active[p.Name] = true
+			continue
+		}
+		parentValue, ok := assignmentsMap[p.Condition.Parent]
+		active[p.Name] = ok && active[p.Condition.Parent] && slices.Contains(p.Condition.Values, parentValue)
+	}
+
+	activeAssignments := make([]common.ParameterAssignment, 0, len(assignments))
+	for _, a := range assignments {
+		// Assignments which don't belong to the Experiment parameters are kept as is.
+		if isActive, ok := active[a.Name]; !ok || isActive {
+			activeAssignments = append(activeAssignments, a)
+		}
+	}
+	return activeAssignments
+}
+
+// IsFeasibleParameterAssignments checks whether all assignments belong to the Experiment parameters
+// and their values are in the feasible space.
+func (exp *Experiment) IsFeasibleParameterAssignments(assignments []common.ParameterAssignment) bool {
+	parameters := make(map[string]ParameterSpec, len(exp.Spec.Parameters))
+	for _, p := range exp.Spec.Parameters {
+		parameters[p.Name] = p
+	}
+	for _, a := range assignments {
+		p, ok := parameters[a.Name]
+		if !ok || !p.isFeasibleValue(a.Value) {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *ParameterSpec) isFeasibleValue(value string) bool {
+	switch p.ParameterType {
+	case ParameterTypeInt:
+		if _, err := strconv.Atoi(value); err != nil {
+			return false
+		}
+		fallthrough
+	case ParameterTypeDouble:
+		v, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return false
+		}
+		if minValue, err := strconv.ParseFloat(p.FeasibleSpace.Min, 64); err == nil && v < minValue {
+			return false
+		}
+		if maxValue, err := strconv.ParseFloat(p.FeasibleSpace.Max, 64); err == nil && v > maxValue {
+			return false
+		}
+		return true
+	case ParameterTypeDiscrete, ParameterTypeCategorical:
+		return slices.Contains(p.FeasibleSpace.List, value)
+	}
+	return false
+}
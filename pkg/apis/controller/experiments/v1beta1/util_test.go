@@ -0,0 +1,189 @@
+/*
+Copyright 2022 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	common "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1"
+)
+
+func TestGetActiveParameterAssignments(t *testing.T) {
+	exp := &Experiment{
+		Spec: ExperimentSpec{
+			Parameters: []ParameterSpec{
+				{
+					Name:          "lr",
+					ParameterType: ParameterTypeDouble,
+				},
+				{
+					Name:          "optimizer",
+					ParameterType: ParameterTypeCategorical,
+				},
+				{
+					Name:          "momentum",
+					ParameterType: ParameterTypeDouble,
+					Condition:     &ParameterCondition{Parent: "optimizer", Values: []string{"sgd"}},
+				},
+				{
+					Name:          "nesterov",
+					ParameterType: ParameterTypeCategorical,
+					Condition:     &ParameterCondition{Parent: "optimizer", Values: []string{"sgd"}},
+				},
+				{
+					Name:          "dampening",
+					ParameterType: ParameterTypeDouble,
+					Condition:     &ParameterCondition{Parent: "nesterov", Values: []string{"false"}},
+				},
+			},
+		},
+	}
+
+	cases := map[string]struct {
+		assignments     []common.ParameterAssignment
+		wantAssignments []common.ParameterAssignment
+	}{
+		"All parameters are active": {
+			assignments: []common.ParameterAssignment{
+				{Name: "lr", Value: "0.01"},
+				{Name: "optimizer", Value: "sgd"},
+				{Name: "momentum", Value: "0.9"},
+				{Name: "nesterov", Value: "false"},
+				{Name: "dampening", Value: "0.1"},
+			},
+			wantAssignments: []common.ParameterAssignment{
+				{Name: "lr", Value: "0.01"},
+				{Name: "optimizer", Value: "sgd"},
+				{Name: "momentum", Value: "0.9"},
+				{Name: "nesterov", Value: "false"},
+				{Name: "dampening", Value: "0.1"},
+			},
+		},
+		"Children of the inactive parameter are inactive": {
+			assignments: []common.ParameterAssignment{
+				{Name: "lr", Value: "0.01"},
+				{Name: "optimizer", Value: "adam"},
+				{Name: "momentum", Value: "0.9"},
+				{Name: "nesterov", Value: "false"},
+				{Name: "dampening", Value: "0.1"},
+			},
+			wantAssignments: []common.ParameterAssignment{
+				{Name: "lr", Value: "0.01"},
+				{Name: "optimizer", Value: "adam"},
+			},
+		},
+		"Inactive parameters are not assigned": {
+			assignments: []common.ParameterAssignment{
+				{Name: "lr", Value: "0.01"},
+				{Name: "optimizer", Value: "sgd"},
+				{Name: "momentum", Value: "0.9"},
+				{Name: "nesterov", Value: "true"},
+			},
+			wantAssignments: []common.ParameterAssignment{
+				{Name: "lr", Value: "0.01"},
+				{Name: "optimizer", Value: "sgd"},
+				{Name: "momentum", Value: "0.9"},
+				{Name: "nesterov", Value: "true"},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := exp.GetActiveParameterAssignments(tc.assignments)
+			if diff := cmp.Diff(tc.wantAssignments, got); len(diff) != 0 {
+				t.Errorf("Unexpected assignments (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsFeasibleParameterAssignments(t *testing.T) {
+	exp := &Experiment{
+		Spec: ExperimentSpec{
+			Parameters: []ParameterSpec{
+				{
+					Name:          "lr",
+					ParameterType: ParameterTypeDouble,
+					FeasibleSpace: FeasibleSpace{Min: "0.01", Max: "0.1"},
+				},
+				{
+					Name:          "num-layers",
+					ParameterType: ParameterTypeInt,
+					FeasibleSpace: FeasibleSpace{Min: "2", Max: "5"},
+				},
+				{
+					Name:          "optimizer",
+					ParameterType: ParameterTypeCategorical,
+					FeasibleSpace: FeasibleSpace{List: []string{"sgd", "adam"}},
+				},
+			},
+		},
+	}
+
+	cases := map[string]struct {
+		assignments []common.ParameterAssignment
+		want        bool
+	}{
+		"All assignments are feasible": {
+			assignments: []common.ParameterAssignment{
+				{Name: "lr", Value: "0.05"},
+				{Name: "num-layers", Value: "5"},
+				{Name: "optimizer", Value: "adam"},
+			},
+			want: true,
+		},
+		"Some parameters are not assigned": {
+			assignments: []common.ParameterAssignment{
+				{Name: "lr", Value: "0.01"},
+			},
+			want: true,
+		},
+		"Double is out of range": {
+			assignments: []common.ParameterAssignment{
+				{Name: "lr", Value: "0.2"},
+			},
+			want: false,
+		},
+		"Int is not integer": {
+			assignments: []common.ParameterAssignment{
+				{Name: "num-layers", Value: "2.5"},
+			},
+			want: false,
+		},
+		"Categorical value is not in the list": {
+			assignments: []common.ParameterAssignment{
+				{Name: "optimizer", Value: "rmsprop"},
+			},
+			want: false,
+		},
+		"Unknown parameter": {
+			assignments: []common.ParameterAssignment{
+				{Name: "momentum", Value: "0.9"},
+			},
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := exp.IsFeasibleParameterAssignments(tc.assignments); got != tc.want {
+				t.Errorf("Unexpected result from IsFeasibleParameterAssignments, want: %v, got: %v", tc.want, got)
+			}
+		})
+	}
+}
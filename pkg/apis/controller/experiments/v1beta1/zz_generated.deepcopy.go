@@ -23,9 +23,31 @@ package v1beta1
 
 import (
 	commonv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdaptiveParallelismSpec) DeepCopyInto(out *AdaptiveParallelismSpec) {
+	*out = *in
+	if in.PendingTimeout != nil {
+		in, out := &in.PendingTimeout, &out.PendingTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdaptiveParallelismSpec.
+func (in *AdaptiveParallelismSpec) DeepCopy() *AdaptiveParallelismSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AdaptiveParallelismSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ConfigMapSource) DeepCopyInto(out *ConfigMapSource) {
 	*out = *in
@@ -42,6 +64,64 @@ func (in *ConfigMapSource) DeepCopy() *ConfigMapSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConvergenceSpec) DeepCopyInto(out *ConvergenceSpec) {
+	*out = *in
+	if in.MinDelta != nil {
+		in, out := &in.MinDelta, &out.MinDelta
+		*out = new(float64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConvergenceSpec.
+func (in *ConvergenceSpec) DeepCopy() *ConvergenceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ConvergenceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConvergenceStatus) DeepCopyInto(out *ConvergenceStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConvergenceStatus.
+func (in *ConvergenceStatus) DeepCopy() *ConvergenceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ConvergenceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DuplicateSuggestionSpec) DeepCopyInto(out *DuplicateSuggestionSpec) {
+	*out = *in
+	if in.Tolerance != nil {
+		in, out := &in.Tolerance, &out.Tolerance
+		*out = new(float64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DuplicateSuggestionSpec.
+func (in *DuplicateSuggestionSpec) DeepCopy() *DuplicateSuggestionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DuplicateSuggestionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Experiment) DeepCopyInto(out *Experiment) {
 	*out = *in
@@ -121,6 +201,82 @@ func (in *ExperimentList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExperimentQueue) DeepCopyInto(out *ExperimentQueue) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExperimentQueue.
+func (in *ExperimentQueue) DeepCopy() *ExperimentQueue {
+	if in == nil {
+		return nil
+	}
+	out := new(ExperimentQueue)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ExperimentQueue) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExperimentQueueList) DeepCopyInto(out *ExperimentQueueList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ExperimentQueue, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExperimentQueueList.
+func (in *ExperimentQueueList) DeepCopy() *ExperimentQueueList {
+	if in == nil {
+		return nil
+	}
+	out := new(ExperimentQueueList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ExperimentQueueList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExperimentQueueSpec) DeepCopyInto(out *ExperimentQueueSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExperimentQueueSpec.
+func (in *ExperimentQueueSpec) DeepCopy() *ExperimentQueueSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ExperimentQueueSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExperimentSpec) DeepCopyInto(out *ExperimentSpec) {
 	*out = *in
@@ -131,6 +287,11 @@ func (in *ExperimentSpec) DeepCopyInto(out *ExperimentSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ParameterConstraints != nil {
+		in, out := &in.ParameterConstraints, &out.ParameterConstraints
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.Objective != nil {
 		in, out := &in.Objective, &out.Objective
 		*out = new(commonv1beta1.ObjectiveSpec)
@@ -156,6 +317,11 @@ func (in *ExperimentSpec) DeepCopyInto(out *ExperimentSpec) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.AdaptiveParallelism != nil {
+		in, out := &in.AdaptiveParallelism, &out.AdaptiveParallelism
+		*out = new(AdaptiveParallelismSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.MaxTrialCount != nil {
 		in, out := &in.MaxTrialCount, &out.MaxTrialCount
 		*out = new(int32)
@@ -166,6 +332,31 @@ func (in *ExperimentSpec) DeepCopyInto(out *ExperimentSpec) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.MaxDuration != nil {
+		in, out := &in.MaxDuration, &out.MaxDuration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MaxResourceUsage != nil {
+		in, out := &in.MaxResourceUsage, &out.MaxResourceUsage
+		*out = new(ResourceUsageSpec)
+		**out = **in
+	}
+	if in.Suspend != nil {
+		in, out := &in.Suspend, &out.Suspend
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Queueing != nil {
+		in, out := &in.Queueing, &out.Queueing
+		*out = new(QueueingSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Convergence != nil {
+		in, out := &in.Convergence, &out.Convergence
+		*out = new(ConvergenceSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.MetricsCollectorSpec != nil {
 		in, out := &in.MetricsCollectorSpec, &out.MetricsCollectorSpec
 		*out = new(commonv1beta1.MetricsCollectorSpec)
@@ -176,6 +367,21 @@ func (in *ExperimentSpec) DeepCopyInto(out *ExperimentSpec) {
 		*out = new(NasConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.WarmStart != nil {
+		in, out := &in.WarmStart, &out.WarmStart
+		*out = new(WarmStartSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DuplicateSuggestion != nil {
+		in, out := &in.DuplicateSuggestion, &out.DuplicateSuggestion
+		*out = new(DuplicateSuggestionSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TrialCache != nil {
+		in, out := &in.TrialCache, &out.TrialCache
+		*out = new(commonv1beta1.TrialCacheSpec)
+		**out = **in
+	}
 	return
 }
 
@@ -212,6 +418,13 @@ func (in *ExperimentStatus) DeepCopyInto(out *ExperimentStatus) {
 		}
 	}
 	in.CurrentOptimalTrial.DeepCopyInto(&out.CurrentOptimalTrial)
+	if in.ParetoOptimalTrials != nil {
+		in, out := &in.ParetoOptimalTrials, &out.ParetoOptimalTrials
+		*out = make([]OptimalTrial, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.RunningTrialList != nil {
 		in, out := &in.RunningTrialList, &out.RunningTrialList
 		*out = make([]string, len(*in))
@@ -247,6 +460,21 @@ func (in *ExperimentStatus) DeepCopyInto(out *ExperimentStatus) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.ResourceUsage != nil {
+		in, out := &in.ResourceUsage, &out.ResourceUsage
+		*out = new(float64)
+		**out = **in
+	}
+	if in.Convergence != nil {
+		in, out := &in.Convergence, &out.Convergence
+		*out = new(ConvergenceStatus)
+		**out = **in
+	}
+	if in.Parallelism != nil {
+		in, out := &in.Parallelism, &out.Parallelism
+		*out = new(ParallelismStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -381,10 +609,54 @@ func (in *OptimalTrial) DeepCopy() *OptimalTrial {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ParallelismStatus) DeepCopyInto(out *ParallelismStatus) {
+	*out = *in
+	out.PendingDuration = in.PendingDuration
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ParallelismStatus.
+func (in *ParallelismStatus) DeepCopy() *ParallelismStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ParallelismStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ParameterCondition) DeepCopyInto(out *ParameterCondition) {
+	*out = *in
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ParameterCondition.
+func (in *ParameterCondition) DeepCopy() *ParameterCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(ParameterCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ParameterSpec) DeepCopyInto(out *ParameterSpec) {
 	*out = *in
 	in.FeasibleSpace.DeepCopyInto(&out.FeasibleSpace)
+	if in.Condition != nil {
+		in, out := &in.Condition, &out.Condition
+		*out = new(ParameterCondition)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -398,6 +670,43 @@ func (in *ParameterSpec) DeepCopy() *ParameterSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QueueingSpec) DeepCopyInto(out *QueueingSpec) {
+	*out = *in
+	if in.Weight != nil {
+		in, out := &in.Weight, &out.Weight
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueueingSpec.
+func (in *QueueingSpec) DeepCopy() *QueueingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(QueueingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceUsageSpec) DeepCopyInto(out *ResourceUsageSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceUsageSpec.
+func (in *ResourceUsageSpec) DeepCopy() *ResourceUsageSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceUsageSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TrialParameterSpec) DeepCopyInto(out *TrialParameterSpec) {
 	*out = *in
@@ -455,6 +764,21 @@ func (in *TrialTemplate) DeepCopyInto(out *TrialTemplate) {
 			(*out)[key] = val
 		}
 	}
+	if in.RetryPolicy != nil {
+		in, out := &in.RetryPolicy, &out.RetryPolicy
+		*out = new(commonv1beta1.RetryPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ActiveDeadlineSeconds != nil {
+		in, out := &in.ActiveDeadlineSeconds, &out.ActiveDeadlineSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.PendingDeadlineSeconds != nil {
+		in, out := &in.PendingDeadlineSeconds, &out.PendingDeadlineSeconds
+		*out = new(int64)
+		**out = **in
+	}
 	return
 }
 
@@ -467,3 +791,45 @@ func (in *TrialTemplate) DeepCopy() *TrialTemplate {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrialsConfigMapSource) DeepCopyInto(out *TrialsConfigMapSource) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrialsConfigMapSource.
+func (in *TrialsConfigMapSource) DeepCopy() *TrialsConfigMapSource {
+	if in == nil {
+		return nil
+	}
+	out := new(TrialsConfigMapSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WarmStartSpec) DeepCopyInto(out *WarmStartSpec) {
+	*out = *in
+	if in.SourceExperiments != nil {
+		in, out := &in.SourceExperiments, &out.SourceExperiments
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TrialsConfigMap != nil {
+		in, out := &in.TrialsConfigMap, &out.TrialsConfigMap
+		*out = new(TrialsConfigMapSource)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WarmStartSpec.
+func (in *WarmStartSpec) DeepCopy() *WarmStartSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(WarmStartSpec)
+	in.DeepCopyInto(out)
+	return out
+}
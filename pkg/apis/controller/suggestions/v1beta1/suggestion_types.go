@@ -39,6 +39,10 @@ type SuggestionSpec struct {
 	// ResumePolicy describes resuming policy which usually take effect after experiment terminated.
 	// Default value is Never.
 	ResumePolicy experiment.ResumePolicyType `json:"resumePolicy,omitempty"`
+
+	// Suspend indicates that the Experiment is suspended.
+	// Suggestion deployment is scaled down and no assignments are requested while it is true.
+	Suspend bool `json:"suspend,omitempty"`
 }
 
 // SuggestionStatus is the current status of a Suggestion.
@@ -53,6 +57,17 @@ type SuggestionStatus struct {
 	// Number of suggestion results
 	SuggestionCount int32 `json:"suggestionCount,omitempty"`
 
+	// Number of suggested parameter assignments which were rejected, because they violate
+	// the parameter constraints or duplicate the earlier assignments.
+	// They are counted in the total request number, so algorithms which enumerate
+	// the search space, e.g. grid, don't suggest them again.
+	RejectedSuggestionCount int32 `json:"rejectedSuggestionCount,omitempty"`
+
+	// Number of consecutive rounds in which all suggested parameter assignments were rejected.
+	// Suggestion is exhausted when it reaches the limit, since the algorithm may never
+	// report the exhausted search space, e.g. random with the small discrete space.
+	RejectedRoundCount int32 `json:"rejectedRoundCount,omitempty"`
+
 	// Suggestion results
 	// +listType=map
 	// +listMapKey=name
@@ -97,6 +112,14 @@ type TrialAssignment struct {
 
 	// Suggestion label metadata to attach to Trial job
 	Labels map[string]string `json:"labels,omitempty"`
+
+	// Name of the earlier suggestion with the same parameter assignments.
+	// It is set only if the duplicated assignments are reused, see Experiment spec.duplicateSuggestion.
+	DuplicateOf string `json:"duplicateOf,omitempty"`
+
+	// Budget of the Trial for multi-fidelity algorithms, e.g. number of epochs or dataset fraction.
+	// It is not a hyperparameter and it is referenced in the Trial template by ${trialSpec.Budget}.
+	Budget string `json:"budget,omitempty"`
 }
 
 // SuggestionCondition describes the state of the Suggestion at a certain point.
@@ -130,6 +153,8 @@ const (
 	SuggestionRunning         SuggestionConditionType = "Running"
 	SuggestionSucceeded       SuggestionConditionType = "Succeeded"
 	SuggestionFailed          SuggestionConditionType = "Failed"
+	// SuggestionExhausted means that the algorithm has suggested all assignments of the search space.
+	SuggestionExhausted SuggestionConditionType = "Exhausted"
 )
 
 // +genclient
@@ -33,6 +33,9 @@ type TrialSpec struct {
 	// +listMapKey=name
 	ParameterAssignments []common.ParameterAssignment `json:"parameterAssignments,omitempty"`
 
+	// Budget of the Trial for multi-fidelity algorithms, e.g. number of epochs or dataset fraction.
+	Budget string `json:"budget,omitempty"`
+
 	// Rules for early stopping techniques.
 	// Each rule should be met to early stop Trial.
 	// +listType=map
@@ -68,6 +71,26 @@ type TrialSpec struct {
 
 	// Labels that provide additional metadata for services (e.g. Suggestions tracking)
 	Labels map[string]string `json:"labels,omitempty"`
+
+	// Name of the Trial in the same namespace which results are reused.
+	// If it is set, the Trial run is not created and the Trial is completed
+	// with the observation of the referenced Trial once it is completed.
+	ReuseObservationFrom string `json:"reuseObservationFrom,omitempty"`
+
+	// Describes how the results of the earlier Trials with the same fingerprint are reused.
+	// If it is set and the succeeded Trial with the same fingerprint exists, the Trial run is not created
+	// and the observation log of that Trial is copied.
+	Cache *common.TrialCacheSpec `json:"cache,omitempty"`
+
+	// Describes how the failed Trial run is retried.
+	RetryPolicy *common.RetryPolicy `json:"retryPolicy,omitempty"`
+
+	// Duration in seconds relative to the Trial run creation time that the run may be active.
+	ActiveDeadlineSeconds *int64 `json:"activeDeadlineSeconds,omitempty"`
+
+	// Duration in seconds relative to the Trial run creation time that the run may wait
+	// until any of its pods is running.
+	PendingDeadlineSeconds *int64 `json:"pendingDeadlineSeconds,omitempty"`
 }
 
 // TrialStatus is the current status of a Trial.
@@ -94,6 +117,25 @@ type TrialStatus struct {
 
 	// Results of the Trial - objectives and other metrics values.
 	Observation *common.Observation `json:"observation,omitempty"`
+
+	// History of the failed Trial runs which have been retried.
+	// +listType=atomic
+	Attempts []TrialAttempt `json:"attempts,omitempty"`
+}
+
+// TrialAttempt describes the failed Trial run which has been retried.
+type TrialAttempt struct {
+	// Time when the run was created.
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// Time when the run was failed.
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// The reason of the run failure.
+	Reason string `json:"reason,omitempty"`
+
+	// A human readable message of the run failure.
+	Message string `json:"message,omitempty"`
 }
 
 // +k8s:deepcopy-gen=true
@@ -147,6 +147,11 @@ func (trial *Trial) MarkTrialStatusRunning(reason, message string) {
 	trial.setCondition(TrialRunning, v1.ConditionTrue, reason, message)
 }
 
+// MarkTrialStatusRetrying marks the Trial not running while its failed run is recreated.
+func (trial *Trial) MarkTrialStatusRetrying(reason, message string) {
+	trial.setCondition(TrialRunning, v1.ConditionFalse, reason, message)
+}
+
 func (trial *Trial) MarkTrialStatusSucceeded(status v1.ConditionStatus, reason, message string) {
 	currentCond := getCondition(trial, TrialRunning)
 	if currentCond != nil {
@@ -54,6 +54,30 @@ func (in *Trial) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrialAttempt) DeepCopyInto(out *TrialAttempt) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrialAttempt.
+func (in *TrialAttempt) DeepCopy() *TrialAttempt {
+	if in == nil {
+		return nil
+	}
+	out := new(TrialAttempt)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TrialCondition) DeepCopyInto(out *TrialCondition) {
 	*out = *in
@@ -142,6 +166,26 @@ func (in *TrialSpec) DeepCopyInto(out *TrialSpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.Cache != nil {
+		in, out := &in.Cache, &out.Cache
+		*out = new(commonv1beta1.TrialCacheSpec)
+		**out = **in
+	}
+	if in.RetryPolicy != nil {
+		in, out := &in.RetryPolicy, &out.RetryPolicy
+		*out = new(commonv1beta1.RetryPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ActiveDeadlineSeconds != nil {
+		in, out := &in.ActiveDeadlineSeconds, &out.ActiveDeadlineSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.PendingDeadlineSeconds != nil {
+		in, out := &in.PendingDeadlineSeconds, &out.PendingDeadlineSeconds
+		*out = new(int64)
+		**out = **in
+	}
 	return
 }
 
@@ -182,6 +226,13 @@ func (in *TrialStatus) DeepCopyInto(out *TrialStatus) {
 		*out = new(commonv1beta1.Observation)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Attempts != nil {
+		in, out := &in.Attempts, &out.Attempts
+		*out = make([]TrialAttempt, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -32,48 +32,65 @@ import (
 
 func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenAPIDefinition {
 	return map[string]common.OpenAPIDefinition{
-		"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.AlgorithmSetting":         schema_apis_controller_common_v1beta1_AlgorithmSetting(ref),
-		"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.AlgorithmSpec":            schema_apis_controller_common_v1beta1_AlgorithmSpec(ref),
-		"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.CollectorSpec":            schema_apis_controller_common_v1beta1_CollectorSpec(ref),
-		"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.EarlyStoppingRule":        schema_apis_controller_common_v1beta1_EarlyStoppingRule(ref),
-		"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.EarlyStoppingSetting":     schema_apis_controller_common_v1beta1_EarlyStoppingSetting(ref),
-		"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.EarlyStoppingSpec":        schema_apis_controller_common_v1beta1_EarlyStoppingSpec(ref),
-		"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.FileSystemPath":           schema_apis_controller_common_v1beta1_FileSystemPath(ref),
-		"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.FilterSpec":               schema_apis_controller_common_v1beta1_FilterSpec(ref),
-		"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.Metric":                   schema_apis_controller_common_v1beta1_Metric(ref),
-		"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.MetricStrategy":           schema_apis_controller_common_v1beta1_MetricStrategy(ref),
-		"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.MetricsCollectorSpec":     schema_apis_controller_common_v1beta1_MetricsCollectorSpec(ref),
-		"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.ObjectiveSpec":            schema_apis_controller_common_v1beta1_ObjectiveSpec(ref),
-		"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.Observation":              schema_apis_controller_common_v1beta1_Observation(ref),
-		"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.ParameterAssignment":      schema_apis_controller_common_v1beta1_ParameterAssignment(ref),
-		"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.SourceSpec":               schema_apis_controller_common_v1beta1_SourceSpec(ref),
-		"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.ConfigMapSource":     schema_apis_controller_experiments_v1beta1_ConfigMapSource(ref),
-		"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.Experiment":          schema_apis_controller_experiments_v1beta1_Experiment(ref),
-		"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.ExperimentCondition": schema_apis_controller_experiments_v1beta1_ExperimentCondition(ref),
-		"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.ExperimentList":      schema_apis_controller_experiments_v1beta1_ExperimentList(ref),
-		"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.ExperimentSpec":      schema_apis_controller_experiments_v1beta1_ExperimentSpec(ref),
-		"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.ExperimentStatus":    schema_apis_controller_experiments_v1beta1_ExperimentStatus(ref),
-		"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.FeasibleSpace":       schema_apis_controller_experiments_v1beta1_FeasibleSpace(ref),
-		"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.GraphConfig":         schema_apis_controller_experiments_v1beta1_GraphConfig(ref),
-		"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.NasConfig":           schema_apis_controller_experiments_v1beta1_NasConfig(ref),
-		"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.Operation":           schema_apis_controller_experiments_v1beta1_Operation(ref),
-		"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.OptimalTrial":        schema_apis_controller_experiments_v1beta1_OptimalTrial(ref),
-		"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.ParameterSpec":       schema_apis_controller_experiments_v1beta1_ParameterSpec(ref),
-		"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.TrialParameterSpec":  schema_apis_controller_experiments_v1beta1_TrialParameterSpec(ref),
-		"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.TrialSource":         schema_apis_controller_experiments_v1beta1_TrialSource(ref),
-		"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.TrialTemplate":       schema_apis_controller_experiments_v1beta1_TrialTemplate(ref),
-		"github.com/kubeflow/katib/pkg/apis/controller/suggestions/v1beta1.Suggestion":          schema_apis_controller_suggestions_v1beta1_Suggestion(ref),
-		"github.com/kubeflow/katib/pkg/apis/controller/suggestions/v1beta1.SuggestionCondition": schema_apis_controller_suggestions_v1beta1_SuggestionCondition(ref),
-		"github.com/kubeflow/katib/pkg/apis/controller/suggestions/v1beta1.SuggestionList":      schema_apis_controller_suggestions_v1beta1_SuggestionList(ref),
-		"github.com/kubeflow/katib/pkg/apis/controller/suggestions/v1beta1.SuggestionSpec":      schema_apis_controller_suggestions_v1beta1_SuggestionSpec(ref),
-		"github.com/kubeflow/katib/pkg/apis/controller/suggestions/v1beta1.SuggestionStatus":    schema_apis_controller_suggestions_v1beta1_SuggestionStatus(ref),
-		"github.com/kubeflow/katib/pkg/apis/controller/suggestions/v1beta1.TrialAssignment":     schema_apis_controller_suggestions_v1beta1_TrialAssignment(ref),
-		"github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1.Trial":                    schema_apis_controller_trials_v1beta1_Trial(ref),
-		"github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1.TrialCondition":           schema_apis_controller_trials_v1beta1_TrialCondition(ref),
-		"github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1.TrialList":                schema_apis_controller_trials_v1beta1_TrialList(ref),
-		"github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1.TrialSpec":                schema_apis_controller_trials_v1beta1_TrialSpec(ref),
-		"github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1.TrialStatus":              schema_apis_controller_trials_v1beta1_TrialStatus(ref),
-		"k8s.io/api/core/v1.AWSElasticBlockStoreVolumeSource":                                   schema_k8sio_api_core_v1_AWSElasticBlockStoreVolumeSource(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.AdditionalObjective":          schema_apis_controller_common_v1beta1_AdditionalObjective(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.AlgorithmSetting":             schema_apis_controller_common_v1beta1_AlgorithmSetting(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.AlgorithmSpec":                schema_apis_controller_common_v1beta1_AlgorithmSpec(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.CollectorSpec":                schema_apis_controller_common_v1beta1_CollectorSpec(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.EarlyStoppingRule":            schema_apis_controller_common_v1beta1_EarlyStoppingRule(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.EarlyStoppingSetting":         schema_apis_controller_common_v1beta1_EarlyStoppingSetting(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.EarlyStoppingSpec":            schema_apis_controller_common_v1beta1_EarlyStoppingSpec(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.FileSystemPath":               schema_apis_controller_common_v1beta1_FileSystemPath(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.FilterSpec":                   schema_apis_controller_common_v1beta1_FilterSpec(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.Metric":                       schema_apis_controller_common_v1beta1_Metric(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.MetricStrategy":               schema_apis_controller_common_v1beta1_MetricStrategy(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.MetricsCollectorSpec":         schema_apis_controller_common_v1beta1_MetricsCollectorSpec(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.ObjectiveSpec":                schema_apis_controller_common_v1beta1_ObjectiveSpec(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.Observation":                  schema_apis_controller_common_v1beta1_Observation(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.ParameterAssignment":          schema_apis_controller_common_v1beta1_ParameterAssignment(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.RetryPolicy":                  schema_apis_controller_common_v1beta1_RetryPolicy(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.SourceSpec":                   schema_apis_controller_common_v1beta1_SourceSpec(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.TrialCacheSpec":               schema_apis_controller_common_v1beta1_TrialCacheSpec(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.AdaptiveParallelismSpec": schema_apis_controller_experiments_v1beta1_AdaptiveParallelismSpec(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.ConfigMapSource":         schema_apis_controller_experiments_v1beta1_ConfigMapSource(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.ConvergenceSpec":         schema_apis_controller_experiments_v1beta1_ConvergenceSpec(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.ConvergenceStatus":       schema_apis_controller_experiments_v1beta1_ConvergenceStatus(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.DuplicateSuggestionSpec": schema_apis_controller_experiments_v1beta1_DuplicateSuggestionSpec(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.Experiment":              schema_apis_controller_experiments_v1beta1_Experiment(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.ExperimentCondition":     schema_apis_controller_experiments_v1beta1_ExperimentCondition(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.ExperimentList":          schema_apis_controller_experiments_v1beta1_ExperimentList(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.ExperimentQueue":         schema_apis_controller_experiments_v1beta1_ExperimentQueue(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.ExperimentQueueList":     schema_apis_controller_experiments_v1beta1_ExperimentQueueList(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.ExperimentQueueSpec":     schema_apis_controller_experiments_v1beta1_ExperimentQueueSpec(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.ExperimentSpec":          schema_apis_controller_experiments_v1beta1_ExperimentSpec(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.ExperimentStatus":        schema_apis_controller_experiments_v1beta1_ExperimentStatus(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.FeasibleSpace":           schema_apis_controller_experiments_v1beta1_FeasibleSpace(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.GraphConfig":             schema_apis_controller_experiments_v1beta1_GraphConfig(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.NasConfig":               schema_apis_controller_experiments_v1beta1_NasConfig(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.Operation":               schema_apis_controller_experiments_v1beta1_Operation(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.OptimalTrial":            schema_apis_controller_experiments_v1beta1_OptimalTrial(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.ParallelismStatus":       schema_apis_controller_experiments_v1beta1_ParallelismStatus(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.ParameterCondition":      schema_apis_controller_experiments_v1beta1_ParameterCondition(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.ParameterSpec":           schema_apis_controller_experiments_v1beta1_ParameterSpec(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.QueueingSpec":            schema_apis_controller_experiments_v1beta1_QueueingSpec(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.ResourceUsageSpec":       schema_apis_controller_experiments_v1beta1_ResourceUsageSpec(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.TrialParameterSpec":      schema_apis_controller_experiments_v1beta1_TrialParameterSpec(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.TrialSource":             schema_apis_controller_experiments_v1beta1_TrialSource(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.TrialTemplate":           schema_apis_controller_experiments_v1beta1_TrialTemplate(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.TrialsConfigMapSource":   schema_apis_controller_experiments_v1beta1_TrialsConfigMapSource(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.WarmStartSpec":           schema_apis_controller_experiments_v1beta1_WarmStartSpec(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/suggestions/v1beta1.Suggestion":              schema_apis_controller_suggestions_v1beta1_Suggestion(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/suggestions/v1beta1.SuggestionCondition":     schema_apis_controller_suggestions_v1beta1_SuggestionCondition(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/suggestions/v1beta1.SuggestionList":          schema_apis_controller_suggestions_v1beta1_SuggestionList(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/suggestions/v1beta1.SuggestionSpec":          schema_apis_controller_suggestions_v1beta1_SuggestionSpec(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/suggestions/v1beta1.SuggestionStatus":        schema_apis_controller_suggestions_v1beta1_SuggestionStatus(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/suggestions/v1beta1.TrialAssignment":         schema_apis_controller_suggestions_v1beta1_TrialAssignment(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1.Trial":                        schema_apis_controller_trials_v1beta1_Trial(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1.TrialAttempt":                 schema_apis_controller_trials_v1beta1_TrialAttempt(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1.TrialCondition":               schema_apis_controller_trials_v1beta1_TrialCondition(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1.TrialList":                    schema_apis_controller_trials_v1beta1_TrialList(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1.TrialSpec":                    schema_apis_controller_trials_v1beta1_TrialSpec(ref),
+		"github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1.TrialStatus":                  schema_apis_controller_trials_v1beta1_TrialStatus(ref),
+		"k8s.io/api/core/v1.AWSElasticBlockStoreVolumeSource":                                       schema_k8sio_api_core_v1_AWSElasticBlockStoreVolumeSource(ref),
 		"k8s.io/api/core/v1.Affinity":                                    schema_k8sio_api_core_v1_Affinity(ref),
 		"k8s.io/api/core/v1.AppArmorProfile":                             schema_k8sio_api_core_v1_AppArmorProfile(ref),
 		"k8s.io/api/core/v1.AttachedVolume":                              schema_k8sio_api_core_v1_AttachedVolume(ref),
@@ -367,6 +384,33 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 	}
 }
 
+func schema_apis_controller_common_v1beta1_AdditionalObjective(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AdditionalObjective represents the additional metric to optimize in the multi-objective Experiment.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type for the metric optimization.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"objectiveMetricName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObjectiveMetricName represents the metric name to optimize.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_apis_controller_common_v1beta1_AlgorithmSetting(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -600,6 +644,34 @@ func schema_apis_controller_common_v1beta1_FileSystemPath(ref common.ReferenceCa
 							Format: "",
 						},
 					},
+					"timestampKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Key of the metrics timestamp when the format is JSON, nested keys are separated by dots, e.g. \"meta.time\". Defaults to \"timestamp\"",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"timestampFormat": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Go layout of the metrics timestamp string when the format is JSON, e.g. \"2006-01-02 15:04:05\". Defaults to RFC3339. Numeric timestamps are parsed as Unix time in seconds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"pattern": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Glob pattern of the metrics files in the directory when the kind is Directory, e.g. \"metrics-*.log\". Files which are created after the training starts are collected as well. Each metric is tagged with the name of its file as the source",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"aggregation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Aggregation of the metrics across the metrics files which match the pattern. Defaults to None",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -770,6 +842,28 @@ func schema_apis_controller_common_v1beta1_ObjectiveSpec(ref common.ReferenceCal
 							},
 						},
 					},
+					"additionalObjectives": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"objectiveMetricName",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "AdditionalObjectives represents metrics that are optimized together with the objective metric in the multi-objective Experiment. Each metric has its own optimization type. Additional objectives are collected from Trials as the additional metrics.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.AdditionalObjective"),
+									},
+								},
+							},
+						},
+					},
 					"metricStrategies": {
 						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
@@ -796,7 +890,7 @@ func schema_apis_controller_common_v1beta1_ObjectiveSpec(ref common.ReferenceCal
 			},
 		},
 		Dependencies: []string{
-			"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.MetricStrategy"},
+			"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.AdditionalObjective", "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.MetricStrategy"},
 	}
 }
 
@@ -860,6 +954,74 @@ func schema_apis_controller_common_v1beta1_ParameterAssignment(ref common.Refere
 	}
 }
 
+func schema_apis_controller_common_v1beta1_RetryPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RetryPolicy describes how the failed Trial run is retried. The run is recreated with the same parameter assignments, so the failed attempt doesn't count against the max failed trials of the Experiment.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"maxRetries": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Max number of retries of the failed Trial run.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"backoff": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Delay before the first retry. It is doubled for every following retry. Defaults to 10s.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"onReasons": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Regular expressions for the failure reason of the Trial run. If any of onReasons and onMessages is set, the run is retried only if its failure reason or message matches one of them.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"onMessages": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Regular expressions for the failure message of the Trial run.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
 func schema_apis_controller_common_v1beta1_SourceSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -892,6 +1054,61 @@ func schema_apis_controller_common_v1beta1_SourceSpec(ref common.ReferenceCallba
 	}
 }
 
+func schema_apis_controller_common_v1beta1_TrialCacheSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TrialCacheSpec describes how the results of the earlier Trials are reused. Trial is completed with the observation of the succeeded Trial which has the same fingerprint, i.e. the same run spec and parameter assignments, instead of creating the Trial run.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"scope": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Scope of the Trials which results are reused. Defaults to Namespace.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_apis_controller_experiments_v1beta1_AdaptiveParallelismSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AdaptiveParallelismSpec describes the bounds of the parallel trials. Parallelism is raised while the trials start running promptly and lowered when the trials stay pending longer than the pending timeout.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"minParallelTrialCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Min number of trials which run in parallel.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"maxParallelTrialCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Max number of trials which run in parallel.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"pendingTimeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "How long the trial can be pending before the parallelism is lowered. It is also the minimal interval between the parallelism changes. Default value is 5m.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
 func schema_apis_controller_experiments_v1beta1_ConfigMapSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -926,6 +1143,94 @@ func schema_apis_controller_experiments_v1beta1_ConfigMapSource(ref common.Refer
 	}
 }
 
+func schema_apis_controller_experiments_v1beta1_ConvergenceSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ConvergenceSpec describes the plateau of the best objective value to stop the Experiment. Trials are counted in order of the completion time, only trials with the objective metric value are counted.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"patience": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Number of the completed trials without improvement of the best objective value to mark experiment as succeeded.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"minDelta": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Min change of the best objective value to qualify as the improvement. Defaults to 0, i.e. any better objective value is the improvement.",
+							Type:        []string{"number"},
+							Format:      "double",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_apis_controller_experiments_v1beta1_ConvergenceStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ConvergenceStatus is the statistics of the best objective value plateau.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"bestTrialName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the trial which has improved the best objective value last.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"bestObjectiveValue": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Objective value of the trial which has improved the best objective value last.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"trialsWithoutImprovement": {
+						SchemaProps: spec.SchemaProps{
+							Description: "How many trials have been completed since the last improvement of the best objective value.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_apis_controller_experiments_v1beta1_DuplicateSuggestionSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DuplicateSuggestionSpec describes how the duplicated parameter assignments are detected and handled",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"policy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Policy for the parameter assignments which have already been suggested.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"tolerance": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Tolerance to compare the values of the int and double parameters, relative to the range of the feasible space. For example, with tolerance 0.01 and feasible space [0, 10], values 1.05 and 1.1 are the same. Values of the categorical and discrete parameters are compared exactly. Defaults to 0, i.e. assignments are duplicated only if all values are equal.",
+							Type:        []string{"number"},
+							Format:      "double",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_apis_controller_experiments_v1beta1_Experiment(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1080,6 +1385,118 @@ func schema_apis_controller_experiments_v1beta1_ExperimentList(ref common.Refere
 	}
 }
 
+func schema_apis_controller_experiments_v1beta1_ExperimentQueue(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Structure of the ExperimentQueue custom resource. ExperimentQueue caps the total number of active trials of the Experiments in its namespace.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.ExperimentQueueSpec"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.ExperimentQueueSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_apis_controller_experiments_v1beta1_ExperimentQueueList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ExperimentQueueList contains a list of ExperimentQueues",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.ExperimentQueue"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.ExperimentQueue", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_apis_controller_experiments_v1beta1_ExperimentQueueSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ExperimentQueueSpec is the specification of an ExperimentQueue.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"maxActiveTrials": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Max number of active trials of all Experiments in the namespace. The trial slots are given to the Experiments with higher priority first, and divided by weight between the Experiments with the same priority.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"maxActiveTrials"},
+			},
+		},
+	}
+}
+
 func schema_apis_controller_experiments_v1beta1_ExperimentSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1109,6 +1526,26 @@ func schema_apis_controller_experiments_v1beta1_ExperimentSpec(ref common.Refere
 							},
 						},
 					},
+					"parameterConstraints": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "List of constraints for the parameter assignments, for example \"batch_size * grad_accum <= 512\". Each constraint is the boolean expression in the Go syntax over the parameter names. Parameters which names are not valid identifiers can be referenced as params[\"num-layers\"]. Trials are not created for the assignments which violate any constraint.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
 					"objective": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Describes the objective of the experiment.",
@@ -1140,6 +1577,12 @@ func schema_apis_controller_experiments_v1beta1_ExperimentSpec(ref common.Refere
 							Format:      "int32",
 						},
 					},
+					"adaptiveParallelism": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Describes how the number of trials which run in parallel follows the cluster capacity. If it is set, parallelTrialCount is the initial number of parallel trials.",
+							Ref:         ref("github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.AdaptiveParallelismSpec"),
+						},
+					},
 					"maxTrialCount": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Max completed trials to mark experiment as succeeded",
@@ -1154,6 +1597,51 @@ func schema_apis_controller_experiments_v1beta1_ExperimentSpec(ref common.Refere
 							Format:      "int32",
 						},
 					},
+					"maxDuration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Max duration of the Experiment since its start time to mark experiment as succeeded.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"maxResourceUsage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Max cumulative resource usage of the trials to mark experiment as succeeded.",
+							Ref:         ref("github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.ResourceUsageSpec"),
+						},
+					},
+					"budgetPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Describes what happens to the active trials once max duration or max resource usage is reached. Default value is Finish.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"suspend": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Whether the Experiment is suspended. Suspended Experiment doesn't create new trials and its suggestion deployment is scaled down. Setting it back to false resumes the Experiment.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"suspendPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Describes what happens to the active trials once the Experiment is suspended. Default value is Finish.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"queueing": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Describes how the Experiment shares the trial slots of the ExperimentQueues in its namespace.",
+							Ref:         ref("github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.QueueingSpec"),
+						},
+					},
+					"convergence": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Describes when the experiment is succeeded because the best objective value has plateaued.",
+							Ref:         ref("github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.ConvergenceSpec"),
+						},
+					},
 					"metricsCollectorSpec": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Describes the specification of the metrics collector",
@@ -1172,11 +1660,29 @@ func schema_apis_controller_experiments_v1beta1_ExperimentSpec(ref common.Refere
 							Format:      "",
 						},
 					},
+					"warmStart": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Describes the prior Trials to warm-start the suggestion algorithm. Succeeded Trials from the sources are sent to the algorithm as the history, Trials are not created for them.",
+							Ref:         ref("github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.WarmStartSpec"),
+						},
+					},
+					"duplicateSuggestion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Describes how the parameter assignments which have already been suggested are handled. If it is not set, duplicated assignments are not detected.",
+							Ref:         ref("github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.DuplicateSuggestionSpec"),
+						},
+					},
+					"trialCache": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Describes how the results of the earlier Trials with the same run spec and parameter assignments are reused, e.g. from the other Experiments. If it is not set, each Trial creates the Trial run.",
+							Ref:         ref("github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.TrialCacheSpec"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.AlgorithmSpec", "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.EarlyStoppingSpec", "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.MetricsCollectorSpec", "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.ObjectiveSpec", "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.NasConfig", "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.ParameterSpec", "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.TrialTemplate"},
+			"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.AlgorithmSpec", "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.EarlyStoppingSpec", "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.MetricsCollectorSpec", "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.ObjectiveSpec", "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.TrialCacheSpec", "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.AdaptiveParallelismSpec", "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.ConvergenceSpec", "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.DuplicateSuggestionSpec", "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.NasConfig", "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.ParameterSpec", "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.QueueingSpec", "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.ResourceUsageSpec", "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.TrialTemplate", "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.WarmStartSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
@@ -1234,6 +1740,25 @@ func schema_apis_controller_experiments_v1beta1_ExperimentStatus(ref common.Refe
 							Ref:         ref("github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.OptimalTrial"),
 						},
 					},
+					"paretoOptimalTrials": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Trials on the Pareto front of the multi-objective Experiment. Trial is on the Pareto front if no other Trial is better in all objectives. It is set only if spec.objective.additionalObjectives is not empty.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.OptimalTrial"),
+									},
+								},
+							},
+						},
+					},
 					"runningTrialList": {
 						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
@@ -1430,11 +1955,37 @@ func schema_apis_controller_experiments_v1beta1_ExperimentStatus(ref common.Refe
 							Format:      "int32",
 						},
 					},
+					"trialsQueued": {
+						SchemaProps: spec.SchemaProps{
+							Description: "How many trials are waiting for the free trial slots of the ExperimentQueues.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"resourceUsage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Cumulative resource usage of the trials in resource-hours. It is set only if spec.maxResourceUsage is specified.",
+							Type:        []string{"number"},
+							Format:      "double",
+						},
+					},
+					"convergence": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Statistics of the best objective value plateau. It is set only if spec.convergence is specified.",
+							Ref:         ref("github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.ConvergenceStatus"),
+						},
+					},
+					"parallelism": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Current parallelism of the trials. It is set only if spec.adaptiveParallelism is specified.",
+							Ref:         ref("github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.ParallelismStatus"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.ExperimentCondition", "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.OptimalTrial", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.ConvergenceStatus", "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.ExperimentCondition", "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.OptimalTrial", "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.ParallelismStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -1615,73 +2166,147 @@ func schema_apis_controller_experiments_v1beta1_Operation(ref common.ReferenceCa
 							},
 						},
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.ParameterSpec"),
-									},
-								},
-							},
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.ParameterSpec"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.ParameterSpec"},
+	}
+}
+
+func schema_apis_controller_experiments_v1beta1_OptimalTrial(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OptimalTrial is the metrics and assignments of the best trial.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"bestTrialName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BestTrialName is the name of the best trial.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"parameterAssignments": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"name",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Key-value pairs for hyperparameters and assignment values.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.ParameterAssignment"),
+									},
+								},
+							},
+						},
+					},
+					"observation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Observation for this trial",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.Observation"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.Observation", "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.ParameterAssignment"},
+	}
+}
+
+func schema_apis_controller_experiments_v1beta1_ParallelismStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ParallelismStatus is the current parallelism of the trials.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"effectiveParallelTrialCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Number of trials which currently run in parallel.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"pendingDuration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Longest duration for which the active trials have been pending.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"lastTransitionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Last time the effective parallel trial count was changed.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.ParameterSpec"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_apis_controller_experiments_v1beta1_OptimalTrial(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_controller_experiments_v1beta1_ParameterCondition(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "OptimalTrial is the metrics and assignments of the best trial.",
+				Description: "ParameterCondition describes when the conditional parameter is active.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"bestTrialName": {
+					"parent": {
 						SchemaProps: spec.SchemaProps{
-							Description: "BestTrialName is the name of the best trial.",
+							Description: "Name of the categorical parameter which activates this parameter. Parent parameter must be defined before this parameter in the list of parameters.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"parameterAssignments": {
+					"values": {
 						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
-								"x-kubernetes-list-map-keys": []interface{}{
-									"name",
-								},
-								"x-kubernetes-list-type": "map",
+								"x-kubernetes-list-type": "set",
 							},
 						},
 						SchemaProps: spec.SchemaProps{
-							Description: "Key-value pairs for hyperparameters and assignment values.",
+							Description: "Values of the parent parameter which activate this parameter.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.ParameterAssignment"),
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
 						},
 					},
-					"observation": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Observation for this trial",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.Observation"),
-						},
-					},
 				},
 			},
 		},
-		Dependencies: []string{
-			"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.Observation", "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.ParameterAssignment"},
 	}
 }
 
@@ -1709,11 +2334,71 @@ func schema_apis_controller_experiments_v1beta1_ParameterSpec(ref common.Referen
 							Ref:     ref("github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.FeasibleSpace"),
 						},
 					},
+					"condition": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Condition makes the parameter active only when the parent parameter has one of the given values. Inactive parameters are not sampled and not assigned to the Trial, their placeholders in the Trial template are replaced with empty values. If it is not set, the parameter is always active.",
+							Ref:         ref("github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.ParameterCondition"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.FeasibleSpace"},
+			"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.FeasibleSpace", "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.ParameterCondition"},
+	}
+}
+
+func schema_apis_controller_experiments_v1beta1_QueueingSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "QueueingSpec describes how the Experiment shares the trial slots of the ExperimentQueues.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"priority": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Experiments with higher priority get the trial slots first. Default value is 0.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"weight": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Share of the trial slots between the Experiments with the same priority. Default value is 1.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_apis_controller_experiments_v1beta1_ResourceUsageSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ResourceUsageSpec describes the cumulative usage of the resource by the trials. Usage of the trial is the request of the resource in the trial run spec multiplied by the hours between the trial start time and completion time, e.g. 2 GPUs for 30 minutes are 1 GPU-hour.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"resourceName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the resource, for example nvidia.com/gpu or cpu.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"hours": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Max usage of the resource in resource-hours.",
+							Type:        []string{"number"},
+							Format:      "double",
+						},
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -1863,11 +2548,106 @@ func schema_apis_controller_experiments_v1beta1_TrialTemplate(ref common.Referen
 							Format:      "",
 						},
 					},
+					"retryPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Describes how the failed trial run is retried. If it is not set, the failed trial run is not retried.",
+							Ref:         ref("github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.RetryPolicy"),
+						},
+					},
+					"activeDeadlineSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Duration in seconds relative to the trial run creation time that the run may be active. Once it is exceeded, the run is deleted and the trial is failed with the DeadlineExceeded reason.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"pendingDeadlineSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Duration in seconds relative to the trial run creation time that the run may wait until any of its pods is running. Once it is exceeded, the run is deleted and the trial is failed with the DeadlineExceeded reason.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.RetryPolicy", "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.ConfigMapSource", "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.TrialParameterSpec", "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured.Unstructured"},
+	}
+}
+
+func schema_apis_controller_experiments_v1beta1_TrialsConfigMapSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TrialsConfigMapSource references the config map where the exported Trials are located",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"configMapName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of config map where the Trials are located",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"configMapNamespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace of config map where the Trials are located. Defaults to the Experiment namespace.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"trialsPath": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Path in config map where the Trials are located. Trials must be in the JSON format of the Trial list, for example the output of \"kubectl get trials -l katib.kubeflow.org/experiment=<name> -o json\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_apis_controller_experiments_v1beta1_WarmStartSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "WarmStartSpec describes the sources of the prior Trials",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"sourceExperiments": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Names of the Experiments in the Experiment namespace which Trials are used as the prior history",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"trialsConfigMap": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reference to the config map where the exported Trials are located",
+							Ref:         ref("github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.TrialsConfigMapSource"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.ConfigMapSource", "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.TrialParameterSpec", "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured.Unstructured"},
+			"github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1.TrialsConfigMapSource"},
 	}
 }
 
@@ -2058,6 +2838,13 @@ func schema_apis_controller_suggestions_v1beta1_SuggestionSpec(ref common.Refere
 							Format:      "",
 						},
 					},
+					"suspend": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Suspend indicates that the Experiment is suspended. Suggestion deployment is scaled down and no assignments are requested while it is true.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -2246,6 +3033,20 @@ func schema_apis_controller_suggestions_v1beta1_TrialAssignment(ref common.Refer
 							},
 						},
 					},
+					"duplicateOf": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the earlier suggestion with the same parameter assignments. It is set only if the duplicated assignments are reused, see Experiment spec.duplicateSuggestion.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"budget": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Budget of the Trial for multi-fidelity algorithms, e.g. number of epochs or dataset fraction. It is not a hyperparameter and it is referenced in the Trial template by ${trialSpec.Budget}.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -2301,6 +3102,47 @@ func schema_apis_controller_trials_v1beta1_Trial(ref common.ReferenceCallback) c
 	}
 }
 
+func schema_apis_controller_trials_v1beta1_TrialAttempt(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TrialAttempt describes the failed Trial run which has been retried.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"startTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Time when the run was created.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"completionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Time when the run was failed.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The reason of the run failure.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "A human readable message of the run failure.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_apis_controller_trials_v1beta1_TrialCondition(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -2443,6 +3285,13 @@ func schema_apis_controller_trials_v1beta1_TrialSpec(ref common.ReferenceCallbac
 							},
 						},
 					},
+					"budget": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Budget of the Trial for multi-fidelity algorithms, e.g. number of epochs or dataset fraction.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"earlyStoppingRules": {
 						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
@@ -2538,11 +3387,44 @@ func schema_apis_controller_trials_v1beta1_TrialSpec(ref common.ReferenceCallbac
 							},
 						},
 					},
+					"reuseObservationFrom": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the Trial in the same namespace which results are reused. If it is set, the Trial run is not created and the Trial is completed with the observation of the referenced Trial once it is completed.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"cache": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Describes how the results of the earlier Trials with the same fingerprint are reused. If it is set and the succeeded Trial with the same fingerprint exists, the Trial run is not created and the observation log of that Trial is copied.",
+							Ref:         ref("github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.TrialCacheSpec"),
+						},
+					},
+					"retryPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Describes how the failed Trial run is retried.",
+							Ref:         ref("github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.RetryPolicy"),
+						},
+					},
+					"activeDeadlineSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Duration in seconds relative to the Trial run creation time that the run may be active.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"pendingDeadlineSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Duration in seconds relative to the Trial run creation time that the run may wait until any of its pods is running.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.EarlyStoppingRule", "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.MetricsCollectorSpec", "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.ObjectiveSpec", "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.ParameterAssignment", "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured.Unstructured"},
+			"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.EarlyStoppingRule", "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.MetricsCollectorSpec", "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.ObjectiveSpec", "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.ParameterAssignment", "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.RetryPolicy", "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.TrialCacheSpec", "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured.Unstructured"},
 	}
 }
 
@@ -2599,11 +3481,30 @@ func schema_apis_controller_trials_v1beta1_TrialStatus(ref common.ReferenceCallb
 							Ref:         ref("github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.Observation"),
 						},
 					},
+					"attempts": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "History of the failed Trial runs which have been retried.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1.TrialAttempt"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.Observation", "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1.TrialCondition", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1.Observation", "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1.TrialAttempt", "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1.TrialCondition", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -14,7 +14,7 @@ _sym_db = _symbol_database.Default()
 
 
 
-DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\tapi.proto\x12\x0c\x61pi.v1.beta1\"R\n\nExperiment\x12\x12\n\x04name\x18\x01 \x01(\tR\x04name\x12\x30\n\x04spec\x18\x02 \x01(\x0b\x32\x1c.api.v1.beta1.ExperimentSpecR\x04spec\"\x85\x04\n\x0e\x45xperimentSpec\x12T\n\x0fparameter_specs\x18\x01 \x01(\x0b\x32+.api.v1.beta1.ExperimentSpec.ParameterSpecsR\x0eparameterSpecs\x12\x39\n\tobjective\x18\x02 \x01(\x0b\x32\x1b.api.v1.beta1.ObjectiveSpecR\tobjective\x12\x39\n\talgorithm\x18\x03 \x01(\x0b\x32\x1b.api.v1.beta1.AlgorithmSpecR\talgorithm\x12\x46\n\x0e\x65\x61rly_stopping\x18\x04 \x01(\x0b\x32\x1f.api.v1.beta1.EarlyStoppingSpecR\rearlyStopping\x12\x30\n\x14parallel_trial_count\x18\x05 \x01(\x05R\x12parallelTrialCount\x12&\n\x0fmax_trial_count\x18\x06 \x01(\x05R\rmaxTrialCount\x12\x36\n\nnas_config\x18\x07 \x01(\x0b\x32\x17.api.v1.beta1.NasConfigR\tnasConfig\x1aM\n\x0eParameterSpecs\x12;\n\nparameters\x18\x01 \x03(\x0b\x32\x1b.api.v1.beta1.ParameterSpecR\nparameters\"\xab\x01\n\rParameterSpec\x12\x12\n\x04name\x18\x01 \x01(\tR\x04name\x12\x42\n\x0eparameter_type\x18\x02 \x01(\x0e\x32\x1b.api.v1.beta1.ParameterTypeR\rparameterType\x12\x42\n\x0e\x66\x65\x61sible_space\x18\x03 \x01(\x0b\x32\x1b.api.v1.beta1.FeasibleSpaceR\rfeasibleSpace\"\x9b\x01\n\rFeasibleSpace\x12\x10\n\x03max\x18\x01 \x01(\tR\x03max\x12\x10\n\x03min\x18\x02 \x01(\tR\x03min\x12\x12\n\x04list\x18\x03 \x03(\tR\x04list\x12\x12\n\x04step\x18\x04 \x01(\tR\x04step\x12>\n\x0c\x64istribution\x18\x05 \x01(\x0e\x32\x1a.api.v1.beta1.DistributionR\x0c\x64istribution\"\xc0\x01\n\rObjectiveSpec\x12/\n\x04type\x18\x01 \x01(\x0e\x32\x1b.api.v1.beta1.ObjectiveTypeR\x04type\x12\x12\n\x04goal\x18\x02 \x01(\x01R\x04goal\x12\x32\n\x15objective_metric_name\x18\x03 \x01(\tR\x13objectiveMetricName\x12\x36\n\x17\x61\x64\x64itional_metric_names\x18\x04 \x03(\tR\x15\x61\x64\x64itionalMetricNames\"\x85\x01\n\rAlgorithmSpec\x12%\n\x0e\x61lgorithm_name\x18\x01 \x01(\tR\ralgorithmName\x12M\n\x12\x61lgorithm_settings\x18\x02 \x03(\x0b\x32\x1e.api.v1.beta1.AlgorithmSettingR\x11\x61lgorithmSettings\"<\n\x10\x41lgorithmSetting\x12\x12\n\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n\x05value\x18\x02 \x01(\tR\x05value\"\x8d\x01\n\x11\x45\x61rlyStoppingSpec\x12%\n\x0e\x61lgorithm_name\x18\x01 \x01(\tR\ralgorithmName\x12Q\n\x12\x61lgorithm_settings\x18\x02 \x03(\x0b\x32\".api.v1.beta1.EarlyStoppingSettingR\x11\x61lgorithmSettings\"@\n\x14\x45\x61rlyStoppingSetting\x12\x12\n\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n\x05value\x18\x02 \x01(\tR\x05value\"\xd2\x01\n\tNasConfig\x12<\n\x0cgraph_config\x18\x01 \x01(\x0b\x32\x19.api.v1.beta1.GraphConfigR\x0bgraphConfig\x12\x42\n\noperations\x18\x02 \x01(\x0b\x32\".api.v1.beta1.NasConfig.OperationsR\noperations\x1a\x43\n\nOperations\x12\x35\n\toperation\x18\x01 \x03(\x0b\x32\x17.api.v1.beta1.OperationR\toperation\"p\n\x0bGraphConfig\x12\x1d\n\nnum_layers\x18\x01 \x01(\x05R\tnumLayers\x12\x1f\n\x0binput_sizes\x18\x02 \x03(\x05R\ninputSizes\x12!\n\x0coutput_sizes\x18\x03 \x03(\x05R\x0boutputSizes\"\xd2\x01\n\tOperation\x12%\n\x0eoperation_type\x18\x01 \x01(\tR\roperationType\x12O\n\x0fparameter_specs\x18\x02 \x01(\x0b\x32&.api.v1.beta1.Operation.ParameterSpecsR\x0eparameterSpecs\x1aM\n\x0eParameterSpecs\x12;\n\nparameters\x18\x01 \x03(\x0b\x32\x1b.api.v1.beta1.ParameterSpecR\nparameters\"{\n\x05Trial\x12\x12\n\x04name\x18\x01 \x01(\tR\x04name\x12+\n\x04spec\x18\x02 \x01(\x0b\x32\x17.api.v1.beta1.TrialSpecR\x04spec\x12\x31\n\x06status\x18\x03 \x01(\x0b\x32\x19.api.v1.beta1.TrialStatusR\x06status\"\xfe\x02\n\tTrialSpec\x12\x39\n\tobjective\x18\x02 \x01(\x0b\x32\x1b.api.v1.beta1.ObjectiveSpecR\tobjective\x12\x61\n\x15parameter_assignments\x18\x03 \x01(\x0b\x32,.api.v1.beta1.TrialSpec.ParameterAssignmentsR\x14parameterAssignments\x12;\n\x06labels\x18\x04 \x03(\x0b\x32#.api.v1.beta1.TrialSpec.LabelsEntryR\x06labels\x1a[\n\x14ParameterAssignments\x12\x43\n\x0b\x61ssignments\x18\x01 \x03(\x0b\x32!.api.v1.beta1.ParameterAssignmentR\x0b\x61ssignments\x1a\x39\n\x0bLabelsEntry\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n\x05value\x18\x02 \x01(\tR\x05value:\x02\x38\x01\"?\n\x13ParameterAssignment\x12\x12\n\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n\x05value\x18\x02 \x01(\tR\x05value\"\xed\x02\n\x0bTrialStatus\x12\x1d\n\nstart_time\x18\x01 \x01(\tR\tstartTime\x12\'\n\x0f\x63ompletion_time\x18\x02 \x01(\tR\x0e\x63ompletionTime\x12J\n\tcondition\x18\x03 \x01(\x0e\x32,.api.v1.beta1.TrialStatus.TrialConditionTypeR\tcondition\x12;\n\x0bobservation\x18\x04 \x01(\x0b\x32\x19.api.v1.beta1.ObservationR\x0bobservation\"\x8c\x01\n\x12TrialConditionType\x12\x0b\n\x07\x43REATED\x10\x00\x12\x0b\n\x07RUNNING\x10\x01\x12\r\n\tSUCCEEDED\x10\x02\x12\n\n\x06KILLED\x10\x03\x12\n\n\x06\x46\x41ILED\x10\x04\x12\x16\n\x12METRICSUNAVAILABLE\x10\x05\x12\x10\n\x0c\x45\x41RLYSTOPPED\x10\x06\x12\x0b\n\x07UNKNOWN\x10\x07\"=\n\x0bObservation\x12.\n\x07metrics\x18\x01 \x03(\x0b\x32\x14.api.v1.beta1.MetricR\x07metrics\"2\n\x06Metric\x12\x12\n\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n\x05value\x18\x02 \x01(\tR\x05value\"\x83\x01\n\x1bReportObservationLogRequest\x12\x1d\n\ntrial_name\x18\x01 \x01(\tR\ttrialName\x12\x45\n\x0fobservation_log\x18\x02 \x01(\x0b\x32\x1c.api.v1.beta1.ObservationLogR\x0eobservationLog\"\x1b\n\x19ReportObservationLogReply\"J\n\x0eObservationLog\x12\x38\n\x0bmetric_logs\x18\x01 \x03(\x0b\x32\x17.api.v1.beta1.MetricLogR\nmetricLogs\"X\n\tMetricLog\x12\x1d\n\ntime_stamp\x18\x01 \x01(\tR\ttimeStamp\x12,\n\x06metric\x18\x02 \x01(\x0b\x32\x14.api.v1.beta1.MetricR\x06metric\"\x94\x01\n\x18GetObservationLogRequest\x12\x1d\n\ntrial_name\x18\x01 \x01(\tR\ttrialName\x12\x1f\n\x0bmetric_name\x18\x02 \x01(\tR\nmetricName\x12\x1d\n\nstart_time\x18\x03 \x01(\tR\tstartTime\x12\x19\n\x08\x65nd_time\x18\x04 \x01(\tR\x07\x65ndTime\"_\n\x16GetObservationLogReply\x12\x45\n\x0fobservation_log\x18\x01 \x01(\x0b\x32\x1c.api.v1.beta1.ObservationLogR\x0eobservationLog\"<\n\x1b\x44\x65leteObservationLogRequest\x12\x1d\n\ntrial_name\x18\x01 \x01(\tR\ttrialName\"\x1b\n\x19\x44\x65leteObservationLogReply\"\xe6\x01\n\x15GetSuggestionsRequest\x12\x38\n\nexperiment\x18\x01 \x01(\x0b\x32\x18.api.v1.beta1.ExperimentR\nexperiment\x12+\n\x06trials\x18\x02 \x03(\x0b\x32\x13.api.v1.beta1.TrialR\x06trials\x12\x34\n\x16\x63urrent_request_number\x18\x04 \x01(\x05R\x14\x63urrentRequestNumber\x12\x30\n\x14total_request_number\x18\x05 \x01(\x05R\x12totalRequestNumber\"\xa4\x04\n\x13GetSuggestionsReply\x12k\n\x15parameter_assignments\x18\x01 \x03(\x0b\x32\x36.api.v1.beta1.GetSuggestionsReply.ParameterAssignmentsR\x14parameterAssignments\x12\x39\n\talgorithm\x18\x02 \x01(\x0b\x32\x1b.api.v1.beta1.AlgorithmSpecR\talgorithm\x12Q\n\x14\x65\x61rly_stopping_rules\x18\x03 \x03(\x0b\x32\x1f.api.v1.beta1.EarlyStoppingRuleR\x12\x65\x61rlyStoppingRules\x1a\x91\x02\n\x14ParameterAssignments\x12\x43\n\x0b\x61ssignments\x18\x01 \x03(\x0b\x32!.api.v1.beta1.ParameterAssignmentR\x0b\x61ssignments\x12\x1d\n\ntrial_name\x18\x02 \x01(\tR\ttrialName\x12Z\n\x06labels\x18\x03 \x03(\x0b\x32\x42.api.v1.beta1.GetSuggestionsReply.ParameterAssignments.LabelsEntryR\x06labels\x1a\x39\n\x0bLabelsEntry\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n\x05value\x18\x02 \x01(\tR\x05value:\x02\x38\x01\"\\\n ValidateAlgorithmSettingsRequest\x12\x38\n\nexperiment\x18\x01 \x01(\x0b\x32\x18.api.v1.beta1.ExperimentR\nexperiment\" \n\x1eValidateAlgorithmSettingsReply\"\xb3\x01\n\x1cGetEarlyStoppingRulesRequest\x12\x38\n\nexperiment\x18\x01 \x01(\x0b\x32\x18.api.v1.beta1.ExperimentR\nexperiment\x12+\n\x06trials\x18\x02 \x03(\x0b\x32\x13.api.v1.beta1.TrialR\x06trials\x12,\n\x12\x64\x62_manager_address\x18\x03 \x01(\tR\x10\x64\x62ManagerAddress\"o\n\x1aGetEarlyStoppingRulesReply\x12Q\n\x14\x65\x61rly_stopping_rules\x18\x01 \x03(\x0b\x32\x1f.api.v1.beta1.EarlyStoppingRuleR\x12\x65\x61rlyStoppingRules\"\x9a\x01\n\x11\x45\x61rlyStoppingRule\x12\x12\n\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n\x05value\x18\x02 \x01(\tR\x05value\x12<\n\ncomparison\x18\x03 \x01(\x0e\x32\x1c.api.v1.beta1.ComparisonTypeR\ncomparison\x12\x1d\n\nstart_step\x18\x04 \x01(\x05R\tstartStep\"n\n$ValidateEarlyStoppingSettingsRequest\x12\x46\n\x0e\x65\x61rly_stopping\x18\x01 \x01(\x0b\x32\x1f.api.v1.beta1.EarlyStoppingSpecR\rearlyStopping\"$\n\"ValidateEarlyStoppingSettingsReply\"6\n\x15SetTrialStatusRequest\x12\x1d\n\ntrial_name\x18\x01 \x01(\tR\ttrialName\"\x15\n\x13SetTrialStatusReply*U\n\rParameterType\x12\x10\n\x0cUNKNOWN_TYPE\x10\x00\x12\n\n\x06\x44OUBLE\x10\x01\x12\x07\n\x03INT\x10\x02\x12\x0c\n\x08\x44ISCRETE\x10\x03\x12\x0f\n\x0b\x43\x41TEGORICAL\x10\x04*f\n\x0c\x44istribution\x12\x1c\n\x18\x44ISTRIBUTION_UNSPECIFIED\x10\x00\x12\x0b\n\x07UNIFORM\x10\x01\x12\x0f\n\x0bLOG_UNIFORM\x10\x02\x12\n\n\x06NORMAL\x10\x03\x12\x0e\n\nLOG_NORMAL\x10\x04*8\n\rObjectiveType\x12\x0b\n\x07UNKNOWN\x10\x00\x12\x0c\n\x08MINIMIZE\x10\x01\x12\x0c\n\x08MAXIMIZE\x10\x02*J\n\x0e\x43omparisonType\x12\x16\n\x12UNKNOWN_COMPARISON\x10\x00\x12\t\n\x05\x45QUAL\x10\x01\x12\x08\n\x04LESS\x10\x02\x12\x0b\n\x07GREATER\x10\x03\x32\xc6\x02\n\tDBManager\x12j\n\x14ReportObservationLog\x12).api.v1.beta1.ReportObservationLogRequest\x1a\'.api.v1.beta1.ReportObservationLogReply\x12\x61\n\x11GetObservationLog\x12&.api.v1.beta1.GetObservationLogRequest\x1a$.api.v1.beta1.GetObservationLogReply\x12j\n\x14\x44\x65leteObservationLog\x12).api.v1.beta1.DeleteObservationLogRequest\x1a\'.api.v1.beta1.DeleteObservationLogReply2\xe1\x01\n\nSuggestion\x12X\n\x0eGetSuggestions\x12#.api.v1.beta1.GetSuggestionsRequest\x1a!.api.v1.beta1.GetSuggestionsReply\x12y\n\x19ValidateAlgorithmSettings\x12..api.v1.beta1.ValidateAlgorithmSettingsRequest\x1a,.api.v1.beta1.ValidateAlgorithmSettingsReply2\xe0\x02\n\rEarlyStopping\x12m\n\x15GetEarlyStoppingRules\x12*.api.v1.beta1.GetEarlyStoppingRulesRequest\x1a(.api.v1.beta1.GetEarlyStoppingRulesReply\x12X\n\x0eSetTrialStatus\x12#.api.v1.beta1.SetTrialStatusRequest\x1a!.api.v1.beta1.SetTrialStatusReply\x12\x85\x01\n\x1dValidateEarlyStoppingSettings\x12\x32.api.v1.beta1.ValidateEarlyStoppingSettingsRequest\x1a\x30.api.v1.beta1.ValidateEarlyStoppingSettingsReplyBAZ?github.com/kubeflow/katib/pkg/apis/manager/v1beta1;api_v1_beta1b\x06proto3')
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\tapi.proto\x12\x0c\x61pi.v1.beta1\"R\n\nExperiment\x12\x12\n\x04name\x18\x01 \x01(\tR\x04name\x12\x30\n\x04spec\x18\x02 \x01(\x0b\x32\x1c.api.v1.beta1.ExperimentSpecR\x04spec\"\xba\x04\n\x0e\x45xperimentSpec\x12T\n\x0fparameter_specs\x18\x01 \x01(\x0b\x32+.api.v1.beta1.ExperimentSpec.ParameterSpecsR\x0eparameterSpecs\x12\x39\n\tobjective\x18\x02 \x01(\x0b\x32\x1b.api.v1.beta1.ObjectiveSpecR\tobjective\x12\x39\n\talgorithm\x18\x03 \x01(\x0b\x32\x1b.api.v1.beta1.AlgorithmSpecR\talgorithm\x12\x46\n\x0e\x65\x61rly_stopping\x18\x04 \x01(\x0b\x32\x1f.api.v1.beta1.EarlyStoppingSpecR\rearlyStopping\x12\x30\n\x14parallel_trial_count\x18\x05 \x01(\x05R\x12parallelTrialCount\x12&\n\x0fmax_trial_count\x18\x06 \x01(\x05R\rmaxTrialCount\x12\x36\n\nnas_config\x18\x07 \x01(\x0b\x32\x17.api.v1.beta1.NasConfigR\tnasConfig\x12\x33\n\x15parameter_constraints\x18\x08 \x03(\tR\x14parameterConstraints\x1aM\n\x0eParameterSpecs\x12;\n\nparameters\x18\x01 \x03(\x0b\x32\x1b.api.v1.beta1.ParameterSpecR\nparameters\"\xeb\x01\n\rParameterSpec\x12\x12\n\x04name\x18\x01 \x01(\tR\x04name\x12\x42\n\x0eparameter_type\x18\x02 \x01(\x0e\x32\x1b.api.v1.beta1.ParameterTypeR\rparameterType\x12\x42\n\x0e\x66\x65\x61sible_space\x18\x03 \x01(\x0b\x32\x1b.api.v1.beta1.FeasibleSpaceR\rfeasibleSpace\x12>\n\tcondition\x18\x04 \x01(\x0b\x32 .api.v1.beta1.ParameterConditionR\tcondition\"D\n\x12ParameterCondition\x12\x16\n\x06parent\x18\x01 \x01(\tR\x06parent\x12\x16\n\x06values\x18\x02 \x03(\tR\x06values\"\x9b\x01\n\rFeasibleSpace\x12\x10\n\x03max\x18\x01 \x01(\tR\x03max\x12\x10\n\x03min\x18\x02 \x01(\tR\x03min\x12\x12\n\x04list\x18\x03 \x03(\tR\x04list\x12\x12\n\x04step\x18\x04 \x01(\tR\x04step\x12>\n\x0c\x64istribution\x18\x05 \x01(\x0e\x32\x1a.api.v1.beta1.DistributionR\x0c\x64istribution\"\x98\x02\n\rObjectiveSpec\x12/\n\x04type\x18\x01 \x01(\x0e\x32\x1b.api.v1.beta1.ObjectiveTypeR\x04type\x12\x12\n\x04goal\x18\x02 \x01(\x01R\x04goal\x12\x32\n\x15objective_metric_name\x18\x03 \x01(\tR\x13objectiveMetricName\x12\x36\n\x17\x61\x64\x64itional_metric_names\x18\x04 \x03(\tR\x15\x61\x64\x64itionalMetricNames\x12V\n\x15\x61\x64\x64itional_objectives\x18\x05 \x03(\x0b\x32!.api.v1.beta1.AdditionalObjectiveR\x14\x61\x64\x64itionalObjectives\"z\n\x13\x41\x64\x64itionalObjective\x12/\n\x04type\x18\x01 \x01(\x0e\x32\x1b.api.v1.beta1.ObjectiveTypeR\x04type\x12\x32\n\x15objective_metric_name\x18\x02 \x01(\tR\x13objectiveMetricName\"\x85\x01\n\rAlgorithmSpec\x12%\n\x0e\x61lgorithm_name\x18\x01 \x01(\tR\ralgorithmName\x12M\n\x12\x61lgorithm_settings\x18\x02 \x03(\x0b\x32\x1e.api.v1.beta1.AlgorithmSettingR\x11\x61lgorithmSettings\"<\n\x10\x41lgorithmSetting\x12\x12\n\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n\x05value\x18\x02 \x01(\tR\x05value\"\x8d\x01\n\x11\x45\x61rlyStoppingSpec\x12%\n\x0e\x61lgorithm_name\x18\x01 \x01(\tR\ralgorithmName\x12Q\n\x12\x61lgorithm_settings\x18\x02 \x03(\x0b\x32\".api.v1.beta1.EarlyStoppingSettingR\x11\x61lgorithmSettings\"@\n\x14\x45\x61rlyStoppingSetting\x12\x12\n\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n\x05value\x18\x02 \x01(\tR\x05value\"\xd2\x01\n\tNasConfig\x12<\n\x0cgraph_config\x18\x01 \x01(\x0b\x32\x19.api.v1.beta1.GraphConfigR\x0bgraphConfig\x12\x42\n\noperations\x18\x02 \x01(\x0b\x32\".api.v1.beta1.NasConfig.OperationsR\noperations\x1a\x43\n\nOperations\x12\x35\n\toperation\x18\x01 \x03(\x0b\x32\x17.api.v1.beta1.OperationR\toperation\"p\n\x0bGraphConfig\x12\x1d\n\nnum_layers\x18\x01 \x01(\x05R\tnumLayers\x12\x1f\n\x0binput_sizes\x18\x02 \x03(\x05R\ninputSizes\x12!\n\x0coutput_sizes\x18\x03 \x03(\x05R\x0boutputSizes\"\xd2\x01\n\tOperation\x12%\n\x0eoperation_type\x18\x01 \x01(\tR\roperationType\x12O\n\x0fparameter_specs\x18\x02 \x01(\x0b\x32&.api.v1.beta1.Operation.ParameterSpecsR\x0eparameterSpecs\x1aM\n\x0eParameterSpecs\x12;\n\nparameters\x18\x01 \x03(\x0b\x32\x1b.api.v1.beta1.ParameterSpecR\nparameters\"{\n\x05Trial\x12\x12\n\x04name\x18\x01 \x01(\tR\x04name\x12+\n\x04spec\x18\x02 \x01(\x0b\x32\x17.api.v1.beta1.TrialSpecR\x04spec\x12\x31\n\x06status\x18\x03 \x01(\x0b\x32\x19.api.v1.beta1.TrialStatusR\x06status\"\x96\x03\n\tTrialSpec\x12\x39\n\tobjective\x18\x02 \x01(\x0b\x32\x1b.api.v1.beta1.ObjectiveSpecR\tobjective\x12\x61\n\x15parameter_assignments\x18\x03 \x01(\x0b\x32,.api.v1.beta1.TrialSpec.ParameterAssignmentsR\x14parameterAssignments\x12;\n\x06labels\x18\x04 \x03(\x0b\x32#.api.v1.beta1.TrialSpec.LabelsEntryR\x06labels\x12\x16\n\x06\x62udget\x18\x05 \x01(\tR\x06\x62udget\x1a[\n\x14ParameterAssignments\x12\x43\n\x0b\x61ssignments\x18\x01 \x03(\x0b\x32!.api.v1.beta1.ParameterAssignmentR\x0b\x61ssignments\x1a\x39\n\x0bLabelsEntry\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n\x05value\x18\x02 \x01(\tR\x05value:\x02\x38\x01\"?\n\x13ParameterAssignment\x12\x12\n\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n\x05value\x18\x02 \x01(\tR\x05value\"\xed\x02\n\x0bTrialStatus\x12\x1d\n\nstart_time\x18\x01 \x01(\tR\tstartTime\x12\'\n\x0f\x63ompletion_time\x18\x02 \x01(\tR\x0e\x63ompletionTime\x12J\n\tcondition\x18\x03 \x01(\x0e\x32,.api.v1.beta1.TrialStatus.TrialConditionTypeR\tcondition\x12;\n\x0bobservation\x18\x04 \x01(\x0b\x32\x19.api.v1.beta1.ObservationR\x0bobservation\"\x8c\x01\n\x12TrialConditionType\x12\x0b\n\x07\x43REATED\x10\x00\x12\x0b\n\x07RUNNING\x10\x01\x12\r\n\tSUCCEEDED\x10\x02\x12\n\n\x06KILLED\x10\x03\x12\n\n\x06\x46\x41ILED\x10\x04\x12\x16\n\x12METRICSUNAVAILABLE\x10\x05\x12\x10\n\x0c\x45\x41RLYSTOPPED\x10\x06\x12\x0b\n\x07UNKNOWN\x10\x07\"=\n\x0bObservation\x12.\n\x07metrics\x18\x01 \x03(\x0b\x32\x14.api.v1.beta1.MetricR\x07metrics\"2\n\x06Metric\x12\x12\n\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n\x05value\x18\x02 \x01(\tR\x05value\"\xac\x01\n\x1bReportObservationLogRequest\x12\x1d\n\ntrial_name\x18\x01 \x01(\tR\ttrialName\x12\x45\n\x0fobservation_log\x18\x02 \x01(\x0b\x32\x1c.api.v1.beta1.ObservationLogR\x0eobservationLog\x12\'\n\x0fidempotency_key\x18\x03 \x01(\tR\x0eidempotencyKey\"\x1b\n\x19ReportObservationLogReply\"J\n\x0eObservationLog\x12\x38\n\x0bmetric_logs\x18\x01 \x03(\x0b\x32\x17.api.v1.beta1.MetricLogR\nmetricLogs\"\x92\x01\n\tMetricLog\x12\x1d\n\ntime_stamp\x18\x01 \x01(\tR\ttimeStamp\x12,\n\x06metric\x18\x02 \x01(\x0b\x32\x14.api.v1.beta1.MetricR\x06metric\x12\x17\n\x04step\x18\x03 \x01(\x03H\x00R\x04step\x88\x01\x01\x12\x16\n\x06source\x18\x04 \x01(\tR\x06sourceB\x07\n\x05_step\"\xf4\x01\n\x18GetObservationLogRequest\x12\x1d\n\ntrial_name\x18\x01 \x01(\tR\ttrialName\x12\x1f\n\x0bmetric_name\x18\x02 \x01(\tR\nmetricName\x12\x1d\n\nstart_time\x18\x03 \x01(\tR\tstartTime\x12\x19\n\x08\x65nd_time\x18\x04 \x01(\tR\x07\x65ndTime\x12\"\n\nstart_step\x18\x05 \x01(\x03H\x00R\tstartStep\x88\x01\x01\x12\x1e\n\x08\x65nd_step\x18\x06 \x01(\x03H\x01R\x07\x65ndStep\x88\x01\x01\x42\r\n\x0b_start_stepB\x0b\n\t_end_step\"_\n\x16GetObservationLogReply\x12\x45\n\x0fobservation_log\x18\x01 \x01(\x0b\x32\x1c.api.v1.beta1.ObservationLogR\x0eobservationLog\"<\n\x1b\x44\x65leteObservationLogRequest\x12\x1d\n\ntrial_name\x18\x01 \x01(\tR\ttrialName\"\x1b\n\x19\x44\x65leteObservationLogReply\"\xe6\x01\n\x15GetSuggestionsRequest\x12\x38\n\nexperiment\x18\x01 \x01(\x0b\x32\x18.api.v1.beta1.ExperimentR\nexperiment\x12+\n\x06trials\x18\x02 \x03(\x0b\x32\x13.api.v1.beta1.TrialR\x06trials\x12\x34\n\x16\x63urrent_request_number\x18\x04 \x01(\x05R\x14\x63urrentRequestNumber\x12\x30\n\x14total_request_number\x18\x05 \x01(\x05R\x12totalRequestNumber\"\xf2\x04\n\x13GetSuggestionsReply\x12k\n\x15parameter_assignments\x18\x01 \x03(\x0b\x32\x36.api.v1.beta1.GetSuggestionsReply.ParameterAssignmentsR\x14parameterAssignments\x12\x39\n\talgorithm\x18\x02 \x01(\x0b\x32\x1b.api.v1.beta1.AlgorithmSpecR\talgorithm\x12Q\n\x14\x65\x61rly_stopping_rules\x18\x03 \x03(\x0b\x32\x1f.api.v1.beta1.EarlyStoppingRuleR\x12\x65\x61rlyStoppingRules\x12\x34\n\x16search_space_exhausted\x18\x04 \x01(\x08R\x14searchSpaceExhausted\x1a\xa9\x02\n\x14ParameterAssignments\x12\x43\n\x0b\x61ssignments\x18\x01 \x03(\x0b\x32!.api.v1.beta1.ParameterAssignmentR\x0b\x61ssignments\x12\x1d\n\ntrial_name\x18\x02 \x01(\tR\ttrialName\x12Z\n\x06labels\x18\x03 \x03(\x0b\x32\x42.api.v1.beta1.GetSuggestionsReply.ParameterAssignments.LabelsEntryR\x06labels\x12\x16\n\x06\x62udget\x18\x04 \x01(\tR\x06\x62udget\x1a\x39\n\x0bLabelsEntry\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n\x05value\x18\x02 \x01(\tR\x05value:\x02\x38\x01\"\\\n ValidateAlgorithmSettingsRequest\x12\x38\n\nexperiment\x18\x01 \x01(\x0b\x32\x18.api.v1.beta1.ExperimentR\nexperiment\" \n\x1eValidateAlgorithmSettingsReply\"\xb3\x01\n\x1cGetEarlyStoppingRulesRequest\x12\x38\n\nexperiment\x18\x01 \x01(\x0b\x32\x18.api.v1.beta1.ExperimentR\nexperiment\x12+\n\x06trials\x18\x02 \x03(\x0b\x32\x13.api.v1.beta1.TrialR\x06trials\x12,\n\x12\x64\x62_manager_address\x18\x03 \x01(\tR\x10\x64\x62ManagerAddress\"o\n\x1aGetEarlyStoppingRulesReply\x12Q\n\x14\x65\x61rly_stopping_rules\x18\x01 \x03(\x0b\x32\x1f.api.v1.beta1.EarlyStoppingRuleR\x12\x65\x61rlyStoppingRules\"\x9a\x01\n\x11\x45\x61rlyStoppingRule\x12\x12\n\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n\x05value\x18\x02 \x01(\tR\x05value\x12<\n\ncomparison\x18\x03 \x01(\x0e\x32\x1c.api.v1.beta1.ComparisonTypeR\ncomparison\x12\x1d\n\nstart_step\x18\x04 \x01(\x05R\tstartStep\"n\n$ValidateEarlyStoppingSettingsRequest\x12\x46\n\x0e\x65\x61rly_stopping\x18\x01 \x01(\x0b\x32\x1f.api.v1.beta1.EarlyStoppingSpecR\rearlyStopping\"$\n\"ValidateEarlyStoppingSettingsReply\"6\n\x15SetTrialStatusRequest\x12\x1d\n\ntrial_name\x18\x01 \x01(\tR\ttrialName\"\x15\n\x13SetTrialStatusReply*U\n\rParameterType\x12\x10\n\x0cUNKNOWN_TYPE\x10\x00\x12\n\n\x06\x44OUBLE\x10\x01\x12\x07\n\x03INT\x10\x02\x12\x0c\n\x08\x44ISCRETE\x10\x03\x12\x0f\n\x0b\x43\x41TEGORICAL\x10\x04*f\n\x0c\x44istribution\x12\x1c\n\x18\x44ISTRIBUTION_UNSPECIFIED\x10\x00\x12\x0b\n\x07UNIFORM\x10\x01\x12\x0f\n\x0bLOG_UNIFORM\x10\x02\x12\n\n\x06NORMAL\x10\x03\x12\x0e\n\nLOG_NORMAL\x10\x04*8\n\rObjectiveType\x12\x0b\n\x07UNKNOWN\x10\x00\x12\x0c\n\x08MINIMIZE\x10\x01\x12\x0c\n\x08MAXIMIZE\x10\x02*J\n\x0e\x43omparisonType\x12\x16\n\x12UNKNOWN_COMPARISON\x10\x00\x12\t\n\x05\x45QUAL\x10\x01\x12\x08\n\x04LESS\x10\x02\x12\x0b\n\x07GREATER\x10\x03\x32\xc6\x02\n\tDBManager\x12j\n\x14ReportObservationLog\x12).api.v1.beta1.ReportObservationLogRequest\x1a\'.api.v1.beta1.ReportObservationLogReply\x12\x61\n\x11GetObservationLog\x12&.api.v1.beta1.GetObservationLogRequest\x1a$.api.v1.beta1.GetObservationLogReply\x12j\n\x14\x44\x65leteObservationLog\x12).api.v1.beta1.DeleteObservationLogRequest\x1a\'.api.v1.beta1.DeleteObservationLogReply2\xe1\x01\n\nSuggestion\x12X\n\x0eGetSuggestions\x12#.api.v1.beta1.GetSuggestionsRequest\x1a!.api.v1.beta1.GetSuggestionsReply\x12y\n\x19ValidateAlgorithmSettings\x12..api.v1.beta1.ValidateAlgorithmSettingsRequest\x1a,.api.v1.beta1.ValidateAlgorithmSettingsReply2\xe0\x02\n\rEarlyStopping\x12m\n\x15GetEarlyStoppingRules\x12*.api.v1.beta1.GetEarlyStoppingRulesRequest\x1a(.api.v1.beta1.GetEarlyStoppingRulesReply\x12X\n\x0eSetTrialStatus\x12#.api.v1.beta1.SetTrialStatusRequest\x1a!.api.v1.beta1.SetTrialStatusReply\x12\x85\x01\n\x1dValidateEarlyStoppingSettings\x12\x32.api.v1.beta1.ValidateEarlyStoppingSettingsRequest\x1a\x30.api.v1.beta1.ValidateEarlyStoppingSettingsReplyBAZ?github.com/kubeflow/katib/pkg/apis/manager/v1beta1;api_v1_beta1b\x06proto3')
 
 _globals = globals()
 _builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, _globals)
@@ -26,108 +26,112 @@ if not _descriptor._USE_C_DESCRIPTORS:
   _globals['_TRIALSPEC_LABELSENTRY']._serialized_options = b'8\001'
   _globals['_GETSUGGESTIONSREPLY_PARAMETERASSIGNMENTS_LABELSENTRY']._loaded_options = None
   _globals['_GETSUGGESTIONSREPLY_PARAMETERASSIGNMENTS_LABELSENTRY']._serialized_options = b'8\001'
-  _globals['_PARAMETERTYPE']._serialized_start=5425
-  _globals['_PARAMETERTYPE']._serialized_end=5510
-  _globals['_DISTRIBUTION']._serialized_start=5512
-  _globals['_DISTRIBUTION']._serialized_end=5614
-  _globals['_OBJECTIVETYPE']._serialized_start=5616
-  _globals['_OBJECTIVETYPE']._serialized_end=5672
-  _globals['_COMPARISONTYPE']._serialized_start=5674
-  _globals['_COMPARISONTYPE']._serialized_end=5748
+  _globals['_PARAMETERTYPE']._serialized_start=6122
+  _globals['_PARAMETERTYPE']._serialized_end=6207
+  _globals['_DISTRIBUTION']._serialized_start=6209
+  _globals['_DISTRIBUTION']._serialized_end=6311
+  _globals['_OBJECTIVETYPE']._serialized_start=6313
+  _globals['_OBJECTIVETYPE']._serialized_end=6369
+  _globals['_COMPARISONTYPE']._serialized_start=6371
+  _globals['_COMPARISONTYPE']._serialized_end=6445
   _globals['_EXPERIMENT']._serialized_start=27
   _globals['_EXPERIMENT']._serialized_end=109
   _globals['_EXPERIMENTSPEC']._serialized_start=112
-  _globals['_EXPERIMENTSPEC']._serialized_end=629
-  _globals['_EXPERIMENTSPEC_PARAMETERSPECS']._serialized_start=552
-  _globals['_EXPERIMENTSPEC_PARAMETERSPECS']._serialized_end=629
-  _globals['_PARAMETERSPEC']._serialized_start=632
-  _globals['_PARAMETERSPEC']._serialized_end=803
-  _globals['_FEASIBLESPACE']._serialized_start=806
-  _globals['_FEASIBLESPACE']._serialized_end=961
-  _globals['_OBJECTIVESPEC']._serialized_start=964
-  _globals['_OBJECTIVESPEC']._serialized_end=1156
-  _globals['_ALGORITHMSPEC']._serialized_start=1159
-  _globals['_ALGORITHMSPEC']._serialized_end=1292
-  _globals['_ALGORITHMSETTING']._serialized_start=1294
-  _globals['_ALGORITHMSETTING']._serialized_end=1354
-  _globals['_EARLYSTOPPINGSPEC']._serialized_start=1357
-  _globals['_EARLYSTOPPINGSPEC']._serialized_end=1498
-  _globals['_EARLYSTOPPINGSETTING']._serialized_start=1500
-  _globals['_EARLYSTOPPINGSETTING']._serialized_end=1564
-  _globals['_NASCONFIG']._serialized_start=1567
-  _globals['_NASCONFIG']._serialized_end=1777
-  _globals['_NASCONFIG_OPERATIONS']._serialized_start=1710
-  _globals['_NASCONFIG_OPERATIONS']._serialized_end=1777
-  _globals['_GRAPHCONFIG']._serialized_start=1779
-  _globals['_GRAPHCONFIG']._serialized_end=1891
-  _globals['_OPERATION']._serialized_start=1894
-  _globals['_OPERATION']._serialized_end=2104
-  _globals['_OPERATION_PARAMETERSPECS']._serialized_start=552
-  _globals['_OPERATION_PARAMETERSPECS']._serialized_end=629
-  _globals['_TRIAL']._serialized_start=2106
-  _globals['_TRIAL']._serialized_end=2229
-  _globals['_TRIALSPEC']._serialized_start=2232
-  _globals['_TRIALSPEC']._serialized_end=2614
-  _globals['_TRIALSPEC_PARAMETERASSIGNMENTS']._serialized_start=2464
-  _globals['_TRIALSPEC_PARAMETERASSIGNMENTS']._serialized_end=2555
-  _globals['_TRIALSPEC_LABELSENTRY']._serialized_start=2557
-  _globals['_TRIALSPEC_LABELSENTRY']._serialized_end=2614
-  _globals['_PARAMETERASSIGNMENT']._serialized_start=2616
-  _globals['_PARAMETERASSIGNMENT']._serialized_end=2679
-  _globals['_TRIALSTATUS']._serialized_start=2682
-  _globals['_TRIALSTATUS']._serialized_end=3047
-  _globals['_TRIALSTATUS_TRIALCONDITIONTYPE']._serialized_start=2907
-  _globals['_TRIALSTATUS_TRIALCONDITIONTYPE']._serialized_end=3047
-  _globals['_OBSERVATION']._serialized_start=3049
-  _globals['_OBSERVATION']._serialized_end=3110
-  _globals['_METRIC']._serialized_start=3112
-  _globals['_METRIC']._serialized_end=3162
-  _globals['_REPORTOBSERVATIONLOGREQUEST']._serialized_start=3165
-  _globals['_REPORTOBSERVATIONLOGREQUEST']._serialized_end=3296
-  _globals['_REPORTOBSERVATIONLOGREPLY']._serialized_start=3298
-  _globals['_REPORTOBSERVATIONLOGREPLY']._serialized_end=3325
-  _globals['_OBSERVATIONLOG']._serialized_start=3327
-  _globals['_OBSERVATIONLOG']._serialized_end=3401
-  _globals['_METRICLOG']._serialized_start=3403
-  _globals['_METRICLOG']._serialized_end=3491
-  _globals['_GETOBSERVATIONLOGREQUEST']._serialized_start=3494
-  _globals['_GETOBSERVATIONLOGREQUEST']._serialized_end=3642
-  _globals['_GETOBSERVATIONLOGREPLY']._serialized_start=3644
-  _globals['_GETOBSERVATIONLOGREPLY']._serialized_end=3739
-  _globals['_DELETEOBSERVATIONLOGREQUEST']._serialized_start=3741
-  _globals['_DELETEOBSERVATIONLOGREQUEST']._serialized_end=3801
-  _globals['_DELETEOBSERVATIONLOGREPLY']._serialized_start=3803
-  _globals['_DELETEOBSERVATIONLOGREPLY']._serialized_end=3830
-  _globals['_GETSUGGESTIONSREQUEST']._serialized_start=3833
-  _globals['_GETSUGGESTIONSREQUEST']._serialized_end=4063
-  _globals['_GETSUGGESTIONSREPLY']._serialized_start=4066
-  _globals['_GETSUGGESTIONSREPLY']._serialized_end=4614
-  _globals['_GETSUGGESTIONSREPLY_PARAMETERASSIGNMENTS']._serialized_start=4341
-  _globals['_GETSUGGESTIONSREPLY_PARAMETERASSIGNMENTS']._serialized_end=4614
-  _globals['_GETSUGGESTIONSREPLY_PARAMETERASSIGNMENTS_LABELSENTRY']._serialized_start=2557
-  _globals['_GETSUGGESTIONSREPLY_PARAMETERASSIGNMENTS_LABELSENTRY']._serialized_end=2614
-  _globals['_VALIDATEALGORITHMSETTINGSREQUEST']._serialized_start=4616
-  _globals['_VALIDATEALGORITHMSETTINGSREQUEST']._serialized_end=4708
-  _globals['_VALIDATEALGORITHMSETTINGSREPLY']._serialized_start=4710
-  _globals['_VALIDATEALGORITHMSETTINGSREPLY']._serialized_end=4742
-  _globals['_GETEARLYSTOPPINGRULESREQUEST']._serialized_start=4745
-  _globals['_GETEARLYSTOPPINGRULESREQUEST']._serialized_end=4924
-  _globals['_GETEARLYSTOPPINGRULESREPLY']._serialized_start=4926
-  _globals['_GETEARLYSTOPPINGRULESREPLY']._serialized_end=5037
-  _globals['_EARLYSTOPPINGRULE']._serialized_start=5040
-  _globals['_EARLYSTOPPINGRULE']._serialized_end=5194
-  _globals['_VALIDATEEARLYSTOPPINGSETTINGSREQUEST']._serialized_start=5196
-  _globals['_VALIDATEEARLYSTOPPINGSETTINGSREQUEST']._serialized_end=5306
-  _globals['_VALIDATEEARLYSTOPPINGSETTINGSREPLY']._serialized_start=5308
-  _globals['_VALIDATEEARLYSTOPPINGSETTINGSREPLY']._serialized_end=5344
-  _globals['_SETTRIALSTATUSREQUEST']._serialized_start=5346
-  _globals['_SETTRIALSTATUSREQUEST']._serialized_end=5400
-  _globals['_SETTRIALSTATUSREPLY']._serialized_start=5402
-  _globals['_SETTRIALSTATUSREPLY']._serialized_end=5423
-  _globals['_DBMANAGER']._serialized_start=5751
-  _globals['_DBMANAGER']._serialized_end=6077
-  _globals['_SUGGESTION']._serialized_start=6080
-  _globals['_SUGGESTION']._serialized_end=6305
-  _globals['_EARLYSTOPPING']._serialized_start=6308
-  _globals['_EARLYSTOPPING']._serialized_end=6660
+  _globals['_EXPERIMENTSPEC']._serialized_end=682
+  _globals['_EXPERIMENTSPEC_PARAMETERSPECS']._serialized_start=605
+  _globals['_EXPERIMENTSPEC_PARAMETERSPECS']._serialized_end=682
+  _globals['_PARAMETERSPEC']._serialized_start=685
+  _globals['_PARAMETERSPEC']._serialized_end=920
+  _globals['_PARAMETERCONDITION']._serialized_start=922
+  _globals['_PARAMETERCONDITION']._serialized_end=990
+  _globals['_FEASIBLESPACE']._serialized_start=993
+  _globals['_FEASIBLESPACE']._serialized_end=1148
+  _globals['_OBJECTIVESPEC']._serialized_start=1151
+  _globals['_OBJECTIVESPEC']._serialized_end=1431
+  _globals['_ADDITIONALOBJECTIVE']._serialized_start=1433
+  _globals['_ADDITIONALOBJECTIVE']._serialized_end=1555
+  _globals['_ALGORITHMSPEC']._serialized_start=1558
+  _globals['_ALGORITHMSPEC']._serialized_end=1691
+  _globals['_ALGORITHMSETTING']._serialized_start=1693
+  _globals['_ALGORITHMSETTING']._serialized_end=1753
+  _globals['_EARLYSTOPPINGSPEC']._serialized_start=1756
+  _globals['_EARLYSTOPPINGSPEC']._serialized_end=1897
+  _globals['_EARLYSTOPPINGSETTING']._serialized_start=1899
+  _globals['_EARLYSTOPPINGSETTING']._serialized_end=1963
+  _globals['_NASCONFIG']._serialized_start=1966
+  _globals['_NASCONFIG']._serialized_end=2176
+  _globals['_NASCONFIG_OPERATIONS']._serialized_start=2109
+  _globals['_NASCONFIG_OPERATIONS']._serialized_end=2176
+  _globals['_GRAPHCONFIG']._serialized_start=2178
+  _globals['_GRAPHCONFIG']._serialized_end=2290
+  _globals['_OPERATION']._serialized_start=2293
+  _globals['_OPERATION']._serialized_end=2503
+  _globals['_OPERATION_PARAMETERSPECS']._serialized_start=605
+  _globals['_OPERATION_PARAMETERSPECS']._serialized_end=682
+  _globals['_TRIAL']._serialized_start=2505
+  _globals['_TRIAL']._serialized_end=2628
+  _globals['_TRIALSPEC']._serialized_start=2631
+  _globals['_TRIALSPEC']._serialized_end=3037
+  _globals['_TRIALSPEC_PARAMETERASSIGNMENTS']._serialized_start=2887
+  _globals['_TRIALSPEC_PARAMETERASSIGNMENTS']._serialized_end=2978
+  _globals['_TRIALSPEC_LABELSENTRY']._serialized_start=2980
+  _globals['_TRIALSPEC_LABELSENTRY']._serialized_end=3037
+  _globals['_PARAMETERASSIGNMENT']._serialized_start=3039
+  _globals['_PARAMETERASSIGNMENT']._serialized_end=3102
+  _globals['_TRIALSTATUS']._serialized_start=3105
+  _globals['_TRIALSTATUS']._serialized_end=3470
+  _globals['_TRIALSTATUS_TRIALCONDITIONTYPE']._serialized_start=3330
+  _globals['_TRIALSTATUS_TRIALCONDITIONTYPE']._serialized_end=3470
+  _globals['_OBSERVATION']._serialized_start=3472
+  _globals['_OBSERVATION']._serialized_end=3533
+  _globals['_METRIC']._serialized_start=3535
+  _globals['_METRIC']._serialized_end=3585
+  _globals['_REPORTOBSERVATIONLOGREQUEST']._serialized_start=3588
+  _globals['_REPORTOBSERVATIONLOGREQUEST']._serialized_end=3760
+  _globals['_REPORTOBSERVATIONLOGREPLY']._serialized_start=3762
+  _globals['_REPORTOBSERVATIONLOGREPLY']._serialized_end=3789
+  _globals['_OBSERVATIONLOG']._serialized_start=3791
+  _globals['_OBSERVATIONLOG']._serialized_end=3865
+  _globals['_METRICLOG']._serialized_start=3868
+  _globals['_METRICLOG']._serialized_end=4014
+  _globals['_GETOBSERVATIONLOGREQUEST']._serialized_start=4017
+  _globals['_GETOBSERVATIONLOGREQUEST']._serialized_end=4261
+  _globals['_GETOBSERVATIONLOGREPLY']._serialized_start=4263
+  _globals['_GETOBSERVATIONLOGREPLY']._serialized_end=4358
+  _globals['_DELETEOBSERVATIONLOGREQUEST']._serialized_start=4360
+  _globals['_DELETEOBSERVATIONLOGREQUEST']._serialized_end=4420
+  _globals['_DELETEOBSERVATIONLOGREPLY']._serialized_start=4422
+  _globals['_DELETEOBSERVATIONLOGREPLY']._serialized_end=4449
+  _globals['_GETSUGGESTIONSREQUEST']._serialized_start=4452
+  _globals['_GETSUGGESTIONSREQUEST']._serialized_end=4682
+  _globals['_GETSUGGESTIONSREPLY']._serialized_start=4685
+  _globals['_GETSUGGESTIONSREPLY']._serialized_end=5311
+  _globals['_GETSUGGESTIONSREPLY_PARAMETERASSIGNMENTS']._serialized_start=5014
+  _globals['_GETSUGGESTIONSREPLY_PARAMETERASSIGNMENTS']._serialized_end=5311
+  _globals['_GETSUGGESTIONSREPLY_PARAMETERASSIGNMENTS_LABELSENTRY']._serialized_start=2980
+  _globals['_GETSUGGESTIONSREPLY_PARAMETERASSIGNMENTS_LABELSENTRY']._serialized_end=3037
+  _globals['_VALIDATEALGORITHMSETTINGSREQUEST']._serialized_start=5313
+  _globals['_VALIDATEALGORITHMSETTINGSREQUEST']._serialized_end=5405
+  _globals['_VALIDATEALGORITHMSETTINGSREPLY']._serialized_start=5407
+  _globals['_VALIDATEALGORITHMSETTINGSREPLY']._serialized_end=5439
+  _globals['_GETEARLYSTOPPINGRULESREQUEST']._serialized_start=5442
+  _globals['_GETEARLYSTOPPINGRULESREQUEST']._serialized_end=5621
+  _globals['_GETEARLYSTOPPINGRULESREPLY']._serialized_start=5623
+  _globals['_GETEARLYSTOPPINGRULESREPLY']._serialized_end=5734
+  _globals['_EARLYSTOPPINGRULE']._serialized_start=5737
+  _globals['_EARLYSTOPPINGRULE']._serialized_end=5891
+  _globals['_VALIDATEEARLYSTOPPINGSETTINGSREQUEST']._serialized_start=5893
+  _globals['_VALIDATEEARLYSTOPPINGSETTINGSREQUEST']._serialized_end=6003
+  _globals['_VALIDATEEARLYSTOPPINGSETTINGSREPLY']._serialized_start=6005
+  _globals['_VALIDATEEARLYSTOPPINGSETTINGSREPLY']._serialized_end=6041
+  _globals['_SETTRIALSTATUSREQUEST']._serialized_start=6043
+  _globals['_SETTRIALSTATUSREQUEST']._serialized_end=6097
+  _globals['_SETTRIALSTATUSREPLY']._serialized_start=6099
+  _globals['_SETTRIALSTATUSREPLY']._serialized_end=6120
+  _globals['_DBMANAGER']._serialized_start=6448
+  _globals['_DBMANAGER']._serialized_end=6774
+  _globals['_SUGGESTION']._serialized_start=6777
+  _globals['_SUGGESTION']._serialized_end=7002
+  _globals['_EARLYSTOPPING']._serialized_start=7005
+  _globals['_EARLYSTOPPING']._serialized_end=7357
 # @@protoc_insertion_point(module_scope)
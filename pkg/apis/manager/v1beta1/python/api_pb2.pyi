@@ -61,7 +61,7 @@ class Experiment(_message.Message):
     def __init__(self, name: _Optional[str] = ..., spec: _Optional[_Union[ExperimentSpec, _Mapping]] = ...) -> None: ...
 
 class ExperimentSpec(_message.Message):
-    __slots__ = ("parameter_specs", "objective", "algorithm", "early_stopping", "parallel_trial_count", "max_trial_count", "nas_config")
+    __slots__ = ("parameter_specs", "objective", "algorithm", "early_stopping", "parallel_trial_count", "max_trial_count", "nas_config", "parameter_constraints")
     class ParameterSpecs(_message.Message):
         __slots__ = ("parameters",)
         PARAMETERS_FIELD_NUMBER: _ClassVar[int]
@@ -74,6 +74,7 @@ class ExperimentSpec(_message.Message):
     PARALLEL_TRIAL_COUNT_FIELD_NUMBER: _ClassVar[int]
     MAX_TRIAL_COUNT_FIELD_NUMBER: _ClassVar[int]
     NAS_CONFIG_FIELD_NUMBER: _ClassVar[int]
+    PARAMETER_CONSTRAINTS_FIELD_NUMBER: _ClassVar[int]
     parameter_specs: ExperimentSpec.ParameterSpecs
     objective: ObjectiveSpec
     algorithm: AlgorithmSpec
@@ -81,17 +82,28 @@ class ExperimentSpec(_message.Message):
     parallel_trial_count: int
     max_trial_count: int
     nas_config: NasConfig
-    def __init__(self, parameter_specs: _Optional[_Union[ExperimentSpec.ParameterSpecs, _Mapping]] = ..., objective: _Optional[_Union[ObjectiveSpec, _Mapping]] = ..., algorithm: _Optional[_Union[AlgorithmSpec, _Mapping]] = ..., early_stopping: _Optional[_Union[EarlyStoppingSpec, _Mapping]] = ..., parallel_trial_count: _Optional[int] = ..., max_trial_count: _Optional[int] = ..., nas_config: _Optional[_Union[NasConfig, _Mapping]] = ...) -> None: ...
+    parameter_constraints: _containers.RepeatedScalarFieldContainer[str]
+    def __init__(self, parameter_specs: _Optional[_Union[ExperimentSpec.ParameterSpecs, _Mapping]] = ..., objective: _Optional[_Union[ObjectiveSpec, _Mapping]] = ..., algorithm: _Optional[_Union[AlgorithmSpec, _Mapping]] = ..., early_stopping: _Optional[_Union[EarlyStoppingSpec, _Mapping]] = ..., parallel_trial_count: _Optional[int] = ..., max_trial_count: _Optional[int] = ..., nas_config: _Optional[_Union[NasConfig, _Mapping]] = ..., parameter_constraints: _Optional[_Iterable[str]] = ...) -> None: ...
 
 class ParameterSpec(_message.Message):
-    __slots__ = ("name", "parameter_type", "feasible_space")
+    __slots__ = ("name", "parameter_type", "feasible_space", "condition")
     NAME_FIELD_NUMBER: _ClassVar[int]
     PARAMETER_TYPE_FIELD_NUMBER: _ClassVar[int]
     FEASIBLE_SPACE_FIELD_NUMBER: _ClassVar[int]
+    CONDITION_FIELD_NUMBER: _ClassVar[int]
     name: str
     parameter_type: ParameterType
     feasible_space: FeasibleSpace
-    def __init__(self, name: _Optional[str] = ..., parameter_type: _Optional[_Union[ParameterType, str]] = ..., feasible_space: _Optional[_Union[FeasibleSpace, _Mapping]] = ...) -> None: ...
+    condition: ParameterCondition
+    def __init__(self, name: _Optional[str] = ..., parameter_type: _Optional[_Union[ParameterType, str]] = ..., feasible_space: _Optional[_Union[FeasibleSpace, _Mapping]] = ..., condition: _Optional[_Union[ParameterCondition, _Mapping]] = ...) -> None: ...
+
+class ParameterCondition(_message.Message):
+    __slots__ = ("parent", "values")
+    PARENT_FIELD_NUMBER: _ClassVar[int]
+    VALUES_FIELD_NUMBER: _ClassVar[int]
+    parent: str
+    values: _containers.RepeatedScalarFieldContainer[str]
+    def __init__(self, parent: _Optional[str] = ..., values: _Optional[_Iterable[str]] = ...) -> None: ...
 
 class FeasibleSpace(_message.Message):
     __slots__ = ("max", "min", "list", "step", "distribution")
@@ -108,16 +120,26 @@ class FeasibleSpace(_message.Message):
     def __init__(self, max: _Optional[str] = ..., min: _Optional[str] = ..., list: _Optional[_Iterable[str]] = ..., step: _Optional[str] = ..., distribution: _Optional[_Union[Distribution, str]] = ...) -> None: ...
 
 class ObjectiveSpec(_message.Message):
-    __slots__ = ("type", "goal", "objective_metric_name", "additional_metric_names")
+    __slots__ = ("type", "goal", "objective_metric_name", "additional_metric_names", "additional_objectives")
     TYPE_FIELD_NUMBER: _ClassVar[int]
     GOAL_FIELD_NUMBER: _ClassVar[int]
     OBJECTIVE_METRIC_NAME_FIELD_NUMBER: _ClassVar[int]
     ADDITIONAL_METRIC_NAMES_FIELD_NUMBER: _ClassVar[int]
+    ADDITIONAL_OBJECTIVES_FIELD_NUMBER: _ClassVar[int]
     type: ObjectiveType
     goal: float
     objective_metric_name: str
     additional_metric_names: _containers.RepeatedScalarFieldContainer[str]
-    def __init__(self, type: _Optional[_Union[ObjectiveType, str]] = ..., goal: _Optional[float] = ..., objective_metric_name: _Optional[str] = ..., additional_metric_names: _Optional[_Iterable[str]] = ...) -> None: ...
+    additional_objectives: _containers.RepeatedCompositeFieldContainer[AdditionalObjective]
+    def __init__(self, type: _Optional[_Union[ObjectiveType, str]] = ..., goal: _Optional[float] = ..., objective_metric_name: _Optional[str] = ..., additional_metric_names: _Optional[_Iterable[str]] = ..., additional_objectives: _Optional[_Iterable[_Union[AdditionalObjective, _Mapping]]] = ...) -> None: ...
+
+class AdditionalObjective(_message.Message):
+    __slots__ = ("type", "objective_metric_name")
+    TYPE_FIELD_NUMBER: _ClassVar[int]
+    OBJECTIVE_METRIC_NAME_FIELD_NUMBER: _ClassVar[int]
+    type: ObjectiveType
+    objective_metric_name: str
+    def __init__(self, type: _Optional[_Union[ObjectiveType, str]] = ..., objective_metric_name: _Optional[str] = ...) -> None: ...
 
 class AlgorithmSpec(_message.Message):
     __slots__ = ("algorithm_name", "algorithm_settings")
@@ -198,7 +220,7 @@ class Trial(_message.Message):
     def __init__(self, name: _Optional[str] = ..., spec: _Optional[_Union[TrialSpec, _Mapping]] = ..., status: _Optional[_Union[TrialStatus, _Mapping]] = ...) -> None: ...
 
 class TrialSpec(_message.Message):
-    __slots__ = ("objective", "parameter_assignments", "labels")
+    __slots__ = ("objective", "parameter_assignments", "labels", "budget")
     class ParameterAssignments(_message.Message):
         __slots__ = ("assignments",)
         ASSIGNMENTS_FIELD_NUMBER: _ClassVar[int]
@@ -214,10 +236,12 @@ class TrialSpec(_message.Message):
     OBJECTIVE_FIELD_NUMBER: _ClassVar[int]
     PARAMETER_ASSIGNMENTS_FIELD_NUMBER: _ClassVar[int]
     LABELS_FIELD_NUMBER: _ClassVar[int]
+    BUDGET_FIELD_NUMBER: _ClassVar[int]
     objective: ObjectiveSpec
     parameter_assignments: TrialSpec.ParameterAssignments
     labels: _containers.ScalarMap[str, str]
-    def __init__(self, objective: _Optional[_Union[ObjectiveSpec, _Mapping]] = ..., parameter_assignments: _Optional[_Union[TrialSpec.ParameterAssignments, _Mapping]] = ..., labels: _Optional[_Mapping[str, str]] = ...) -> None: ...
+    budget: str
+    def __init__(self, objective: _Optional[_Union[ObjectiveSpec, _Mapping]] = ..., parameter_assignments: _Optional[_Union[TrialSpec.ParameterAssignments, _Mapping]] = ..., labels: _Optional[_Mapping[str, str]] = ..., budget: _Optional[str] = ...) -> None: ...
 
 class ParameterAssignment(_message.Message):
     __slots__ = ("name", "value")
@@ -272,12 +296,14 @@ class Metric(_message.Message):
     def __init__(self, name: _Optional[str] = ..., value: _Optional[str] = ...) -> None: ...
 
 class ReportObservationLogRequest(_message.Message):
-    __slots__ = ("trial_name", "observation_log")
+    __slots__ = ("trial_name", "observation_log", "idempotency_key")
     TRIAL_NAME_FIELD_NUMBER: _ClassVar[int]
     OBSERVATION_LOG_FIELD_NUMBER: _ClassVar[int]
+    IDEMPOTENCY_KEY_FIELD_NUMBER: _ClassVar[int]
     trial_name: str
     observation_log: ObservationLog
-    def __init__(self, trial_name: _Optional[str] = ..., observation_log: _Optional[_Union[ObservationLog, _Mapping]] = ...) -> None: ...
+    idempotency_key: str
+    def __init__(self, trial_name: _Optional[str] = ..., observation_log: _Optional[_Union[ObservationLog, _Mapping]] = ..., idempotency_key: _Optional[str] = ...) -> None: ...
 
 class ReportObservationLogReply(_message.Message):
     __slots__ = ()
@@ -290,24 +316,32 @@ class ObservationLog(_message.Message):
     def __init__(self, metric_logs: _Optional[_Iterable[_Union[MetricLog, _Mapping]]] = ...) -> None: ...
 
 class MetricLog(_message.Message):
-    __slots__ = ("time_stamp", "metric")
+    __slots__ = ("time_stamp", "metric", "step", "source")
     TIME_STAMP_FIELD_NUMBER: _ClassVar[int]
     METRIC_FIELD_NUMBER: _ClassVar[int]
+    STEP_FIELD_NUMBER: _ClassVar[int]
+    SOURCE_FIELD_NUMBER: _ClassVar[int]
     time_stamp: str
     metric: Metric
-    def __init__(self, time_stamp: _Optional[str] = ..., metric: _Optional[_Union[Metric, _Mapping]] = ...) -> None: ...
+    step: int
+    source: str
+    def __init__(self, time_stamp: _Optional[str] = ..., metric: _Optional[_Union[Metric, _Mapping]] = ..., step: _Optional[int] = ..., source: _Optional[str] = ...) -> None: ...
 
 class GetObservationLogRequest(_message.Message):
-    __slots__ = ("trial_name", "metric_name", "start_time", "end_time")
+    __slots__ = ("trial_name", "metric_name", "start_time", "end_time", "start_step", "end_step")
     TRIAL_NAME_FIELD_NUMBER: _ClassVar[int]
     METRIC_NAME_FIELD_NUMBER: _ClassVar[int]
     START_TIME_FIELD_NUMBER: _ClassVar[int]
     END_TIME_FIELD_NUMBER: _ClassVar[int]
+    START_STEP_FIELD_NUMBER: _ClassVar[int]
+    END_STEP_FIELD_NUMBER: _ClassVar[int]
     trial_name: str
     metric_name: str
     start_time: str
     end_time: str
-    def __init__(self, trial_name: _Optional[str] = ..., metric_name: _Optional[str] = ..., start_time: _Optional[str] = ..., end_time: _Optional[str] = ...) -> None: ...
+    start_step: int
+    end_step: int
+    def __init__(self, trial_name: _Optional[str] = ..., metric_name: _Optional[str] = ..., start_time: _Optional[str] = ..., end_time: _Optional[str] = ..., start_step: _Optional[int] = ..., end_step: _Optional[int] = ...) -> None: ...
 
 class GetObservationLogReply(_message.Message):
     __slots__ = ("observation_log",)
@@ -338,9 +372,9 @@ class GetSuggestionsRequest(_message.Message):
     def __init__(self, experiment: _Optional[_Union[Experiment, _Mapping]] = ..., trials: _Optional[_Iterable[_Union[Trial, _Mapping]]] = ..., current_request_number: _Optional[int] = ..., total_request_number: _Optional[int] = ...) -> None: ...
 
 class GetSuggestionsReply(_message.Message):
-    __slots__ = ("parameter_assignments", "algorithm", "early_stopping_rules")
+    __slots__ = ("parameter_assignments", "algorithm", "early_stopping_rules", "search_space_exhausted")
     class ParameterAssignments(_message.Message):
-        __slots__ = ("assignments", "trial_name", "labels")
+        __slots__ = ("assignments", "trial_name", "labels", "budget")
         class LabelsEntry(_message.Message):
             __slots__ = ("key", "value")
             KEY_FIELD_NUMBER: _ClassVar[int]
@@ -351,17 +385,21 @@ class GetSuggestionsReply(_message.Message):
         ASSIGNMENTS_FIELD_NUMBER: _ClassVar[int]
         TRIAL_NAME_FIELD_NUMBER: _ClassVar[int]
         LABELS_FIELD_NUMBER: _ClassVar[int]
+        BUDGET_FIELD_NUMBER: _ClassVar[int]
         assignments: _containers.RepeatedCompositeFieldContainer[ParameterAssignment]
         trial_name: str
         labels: _containers.ScalarMap[str, str]
-        def __init__(self, assignments: _Optional[_Iterable[_Union[ParameterAssignment, _Mapping]]] = ..., trial_name: _Optional[str] = ..., labels: _Optional[_Mapping[str, str]] = ...) -> None: ...
+        budget: str
+        def __init__(self, assignments: _Optional[_Iterable[_Union[ParameterAssignment, _Mapping]]] = ..., trial_name: _Optional[str] = ..., labels: _Optional[_Mapping[str, str]] = ..., budget: _Optional[str] = ...) -> None: ...
     PARAMETER_ASSIGNMENTS_FIELD_NUMBER: _ClassVar[int]
     ALGORITHM_FIELD_NUMBER: _ClassVar[int]
     EARLY_STOPPING_RULES_FIELD_NUMBER: _ClassVar[int]
+    SEARCH_SPACE_EXHAUSTED_FIELD_NUMBER: _ClassVar[int]
     parameter_assignments: _containers.RepeatedCompositeFieldContainer[GetSuggestionsReply.ParameterAssignments]
     algorithm: AlgorithmSpec
     early_stopping_rules: _containers.RepeatedCompositeFieldContainer[EarlyStoppingRule]
-    def __init__(self, parameter_assignments: _Optional[_Iterable[_Union[GetSuggestionsReply.ParameterAssignments, _Mapping]]] = ..., algorithm: _Optional[_Union[AlgorithmSpec, _Mapping]] = ..., early_stopping_rules: _Optional[_Iterable[_Union[EarlyStoppingRule, _Mapping]]] = ...) -> None: ...
+    search_space_exhausted: bool
+    def __init__(self, parameter_assignments: _Optional[_Iterable[_Union[GetSuggestionsReply.ParameterAssignments, _Mapping]]] = ..., algorithm: _Optional[_Union[AlgorithmSpec, _Mapping]] = ..., early_stopping_rules: _Optional[_Iterable[_Union[EarlyStoppingRule, _Mapping]]] = ..., search_space_exhausted: bool = ...) -> None: ...
 
 class ValidateAlgorithmSettingsRequest(_message.Message):
     __slots__ = ("experiment",)
@@ -21,22 +21,35 @@ package v1beta1
 import (
 	commonv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1"
 	experimentsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // ExperimentSpecApplyConfiguration represents a declarative configuration of the ExperimentSpec type for use
 // with apply.
 type ExperimentSpecApplyConfiguration struct {
-	Parameters           []ParameterSpecApplyConfiguration    `json:"parameters,omitempty"`
-	Objective            *commonv1beta1.ObjectiveSpec         `json:"objective,omitempty"`
-	Algorithm            *commonv1beta1.AlgorithmSpec         `json:"algorithm,omitempty"`
-	EarlyStopping        *commonv1beta1.EarlyStoppingSpec     `json:"earlyStopping,omitempty"`
-	TrialTemplate        *TrialTemplateApplyConfiguration     `json:"trialTemplate,omitempty"`
-	ParallelTrialCount   *int32                               `json:"parallelTrialCount,omitempty"`
-	MaxTrialCount        *int32                               `json:"maxTrialCount,omitempty"`
-	MaxFailedTrialCount  *int32                               `json:"maxFailedTrialCount,omitempty"`
-	MetricsCollectorSpec *commonv1beta1.MetricsCollectorSpec  `json:"metricsCollectorSpec,omitempty"`
-	NasConfig            *NasConfigApplyConfiguration         `json:"nasConfig,omitempty"`
-	ResumePolicy         *experimentsv1beta1.ResumePolicyType `json:"resumePolicy,omitempty"`
+	Parameters           []ParameterSpecApplyConfiguration          `json:"parameters,omitempty"`
+	ParameterConstraints []string                                   `json:"parameterConstraints,omitempty"`
+	Objective            *commonv1beta1.ObjectiveSpec               `json:"objective,omitempty"`
+	Algorithm            *commonv1beta1.AlgorithmSpec               `json:"algorithm,omitempty"`
+	EarlyStopping        *commonv1beta1.EarlyStoppingSpec           `json:"earlyStopping,omitempty"`
+	TrialTemplate        *TrialTemplateApplyConfiguration           `json:"trialTemplate,omitempty"`
+	ParallelTrialCount   *int32                                     `json:"parallelTrialCount,omitempty"`
+	AdaptiveParallelism  *AdaptiveParallelismSpecApplyConfiguration `json:"adaptiveParallelism,omitempty"`
+	MaxTrialCount        *int32                                     `json:"maxTrialCount,omitempty"`
+	MaxFailedTrialCount  *int32                                     `json:"maxFailedTrialCount,omitempty"`
+	MaxDuration          *v1.Duration                               `json:"maxDuration,omitempty"`
+	MaxResourceUsage     *ResourceUsageSpecApplyConfiguration       `json:"maxResourceUsage,omitempty"`
+	BudgetPolicy         *experimentsv1beta1.ActiveTrialsPolicyType `json:"budgetPolicy,omitempty"`
+	Suspend              *bool                                      `json:"suspend,omitempty"`
+	SuspendPolicy        *experimentsv1beta1.ActiveTrialsPolicyType `json:"suspendPolicy,omitempty"`
+	Queueing             *QueueingSpecApplyConfiguration            `json:"queueing,omitempty"`
+	Convergence          *ConvergenceSpecApplyConfiguration         `json:"convergence,omitempty"`
+	MetricsCollectorSpec *commonv1beta1.MetricsCollectorSpec        `json:"metricsCollectorSpec,omitempty"`
+	NasConfig            *NasConfigApplyConfiguration               `json:"nasConfig,omitempty"`
+	ResumePolicy         *experimentsv1beta1.ResumePolicyType       `json:"resumePolicy,omitempty"`
+	WarmStart            *WarmStartSpecApplyConfiguration           `json:"warmStart,omitempty"`
+	DuplicateSuggestion  *DuplicateSuggestionSpecApplyConfiguration `json:"duplicateSuggestion,omitempty"`
+	TrialCache           *commonv1beta1.TrialCacheSpec              `json:"trialCache,omitempty"`
 }
 
 // ExperimentSpecApplyConfiguration constructs a declarative configuration of the ExperimentSpec type for use with
@@ -58,6 +71,16 @@ func (b *ExperimentSpecApplyConfiguration) WithParameters(values ...*ParameterSp
 	return b
 }
 
+// WithParameterConstraints adds the given value to the ParameterConstraints field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the ParameterConstraints field.
+func (b *ExperimentSpecApplyConfiguration) WithParameterConstraints(values ...string) *ExperimentSpecApplyConfiguration {
+	for i := range values {
+		b.ParameterConstraints = append(b.ParameterConstraints, values[i])
+	}
+	return b
+}
+
 // WithObjective sets the Objective field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Objective field is set to the value of the last call.
@@ -98,6 +121,14 @@ func (b *ExperimentSpecApplyConfiguration) WithParallelTrialCount(value int32) *
 	return b
 }
 
+// WithAdaptiveParallelism sets the AdaptiveParallelism field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AdaptiveParallelism field is set to the value of the last call.
+func (b *ExperimentSpecApplyConfiguration) WithAdaptiveParallelism(value *AdaptiveParallelismSpecApplyConfiguration) *ExperimentSpecApplyConfiguration {
+	b.AdaptiveParallelism = value
+	return b
+}
+
 // WithMaxTrialCount sets the MaxTrialCount field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the MaxTrialCount field is set to the value of the last call.
@@ -114,6 +145,62 @@ func (b *ExperimentSpecApplyConfiguration) WithMaxFailedTrialCount(value int32)
 	return b
 }
 
+// WithMaxDuration sets the MaxDuration field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxDuration field is set to the value of the last call.
+func (b *ExperimentSpecApplyConfiguration) WithMaxDuration(value v1.Duration) *ExperimentSpecApplyConfiguration {
+	b.MaxDuration = &value
+	return b
+}
+
+// WithMaxResourceUsage sets the MaxResourceUsage field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxResourceUsage field is set to the value of the last call.
+func (b *ExperimentSpecApplyConfiguration) WithMaxResourceUsage(value *ResourceUsageSpecApplyConfiguration) *ExperimentSpecApplyConfiguration {
+	b.MaxResourceUsage = value
+	return b
+}
+
+// WithBudgetPolicy sets the BudgetPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the BudgetPolicy field is set to the value of the last call.
+func (b *ExperimentSpecApplyConfiguration) WithBudgetPolicy(value experimentsv1beta1.ActiveTrialsPolicyType) *ExperimentSpecApplyConfiguration {
+	b.BudgetPolicy = &value
+	return b
+}
+
+// WithSuspend sets the Suspend field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Suspend field is set to the value of the last call.
+func (b *ExperimentSpecApplyConfiguration) WithSuspend(value bool) *ExperimentSpecApplyConfiguration {
+	b.Suspend = &value
+	return b
+}
+
+// WithSuspendPolicy sets the SuspendPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SuspendPolicy field is set to the value of the last call.
+func (b *ExperimentSpecApplyConfiguration) WithSuspendPolicy(value experimentsv1beta1.ActiveTrialsPolicyType) *ExperimentSpecApplyConfiguration {
+	b.SuspendPolicy = &value
+	return b
+}
+
+// WithQueueing sets the Queueing field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Queueing field is set to the value of the last call.
+func (b *ExperimentSpecApplyConfiguration) WithQueueing(value *QueueingSpecApplyConfiguration) *ExperimentSpecApplyConfiguration {
+	b.Queueing = value
+	return b
+}
+
+// WithConvergence sets the Convergence field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Convergence field is set to the value of the last call.
+func (b *ExperimentSpecApplyConfiguration) WithConvergence(value *ConvergenceSpecApplyConfiguration) *ExperimentSpecApplyConfiguration {
+	b.Convergence = value
+	return b
+}
+
 // WithMetricsCollectorSpec sets the MetricsCollectorSpec field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the MetricsCollectorSpec field is set to the value of the last call.
@@ -137,3 +224,27 @@ func (b *ExperimentSpecApplyConfiguration) WithResumePolicy(value experimentsv1b
 	b.ResumePolicy = &value
 	return b
 }
+
+// WithWarmStart sets the WarmStart field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the WarmStart field is set to the value of the last call.
+func (b *ExperimentSpecApplyConfiguration) WithWarmStart(value *WarmStartSpecApplyConfiguration) *ExperimentSpecApplyConfiguration {
+	b.WarmStart = value
+	return b
+}
+
+// WithDuplicateSuggestion sets the DuplicateSuggestion field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DuplicateSuggestion field is set to the value of the last call.
+func (b *ExperimentSpecApplyConfiguration) WithDuplicateSuggestion(value *DuplicateSuggestionSpecApplyConfiguration) *ExperimentSpecApplyConfiguration {
+	b.DuplicateSuggestion = value
+	return b
+}
+
+// WithTrialCache sets the TrialCache field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TrialCache field is set to the value of the last call.
+func (b *ExperimentSpecApplyConfiguration) WithTrialCache(value commonv1beta1.TrialCacheSpec) *ExperimentSpecApplyConfiguration {
+	b.TrialCache = &value
+	return b
+}
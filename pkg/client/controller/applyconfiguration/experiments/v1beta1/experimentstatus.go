@@ -30,6 +30,7 @@ type ExperimentStatusApplyConfiguration struct {
 	LastReconcileTime           *v1.Time                                `json:"lastReconcileTime,omitempty"`
 	Conditions                  []ExperimentConditionApplyConfiguration `json:"conditions,omitempty"`
 	CurrentOptimalTrial         *OptimalTrialApplyConfiguration         `json:"currentOptimalTrial,omitempty"`
+	ParetoOptimalTrials         []OptimalTrialApplyConfiguration        `json:"paretoOptimalTrials,omitempty"`
 	RunningTrialList            []string                                `json:"runningTrialList,omitempty"`
 	PendingTrialList            []string                                `json:"pendingTrialList,omitempty"`
 	FailedTrialList             []string                                `json:"failedTrialList,omitempty"`
@@ -45,6 +46,10 @@ type ExperimentStatusApplyConfiguration struct {
 	TrialsRunning               *int32                                  `json:"trialsRunning,omitempty"`
 	TrialsEarlyStopped          *int32                                  `json:"trialsEarlyStopped,omitempty"`
 	TrialMetricsUnavailable     *int32                                  `json:"trialMetricsUnavailable,omitempty"`
+	TrialsQueued                *int32                                  `json:"trialsQueued,omitempty"`
+	ResourceUsage               *float64                                `json:"resourceUsage,omitempty"`
+	Convergence                 *ConvergenceStatusApplyConfiguration    `json:"convergence,omitempty"`
+	Parallelism                 *ParallelismStatusApplyConfiguration    `json:"parallelism,omitempty"`
 }
 
 // ExperimentStatusApplyConfiguration constructs a declarative configuration of the ExperimentStatus type for use with
@@ -98,6 +103,19 @@ func (b *ExperimentStatusApplyConfiguration) WithCurrentOptimalTrial(value *Opti
 	return b
 }
 
+// WithParetoOptimalTrials adds the given value to the ParetoOptimalTrials field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the ParetoOptimalTrials field.
+func (b *ExperimentStatusApplyConfiguration) WithParetoOptimalTrials(values ...*OptimalTrialApplyConfiguration) *ExperimentStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithParetoOptimalTrials")
+		}
+		b.ParetoOptimalTrials = append(b.ParetoOptimalTrials, *values[i])
+	}
+	return b
+}
+
 // WithRunningTrialList adds the given value to the RunningTrialList field in the declarative configuration
 // and returns the receiver, so that objects can be build by chaining "With" function invocations.
 // If called multiple times, values provided by each call will be appended to the RunningTrialList field.
@@ -231,3 +249,35 @@ func (b *ExperimentStatusApplyConfiguration) WithTrialMetricsUnavailable(value i
 	b.TrialMetricsUnavailable = &value
 	return b
 }
+
+// WithTrialsQueued sets the TrialsQueued field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TrialsQueued field is set to the value of the last call.
+func (b *ExperimentStatusApplyConfiguration) WithTrialsQueued(value int32) *ExperimentStatusApplyConfiguration {
+	b.TrialsQueued = &value
+	return b
+}
+
+// WithResourceUsage sets the ResourceUsage field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ResourceUsage field is set to the value of the last call.
+func (b *ExperimentStatusApplyConfiguration) WithResourceUsage(value float64) *ExperimentStatusApplyConfiguration {
+	b.ResourceUsage = &value
+	return b
+}
+
+// WithConvergence sets the Convergence field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Convergence field is set to the value of the last call.
+func (b *ExperimentStatusApplyConfiguration) WithConvergence(value *ConvergenceStatusApplyConfiguration) *ExperimentStatusApplyConfiguration {
+	b.Convergence = value
+	return b
+}
+
+// WithParallelism sets the Parallelism field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Parallelism field is set to the value of the last call.
+func (b *ExperimentStatusApplyConfiguration) WithParallelism(value *ParallelismStatusApplyConfiguration) *ExperimentStatusApplyConfiguration {
+	b.Parallelism = value
+	return b
+}
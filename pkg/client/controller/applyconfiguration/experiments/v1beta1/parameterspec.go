@@ -25,9 +25,10 @@ import (
 // ParameterSpecApplyConfiguration represents a declarative configuration of the ParameterSpec type for use
 // with apply.
 type ParameterSpecApplyConfiguration struct {
-	Name          *string                           `json:"name,omitempty"`
-	ParameterType *experimentsv1beta1.ParameterType `json:"parameterType,omitempty"`
-	FeasibleSpace *FeasibleSpaceApplyConfiguration  `json:"feasibleSpace,omitempty"`
+	Name          *string                               `json:"name,omitempty"`
+	ParameterType *experimentsv1beta1.ParameterType     `json:"parameterType,omitempty"`
+	FeasibleSpace *FeasibleSpaceApplyConfiguration      `json:"feasibleSpace,omitempty"`
+	Condition     *ParameterConditionApplyConfiguration `json:"condition,omitempty"`
 }
 
 // ParameterSpecApplyConfiguration constructs a declarative configuration of the ParameterSpec type for use with
@@ -59,3 +60,11 @@ func (b *ParameterSpecApplyConfiguration) WithFeasibleSpace(value *FeasibleSpace
 	b.FeasibleSpace = value
 	return b
 }
+
+// WithCondition sets the Condition field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Condition field is set to the value of the last call.
+func (b *ParameterSpecApplyConfiguration) WithCondition(value *ParameterConditionApplyConfiguration) *ParameterSpecApplyConfiguration {
+	b.Condition = value
+	return b
+}
@@ -19,6 +19,7 @@ limitations under the License.
 package v1beta1
 
 import (
+	commonv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1"
 	unstructured "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 )
 
@@ -32,6 +33,9 @@ type TrialTemplateApplyConfiguration struct {
 	PrimaryContainerName          *string                                `json:"primaryContainerName,omitempty"`
 	SuccessCondition              *string                                `json:"successCondition,omitempty"`
 	FailureCondition              *string                                `json:"failureCondition,omitempty"`
+	RetryPolicy                   *commonv1beta1.RetryPolicy             `json:"retryPolicy,omitempty"`
+	ActiveDeadlineSeconds         *int64                                 `json:"activeDeadlineSeconds,omitempty"`
+	PendingDeadlineSeconds        *int64                                 `json:"pendingDeadlineSeconds,omitempty"`
 }
 
 // TrialTemplateApplyConfiguration constructs a declarative configuration of the TrialTemplate type for use with
@@ -114,3 +118,27 @@ func (b *TrialTemplateApplyConfiguration) WithFailureCondition(value string) *Tr
 	b.FailureCondition = &value
 	return b
 }
+
+// WithRetryPolicy sets the RetryPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RetryPolicy field is set to the value of the last call.
+func (b *TrialTemplateApplyConfiguration) WithRetryPolicy(value commonv1beta1.RetryPolicy) *TrialTemplateApplyConfiguration {
+	b.RetryPolicy = &value
+	return b
+}
+
+// WithActiveDeadlineSeconds sets the ActiveDeadlineSeconds field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ActiveDeadlineSeconds field is set to the value of the last call.
+func (b *TrialTemplateApplyConfiguration) WithActiveDeadlineSeconds(value int64) *TrialTemplateApplyConfiguration {
+	b.ActiveDeadlineSeconds = &value
+	return b
+}
+
+// WithPendingDeadlineSeconds sets the PendingDeadlineSeconds field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PendingDeadlineSeconds field is set to the value of the last call.
+func (b *TrialTemplateApplyConfiguration) WithPendingDeadlineSeconds(value int64) *TrialTemplateApplyConfiguration {
+	b.PendingDeadlineSeconds = &value
+	return b
+}
@@ -26,13 +26,15 @@ import (
 // SuggestionStatusApplyConfiguration represents a declarative configuration of the SuggestionStatus type for use
 // with apply.
 type SuggestionStatusApplyConfiguration struct {
-	AlgorithmSettings []commonv1beta1.AlgorithmSetting        `json:"algorithmSettings,omitempty"`
-	SuggestionCount   *int32                                  `json:"suggestionCount,omitempty"`
-	Suggestions       []TrialAssignmentApplyConfiguration     `json:"suggestions,omitempty"`
-	StartTime         *v1.Time                                `json:"startTime,omitempty"`
-	CompletionTime    *v1.Time                                `json:"completionTime,omitempty"`
-	LastReconcileTime *v1.Time                                `json:"lastReconcileTime,omitempty"`
-	Conditions        []SuggestionConditionApplyConfiguration `json:"conditions,omitempty"`
+	AlgorithmSettings       []commonv1beta1.AlgorithmSetting        `json:"algorithmSettings,omitempty"`
+	SuggestionCount         *int32                                  `json:"suggestionCount,omitempty"`
+	RejectedSuggestionCount *int32                                  `json:"rejectedSuggestionCount,omitempty"`
+	RejectedRoundCount      *int32                                  `json:"rejectedRoundCount,omitempty"`
+	Suggestions             []TrialAssignmentApplyConfiguration     `json:"suggestions,omitempty"`
+	StartTime               *v1.Time                                `json:"startTime,omitempty"`
+	CompletionTime          *v1.Time                                `json:"completionTime,omitempty"`
+	LastReconcileTime       *v1.Time                                `json:"lastReconcileTime,omitempty"`
+	Conditions              []SuggestionConditionApplyConfiguration `json:"conditions,omitempty"`
 }
 
 // SuggestionStatusApplyConfiguration constructs a declarative configuration of the SuggestionStatus type for use with
@@ -59,6 +61,22 @@ func (b *SuggestionStatusApplyConfiguration) WithSuggestionCount(value int32) *S
 	return b
 }
 
+// WithRejectedSuggestionCount sets the RejectedSuggestionCount field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RejectedSuggestionCount field is set to the value of the last call.
+func (b *SuggestionStatusApplyConfiguration) WithRejectedSuggestionCount(value int32) *SuggestionStatusApplyConfiguration {
+	b.RejectedSuggestionCount = &value
+	return b
+}
+
+// WithRejectedRoundCount sets the RejectedRoundCount field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RejectedRoundCount field is set to the value of the last call.
+func (b *SuggestionStatusApplyConfiguration) WithRejectedRoundCount(value int32) *SuggestionStatusApplyConfiguration {
+	b.RejectedRoundCount = &value
+	return b
+}
+
 // WithSuggestions adds the given value to the Suggestions field in the declarative configuration
 // and returns the receiver, so that objects can be build by chaining "With" function invocations.
 // If called multiple times, values provided by each call will be appended to the Suggestions field.
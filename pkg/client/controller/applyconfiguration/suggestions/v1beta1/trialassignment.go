@@ -29,6 +29,8 @@ type TrialAssignmentApplyConfiguration struct {
 	Name                 *string                             `json:"name,omitempty"`
 	EarlyStoppingRules   []commonv1beta1.EarlyStoppingRule   `json:"earlyStoppingRules,omitempty"`
 	Labels               map[string]string                   `json:"labels,omitempty"`
+	DuplicateOf          *string                             `json:"duplicateOf,omitempty"`
+	Budget               *string                             `json:"budget,omitempty"`
 }
 
 // TrialAssignmentApplyConfiguration constructs a declarative configuration of the TrialAssignment type for use with
@@ -78,3 +80,19 @@ func (b *TrialAssignmentApplyConfiguration) WithLabels(entries map[string]string
 	}
 	return b
 }
+
+// WithDuplicateOf sets the DuplicateOf field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DuplicateOf field is set to the value of the last call.
+func (b *TrialAssignmentApplyConfiguration) WithDuplicateOf(value string) *TrialAssignmentApplyConfiguration {
+	b.DuplicateOf = &value
+	return b
+}
+
+// WithBudget sets the Budget field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Budget field is set to the value of the last call.
+func (b *TrialAssignmentApplyConfiguration) WithBudget(value string) *TrialAssignmentApplyConfiguration {
+	b.Budget = &value
+	return b
+}
@@ -26,17 +26,23 @@ import (
 // TrialSpecApplyConfiguration represents a declarative configuration of the TrialSpec type for use
 // with apply.
 type TrialSpecApplyConfiguration struct {
-	Objective            *commonv1beta1.ObjectiveSpec        `json:"objective,omitempty"`
-	ParameterAssignments []commonv1beta1.ParameterAssignment `json:"parameterAssignments,omitempty"`
-	EarlyStoppingRules   []commonv1beta1.EarlyStoppingRule   `json:"earlyStoppingRules,omitempty"`
-	RunSpec              *unstructured.Unstructured          `json:"runSpec,omitempty"`
-	RetainRun            *bool                               `json:"retainRun,omitempty"`
-	MetricsCollector     *commonv1beta1.MetricsCollectorSpec `json:"metricsCollector,omitempty"`
-	PrimaryPodLabels     map[string]string                   `json:"primaryPodLabels,omitempty"`
-	PrimaryContainerName *string                             `json:"primaryContainerName,omitempty"`
-	SuccessCondition     *string                             `json:"successCondition,omitempty"`
-	FailureCondition     *string                             `json:"failureCondition,omitempty"`
-	Labels               map[string]string                   `json:"labels,omitempty"`
+	Objective              *commonv1beta1.ObjectiveSpec        `json:"objective,omitempty"`
+	ParameterAssignments   []commonv1beta1.ParameterAssignment `json:"parameterAssignments,omitempty"`
+	Budget                 *string                             `json:"budget,omitempty"`
+	EarlyStoppingRules     []commonv1beta1.EarlyStoppingRule   `json:"earlyStoppingRules,omitempty"`
+	RunSpec                *unstructured.Unstructured          `json:"runSpec,omitempty"`
+	RetainRun              *bool                               `json:"retainRun,omitempty"`
+	MetricsCollector       *commonv1beta1.MetricsCollectorSpec `json:"metricsCollector,omitempty"`
+	PrimaryPodLabels       map[string]string                   `json:"primaryPodLabels,omitempty"`
+	PrimaryContainerName   *string                             `json:"primaryContainerName,omitempty"`
+	SuccessCondition       *string                             `json:"successCondition,omitempty"`
+	FailureCondition       *string                             `json:"failureCondition,omitempty"`
+	Labels                 map[string]string                   `json:"labels,omitempty"`
+	ReuseObservationFrom   *string                             `json:"reuseObservationFrom,omitempty"`
+	Cache                  *commonv1beta1.TrialCacheSpec       `json:"cache,omitempty"`
+	RetryPolicy            *commonv1beta1.RetryPolicy          `json:"retryPolicy,omitempty"`
+	ActiveDeadlineSeconds  *int64                              `json:"activeDeadlineSeconds,omitempty"`
+	PendingDeadlineSeconds *int64                              `json:"pendingDeadlineSeconds,omitempty"`
 }
 
 // TrialSpecApplyConfiguration constructs a declarative configuration of the TrialSpec type for use with
@@ -63,6 +69,14 @@ func (b *TrialSpecApplyConfiguration) WithParameterAssignments(values ...commonv
 	return b
 }
 
+// WithBudget sets the Budget field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Budget field is set to the value of the last call.
+func (b *TrialSpecApplyConfiguration) WithBudget(value string) *TrialSpecApplyConfiguration {
+	b.Budget = &value
+	return b
+}
+
 // WithEarlyStoppingRules adds the given value to the EarlyStoppingRules field in the declarative configuration
 // and returns the receiver, so that objects can be build by chaining "With" function invocations.
 // If called multiple times, values provided by each call will be appended to the EarlyStoppingRules field.
@@ -148,3 +162,43 @@ func (b *TrialSpecApplyConfiguration) WithLabels(entries map[string]string) *Tri
 	}
 	return b
 }
+
+// WithReuseObservationFrom sets the ReuseObservationFrom field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ReuseObservationFrom field is set to the value of the last call.
+func (b *TrialSpecApplyConfiguration) WithReuseObservationFrom(value string) *TrialSpecApplyConfiguration {
+	b.ReuseObservationFrom = &value
+	return b
+}
+
+// WithCache sets the Cache field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Cache field is set to the value of the last call.
+func (b *TrialSpecApplyConfiguration) WithCache(value commonv1beta1.TrialCacheSpec) *TrialSpecApplyConfiguration {
+	b.Cache = &value
+	return b
+}
+
+// WithRetryPolicy sets the RetryPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RetryPolicy field is set to the value of the last call.
+func (b *TrialSpecApplyConfiguration) WithRetryPolicy(value commonv1beta1.RetryPolicy) *TrialSpecApplyConfiguration {
+	b.RetryPolicy = &value
+	return b
+}
+
+// WithActiveDeadlineSeconds sets the ActiveDeadlineSeconds field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ActiveDeadlineSeconds field is set to the value of the last call.
+func (b *TrialSpecApplyConfiguration) WithActiveDeadlineSeconds(value int64) *TrialSpecApplyConfiguration {
+	b.ActiveDeadlineSeconds = &value
+	return b
+}
+
+// WithPendingDeadlineSeconds sets the PendingDeadlineSeconds field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PendingDeadlineSeconds field is set to the value of the last call.
+func (b *TrialSpecApplyConfiguration) WithPendingDeadlineSeconds(value int64) *TrialSpecApplyConfiguration {
+	b.PendingDeadlineSeconds = &value
+	return b
+}
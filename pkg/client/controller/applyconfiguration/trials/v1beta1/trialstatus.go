@@ -31,6 +31,7 @@ type TrialStatusApplyConfiguration struct {
 	LastReconcileTime *v1.Time                           `json:"lastReconcileTime,omitempty"`
 	Conditions        []TrialConditionApplyConfiguration `json:"conditions,omitempty"`
 	Observation       *commonv1beta1.Observation         `json:"observation,omitempty"`
+	Attempts          []TrialAttemptApplyConfiguration   `json:"attempts,omitempty"`
 }
 
 // TrialStatusApplyConfiguration constructs a declarative configuration of the TrialStatus type for use with
@@ -83,3 +84,16 @@ func (b *TrialStatusApplyConfiguration) WithObservation(value commonv1beta1.Obse
 	b.Observation = &value
 	return b
 }
+
+// WithAttempts adds the given value to the Attempts field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Attempts field.
+func (b *TrialStatusApplyConfiguration) WithAttempts(values ...*TrialAttemptApplyConfiguration) *TrialStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithAttempts")
+		}
+		b.Attempts = append(b.Attempts, *values[i])
+	}
+	return b
+}
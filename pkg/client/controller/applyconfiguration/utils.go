@@ -36,12 +36,24 @@ import (
 func ForKind(kind schema.GroupVersionKind) interface{} {
 	switch kind {
 	// Group=experiment.kubeflow.org, Version=v1beta1
+	case v1beta1.SchemeGroupVersion.WithKind("AdaptiveParallelismSpec"):
+		return &experimentsv1beta1.AdaptiveParallelismSpecApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("ConfigMapSource"):
 		return &experimentsv1beta1.ConfigMapSourceApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ConvergenceSpec"):
+		return &experimentsv1beta1.ConvergenceSpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ConvergenceStatus"):
+		return &experimentsv1beta1.ConvergenceStatusApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("DuplicateSuggestionSpec"):
+		return &experimentsv1beta1.DuplicateSuggestionSpecApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("Experiment"):
 		return &experimentsv1beta1.ExperimentApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("ExperimentCondition"):
 		return &experimentsv1beta1.ExperimentConditionApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ExperimentQueue"):
+		return &experimentsv1beta1.ExperimentQueueApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ExperimentQueueSpec"):
+		return &experimentsv1beta1.ExperimentQueueSpecApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("ExperimentSpec"):
 		return &experimentsv1beta1.ExperimentSpecApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("ExperimentStatus"):
@@ -56,14 +68,26 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &experimentsv1beta1.OperationApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("OptimalTrial"):
 		return &experimentsv1beta1.OptimalTrialApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ParallelismStatus"):
+		return &experimentsv1beta1.ParallelismStatusApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ParameterCondition"):
+		return &experimentsv1beta1.ParameterConditionApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("ParameterSpec"):
 		return &experimentsv1beta1.ParameterSpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("QueueingSpec"):
+		return &experimentsv1beta1.QueueingSpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ResourceUsageSpec"):
+		return &experimentsv1beta1.ResourceUsageSpecApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("TrialParameterSpec"):
 		return &experimentsv1beta1.TrialParameterSpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("TrialsConfigMapSource"):
+		return &experimentsv1beta1.TrialsConfigMapSourceApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("TrialSource"):
 		return &experimentsv1beta1.TrialSourceApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("TrialTemplate"):
 		return &experimentsv1beta1.TrialTemplateApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("WarmStartSpec"):
+		return &experimentsv1beta1.WarmStartSpecApplyConfiguration{}
 
 		// Group=suggestion.kubeflow.org, Version=v1beta1
 	case suggestionsv1beta1.SchemeGroupVersion.WithKind("Suggestion"):
@@ -80,6 +104,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		// Group=trial.kubeflow.org, Version=v1beta1
 	case trialsv1beta1.SchemeGroupVersion.WithKind("Trial"):
 		return &applyconfigurationtrialsv1beta1.TrialApplyConfiguration{}
+	case trialsv1beta1.SchemeGroupVersion.WithKind("TrialAttempt"):
+		return &applyconfigurationtrialsv1beta1.TrialAttemptApplyConfiguration{}
 	case trialsv1beta1.SchemeGroupVersion.WithKind("TrialCondition"):
 		return &applyconfigurationtrialsv1beta1.TrialConditionApplyConfiguration{}
 	case trialsv1beta1.SchemeGroupVersion.WithKind("TrialSpec"):
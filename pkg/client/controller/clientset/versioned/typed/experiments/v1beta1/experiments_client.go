@@ -29,6 +29,7 @@ import (
 type ExperimentV1beta1Interface interface {
 	RESTClient() rest.Interface
 	ExperimentsGetter
+	ExperimentQueuesGetter
 }
 
 // ExperimentV1beta1Client is used to interact with features provided by the experiment.kubeflow.org group.
@@ -40,6 +41,10 @@ func (c *ExperimentV1beta1Client) Experiments(namespace string) ExperimentInterf
 	return newExperiments(c, namespace)
 }
 
+func (c *ExperimentV1beta1Client) ExperimentQueues(namespace string) ExperimentQueueInterface {
+	return newExperimentQueues(c, namespace)
+}
+
 // NewForConfig creates a new ExperimentV1beta1Client for the given config.
 // NewForConfig is equivalent to NewForConfigAndClient(c, httpClient),
 // where httpClient was generated with rest.HTTPClientFor(c).
@@ -32,6 +32,10 @@ func (c *FakeExperimentV1beta1) Experiments(namespace string) v1beta1.Experiment
 	return newFakeExperiments(c, namespace)
 }
 
+func (c *FakeExperimentV1beta1) ExperimentQueues(namespace string) v1beta1.ExperimentQueueInterface {
+	return newFakeExperimentQueues(c, namespace)
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeExperimentV1beta1) RESTClient() rest.Interface {
@@ -26,6 +26,8 @@ import (
 type Interface interface {
 	// Experiments returns a ExperimentInformer.
 	Experiments() ExperimentInformer
+	// ExperimentQueues returns a ExperimentQueueInformer.
+	ExperimentQueues() ExperimentQueueInformer
 }
 
 type version struct {
@@ -43,3 +45,8 @@ func New(f internalinterfaces.SharedInformerFactory, namespace string, tweakList
 func (v *version) Experiments() ExperimentInformer {
 	return &experimentInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
+
+// ExperimentQueues returns a ExperimentQueueInformer.
+func (v *version) ExperimentQueues() ExperimentQueueInformer {
+	return &experimentQueueInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
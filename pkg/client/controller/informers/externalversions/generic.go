@@ -57,6 +57,8 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 	// Group=experiment.kubeflow.org, Version=v1beta1
 	case v1beta1.SchemeGroupVersion.WithResource("experiments"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Experiment().V1beta1().Experiments().Informer()}, nil
+	case v1beta1.SchemeGroupVersion.WithResource("experimentqueues"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Experiment().V1beta1().ExperimentQueues().Informer()}, nil
 
 		// Group=suggestion.kubeflow.org, Version=v1beta1
 	case suggestionsv1beta1.SchemeGroupVersion.WithResource("suggestions"):
@@ -25,3 +25,11 @@ type ExperimentListerExpansion interface{}
 // ExperimentNamespaceListerExpansion allows custom methods to be added to
 // ExperimentNamespaceLister.
 type ExperimentNamespaceListerExpansion interface{}
+
+// ExperimentQueueListerExpansion allows custom methods to be added to
+// ExperimentQueueLister.
+type ExperimentQueueListerExpansion interface{}
+
+// ExperimentQueueNamespaceListerExpansion allows custom methods to be added to
+// ExperimentQueueNamespaceLister.
+type ExperimentQueueNamespaceListerExpansion interface{}
@@ -71,6 +71,8 @@ const (
 	LabelTrialName = "katib.kubeflow.org/trial"
 	// LabelDeploymentName is the label of deployment name.
 	LabelDeploymentName = "katib.kubeflow.org/deployment"
+	// LabelTrialFingerprint is the label of trial fingerprint to find the trial with the same run spec and assignments.
+	LabelTrialFingerprint = "katib.kubeflow.org/trial-fingerprint"
 
 	// ContainerSuggestion is the container name to run Suggestion service.
 	ContainerSuggestion = "suggestion"
@@ -93,6 +95,14 @@ const (
 	// DefaultGRPCRetryPeriod is a fixed period of time between gRPC call retries
 	DefaultGRPCRetryPeriod = 3 * time.Second
 
+	// DefaultConstraintResampleAttempts is the maximum number of GetSuggestions calls
+	// to get parameter assignments which satisfy the Experiment parameter constraints
+	DefaultConstraintResampleAttempts = 5
+
+	// DefaultRejectedRoundsLimit is the maximum number of consecutive rounds in which
+	// all suggested parameter assignments are rejected before the Suggestion is exhausted
+	DefaultRejectedRoundsLimit = 5
+
 	// DefaultKatibNamespaceEnvName is the default env name of katib namespace
 	DefaultKatibNamespaceEnvName = "KATIB_CORE_NAMESPACE"
 	// DefaultKatibComposerEnvName is the default env name of katib suggestion composer
@@ -149,6 +159,7 @@ const (
 	TrialTemplateMetaKeyOfAPIVersion  = "APIVersion"
 	TrialTemplateMetaKeyOfAnnotations = "Annotations"
 	TrialTemplateMetaKeyOfLabels      = "Labels"
+	TrialTemplateMetaKeyOfBudget      = "Budget"
 
 	// UnavailableMetricValue is the value when metric was not reported or metric value can't be converted to float64
 	// This value is recorded in to DB when metrics collector can't parse objective metric from the training logs.
@@ -180,5 +191,6 @@ var (
 		TrialTemplateMetaKeyOfAPIVersion,
 		TrialTemplateMetaKeyOfAnnotations,
 		TrialTemplateMetaKeyOfLabels,
+		TrialTemplateMetaKeyOfBudget,
 	}
 )
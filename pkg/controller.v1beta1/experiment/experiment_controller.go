@@ -66,9 +66,10 @@ func Add(mgr manager.Manager) error {
 // newReconciler returns a new reconcile.Reconciler
 func newReconciler(mgr manager.Manager) reconcile.Reconciler {
 	r := &ReconcileExperiment{
-		Client:   mgr.GetClient(),
-		scheme:   mgr.GetScheme(),
-		recorder: mgr.GetEventRecorderFor(ControllerName),
+		Client:    mgr.GetClient(),
+		apiReader: mgr.GetAPIReader(),
+		scheme:    mgr.GetScheme(),
+		recorder:  mgr.GetEventRecorderFor(ControllerName),
 	}
 	imp := viper.GetString(consts.ConfigExperimentSuggestionName)
 	r.Suggestion = newSuggestion(imp, mgr.GetScheme(), mgr.GetClient())
@@ -150,8 +151,10 @@ var _ reconcile.Reconciler = &ReconcileExperiment{}
 // ReconcileExperiment reconciles a Experiment object
 type ReconcileExperiment struct {
 	client.Client
-	scheme   *runtime.Scheme
-	recorder record.EventRecorder
+	// apiReader reads the pods of the Trials, so they are not cached.
+	apiReader client.Reader
+	scheme    *runtime.Scheme
+	recorder  record.EventRecorder
 
 	suggestion.Suggestion
 	manifest.Generator
@@ -198,10 +201,12 @@ func (r *ReconcileExperiment) Reconcile(ctx context.Context, request reconcile.R
 		}
 		// Check if experiment is restartable and max trials is reconfigured
 		// That means experiment is restarting
-		if (util.IsCompletedExperimentRestartable(instance) &&
-			instance.Spec.MaxTrialCount != nil &&
-			*instance.Spec.MaxTrialCount > instance.Status.Trials) ||
-			(instance.Spec.MaxTrialCount == nil && instance.Status.Trials != 0) {
+		// Experiment which has reached the budget or has converged is not restarted.
+		if !util.IsBudgetReached(instance) && !instance.IsCompletedReason(util.ExperimentConvergedReason) &&
+			((util.IsCompletedExperimentRestartable(instance) &&
+				instance.Spec.MaxTrialCount != nil &&
+				*instance.Spec.MaxTrialCount > instance.Status.Trials) ||
+				(instance.Spec.MaxTrialCount == nil && instance.Status.Trials != 0)) {
 			logger.Info("Experiment is restarting",
 				"MaxTrialCount", instance.Spec.MaxTrialCount,
 				"ParallelTrialCount", instance.Spec.ParallelTrialCount,
@@ -218,7 +223,7 @@ func (r *ReconcileExperiment) Reconcile(ctx context.Context, request reconcile.R
 			}
 		} else {
 			// If experiment is completed with no running trials, stop reconcile
-			if !instance.HasRunningTrials() {
+			if !instance.HasRunningTrials() && !util.IsActiveTrialsKillRequired(instance) {
 				return reconcile.Result{}, nil
 			}
 		}
@@ -255,7 +260,15 @@ func (r *ReconcileExperiment) Reconcile(ctx context.Context, request reconcile.R
 		}
 	}
 
-	return reconcile.Result{}, nil
+	// Budget of the Experiment is consumed over time, so it is checked again without any event.
+	// Queued Trials are created once the other Experiments free the trial slots, so they are checked periodically.
+	// Pending Trials lower the adaptive parallelism over time as well.
+	requeueAfter := minRequeueAfter(
+		util.GetBudgetRequeueAfter(instance, time.Now()),
+		util.GetQueueRequeueAfter(instance),
+		util.GetParallelismRequeueAfter(instance),
+	)
+	return reconcile.Result{RequeueAfter: requeueAfter}, nil
 }
 
 // ReconcileExperiment is the main reconcile loop.
@@ -269,29 +282,112 @@ func (r *ReconcileExperiment) ReconcileExperiment(instance *experimentsv1beta1.E
 		return err
 	}
 	if len(trials.Items) > 0 {
-		if err := util.UpdateExperimentStatus(r.collector, instance, trials); err != nil {
+		suggestionExhausted, err := r.isSuggestionExhausted(instance)
+		if err != nil {
+			logger.Error(err, "Suggestion get error")
+			return err
+		}
+		startedTrials, err := r.getStartedTrials(instance)
+		if err != nil {
+			logger.Error(err, "Trial pods list error")
+			return err
+		}
+		if err := util.UpdateExperimentStatus(r.collector, instance, trials, startedTrials, suggestionExhausted); err != nil {
 			logger.Error(err, "Update experiment status error")
 			return err
 		}
+	} else if !instance.IsCompleted() {
+		util.UpdateExperimentSuspendCondition(instance)
 	}
+	// Queued Trials are counted again by ReconcileTrials.
+	instance.Status.TrialsQueued = 0
 	reconcileRequired := !instance.IsCompleted()
 	if reconcileRequired {
+		if err := r.reconcileSuggestionSuspend(instance); err != nil {
+			logger.Error(err, "Suggestion suspend error")
+			return err
+		}
+		// Suspended Experiment doesn't create new Trials until it is resumed.
+		if instance.IsSuspended() {
+			if instance.Spec.SuspendPolicy == experimentsv1beta1.KillActiveTrials {
+				msg := "Trial is killed because the Experiment is suspended"
+				return r.killActiveTrials(instance, trials.Items, util.ExperimentSuspendedReason, msg)
+			}
+			return nil
+		}
 		return r.ReconcileTrials(instance, trials.Items)
 	}
+	if util.IsActiveTrialsKillRequired(instance) {
+		reason := util.ExperimentMaxDurationReachedReason
+		if instance.IsCompletedReason(util.ExperimentMaxResourceUsageReachedReason) {
+			reason = util.ExperimentMaxResourceUsageReachedReason
+		}
+		msg := "Trial is killed because the budget of the Experiment has reached"
+		return r.killActiveTrials(instance, trials.Items, reason, msg)
+	}
 
 	return nil
 }
 
+// isSuggestionExhausted returns true if the Suggestion of the Experiment has suggested all assignments of the search space.
+func (r *ReconcileExperiment) isSuggestionExhausted(instance *experimentsv1beta1.Experiment) (bool, error) {
+	suggestion := &suggestionsv1beta1.Suggestion{}
+	err := r.Get(context.TODO(), types.NamespacedName{Name: instance.GetName(), Namespace: instance.GetNamespace()}, suggestion)
+	if errors.IsNotFound(err) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	return suggestion.IsExhausted(), nil
+}
+
+// reconcileSuggestionSuspend propagates the suspended state of the Experiment to its Suggestion.
+// Suggestion controller scales down the suggestion deployment while the Suggestion is suspended.
+// The Suggestion keeps its assignments, so the resumed Experiment continues with the same history.
+func (r *ReconcileExperiment) reconcileSuggestionSuspend(instance *experimentsv1beta1.Experiment) error {
+	original := &suggestionsv1beta1.Suggestion{}
+	err := r.Get(context.TODO(), types.NamespacedName{Name: instance.GetName(), Namespace: instance.GetNamespace()}, original)
+	if errors.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if original.Spec.Suspend == instance.IsSuspended() {
+		return nil
+	}
+	suggestion := original.DeepCopy()
+	suggestion.Spec.Suspend = instance.IsSuspended()
+	return r.UpdateSuggestion(suggestion)
+}
+
+// limitTrialsByQueues returns how many of addCount new Trials the Experiment can create
+// within the trial slots of the ExperimentQueues in its namespace.
+func (r *ReconcileExperiment) limitTrialsByQueues(instance *experimentsv1beta1.Experiment, addCount int32) (int32, error) {
+	queues := &experimentsv1beta1.ExperimentQueueList{}
+	if err := r.List(context.TODO(), queues, client.InNamespace(instance.Namespace)); err != nil {
+		return 0, err
+	}
+	if len(queues.Items) == 0 {
+		return addCount, nil
+	}
+	experiments := &experimentsv1beta1.ExperimentList{}
+	if err := r.List(context.TODO(), experiments, client.InNamespace(instance.Namespace)); err != nil {
+		return 0, err
+	}
+	return util.LimitTrialsByQueues(queues.Items, instance, experiments.Items, addCount), nil
+}
+
 // ReconcileTrials syncs trials.
 func (r *ReconcileExperiment) ReconcileTrials(instance *experimentsv1beta1.Experiment, trials []trialsv1beta1.Trial) error {
 
 	logger := log.WithValues("Experiment", types.NamespacedName{Name: instance.GetName(), Namespace: instance.GetNamespace()})
 
-	parallelCount := *instance.Spec.ParallelTrialCount
+	parallelCount := util.GetParallelTrialCount(instance)
 	activeCount := instance.Status.TrialsPending + instance.Status.TrialsRunning
 	completedCount := instance.Status.TrialsSucceeded + instance.Status.TrialsFailed + instance.Status.TrialsKilled + instance.Status.TrialsEarlyStopped
 
-	if activeCount > parallelCount {
+	// Active trials are not deleted when the adaptive parallelism is lowered, new trials are not created until they finish.
+	if activeCount > parallelCount && instance.Spec.AdaptiveParallelism == nil {
 		deleteCount := activeCount - parallelCount
 		if deleteCount > 0 {
 			//delete 'deleteCount' number of trails. Sort them?
@@ -320,11 +416,23 @@ func (r *ReconcileExperiment) ReconcileTrials(instance *experimentsv1beta1.Exper
 			addCount = 0
 		}
 
+		// ExperimentQueues in the namespace limit the number of new trials.
+		if addCount > 0 {
+			allowedCount, err := r.limitTrialsByQueues(instance, addCount)
+			if err != nil {
+				logger.Error(err, "Limit trials by ExperimentQueues error")
+				return err
+			}
+			instance.Status.TrialsQueued = addCount - allowedCount
+			addCount = allowedCount
+		}
+
 		logger.Info("Statistics",
 			"requiredActiveCount", requiredActiveCount,
 			"parallelCount", parallelCount,
 			"activeCount", activeCount,
 			"completedCount", completedCount,
+			"queuedCount", instance.Status.TrialsQueued,
 		)
 
 		//skip if no trials need to be created
@@ -453,6 +561,32 @@ func (r *ReconcileExperiment) deleteTrials(instance *experimentsv1beta1.Experime
 	return nil
 }
 
+// killActiveTrials marks the pending and running Trials killed once the budget of the Experiment is reached
+// or the Experiment is suspended. Trial controller deletes the runs of the killed Trials.
+func (r *ReconcileExperiment) killActiveTrials(instance *experimentsv1beta1.Experiment, trials []trialsv1beta1.Trial, reason, msg string) error {
+	logger := log.WithValues("Experiment", types.NamespacedName{Name: instance.GetName(), Namespace: instance.GetNamespace()})
+
+	killedNames := []string{}
+	for i := range trials {
+		trial := &trials[i]
+		if trial.IsCompleted() {
+			continue
+		}
+		now := metav1.Now()
+		trial.MarkTrialStatusKilled(reason, msg)
+		trial.Status.CompletionTime = &now
+		if err := r.Status().Update(context.TODO(), trial); err != nil {
+			logger.Error(err, "Trial status update error", "Trial name", trial.Name)
+			return err
+		}
+		killedNames = append(killedNames, trial.Name)
+	}
+	if len(killedNames) != 0 {
+		logger.Info("Killed Trials", "trialNames", killedNames)
+	}
+	return nil
+}
+
 // ReconcileSuggestions gets or creates the suggestion if needed.
 func (r *ReconcileExperiment) ReconcileSuggestions(instance *experimentsv1beta1.Experiment, trialList []trialsv1beta1.Trial, addCount int32) ([]suggestionsv1beta1.TrialAssignment, error) {
 	logger := log.WithValues("Experiment", types.NamespacedName{Name: instance.GetName(), Namespace: instance.GetNamespace()})
@@ -492,7 +626,8 @@ func (r *ReconcileExperiment) ReconcileSuggestions(instance *experimentsv1beta1.
 						}
 					}
 				}
-				if suggestion.Spec.Requests != suggestionRequestsCount {
+				// Exhausted Suggestion doesn't suggest more assignments, so requests are not increased.
+				if suggestion.Spec.Requests != suggestionRequestsCount && !suggestion.IsExhausted() {
 					suggestion.Spec.Requests = suggestionRequestsCount
 					if err := r.UpdateSuggestion(suggestion); err != nil {
 						return nil, err
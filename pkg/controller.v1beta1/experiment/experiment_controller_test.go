@@ -31,8 +31,11 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/config"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
@@ -44,6 +47,7 @@ import (
 	suggestionsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/suggestions/v1beta1"
 	trialsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1"
 	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
+	"github.com/kubeflow/katib/pkg/controller.v1beta1/experiment/suggestion"
 	experimentUtil "github.com/kubeflow/katib/pkg/controller.v1beta1/experiment/util"
 	"github.com/kubeflow/katib/pkg/controller.v1beta1/util"
 	manifestmock "github.com/kubeflow/katib/pkg/mock/v1beta1/experiment/manifest"
@@ -119,6 +123,7 @@ func TestReconcileSuggestions(t *testing.T) {
 
 	r := &ReconcileExperiment{
 		Client:     mgr.GetClient(),
+		apiReader:  mgr.GetAPIReader(),
 		scheme:     mgr.GetScheme(),
 		Suggestion: mockSuggestion,
 		Generator:  mockGenerator,
@@ -178,6 +183,7 @@ func TestReconcile(t *testing.T) {
 
 	r := &ReconcileExperiment{
 		Client:     mgr.GetClient(),
+		apiReader:  mgr.GetAPIReader(),
 		scheme:     mgr.GetScheme(),
 		Suggestion: mockSuggestion,
 		Generator:  mockGenerator,
@@ -217,7 +223,7 @@ func TestReconcile(t *testing.T) {
 	}
 
 	mockGenerator.EXPECT().GetRunSpecWithHyperParameters(gomock.Any(), gomock.Any(),
-		gomock.Any(), gomock.Any()).Return(
+		gomock.Any(), gomock.Any(), gomock.Any()).Return(
 		returnedUnstructured,
 		nil).AnyTimes()
 
@@ -468,6 +474,101 @@ func TestReconcile(t *testing.T) {
 	g.Expect(r.cleanupSuggestionResources(instance)).NotTo(gomega.HaveOccurred())
 }
 
+func TestReconcileSuspendedExperiment(t *testing.T) {
+	cases := map[string]struct {
+		suspend           bool
+		suspendPolicy     experimentsv1beta1.ActiveTrialsPolicyType
+		suspended         bool
+		suggestionSuspend bool
+		wantSuspend       *bool
+		wantSuspended     bool
+		wantTrialsKilled  bool
+	}{
+		"Suspend Experiment with FinishActiveTrials": {
+			suspend:       true,
+			suspendPolicy: experimentsv1beta1.FinishActiveTrials,
+			wantSuspend:   ptr.To(true),
+			wantSuspended: true,
+		},
+		"Suspend Experiment with KillActiveTrials": {
+			suspend:          true,
+			suspendPolicy:    experimentsv1beta1.KillActiveTrials,
+			wantSuspend:      ptr.To(true),
+			wantSuspended:    true,
+			wantTrialsKilled: true,
+		},
+		"Suspended Experiment with suspended Suggestion": {
+			suspend:           true,
+			suspendPolicy:     experimentsv1beta1.FinishActiveTrials,
+			suspended:         true,
+			suggestionSuspend: true,
+			wantSuspended:     true,
+		},
+		"Resume Experiment": {
+			suspend:           false,
+			suspendPolicy:     experimentsv1beta1.KillActiveTrials,
+			suspended:         true,
+			suggestionSuspend: true,
+			wantSuspend:       ptr.To(false),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			g := gomega.NewGomegaWithT(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockSuggestion := suggestionmock.NewMockSuggestion(mockCtrl)
+
+			instance := newFakeInstance()
+			instance.Spec.Suspend = ptr.To(tc.suspend)
+			instance.Spec.SuspendPolicy = tc.suspendPolicy
+			instance.MarkExperimentStatusCreated(experimentUtil.ExperimentCreatedReason, "Experiment is created")
+			instance.MarkExperimentStatusRunning(experimentUtil.ExperimentRunningReason, "Experiment is running")
+			if tc.suspended {
+				instance.MarkExperimentStatusSuspended(experimentUtil.ExperimentSuspendedReason, "Experiment is suspended")
+			}
+			suggestion := newFakeSuggestion()
+			suggestion.Spec.Suspend = tc.suggestionSuspend
+
+			// Suggestion is updated only when its suspended state differs from the Experiment.
+			// Running Trials fill the parallel Trial count, so the resumed Experiment doesn't create new Trials.
+			if tc.wantSuspend != nil {
+				mockSuggestion.EXPECT().UpdateSuggestion(gomock.Cond(func(x any) bool {
+					return x.(*suggestionsv1beta1.Suggestion).Spec.Suspend == *tc.wantSuspend
+				})).Return(nil)
+			}
+			r := newFakeReconcileExperiment(mockSuggestion, suggestion,
+				newFakeRunningTrial(trialName+"-1"), newFakeRunningTrial(trialName+"-2"))
+
+			g.Expect(r.ReconcileExperiment(instance)).NotTo(gomega.HaveOccurred())
+			g.Expect(instance.IsSuspended()).To(gomega.Equal(tc.wantSuspended))
+			if !tc.wantSuspended {
+				g.Expect(instance.IsRunning()).To(gomega.BeTrue())
+				g.Expect(instance.Status.Conditions).To(gomega.ContainElement(gomega.And(
+					gomega.HaveField("Type", experimentsv1beta1.ExperimentSuspended),
+					gomega.HaveField("Status", corev1.ConditionFalse),
+					gomega.HaveField("Reason", experimentUtil.ExperimentResumedReason),
+				)))
+			}
+
+			trials := &trialsv1beta1.TrialList{}
+			g.Expect(r.List(ctx, trials)).NotTo(gomega.HaveOccurred())
+			g.Expect(trials.Items).To(gomega.HaveLen(2))
+			for _, trial := range trials.Items {
+				g.Expect(trial.IsKilled()).To(gomega.Equal(tc.wantTrialsKilled))
+				g.Expect(trial.IsRunning()).To(gomega.Equal(!tc.wantTrialsKilled))
+				if tc.wantTrialsKilled {
+					g.Expect(trial.Status.Conditions).To(gomega.ContainElement(gomega.And(
+						gomega.HaveField("Type", trialsv1beta1.TrialKilled),
+						gomega.HaveField("Reason", experimentUtil.ExperimentSuspendedReason),
+					)))
+					g.Expect(trial.Status.CompletionTime).NotTo(gomega.BeNil())
+				}
+			}
+		})
+	}
+}
+
 func newFakeInstance() *experimentsv1beta1.Experiment {
 	var parallelCount int32 = 2
 	var goal float64 = 99.9
@@ -635,3 +736,35 @@ func newFakeBatchJob() *batchv1.Job {
 		},
 	}
 }
+
+func newFakeReconcileExperiment(suggestionClient suggestion.Suggestion, objects ...client.Object) *ReconcileExperiment {
+	c := fake.NewClientBuilder().
+		WithScheme(scheme.Scheme).
+		WithObjects(objects...).
+		WithStatusSubresource(&experimentsv1beta1.Experiment{}, &trialsv1beta1.Trial{}).
+		Build()
+	return &ReconcileExperiment{
+		Client:     c,
+		apiReader:  c,
+		scheme:     scheme.Scheme,
+		Suggestion: suggestionClient,
+		collector:  experimentUtil.NewExpsCollector(nil, prometheus.NewRegistry()),
+		recorder:   record.NewFakeRecorder(100),
+	}
+}
+
+func newFakeRunningTrial(name string) *trialsv1beta1.Trial {
+	trial := &trialsv1beta1.Trial{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+			Labels:    map[string]string{consts.LabelExperimentName: experimentName},
+		},
+		Spec: trialsv1beta1.TrialSpec{
+			Objective: newFakeInstance().Spec.Objective,
+		},
+	}
+	trial.MarkTrialStatusCreated("TrialCreated", "Trial is created")
+	trial.MarkTrialStatusRunning("TrialRunning", "Trial is running")
+	return trial
+}
@@ -18,6 +18,7 @@ package experiment
 
 import (
 	"context"
+	"time"
 
 	"k8s.io/apimachinery/pkg/api/errors"
 
@@ -29,6 +30,8 @@ import (
 	experimentsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1"
 	suggestionsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/suggestions/v1beta1"
 	trialsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1"
+	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
+	trialutil "github.com/kubeflow/katib/pkg/controller.v1beta1/trial/util"
 	"github.com/kubeflow/katib/pkg/controller.v1beta1/util"
 )
 
@@ -59,20 +62,39 @@ func (r *ReconcileExperiment) getTrialInstance(expInstance *experimentsv1beta1.E
 
 	hps := trialAssignment.ParameterAssignments
 	trial.Spec.ParameterAssignments = trialAssignment.ParameterAssignments
+	trial.Spec.Budget = trialAssignment.Budget
 
 	if expInstance.Spec.EarlyStopping != nil {
 		trial.Spec.EarlyStoppingRules = trialAssignment.EarlyStoppingRules
 	}
 
-	runSpec, err := r.GetRunSpecWithHyperParameters(expInstance, trial.Name, trial.Namespace, hps)
+	// Duplicated assignments reuse the observation of the earlier Trial.
+	trial.Spec.ReuseObservationFrom = trialAssignment.DuplicateOf
+
+	runSpec, err := r.GetRunSpecWithHyperParameters(expInstance, trial.Name, trial.Namespace, hps, trial.Spec.Budget)
 	if err != nil {
 		logger.Error(err, "Fail to get RunSpec from experiment", expInstance.Name)
 		return nil, err
 	}
 
 	trial.Spec.RunSpec = runSpec
+
+	// Fingerprint is used to find the earlier Trials with the same run spec and assignments.
+	if expInstance.Spec.TrialCache != nil {
+		fingerprint, err := trialutil.GetTrialFingerprint(trial)
+		if err != nil {
+			logger.Error(err, "Fail to get fingerprint of trial", "trial", trial.Name)
+			return nil, err
+		}
+		trial.Labels[consts.LabelTrialFingerprint] = fingerprint
+		trial.Spec.Cache = expInstance.Spec.TrialCache
+	}
+
 	if expInstance.Spec.TrialTemplate != nil {
 		trial.Spec.RetainRun = expInstance.Spec.TrialTemplate.Retain
+		trial.Spec.RetryPolicy = expInstance.Spec.TrialTemplate.RetryPolicy
+		trial.Spec.ActiveDeadlineSeconds = expInstance.Spec.TrialTemplate.ActiveDeadlineSeconds
+		trial.Spec.PendingDeadlineSeconds = expInstance.Spec.TrialTemplate.PendingDeadlineSeconds
 	}
 
 	if expInstance.Spec.MetricsCollectorSpec != nil {
@@ -95,6 +117,26 @@ func (r *ReconcileExperiment) getTrialInstance(expInstance *experimentsv1beta1.E
 	return trial, nil
 }
 
+// getStartedTrials returns the names of the Experiment Trials which have any pod started.
+// Trials are running as soon as their jobs are created, so their pods are checked to find the pending Trials.
+func (r *ReconcileExperiment) getStartedTrials(instance *experimentsv1beta1.Experiment) (map[string]bool, error) {
+	if instance.Spec.AdaptiveParallelism == nil {
+		return nil, nil
+	}
+	return trialutil.GetStartedTrials(context.TODO(), r.apiReader, instance.GetNamespace())
+}
+
+// minRequeueAfter returns the shortest of the non-zero durations.
+func minRequeueAfter(durations ...time.Duration) time.Duration {
+	var requeueAfter time.Duration
+	for _, d := range durations {
+		if d != 0 && (requeueAfter == 0 || d < requeueAfter) {
+			requeueAfter = d
+		}
+	}
+	return requeueAfter
+}
+
 func needUpdateFinalizers(exp *experimentsv1beta1.Experiment) (bool, []string) {
 	deleted := !exp.ObjectMeta.DeletionTimestamp.IsZero()
 	pendingFinalizers := exp.GetFinalizers()
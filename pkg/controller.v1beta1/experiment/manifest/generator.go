@@ -41,13 +41,14 @@ var (
 	errParamNotFoundInParameterAssignment = errors.New("unable to find non-meta parameter from TrialParameters in ParameterAssignment")
 	errParamNotFoundInTrialParameters     = errors.New("unable to find parameter from ParameterAssignment in TrialParameters")
 	errTrialTemplateNotFound              = errors.New("unable to find trial template in ConfigMap")
+	errTrialBudgetNotFound                = errors.New("unable to find budget of the trial referenced in TrialParameters")
 )
 
 // Generator is the type for manifests Generator.
 type Generator interface {
 	InjectClient(c client.Client)
 	GetTrialTemplate(instance *experimentsv1beta1.Experiment) (string, error)
-	GetRunSpecWithHyperParameters(experiment *experimentsv1beta1.Experiment, trialName, trialNamespace string, assignments []commonapiv1beta1.ParameterAssignment) (*unstructured.Unstructured, error)
+	GetRunSpecWithHyperParameters(experiment *experimentsv1beta1.Experiment, trialName, trialNamespace string, assignments []commonapiv1beta1.ParameterAssignment, budget string) (*unstructured.Unstructured, error)
 	GetSuggestionConfigData(algorithmName string) (configv1beta1.SuggestionConfig, error)
 	GetEarlyStoppingConfigData(algorithmName string) (configv1beta1.EarlyStoppingConfig, error)
 	GetMetricsCollectorConfigData(cKind commonapiv1beta1.CollectorKind) (configv1beta1.MetricsCollectorConfig, error)
@@ -86,10 +87,10 @@ func (g *DefaultGenerator) GetEarlyStoppingConfigData(algorithmName string) (con
 }
 
 // GetRunSpecWithHyperParameters returns the specification for trial with hyperparameters.
-func (g *DefaultGenerator) GetRunSpecWithHyperParameters(experiment *experimentsv1beta1.Experiment, trialName, trialNamespace string, assignments []commonapiv1beta1.ParameterAssignment) (*unstructured.Unstructured, error) {
+func (g *DefaultGenerator) GetRunSpecWithHyperParameters(experiment *experimentsv1beta1.Experiment, trialName, trialNamespace string, assignments []commonapiv1beta1.ParameterAssignment, budget string) (*unstructured.Unstructured, error) {
 
 	// Apply parameters to Trial Template from assignment
-	replacedTemplate, err := g.applyParameters(experiment, trialName, trialNamespace, assignments)
+	replacedTemplate, err := g.applyParameters(experiment, trialName, trialNamespace, assignments, budget)
 	if err != nil {
 		return nil, err
 	}
@@ -106,7 +107,7 @@ func (g *DefaultGenerator) GetRunSpecWithHyperParameters(experiment *experiments
 	return runSpec, nil
 }
 
-func (g *DefaultGenerator) applyParameters(experiment *experimentsv1beta1.Experiment, trialName, trialNamespace string, assignments []commonapiv1beta1.ParameterAssignment) (string, error) {
+func (g *DefaultGenerator) applyParameters(experiment *experimentsv1beta1.Experiment, trialName, trialNamespace string, assignments []commonapiv1beta1.ParameterAssignment, budget string) (string, error) {
 	// Get string Trial template from Experiment spec
 	trialTemplate, err := g.GetTrialTemplate(experiment)
 	if err != nil {
@@ -128,6 +129,14 @@ func (g *DefaultGenerator) applyParameters(experiment *experimentsv1beta1.Experi
 		assignmentsMap[assignment.Name] = assignment.Value
 	}
 
+	// Conditional parameters are not assigned to the Trial when they are inactive
+	conditionalParams := make(map[string]bool)
+	for _, param := range experiment.Spec.Parameters {
+		if param.Condition != nil {
+			conditionalParams[param.Name] = true
+		}
+	}
+
 	placeHolderToValueMap := make(map[string]string)
 	var metaRefKey, metaRefIndex string
 	nonMetaParamCount := 0
@@ -140,6 +149,10 @@ func (g *DefaultGenerator) applyParameters(experiment *experimentsv1beta1.Experi
 				placeHolderToValueMap[param.Name] = value
 				nonMetaParamCount += 1
 				continue
+			} else if conditionalParams[param.Reference] {
+				// placeholders of inactive parameters are replaced with empty value
+				placeHolderToValueMap[param.Name] = ""
+				continue
 			} else {
 				return "", fmt.Errorf("%w: parameter: %v, parameter assignment: %v", errParamNotFoundInParameterAssignment, param.Reference, assignmentsMap)
 			}
@@ -177,6 +190,11 @@ func (g *DefaultGenerator) applyParameters(experiment *experimentsv1beta1.Experi
 			} else {
 				placeHolderToValueMap[param.Name] = value
 			}
+		case consts.TrialTemplateMetaKeyOfBudget:
+			if budget == "" {
+				return "", fmt.Errorf("%w: parameter: %v", errTrialBudgetNotFound, param.Reference)
+			}
+			placeHolderToValueMap[param.Name] = budget
 		default:
 			return "", fmt.Errorf("illegal reference of trial metadata: %v", param.Reference)
 		}
@@ -88,9 +88,26 @@ func TestGetRunSpecWithHP(t *testing.T) {
 		t.Errorf("ConvertObjectToUnstructured failed: %v", err)
 	}
 
+	// Placeholder of the inactive conditional parameter is replaced with empty value.
+	expectedJobWithInactiveParam := expectedJob.DeepCopy()
+	expectedJobWithInactiveParam.Spec.Template.Spec.Containers[0].Command[5] = "--momentum="
+	expectedRunSpecWithInactiveParam, err := util.ConvertObjectToUnstructured(expectedJobWithInactiveParam)
+	if err != nil {
+		t.Errorf("ConvertObjectToUnstructured failed: %v", err)
+	}
+
+	// Placeholder of the Trial budget is replaced with the assigned budget.
+	expectedJobWithBudget := expectedJob.DeepCopy()
+	expectedJobWithBudget.Spec.Template.Spec.Containers[0].Command[2] = "--epochs=10"
+	expectedRunSpecWithBudget, err := util.ConvertObjectToUnstructured(expectedJobWithBudget)
+	if err != nil {
+		t.Errorf("ConvertObjectToUnstructured failed: %v", err)
+	}
+
 	cases := map[string]struct {
 		instance                       *experimentsv1beta1.Experiment
 		parameterAssignments           []commonapiv1beta1.ParameterAssignment
+		budget                         string
 		wantRunSpecWithHyperParameters *unstructured.Unstructured
 		wantError                      error
 	}{
@@ -99,6 +116,39 @@ func TestGetRunSpecWithHP(t *testing.T) {
 			parameterAssignments:           newFakeParameterAssignment(),
 			wantRunSpecWithHyperParameters: expectedRunSpec,
 		},
+		"Run with inactive conditional parameter": {
+			instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.Parameters = []experimentsv1beta1.ParameterSpec{
+					{
+						Name:          "lr",
+						ParameterType: experimentsv1beta1.ParameterTypeCategorical,
+					},
+					{
+						Name:          "momentum",
+						ParameterType: experimentsv1beta1.ParameterTypeDouble,
+						Condition: &experimentsv1beta1.ParameterCondition{
+							Parent: "lr",
+							Values: []string{"0.01"},
+						},
+					},
+				}
+				return i
+			}(),
+			parameterAssignments:           newFakeParameterAssignment()[:1],
+			wantRunSpecWithHyperParameters: expectedRunSpecWithInactiveParam,
+		},
+		"Run with budget": {
+			instance:                       newFakeInstanceWithBudget(),
+			parameterAssignments:           newFakeParameterAssignment(),
+			budget:                         "10",
+			wantRunSpecWithHyperParameters: expectedRunSpecWithBudget,
+		},
+		"Budget from TrialParameters is not assigned": {
+			instance:             newFakeInstanceWithBudget(),
+			parameterAssignments: newFakeParameterAssignment(),
+			wantError:            errTrialBudgetNotFound,
+		},
 		"Invalid JSON in Unstructured Trial template": {
 			instance: func() *experimentsv1beta1.Experiment {
 				i := newFakeInstance()
@@ -140,7 +190,7 @@ func TestGetRunSpecWithHP(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			got, err := p.GetRunSpecWithHyperParameters(tc.instance, "trial-name", "trial-namespace", tc.parameterAssignments)
+			got, err := p.GetRunSpecWithHyperParameters(tc.instance, "trial-name", "trial-namespace", tc.parameterAssignments, tc.budget)
 			if diff := cmp.Diff(tc.wantError, err, cmpopts.EquateErrors()); len(diff) != 0 {
 				t.Errorf("Unexpected error from GetRunSpecWithHyperParameters (-want,+got):\n%s", diff)
 			}
@@ -313,7 +363,7 @@ spec:
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
 			tc.mockConfigMapGetter()
-			got, err := p.GetRunSpecWithHyperParameters(tc.instance, "trial-name", "trial-namespace", tc.parameterAssignments)
+			got, err := p.GetRunSpecWithHyperParameters(tc.instance, "trial-name", "trial-namespace", tc.parameterAssignments, "")
 			if diff := cmp.Diff(tc.wantError, err, cmpopts.EquateErrors()); len(diff) != 0 {
 				t.Errorf("Unexpected error from GetRunSpecWithHyperParameters (-want,+got):\n%s", diff)
 			}
@@ -403,6 +453,21 @@ func newFakeInstance() *experimentsv1beta1.Experiment {
 	}
 }
 
+// newFakeInstanceWithBudget returns the Experiment which Trial template references the Trial budget as epochs.
+func newFakeInstanceWithBudget() *experimentsv1beta1.Experiment {
+	i := newFakeInstance()
+	trialSpec := i.Spec.TrialTemplate.TrialSpec
+	containers, _, _ := unstructured.NestedSlice(trialSpec.Object, "spec", "template", "spec", "containers")
+	containers[0].(map[string]interface{})["command"].([]interface{})[2] = "--epochs=${trialParameters.epochs}"
+	_ = unstructured.SetNestedSlice(trialSpec.Object, containers, "spec", "template", "spec", "containers")
+	i.Spec.TrialTemplate.TrialParameters = append(i.Spec.TrialTemplate.TrialParameters, experimentsv1beta1.TrialParameterSpec{
+		Name:        "epochs",
+		Description: "budget of current trial",
+		Reference:   "${trialSpec.Budget}",
+	})
+	return i
+}
+
 func newFakeParameterAssignment() []commonapiv1beta1.ParameterAssignment {
 	return []commonapiv1beta1.ParameterAssignment{
 		{
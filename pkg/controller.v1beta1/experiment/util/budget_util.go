@@ -0,0 +1,168 @@
+/*
+Copyright 2022 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	experimentsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1"
+	trialsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1"
+)
+
+// resourceUsageCheckPeriod is the period to check the resource usage of the running Trials.
+const resourceUsageCheckPeriod = time.Minute
+
+// updateResourceUsage sets the cumulative resource usage of the Trials if the Experiment has max resource usage.
+func updateResourceUsage(instance *experimentsv1beta1.Experiment, trials *trialsv1beta1.TrialList, now time.Time) {
+	if instance.Spec.MaxResourceUsage == nil {
+		instance.Status.ResourceUsage = nil
+		return
+	}
+	usage := 0.0
+	for i := range trials.Items {
+		usage += getTrialResourceUsage(&trials.Items[i], instance.Spec.MaxResourceUsage.ResourceName, now)
+	}
+	instance.Status.ResourceUsage = &usage
+}
+
+// getTrialResourceUsage returns the usage of the resource by the Trial in resource-hours.
+// Usage of the active Trial is counted until now.
+func getTrialResourceUsage(trial *trialsv1beta1.Trial, name corev1.ResourceName, now time.Time) float64 {
+	if trial.Status.StartTime == nil || trial.Spec.RunSpec == nil {
+		return 0
+	}
+	end := now
+	if trial.IsCompleted() && trial.Status.CompletionTime != nil && !trial.Status.CompletionTime.IsZero() {
+		end = trial.Status.CompletionTime.Time
+	}
+	hours := end.Sub(trial.Status.StartTime.Time).Hours()
+	if hours <= 0 {
+		return 0
+	}
+	return getResourceRequest(trial.Spec.RunSpec.Object, name, 1) * hours
+}
+
+// getResourceRequest returns the total request of the resource by the containers in the run spec.
+// Pod templates are found at any depth of the run spec, e.g. in the replica specs of the Kubeflow Training Job,
+// and the request of the template is multiplied by the replicas or the parallelism of the enclosing spec.
+// Limit of the resource is used if the container doesn't request it, e.g. for the extended resources like GPUs.
+func getResourceRequest(value interface{}, name corev1.ResourceName, replicas float64) float64 {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		if containers, ok := v["containers"].([]interface{}); ok {
+			request := 0.0
+			for _, c := range containers {
+				if container, ok := c.(map[string]interface{}); ok {
+					request += getContainerResourceRequest(container, name)
+				}
+			}
+			return request * replicas
+		}
+		for _, key := range []string{"replicas", "parallelism"} {
+			if n, ok := toFloat(v[key]); ok {
+				replicas *= n
+			}
+		}
+		request := 0.0
+		for _, item := range v {
+			request += getResourceRequest(item, name, replicas)
+		}
+		return request
+	case []interface{}:
+		request := 0.0
+		for _, item := range v {
+			request += getResourceRequest(item, name, replicas)
+		}
+		return request
+	}
+	return 0
+}
+
+func getContainerResourceRequest(container map[string]interface{}, name corev1.ResourceName) float64 {
+	resources, ok := container["resources"].(map[string]interface{})
+	if !ok {
+		return 0
+	}
+	for _, key := range []string{"requests", "limits"} {
+		if list, ok := resources[key].(map[string]interface{}); ok {
+			if quantity, ok := toQuantity(list[string(name)]); ok {
+				return quantity.AsApproximateFloat64()
+			}
+		}
+	}
+	return 0
+}
+
+func toQuantity(value interface{}) (resource.Quantity, bool) {
+	switch v := value.(type) {
+	case string:
+		quantity, err := resource.ParseQuantity(v)
+		return quantity, err == nil
+	case int64:
+		return *resource.NewQuantity(v, resource.DecimalSI), true
+	case float64:
+		return *resource.NewMilliQuantity(int64(v*1000), resource.DecimalSI), true
+	}
+	return resource.Quantity{}, false
+}
+
+func toFloat(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case int64:
+		return float64(v), true
+	case float64:
+		return v, true
+	}
+	return 0, false
+}
+
+// IsBudgetReached returns true if the Experiment is succeeded because max duration or max resource usage is reached.
+func IsBudgetReached(instance *experimentsv1beta1.Experiment) bool {
+	return instance.IsCompletedReason(ExperimentMaxDurationReachedReason) ||
+		instance.IsCompletedReason(ExperimentMaxResourceUsageReachedReason)
+}
+
+// IsActiveTrialsKillRequired returns true if the active Trials of the Experiment must be killed
+// because the budget is reached.
+func IsActiveTrialsKillRequired(instance *experimentsv1beta1.Experiment) bool {
+	return instance.Spec.BudgetPolicy == experimentsv1beta1.KillActiveTrials && IsBudgetReached(instance) &&
+		instance.Status.TrialsPending+instance.Status.TrialsRunning != 0
+}
+
+// GetBudgetRequeueAfter returns the duration after which the budget of the running Experiment must be checked
+// again, since the budget is consumed without any change of the Trials. It returns 0 if the Experiment has no budget.
+func GetBudgetRequeueAfter(instance *experimentsv1beta1.Experiment, now time.Time) time.Duration {
+	if instance.IsCompleted() {
+		return 0
+	}
+	var requeueAfter time.Duration
+	if instance.Spec.MaxDuration != nil && instance.Status.StartTime != nil {
+		requeueAfter = instance.Status.StartTime.Add(instance.Spec.MaxDuration.Duration).Sub(now)
+		// Max duration is reached, but the status is not updated yet.
+		if requeueAfter <= 0 {
+			requeueAfter = time.Second
+		}
+	}
+	if instance.Spec.MaxResourceUsage != nil && instance.Status.TrialsRunning+instance.Status.TrialsPending != 0 &&
+		(requeueAfter == 0 || requeueAfter > resourceUsageCheckPeriod) {
+		requeueAfter = resourceUsageCheckPeriod
+	}
+	return requeueAfter
+}
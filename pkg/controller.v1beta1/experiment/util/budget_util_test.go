@@ -0,0 +1,228 @@
+/*
+Copyright 2022 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/utils/ptr"
+
+	experimentsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1"
+	trialsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1"
+)
+
+func TestGetResourceRequest(t *testing.T) {
+	container := func(requests, limits map[string]interface{}) map[string]interface{} {
+		resources := map[string]interface{}{}
+		if requests != nil {
+			resources["requests"] = requests
+		}
+		if limits != nil {
+			resources["limits"] = limits
+		}
+		return map[string]interface{}{
+			"name":      "training-container",
+			"resources": resources,
+		}
+	}
+	podTemplate := func(containers ...interface{}) map[string]interface{} {
+		return map[string]interface{}{
+			"spec": map[string]interface{}{
+				"containers": containers,
+			},
+		}
+	}
+
+	testCases := map[string]struct {
+		runSpec      map[string]interface{}
+		resourceName corev1.ResourceName
+		wantRequest  float64
+	}{
+		"Requests of the Job with parallelism": {
+			runSpec: map[string]interface{}{
+				"kind": "Job",
+				"spec": map[string]interface{}{
+					"parallelism": int64(2),
+					"template": podTemplate(
+						container(map[string]interface{}{"cpu": "500m"}, nil),
+						container(map[string]interface{}{"cpu": int64(1)}, nil),
+					),
+				},
+			},
+			resourceName: corev1.ResourceCPU,
+			wantRequest:  3,
+		},
+		"Limits of the replicas of the PyTorchJob": {
+			runSpec: map[string]interface{}{
+				"kind": "PyTorchJob",
+				"spec": map[string]interface{}{
+					"pytorchReplicaSpecs": map[string]interface{}{
+						"Master": map[string]interface{}{
+							"replicas": int64(1),
+							"template": podTemplate(container(nil, map[string]interface{}{"nvidia.com/gpu": "1"})),
+						},
+						"Worker": map[string]interface{}{
+							"replicas": int64(3),
+							"template": podTemplate(container(nil, map[string]interface{}{"nvidia.com/gpu": "2"})),
+						},
+					},
+				},
+			},
+			resourceName: "nvidia.com/gpu",
+			wantRequest:  7,
+		},
+		"Resource which is not requested": {
+			runSpec: map[string]interface{}{
+				"kind": "Job",
+				"spec": map[string]interface{}{
+					"template": podTemplate(container(map[string]interface{}{"cpu": "1"}, nil)),
+				},
+			},
+			resourceName: "nvidia.com/gpu",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := getResourceRequest(tc.runSpec, tc.resourceName, 1)
+			if diff := cmp.Diff(tc.wantRequest, got); len(diff) != 0 {
+				t.Errorf("Unexpected request (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdateResourceUsage(t *testing.T) {
+	now := time.Now()
+	newTrial := func(started time.Duration, completed *time.Duration) trialsv1beta1.Trial {
+		trial := trialsv1beta1.Trial{
+			Spec: trialsv1beta1.TrialSpec{
+				RunSpec: &unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"kind": "Job",
+						"spec": map[string]interface{}{
+							"template": map[string]interface{}{
+								"spec": map[string]interface{}{
+									"containers": []interface{}{
+										map[string]interface{}{
+											"resources": map[string]interface{}{
+												"limits": map[string]interface{}{"nvidia.com/gpu": "2"},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			Status: trialsv1beta1.TrialStatus{
+				StartTime: &metav1.Time{Time: now.Add(-started)},
+			},
+		}
+		if completed != nil {
+			trial.Status.CompletionTime = &metav1.Time{Time: now.Add(-*completed)}
+			trial.MarkTrialStatusSucceeded(corev1.ConditionTrue, "", "")
+		}
+		return trial
+	}
+
+	testCases := map[string]struct {
+		maxResourceUsage *experimentsv1beta1.ResourceUsageSpec
+		trials           []trialsv1beta1.Trial
+		wantUsage        *float64
+	}{
+		"Usage of the completed and running Trials": {
+			maxResourceUsage: &experimentsv1beta1.ResourceUsageSpec{ResourceName: "nvidia.com/gpu", Hours: 10},
+			trials: []trialsv1beta1.Trial{
+				newTrial(3*time.Hour, ptr.To(2*time.Hour)),
+				newTrial(30*time.Minute, nil),
+			},
+			wantUsage: ptr.To(3.0),
+		},
+		"Usage is not set without max resource usage": {
+			trials: []trialsv1beta1.Trial{
+				newTrial(time.Hour, nil),
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			instance := &experimentsv1beta1.Experiment{
+				Spec: experimentsv1beta1.ExperimentSpec{
+					MaxResourceUsage: tc.maxResourceUsage,
+				},
+			}
+			updateResourceUsage(instance, &trialsv1beta1.TrialList{Items: tc.trials}, now)
+			if diff := cmp.Diff(tc.wantUsage, instance.Status.ResourceUsage); len(diff) != 0 {
+				t.Errorf("Unexpected resource usage (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetBudgetRequeueAfter(t *testing.T) {
+	now := time.Now()
+	testCases := map[string]struct {
+		spec             experimentsv1beta1.ExperimentSpec
+		status           experimentsv1beta1.ExperimentStatus
+		wantRequeueAfter time.Duration
+	}{
+		"Experiment without budget": {},
+		"Experiment is checked when max duration is reached": {
+			spec: experimentsv1beta1.ExperimentSpec{
+				MaxDuration: &metav1.Duration{Duration: time.Hour},
+			},
+			status: experimentsv1beta1.ExperimentStatus{
+				StartTime: &metav1.Time{Time: now.Add(-50 * time.Minute)},
+			},
+			wantRequeueAfter: 10 * time.Minute,
+		},
+		"Resource usage of the active Trials is checked periodically": {
+			spec: experimentsv1beta1.ExperimentSpec{
+				MaxDuration:      &metav1.Duration{Duration: time.Hour},
+				MaxResourceUsage: &experimentsv1beta1.ResourceUsageSpec{ResourceName: "nvidia.com/gpu", Hours: 10},
+			},
+			status: experimentsv1beta1.ExperimentStatus{
+				StartTime:     &metav1.Time{Time: now.Add(-50 * time.Minute)},
+				TrialsRunning: 1,
+			},
+			wantRequeueAfter: resourceUsageCheckPeriod,
+		},
+		"Resource usage is not checked without active Trials": {
+			spec: experimentsv1beta1.ExperimentSpec{
+				MaxResourceUsage: &experimentsv1beta1.ResourceUsageSpec{ResourceName: "nvidia.com/gpu", Hours: 10},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			instance := &experimentsv1beta1.Experiment{
+				Spec:   tc.spec,
+				Status: tc.status,
+			}
+			got := GetBudgetRequeueAfter(instance, now)
+			if tc.wantRequeueAfter != got {
+				t.Errorf("Unexpected requeue after, want %v, got %v", tc.wantRequeueAfter, got)
+			}
+		})
+	}
+}
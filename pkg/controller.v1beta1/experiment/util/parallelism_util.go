@@ -0,0 +1,110 @@
+/*
+Copyright 2022 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	experimentsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1"
+	trialsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1"
+)
+
+// GetParallelTrialCount returns the number of Trials which the Experiment runs in parallel.
+// With adaptive parallelism it is the effective parallel trial count within the bounds.
+func GetParallelTrialCount(instance *experimentsv1beta1.Experiment) int32 {
+	adaptive := instance.Spec.AdaptiveParallelism
+	if adaptive == nil {
+		return *instance.Spec.ParallelTrialCount
+	}
+	if instance.Status.Parallelism != nil {
+		return instance.Status.Parallelism.EffectiveParallelTrialCount
+	}
+	return min(max(*instance.Spec.ParallelTrialCount, adaptive.MinParallelTrialCount), adaptive.MaxParallelTrialCount)
+}
+
+// GetParallelismRequeueAfter returns the duration after which the pending Trials are checked again.
+func GetParallelismRequeueAfter(instance *experimentsv1beta1.Experiment) time.Duration {
+	if instance.IsCompleted() || instance.Spec.AdaptiveParallelism == nil || instance.Spec.AdaptiveParallelism.PendingTimeout == nil {
+		return 0
+	}
+	return instance.Spec.AdaptiveParallelism.PendingTimeout.Duration
+}
+
+// updateParallelism adjusts the effective parallel trial count if the Experiment has adaptive parallelism.
+// Active Trial is pending until any of its pods has started, even if its job is already created.
+// Parallelism is lowered when any active Trial has been pending longer than the pending timeout,
+// and it is raised when all active Trials are started and they fill the effective parallel trial count.
+// The pending timeout is also the minimal interval between the changes.
+func updateParallelism(instance *experimentsv1beta1.Experiment, trials *trialsv1beta1.TrialList, startedTrials map[string]bool, now time.Time) {
+	adaptive := instance.Spec.AdaptiveParallelism
+	if adaptive == nil {
+		instance.Status.Parallelism = nil
+		return
+	}
+	if instance.Status.Parallelism == nil {
+		instance.Status.Parallelism = &experimentsv1beta1.ParallelismStatus{
+			EffectiveParallelTrialCount: GetParallelTrialCount(instance),
+			LastTransitionTime:          metav1.NewTime(now),
+		}
+	}
+	status := instance.Status.Parallelism
+
+	var pendingDuration time.Duration
+	pendingCount, runningCount := int32(0), int32(0)
+	for i := range trials.Items {
+		trial := &trials.Items[i]
+		if trial.IsCompleted() {
+			continue
+		}
+		if trial.IsRunning() && startedTrials[trial.Name] {
+			runningCount++
+			continue
+		}
+		pendingCount++
+		pendingDuration = max(pendingDuration, now.Sub(getTrialCreatedTime(trial)))
+	}
+	status.PendingDuration = metav1.Duration{Duration: pendingDuration}
+
+	var pendingTimeout time.Duration
+	if adaptive.PendingTimeout != nil {
+		pendingTimeout = adaptive.PendingTimeout.Duration
+	}
+	if now.Sub(status.LastTransitionTime.Time) < pendingTimeout {
+		return
+	}
+	if pendingDuration > pendingTimeout && status.EffectiveParallelTrialCount > adaptive.MinParallelTrialCount {
+		status.EffectiveParallelTrialCount--
+		status.LastTransitionTime = metav1.NewTime(now)
+	} else if pendingCount == 0 && runningCount >= status.EffectiveParallelTrialCount &&
+		status.EffectiveParallelTrialCount < adaptive.MaxParallelTrialCount {
+		status.EffectiveParallelTrialCount++
+		status.LastTransitionTime = metav1.NewTime(now)
+	}
+}
+
+// getTrialCreatedTime returns the time when the Trial has been created.
+func getTrialCreatedTime(trial *trialsv1beta1.Trial) time.Time {
+	for _, c := range trial.Status.Conditions {
+		if c.Type == trialsv1beta1.TrialCreated && c.Status == corev1.ConditionTrue {
+			return c.LastTransitionTime.Time
+		}
+	}
+	return trial.CreationTimestamp.Time
+}
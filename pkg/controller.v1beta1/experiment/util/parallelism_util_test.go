@@ -0,0 +1,183 @@
+/*
+Copyright 2022 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	experimentsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1"
+	trialsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1"
+)
+
+func TestUpdateParallelism(t *testing.T) {
+	now := time.Now()
+	adaptive := &experimentsv1beta1.AdaptiveParallelismSpec{
+		MinParallelTrialCount: 1,
+		MaxParallelTrialCount: 3,
+		PendingTimeout:        &metav1.Duration{Duration: 5 * time.Minute},
+	}
+	trial := func(name string, running bool, created time.Time) trialsv1beta1.Trial {
+		trial := trialsv1beta1.Trial{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+			},
+			Status: trialsv1beta1.TrialStatus{
+				Conditions: []trialsv1beta1.TrialCondition{
+					{
+						Type:               trialsv1beta1.TrialCreated,
+						Status:             corev1.ConditionTrue,
+						LastTransitionTime: metav1.NewTime(created),
+					},
+				},
+			},
+		}
+		if running {
+			trial.Status.Conditions = append(trial.Status.Conditions, trialsv1beta1.TrialCondition{
+				Type:               trialsv1beta1.TrialRunning,
+				Status:             corev1.ConditionTrue,
+				LastTransitionTime: metav1.NewTime(created.Add(time.Second)),
+			})
+		}
+		return trial
+	}
+	parallelism := func(effective int32, pending time.Duration, lastTransition time.Time) *experimentsv1beta1.ParallelismStatus {
+		return &experimentsv1beta1.ParallelismStatus{
+			EffectiveParallelTrialCount: effective,
+			PendingDuration:             metav1.Duration{Duration: pending},
+			LastTransitionTime:          metav1.NewTime(lastTransition),
+		}
+	}
+
+	testCases := map[string]struct {
+		adaptive        *experimentsv1beta1.AdaptiveParallelismSpec
+		parallelism     *experimentsv1beta1.ParallelismStatus
+		trials          []trialsv1beta1.Trial
+		startedTrials   map[string]bool
+		wantParallelism *experimentsv1beta1.ParallelismStatus
+	}{
+		"Parallelism is not set without adaptive parallelism": {
+			parallelism:     parallelism(2, 0, now),
+			wantParallelism: nil,
+		},
+		"Parallel trial count is bounded initially": {
+			adaptive: adaptive,
+			trials: []trialsv1beta1.Trial{
+				trial("trial-1", false, now.Add(-time.Minute)),
+			},
+			wantParallelism: parallelism(3, time.Minute, now),
+		},
+		"Parallelism is lowered when the trial stays pending": {
+			adaptive:    adaptive,
+			parallelism: parallelism(2, 0, now.Add(-10*time.Minute)),
+			trials: []trialsv1beta1.Trial{
+				trial("trial-1", true, now.Add(-10*time.Minute)),
+				trial("trial-2", false, now.Add(-6*time.Minute)),
+			},
+			startedTrials:   map[string]bool{"trial-1": true},
+			wantParallelism: parallelism(1, 6*time.Minute, now),
+		},
+		"Parallelism is not lowered below min": {
+			adaptive:    adaptive,
+			parallelism: parallelism(1, 0, now.Add(-10*time.Minute)),
+			trials: []trialsv1beta1.Trial{
+				trial("trial-1", false, now.Add(-6*time.Minute)),
+			},
+			wantParallelism: parallelism(1, 6*time.Minute, now.Add(-10*time.Minute)),
+		},
+		"Parallelism is raised when all trials are running": {
+			adaptive:    adaptive,
+			parallelism: parallelism(2, 0, now.Add(-10*time.Minute)),
+			trials: []trialsv1beta1.Trial{
+				trial("trial-1", true, now.Add(-10*time.Minute)),
+				trial("trial-2", true, now.Add(-time.Minute)),
+			},
+			startedTrials:   map[string]bool{"trial-1": true, "trial-2": true},
+			wantParallelism: parallelism(3, 0, now),
+		},
+		"Parallelism is not raised above max": {
+			adaptive:    adaptive,
+			parallelism: parallelism(3, 0, now.Add(-10*time.Minute)),
+			trials: []trialsv1beta1.Trial{
+				trial("trial-1", true, now.Add(-10*time.Minute)),
+				trial("trial-2", true, now.Add(-time.Minute)),
+				trial("trial-3", true, now.Add(-time.Minute)),
+			},
+			startedTrials:   map[string]bool{"trial-1": true, "trial-2": true, "trial-3": true},
+			wantParallelism: parallelism(3, 0, now.Add(-10*time.Minute)),
+		},
+		"Parallelism is lowered when the running trial has pending pods": {
+			adaptive:    adaptive,
+			parallelism: parallelism(2, 0, now.Add(-10*time.Minute)),
+			trials: []trialsv1beta1.Trial{
+				trial("trial-1", true, now.Add(-10*time.Minute)),
+				trial("trial-2", true, now.Add(-6*time.Minute)),
+			},
+			startedTrials:   map[string]bool{"trial-1": true, "trial-2": false},
+			wantParallelism: parallelism(1, 6*time.Minute, now),
+		},
+		"Parallelism is not raised when the running trials have pending pods": {
+			adaptive:    adaptive,
+			parallelism: parallelism(2, 0, now.Add(-10*time.Minute)),
+			trials: []trialsv1beta1.Trial{
+				trial("trial-1", true, now.Add(-time.Minute)),
+				trial("trial-2", true, now.Add(-time.Minute)),
+			},
+			wantParallelism: parallelism(2, time.Minute, now.Add(-10*time.Minute)),
+		},
+		"Parallelism is not changed within pending timeout since the last change": {
+			adaptive:    adaptive,
+			parallelism: parallelism(2, 0, now.Add(-time.Minute)),
+			trials: []trialsv1beta1.Trial{
+				trial("trial-1", false, now.Add(-6*time.Minute)),
+			},
+			wantParallelism: parallelism(2, 6*time.Minute, now.Add(-time.Minute)),
+		},
+		"Parallelism is not changed while trials are pending within pending timeout": {
+			adaptive:    adaptive,
+			parallelism: parallelism(2, 0, now.Add(-10*time.Minute)),
+			trials: []trialsv1beta1.Trial{
+				trial("trial-1", true, now.Add(-10*time.Minute)),
+				trial("trial-2", false, now.Add(-time.Minute)),
+			},
+			startedTrials:   map[string]bool{"trial-1": true},
+			wantParallelism: parallelism(2, time.Minute, now.Add(-10*time.Minute)),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			instance := &experimentsv1beta1.Experiment{
+				Spec: experimentsv1beta1.ExperimentSpec{
+					ParallelTrialCount:  ptr.To[int32](5),
+					AdaptiveParallelism: tc.adaptive,
+				},
+				Status: experimentsv1beta1.ExperimentStatus{
+					Parallelism: tc.parallelism,
+				},
+			}
+			updateParallelism(instance, &trialsv1beta1.TrialList{Items: tc.trials}, tc.startedTrials, now)
+			if diff := cmp.Diff(tc.wantParallelism, instance.Status.Parallelism); len(diff) != 0 {
+				t.Errorf("Unexpected parallelism (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,177 @@
+/*
+Copyright 2022 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"sort"
+	"time"
+
+	experimentsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1"
+)
+
+// queuedTrialsCheckPeriod is the period to check whether the trial slots of the ExperimentQueues are freed.
+const queuedTrialsCheckPeriod = 30 * time.Second
+
+// queueEntry is the Experiment which competes for the trial slots of the ExperimentQueue.
+type queueEntry struct {
+	name     string
+	priority int32
+	weight   int32
+	active   int32
+	demand   int32
+	slots    int32
+}
+
+// LimitTrialsByQueues returns how many of addCount new Trials the Experiment can create
+// within the trial slots of the ExperimentQueues. experiments are all Experiments in the namespace.
+func LimitTrialsByQueues(queues []experimentsv1beta1.ExperimentQueue, instance *experimentsv1beta1.Experiment,
+	experiments []experimentsv1beta1.Experiment, addCount int32) int32 {
+	for i := range queues {
+		if slots := getQueueTrialSlots(&queues[i], instance, experiments); slots < addCount {
+			addCount = slots
+		}
+	}
+	return addCount
+}
+
+// GetQueueRequeueAfter returns the duration after which the Experiment is reconciled again
+// to create the Trials which are waiting for the free trial slots.
+func GetQueueRequeueAfter(instance *experimentsv1beta1.Experiment) time.Duration {
+	if instance.Status.TrialsQueued > 0 {
+		return queuedTrialsCheckPeriod
+	}
+	return 0
+}
+
+// getQueueTrialSlots returns the number of new Trials which the Experiment can create within the ExperimentQueue.
+// Trial slots are given to the Experiments with higher priority first and divided by weight between
+// the Experiments with the same priority. Active Trials are never preempted, so the Experiment can't use
+// more slots than the queue has free.
+func getQueueTrialSlots(queue *experimentsv1beta1.ExperimentQueue, instance *experimentsv1beta1.Experiment,
+	experiments []experimentsv1beta1.Experiment) int32 {
+	// The instance has the latest status, so it replaces its copy from the list.
+	competitors := []*experimentsv1beta1.Experiment{instance}
+	for i := range experiments {
+		if experiments[i].Name != instance.Name {
+			competitors = append(competitors, &experiments[i])
+		}
+	}
+
+	free := queue.Spec.MaxActiveTrials
+	capacity := queue.Spec.MaxActiveTrials
+	var entries []*queueEntry
+	var own *queueEntry
+	for _, exp := range competitors {
+		active := exp.Status.TrialsPending + exp.Status.TrialsRunning
+		free -= active
+		// Completed and suspended Experiments don't create new Trials, but their active Trials occupy the slots.
+		if exp.IsCompleted() || exp.IsSuspended() || exp.Spec.ParallelTrialCount == nil {
+			capacity -= active
+			continue
+		}
+		entry := &queueEntry{
+			name:   exp.Name,
+			weight: experimentsv1beta1.DefaultQueueingWeight,
+			active: active,
+			demand: max(getRequiredActiveCount(exp), active),
+		}
+		if exp.Spec.Queueing != nil {
+			entry.priority = exp.Spec.Queueing.Priority
+			if exp.Spec.Queueing.Weight != nil {
+				entry.weight = *exp.Spec.Queueing.Weight
+			}
+		}
+		if exp == instance {
+			own = entry
+		}
+		entries = append(entries, entry)
+	}
+	if own == nil || free <= 0 {
+		return 0
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		if entries[i].priority != entries[j].priority {
+			return entries[i].priority > entries[j].priority
+		}
+		return entries[i].name < entries[j].name
+	})
+	for i := 0; i < len(entries) && capacity > 0; {
+		j := i
+		for j < len(entries) && entries[j].priority == entries[i].priority {
+			j++
+		}
+		capacity -= divideTrialSlots(entries[i:j], capacity)
+		i = j
+	}
+
+	return max(min(own.slots-own.active, free), 0)
+}
+
+// divideTrialSlots divides the slots between the Experiments proportionally to their weights.
+// Slots which Experiment doesn't demand are divided between the rest. It returns the number of given slots.
+func divideTrialSlots(entries []*queueEntry, slots int32) int32 {
+	var given int32
+	for given < slots {
+		var unsatisfied []*queueEntry
+		var weights int64
+		for _, e := range entries {
+			if e.slots < e.demand {
+				unsatisfied = append(unsatisfied, e)
+				weights += int64(e.weight)
+			}
+		}
+		if len(unsatisfied) == 0 {
+			break
+		}
+		available := int64(slots - given)
+		progress := false
+		for _, e := range unsatisfied {
+			share := min(int32(available*int64(e.weight)/weights), e.demand-e.slots)
+			if share > 0 {
+				e.slots += share
+				given += share
+				progress = true
+			}
+		}
+		if !progress {
+			// There are fewer slots than Experiments, so they are given one by one in order of weight.
+			sort.SliceStable(unsatisfied, func(i, j int) bool {
+				return unsatisfied[i].weight > unsatisfied[j].weight
+			})
+			for _, e := range unsatisfied {
+				if given == slots {
+					break
+				}
+				e.slots++
+				given++
+			}
+		}
+	}
+	return given
+}
+
+// getRequiredActiveCount returns the number of active Trials which the Experiment requires.
+func getRequiredActiveCount(instance *experimentsv1beta1.Experiment) int32 {
+	requiredActiveCount := GetParallelTrialCount(instance)
+	if instance.Spec.MaxTrialCount != nil {
+		completedCount := instance.Status.TrialsSucceeded + instance.Status.TrialsFailed +
+			instance.Status.TrialsKilled + instance.Status.TrialsEarlyStopped
+		requiredActiveCount = min(requiredActiveCount, *instance.Spec.MaxTrialCount-completedCount)
+	}
+	return max(requiredActiveCount, 0)
+}
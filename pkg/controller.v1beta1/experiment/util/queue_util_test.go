@@ -0,0 +1,162 @@
+/*
+Copyright 2022 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	experimentsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1"
+)
+
+func TestLimitTrialsByQueues(t *testing.T) {
+	queue := func(maxActiveTrials int32) experimentsv1beta1.ExperimentQueue {
+		return experimentsv1beta1.ExperimentQueue{
+			ObjectMeta: metav1.ObjectMeta{Name: "queue"},
+			Spec:       experimentsv1beta1.ExperimentQueueSpec{MaxActiveTrials: maxActiveTrials},
+		}
+	}
+	experiment := func(name string, parallel, active int32, queueing *experimentsv1beta1.QueueingSpec) experimentsv1beta1.Experiment {
+		return experimentsv1beta1.Experiment{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: experimentsv1beta1.ExperimentSpec{
+				ParallelTrialCount: ptr.To(parallel),
+				Queueing:           queueing,
+			},
+			Status: experimentsv1beta1.ExperimentStatus{
+				TrialsRunning: active,
+			},
+		}
+	}
+
+	testCases := map[string]struct {
+		queues      []experimentsv1beta1.ExperimentQueue
+		instance    experimentsv1beta1.Experiment
+		experiments []experimentsv1beta1.Experiment
+		addCount    int32
+		wantAllowed int32
+	}{
+		"Trials are not limited without ExperimentQueue": {
+			instance: experiment("a", 5, 0, nil),
+			experiments: []experimentsv1beta1.Experiment{
+				experiment("b", 5, 5, nil),
+			},
+			addCount:    5,
+			wantAllowed: 5,
+		},
+		"Slots are divided equally between Experiments": {
+			queues:   []experimentsv1beta1.ExperimentQueue{queue(6)},
+			instance: experiment("a", 5, 0, nil),
+			experiments: []experimentsv1beta1.Experiment{
+				experiment("a", 5, 0, nil),
+				experiment("b", 5, 0, nil),
+			},
+			addCount:    5,
+			wantAllowed: 3,
+		},
+		"Slots are divided by weight": {
+			queues:   []experimentsv1beta1.ExperimentQueue{queue(6)},
+			instance: experiment("a", 5, 0, &experimentsv1beta1.QueueingSpec{Weight: ptr.To[int32](2)}),
+			experiments: []experimentsv1beta1.Experiment{
+				experiment("b", 5, 0, nil),
+			},
+			addCount:    5,
+			wantAllowed: 4,
+		},
+		"Experiment with higher priority gets slots first": {
+			queues:   []experimentsv1beta1.ExperimentQueue{queue(6)},
+			instance: experiment("a", 5, 0, nil),
+			experiments: []experimentsv1beta1.Experiment{
+				experiment("b", 5, 0, &experimentsv1beta1.QueueingSpec{Priority: 10}),
+			},
+			addCount:    5,
+			wantAllowed: 1,
+		},
+		"Slots which are not demanded are given to other Experiments": {
+			queues:   []experimentsv1beta1.ExperimentQueue{queue(6)},
+			instance: experiment("a", 5, 0, nil),
+			experiments: []experimentsv1beta1.Experiment{
+				experiment("b", 1, 1, nil),
+			},
+			addCount:    5,
+			wantAllowed: 5,
+		},
+		"Active Trials of other Experiments are not preempted": {
+			queues:   []experimentsv1beta1.ExperimentQueue{queue(6)},
+			instance: experiment("a", 5, 0, nil),
+			experiments: []experimentsv1beta1.Experiment{
+				experiment("b", 5, 5, nil),
+			},
+			addCount:    5,
+			wantAllowed: 1,
+		},
+		"Active Trials of completed Experiment occupy slots": {
+			queues:   []experimentsv1beta1.ExperimentQueue{queue(6)},
+			instance: experiment("a", 5, 0, nil),
+			experiments: []experimentsv1beta1.Experiment{
+				func() experimentsv1beta1.Experiment {
+					e := experiment("b", 5, 4, nil)
+					e.MarkExperimentStatusSucceeded(ExperimentMaxDurationReachedReason, "Experiment is succeeded")
+					return e
+				}(),
+			},
+			addCount:    5,
+			wantAllowed: 2,
+		},
+		"Suspended Experiment doesn't get slots": {
+			queues:   []experimentsv1beta1.ExperimentQueue{queue(6)},
+			instance: experiment("a", 5, 0, nil),
+			experiments: []experimentsv1beta1.Experiment{
+				func() experimentsv1beta1.Experiment {
+					e := experiment("b", 5, 0, nil)
+					e.MarkExperimentStatusSuspended(ExperimentSuspendedReason, "Experiment is suspended")
+					return e
+				}(),
+			},
+			addCount:    5,
+			wantAllowed: 5,
+		},
+		"The strictest ExperimentQueue limits Trials": {
+			queues:   []experimentsv1beta1.ExperimentQueue{queue(6), queue(2)},
+			instance: experiment("a", 5, 0, nil),
+			experiments: []experimentsv1beta1.Experiment{
+				experiment("b", 5, 0, nil),
+			},
+			addCount:    5,
+			wantAllowed: 1,
+		},
+		"Remaining slot is given to Experiment with higher weight": {
+			queues:   []experimentsv1beta1.ExperimentQueue{queue(1)},
+			instance: experiment("a", 5, 0, nil),
+			experiments: []experimentsv1beta1.Experiment{
+				experiment("b", 5, 0, &experimentsv1beta1.QueueingSpec{Weight: ptr.To[int32](3)}),
+			},
+			addCount:    5,
+			wantAllowed: 0,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := LimitTrialsByQueues(tc.queues, &tc.instance, tc.experiments, tc.addCount)
+			if tc.wantAllowed != got {
+				t.Errorf("Unexpected number of allowed Trials, want %d, got %d", tc.wantAllowed, got)
+			}
+		})
+	}
+}
@@ -17,7 +17,9 @@ limitations under the License.
 package util
 
 import (
+	"sort"
 	"strconv"
+	"time"
 
 	"k8s.io/apimachinery/pkg/types"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
@@ -32,22 +34,32 @@ import (
 var log = logf.Log.WithName("experiment-status-util")
 
 const (
-	ExperimentCreatedReason              = "ExperimentCreated"
-	ExperimentRunningReason              = "ExperimentRunning"
-	ExperimentRestartingReason           = "ExperimentRestarting"
-	ExperimentGoalReachedReason          = "ExperimentGoalReached"
-	ExperimentMaxTrialsReachedReason     = "ExperimentMaxTrialsReached"
-	ExperimentSuggestionEndReachedReason = "ExperimentSuggestionEndReached"
-	ExperimentFailedReason               = "ExperimentFailed"
+	ExperimentCreatedReason                 = "ExperimentCreated"
+	ExperimentRunningReason                 = "ExperimentRunning"
+	ExperimentRestartingReason              = "ExperimentRestarting"
+	ExperimentGoalReachedReason             = "ExperimentGoalReached"
+	ExperimentMaxTrialsReachedReason        = "ExperimentMaxTrialsReached"
+	ExperimentMaxDurationReachedReason      = "ExperimentMaxDurationReached"
+	ExperimentMaxResourceUsageReachedReason = "ExperimentMaxResourceUsageReached"
+	ExperimentConvergedReason               = "ExperimentConverged"
+	ExperimentSuggestionEndReachedReason    = "ExperimentSuggestionEndReached"
+	ExperimentFailedReason                  = "ExperimentFailed"
+	ExperimentSuspendedReason               = "ExperimentSuspended"
+	ExperimentResumedReason                 = "ExperimentResumed"
 )
 
-// UpdateExperimentStatus checks if objective goal is reached and updates Experiment status from current Trials
-func UpdateExperimentStatus(collector *ExperimentsCollector, instance *experimentsv1beta1.Experiment, trials *trialsv1beta1.TrialList) error {
+// UpdateExperimentStatus checks if objective goal is reached and updates Experiment status from current Trials.
+// Experiment is succeeded if the Suggestion has exhausted the search space and there are no active Trials.
+// startedTrials contains the names of the Trials which have any pod started.
+func UpdateExperimentStatus(collector *ExperimentsCollector, instance *experimentsv1beta1.Experiment, trials *trialsv1beta1.TrialList, startedTrials map[string]bool, suggestionExhausted bool) error {
 
 	isObjectiveGoalReached := updateTrialsSummary(instance, trials)
+	updateResourceUsage(instance, trials, time.Now())
+	updateConvergence(instance, trials)
+	updateParallelism(instance, trials, startedTrials, time.Now())
 
 	if !instance.IsCompleted() {
-		UpdateExperimentStatusCondition(collector, instance, isObjectiveGoalReached, false)
+		UpdateExperimentStatusCondition(collector, instance, isObjectiveGoalReached, suggestionExhausted)
 	}
 	return nil
 
@@ -147,24 +159,161 @@ func updateTrialsSummary(instance *experimentsv1beta1.Experiment, trials *trials
 		sts.CurrentOptimalTrial.Observation.Metrics = []commonv1beta1.Metric{}
 		sts.CurrentOptimalTrial.Observation.Metrics = append(sts.CurrentOptimalTrial.Observation.Metrics, bestTrial.Status.Observation.Metrics...)
 	}
+
+	if len(instance.Spec.Objective.AdditionalObjectives) != 0 {
+		updateParetoOptimalTrials(instance, trials)
+	}
 	return isObjectiveGoalReached
 }
 
+// updateConvergence sets the statistics of the best objective value plateau if the Experiment has convergence criterion.
+// Trials with the objective metric value are checked in order of the completion time, and the best objective value
+// is updated only if the trial improves it by more than min delta.
+func updateConvergence(instance *experimentsv1beta1.Experiment, trials *trialsv1beta1.TrialList) {
+	if instance.Spec.Convergence == nil {
+		instance.Status.Convergence = nil
+		return
+	}
+	minDelta := 0.0
+	if instance.Spec.Convergence.MinDelta != nil {
+		minDelta = *instance.Spec.Convergence.MinDelta
+	}
+
+	var completedTrials []trialsv1beta1.Trial
+	for _, trial := range trials.Items {
+		if trial.IsCompleted() && trial.Status.CompletionTime != nil {
+			completedTrials = append(completedTrials, trial)
+		}
+	}
+	sort.SliceStable(completedTrials, func(i, j int) bool {
+		return completedTrials[i].Status.CompletionTime.Before(completedTrials[j].Status.CompletionTime)
+	})
+
+	convergence := &experimentsv1beta1.ConvergenceStatus{}
+	var bestValue float64
+	for _, trial := range completedTrials {
+		value, err := strconv.ParseFloat(getObjectiveMetricValue(trial), 64)
+		if err != nil {
+			continue
+		}
+		improvement := value - bestValue
+		if instance.Spec.Objective.Type == commonv1beta1.ObjectiveTypeMinimize {
+			improvement = bestValue - value
+		}
+		if convergence.BestTrialName == "" || (improvement > 0 && improvement >= minDelta) {
+			bestValue = value
+			convergence.BestTrialName = trial.Name
+			convergence.BestObjectiveValue = getObjectiveMetricValue(trial)
+			convergence.TrialsWithoutImprovement = 0
+		} else {
+			convergence.TrialsWithoutImprovement++
+		}
+	}
+	instance.Status.Convergence = convergence
+}
+
+// updateParetoOptimalTrials sets the Trials which are not dominated by any other Trial
+// in all objectives of the multi-objective Experiment.
+// Only succeeded and early stopped Trials are considered, since Running and Failed Trials may have partial observations.
+// Trials without numeric value of any objective metric are skipped.
+func updateParetoOptimalTrials(instance *experimentsv1beta1.Experiment, trials *trialsv1beta1.TrialList) {
+	objectiveTypes := []commonv1beta1.ObjectiveType{instance.Spec.Objective.Type}
+	for _, additionalObjective := range instance.Spec.Objective.AdditionalObjectives {
+		objectiveTypes = append(objectiveTypes, additionalObjective.Type)
+	}
+
+	var candidates []trialsv1beta1.Trial
+	var candidateValues [][]float64
+	for _, trial := range trials.Items {
+		if !trial.IsSucceeded() && !trial.IsEarlyStopped() {
+			continue
+		}
+		values, ok := getObjectiveMetricValues(trial)
+		if !ok {
+			continue
+		}
+		candidates = append(candidates, trial)
+		candidateValues = append(candidateValues, values)
+	}
+
+	sts := &instance.Status
+	sts.ParetoOptimalTrials = nil
+	for i, trial := range candidates {
+		isDominated := false
+		for j := range candidates {
+			if i != j && dominates(candidateValues[j], candidateValues[i], objectiveTypes) {
+				isDominated = true
+				break
+			}
+		}
+		if isDominated {
+			continue
+		}
+		optimalTrial := experimentsv1beta1.OptimalTrial{
+			BestTrialName:        trial.Name,
+			ParameterAssignments: []commonv1beta1.ParameterAssignment{},
+		}
+		optimalTrial.ParameterAssignments = append(optimalTrial.ParameterAssignments, trial.Spec.ParameterAssignments...)
+		optimalTrial.Observation.Metrics = []commonv1beta1.Metric{}
+		optimalTrial.Observation.Metrics = append(optimalTrial.Observation.Metrics, trial.Status.Observation.Metrics...)
+		sts.ParetoOptimalTrials = append(sts.ParetoOptimalTrials, optimalTrial)
+	}
+}
+
+// dominates returns true if values a are not worse than values b in all objectives
+// and better than b at least in one objective.
+func dominates(a, b []float64, objectiveTypes []commonv1beta1.ObjectiveType) bool {
+	isBetter := false
+	for i, objectiveType := range objectiveTypes {
+		switch {
+		case a[i] == b[i]:
+			continue
+		case (a[i] < b[i]) == (objectiveType == commonv1beta1.ObjectiveTypeMinimize):
+			isBetter = true
+		default:
+			return false
+		}
+	}
+	return isBetter
+}
+
+// getObjectiveMetricValues returns values of the objective metric and additional objectives for the Trial.
+// It returns false if any of the values is unavailable or is not a number.
+func getObjectiveMetricValues(trial trialsv1beta1.Trial) ([]float64, bool) {
+	metricNames := []string{trial.Spec.Objective.ObjectiveMetricName}
+	for _, additionalObjective := range trial.Spec.Objective.AdditionalObjectives {
+		metricNames = append(metricNames, additionalObjective.ObjectiveMetricName)
+	}
+	values := make([]float64, 0, len(metricNames))
+	for _, metricName := range metricNames {
+		value, err := strconv.ParseFloat(getMetricValue(trial, metricName), 64)
+		if err != nil {
+			return nil, false
+		}
+		values = append(values, value)
+	}
+	return values, true
+}
+
 func getObjectiveMetricValue(trial trialsv1beta1.Trial) string {
+	return getMetricValue(trial, trial.Spec.Objective.ObjectiveMetricName)
+}
+
+// getMetricValue returns the metric value of the Trial extracted by the metric strategy.
+func getMetricValue(trial trialsv1beta1.Trial, metricName string) string {
 	if trial.Status.Observation == nil {
 		return consts.UnavailableMetricValue
 	}
-	var objectiveStrategy commonv1beta1.MetricStrategyType
-	objectiveMetricName := trial.Spec.Objective.ObjectiveMetricName
+	var metricStrategy commonv1beta1.MetricStrategyType
 	for _, strategy := range trial.Spec.Objective.MetricStrategies {
-		if strategy.Name == objectiveMetricName {
-			objectiveStrategy = strategy.Value
+		if strategy.Name == metricName {
+			metricStrategy = strategy.Value
 			break
 		}
 	}
 	for _, metric := range trial.Status.Observation.Metrics {
-		if objectiveMetricName == metric.Name {
-			switch objectiveStrategy {
+		if metricName == metric.Name {
+			switch metricStrategy {
 			case commonv1beta1.ExtractByMin:
 				if metric.Min == consts.UnavailableMetricValue {
 					return metric.Latest
@@ -221,6 +370,38 @@ func UpdateExperimentStatusCondition(collector *ExperimentsCollector, instance *
 		return
 	}
 
+	// Then check if the best objective value has plateaued.
+	if instance.Spec.Convergence != nil && instance.Status.Convergence != nil &&
+		instance.Status.Convergence.TrialsWithoutImprovement >= instance.Spec.Convergence.Patience {
+		msg := "Experiment has succeeded because the objective value has converged"
+		instance.MarkExperimentStatusSucceeded(ExperimentConvergedReason, msg)
+		instance.Status.CompletionTime = &now
+		collector.IncreaseExperimentsSucceededCount(instance.Namespace)
+		logger.Info(msg)
+		return
+	}
+
+	// Then check if the budget of the Experiment is reached.
+	if instance.Spec.MaxDuration != nil && instance.Status.StartTime != nil &&
+		now.Sub(instance.Status.StartTime.Time) >= instance.Spec.MaxDuration.Duration {
+		msg := "Experiment has succeeded because max duration has reached"
+		instance.MarkExperimentStatusSucceeded(ExperimentMaxDurationReachedReason, msg)
+		instance.Status.CompletionTime = &now
+		collector.IncreaseExperimentsSucceededCount(instance.Namespace)
+		logger.Info(msg)
+		return
+	}
+
+	if instance.Spec.MaxResourceUsage != nil && instance.Status.ResourceUsage != nil &&
+		*instance.Status.ResourceUsage >= instance.Spec.MaxResourceUsage.Hours {
+		msg := "Experiment has succeeded because max resource usage has reached"
+		instance.MarkExperimentStatusSucceeded(ExperimentMaxResourceUsageReachedReason, msg)
+		instance.Status.CompletionTime = &now
+		collector.IncreaseExperimentsSucceededCount(instance.Namespace)
+		logger.Info(msg)
+		return
+	}
+
 	if getSuggestionDone && activeTrialsCount == 0 {
 		msg := "Experiment has succeeded because suggestion service has reached the end"
 		instance.MarkExperimentStatusSucceeded(ExperimentSuggestionEndReachedReason, msg)
@@ -230,10 +411,29 @@ func UpdateExperimentStatusCondition(collector *ExperimentsCollector, instance *
 		return
 	}
 
+	if UpdateExperimentSuspendCondition(instance) {
+		return
+	}
+
 	msg := "Experiment is running"
 	instance.MarkExperimentStatusRunning(ExperimentRunningReason, msg)
 }
 
+// UpdateExperimentSuspendCondition marks the Experiment suspended or resumed according to spec.suspend.
+// It returns true if the Experiment is suspended.
+func UpdateExperimentSuspendCondition(instance *experimentsv1beta1.Experiment) bool {
+	if instance.Spec.Suspend != nil && *instance.Spec.Suspend {
+		msg := "Experiment is suspended"
+		instance.MarkExperimentStatusSuspended(ExperimentSuspendedReason, msg)
+		return true
+	}
+	if instance.IsSuspended() {
+		msg := "Experiment is resumed"
+		instance.MarkExperimentStatusResumed(ExperimentResumedReason, msg)
+	}
+	return false
+}
+
 // IsCompletedExperimentRestartable returns whether experiment is restartable or not
 // Experiment is restartable only if it is in succeeded state by reaching max trials and
 // ResumePolicy = LongRunning or ResumePolicy = FromVolume
@@ -0,0 +1,399 @@
+/*
+Copyright 2022 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/prometheus/client_golang/prometheus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	commonv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1"
+	experimentsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1"
+	trialsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1"
+	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
+)
+
+func TestUpdateTrialsSummary(t *testing.T) {
+	multiObjective := &commonv1beta1.ObjectiveSpec{
+		Type:                commonv1beta1.ObjectiveTypeMaximize,
+		ObjectiveMetricName: "accuracy",
+		AdditionalObjectives: []commonv1beta1.AdditionalObjective{
+			{Type: commonv1beta1.ObjectiveTypeMinimize, ObjectiveMetricName: "latency"},
+		},
+		MetricStrategies: []commonv1beta1.MetricStrategy{
+			{Name: "accuracy", Value: commonv1beta1.ExtractByMax},
+			{Name: "latency", Value: commonv1beta1.ExtractByMin},
+		},
+	}
+
+	testCases := map[string]struct {
+		objective               *commonv1beta1.ObjectiveSpec
+		trials                  []trialsv1beta1.Trial
+		wantCurrentOptimalTrial string
+		wantParetoOptimalTrials []string
+	}{
+		"Single objective Experiment doesn't have Pareto front": {
+			objective: &commonv1beta1.ObjectiveSpec{
+				Type:                commonv1beta1.ObjectiveTypeMaximize,
+				ObjectiveMetricName: "accuracy",
+				MetricStrategies: []commonv1beta1.MetricStrategy{
+					{Name: "accuracy", Value: commonv1beta1.ExtractByMax},
+				},
+			},
+			trials: []trialsv1beta1.Trial{
+				newFakeTrial("trial-1", map[string]string{"accuracy": "0.8"}),
+				newFakeTrial("trial-2", map[string]string{"accuracy": "0.9"}),
+			},
+			wantCurrentOptimalTrial: "trial-2",
+		},
+		"Pareto front contains non dominated Trials": {
+			objective: multiObjective,
+			trials: []trialsv1beta1.Trial{
+				newFakeTrial("trial-1", map[string]string{"accuracy": "0.8", "latency": "10"}),
+				newFakeTrial("trial-2", map[string]string{"accuracy": "0.9", "latency": "20"}),
+				// trial-3 is dominated by trial-1.
+				newFakeTrial("trial-3", map[string]string{"accuracy": "0.7", "latency": "15"}),
+				// trial-4 is dominated by trial-2.
+				newFakeTrial("trial-4", map[string]string{"accuracy": "0.9", "latency": "30"}),
+				newFakeTrial("trial-5", map[string]string{"accuracy": "0.6", "latency": "5"}),
+			},
+			wantCurrentOptimalTrial: "trial-2",
+			wantParetoOptimalTrials: []string{"trial-1", "trial-2", "trial-5"},
+		},
+		"Trials with equal objective values are on Pareto front": {
+			objective: multiObjective,
+			trials: []trialsv1beta1.Trial{
+				newFakeTrial("trial-1", map[string]string{"accuracy": "0.8", "latency": "10"}),
+				newFakeTrial("trial-2", map[string]string{"accuracy": "0.8", "latency": "10"}),
+			},
+			wantCurrentOptimalTrial: "trial-1",
+			wantParetoOptimalTrials: []string{"trial-1", "trial-2"},
+		},
+		"Trials without additional objective values are skipped": {
+			objective: multiObjective,
+			trials: []trialsv1beta1.Trial{
+				newFakeTrial("trial-1", map[string]string{"accuracy": "0.8", "latency": "10"}),
+				newFakeTrial("trial-2", map[string]string{"accuracy": "0.9", "latency": consts.UnavailableMetricValue}),
+				newFakeTrial("trial-3", map[string]string{"accuracy": "0.95"}),
+			},
+			wantCurrentOptimalTrial: "trial-3",
+			wantParetoOptimalTrials: []string{"trial-1"},
+		},
+		"Running and Failed Trials are not on Pareto front": {
+			objective: multiObjective,
+			trials: []trialsv1beta1.Trial{
+				newFakeTrial("trial-1", map[string]string{"accuracy": "0.8", "latency": "10"}),
+				func() trialsv1beta1.Trial {
+					trial := newFakeTrial("trial-2", map[string]string{"accuracy": "0.9", "latency": "5"})
+					trial.Status.Conditions[0].Type = trialsv1beta1.TrialRunning
+					return trial
+				}(),
+				func() trialsv1beta1.Trial {
+					trial := newFakeTrial("trial-3", map[string]string{"accuracy": "0.95", "latency": "5"})
+					trial.Status.Conditions[0].Type = trialsv1beta1.TrialFailed
+					return trial
+				}(),
+			},
+			wantCurrentOptimalTrial: "trial-3",
+			wantParetoOptimalTrials: []string{"trial-1"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			instance := &experimentsv1beta1.Experiment{
+				Spec: experimentsv1beta1.ExperimentSpec{
+					Objective: tc.objective,
+				},
+			}
+			for i := range tc.trials {
+				tc.trials[i].Spec.Objective = tc.objective
+			}
+			updateTrialsSummary(instance, &trialsv1beta1.TrialList{Items: tc.trials})
+
+			if diff := cmp.Diff(tc.wantCurrentOptimalTrial, instance.Status.CurrentOptimalTrial.BestTrialName); len(diff) != 0 {
+				t.Errorf("Unexpected current optimal Trial (-want,+got):\n%s", diff)
+			}
+			var gotParetoOptimalTrials []string
+			for _, optimalTrial := range instance.Status.ParetoOptimalTrials {
+				gotParetoOptimalTrials = append(gotParetoOptimalTrials, optimalTrial.BestTrialName)
+			}
+			if diff := cmp.Diff(tc.wantParetoOptimalTrials, gotParetoOptimalTrials); len(diff) != 0 {
+				t.Errorf("Unexpected Pareto optimal Trials (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdateConvergence(t *testing.T) {
+	objective := &commonv1beta1.ObjectiveSpec{
+		Type:                commonv1beta1.ObjectiveTypeMinimize,
+		ObjectiveMetricName: "loss",
+		MetricStrategies: []commonv1beta1.MetricStrategy{
+			{Name: "loss", Value: commonv1beta1.ExtractByMin},
+		},
+	}
+	startTime := time.Now()
+	// Trials are completed in order of the index.
+	newTrial := func(index int, loss string) trialsv1beta1.Trial {
+		trial := newFakeTrial(fmt.Sprintf("trial-%d", index), map[string]string{"loss": loss})
+		trial.Spec.Objective = objective
+		trial.Status.CompletionTime = &metav1.Time{Time: startTime.Add(time.Duration(index) * time.Minute)}
+		return trial
+	}
+
+	testCases := map[string]struct {
+		convergence     *experimentsv1beta1.ConvergenceSpec
+		trials          []trialsv1beta1.Trial
+		wantConvergence *experimentsv1beta1.ConvergenceStatus
+	}{
+		"Trials after the best objective value are counted": {
+			convergence: &experimentsv1beta1.ConvergenceSpec{Patience: 3},
+			trials: []trialsv1beta1.Trial{
+				newTrial(3, "0.6"),
+				newTrial(1, "0.8"),
+				newTrial(2, "0.5"),
+				newTrial(4, "0.5"),
+			},
+			wantConvergence: &experimentsv1beta1.ConvergenceStatus{
+				BestTrialName:            "trial-2",
+				BestObjectiveValue:       "0.5",
+				TrialsWithoutImprovement: 2,
+			},
+		},
+		"Improvement less than min delta is not counted": {
+			convergence: &experimentsv1beta1.ConvergenceSpec{Patience: 3, MinDelta: ptr.To(0.1)},
+			trials: []trialsv1beta1.Trial{
+				newTrial(1, "0.8"),
+				newTrial(2, "0.75"),
+				newTrial(3, "0.65"),
+				newTrial(4, "0.6"),
+			},
+			wantConvergence: &experimentsv1beta1.ConvergenceStatus{
+				BestTrialName:            "trial-3",
+				BestObjectiveValue:       "0.65",
+				TrialsWithoutImprovement: 1,
+			},
+		},
+		"Trials without objective value and active Trials are not counted": {
+			convergence: &experimentsv1beta1.ConvergenceSpec{Patience: 3},
+			trials: []trialsv1beta1.Trial{
+				newTrial(1, "0.8"),
+				newTrial(2, consts.UnavailableMetricValue),
+				func() trialsv1beta1.Trial {
+					trial := newTrial(3, "0.9")
+					trial.Status.Conditions = nil
+					return trial
+				}(),
+			},
+			wantConvergence: &experimentsv1beta1.ConvergenceStatus{
+				BestTrialName:      "trial-1",
+				BestObjectiveValue: "0.8",
+			},
+		},
+		"Experiment without convergence": {
+			trials: []trialsv1beta1.Trial{
+				newTrial(1, "0.8"),
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			instance := &experimentsv1beta1.Experiment{
+				Spec: experimentsv1beta1.ExperimentSpec{
+					Objective:   objective,
+					Convergence: tc.convergence,
+				},
+			}
+			updateConvergence(instance, &trialsv1beta1.TrialList{Items: tc.trials})
+			if diff := cmp.Diff(tc.wantConvergence, instance.Status.Convergence); len(diff) != 0 {
+				t.Errorf("Unexpected convergence (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdateExperimentStatusCondition(t *testing.T) {
+	testCases := map[string]struct {
+		spec                experimentsv1beta1.ExperimentSpec
+		status              experimentsv1beta1.ExperimentStatus
+		suggestionExhausted bool
+		wantReason          string
+	}{
+		"Experiment is running until the Suggestion is exhausted": {
+			status: experimentsv1beta1.ExperimentStatus{
+				TrialsSucceeded: 2,
+			},
+			wantReason: ExperimentRunningReason,
+		},
+		"Experiment is running until active Trials are completed": {
+			status: experimentsv1beta1.ExperimentStatus{
+				TrialsSucceeded: 2,
+				TrialsRunning:   1,
+			},
+			suggestionExhausted: true,
+			wantReason:          ExperimentRunningReason,
+		},
+		"Experiment is succeeded if the Suggestion is exhausted": {
+			status: experimentsv1beta1.ExperimentStatus{
+				TrialsSucceeded: 2,
+			},
+			suggestionExhausted: true,
+			wantReason:          ExperimentSuggestionEndReachedReason,
+		},
+		"Experiment is running until max duration is reached": {
+			spec: experimentsv1beta1.ExperimentSpec{
+				MaxDuration: &metav1.Duration{Duration: time.Hour},
+			},
+			status: experimentsv1beta1.ExperimentStatus{
+				StartTime:     &metav1.Time{Time: time.Now().Add(-time.Minute)},
+				TrialsRunning: 1,
+			},
+			wantReason: ExperimentRunningReason,
+		},
+		"Experiment is succeeded if max duration is reached": {
+			spec: experimentsv1beta1.ExperimentSpec{
+				MaxDuration: &metav1.Duration{Duration: time.Hour},
+			},
+			status: experimentsv1beta1.ExperimentStatus{
+				StartTime:     &metav1.Time{Time: time.Now().Add(-2 * time.Hour)},
+				TrialsRunning: 1,
+			},
+			wantReason: ExperimentMaxDurationReachedReason,
+		},
+		"Experiment is running until max resource usage is reached": {
+			spec: experimentsv1beta1.ExperimentSpec{
+				MaxResourceUsage: &experimentsv1beta1.ResourceUsageSpec{ResourceName: "nvidia.com/gpu", Hours: 10},
+			},
+			status: experimentsv1beta1.ExperimentStatus{
+				TrialsRunning: 1,
+				ResourceUsage: ptr.To(9.5),
+			},
+			wantReason: ExperimentRunningReason,
+		},
+		"Experiment is succeeded if max resource usage is reached": {
+			spec: experimentsv1beta1.ExperimentSpec{
+				MaxResourceUsage: &experimentsv1beta1.ResourceUsageSpec{ResourceName: "nvidia.com/gpu", Hours: 10},
+			},
+			status: experimentsv1beta1.ExperimentStatus{
+				TrialsRunning: 1,
+				ResourceUsage: ptr.To(10.5),
+			},
+			wantReason: ExperimentMaxResourceUsageReachedReason,
+		},
+		"Experiment is running until the objective value converges": {
+			spec: experimentsv1beta1.ExperimentSpec{
+				Convergence: &experimentsv1beta1.ConvergenceSpec{Patience: 3},
+			},
+			status: experimentsv1beta1.ExperimentStatus{
+				TrialsSucceeded: 3,
+				Convergence:     &experimentsv1beta1.ConvergenceStatus{TrialsWithoutImprovement: 2},
+			},
+			wantReason: ExperimentRunningReason,
+		},
+		"Experiment is succeeded if the objective value converges": {
+			spec: experimentsv1beta1.ExperimentSpec{
+				Convergence: &experimentsv1beta1.ConvergenceSpec{Patience: 3},
+			},
+			status: experimentsv1beta1.ExperimentStatus{
+				TrialsSucceeded: 4,
+				Convergence:     &experimentsv1beta1.ConvergenceStatus{TrialsWithoutImprovement: 3},
+			},
+			wantReason: ExperimentConvergedReason,
+		},
+		"Experiment is suspended": {
+			spec: experimentsv1beta1.ExperimentSpec{
+				Suspend: ptr.To(true),
+			},
+			status: experimentsv1beta1.ExperimentStatus{
+				TrialsRunning: 1,
+			},
+			wantReason: ExperimentSuspendedReason,
+		},
+		"Experiment is running once it is resumed": {
+			spec: experimentsv1beta1.ExperimentSpec{
+				Suspend: ptr.To(false),
+			},
+			status: experimentsv1beta1.ExperimentStatus{
+				Conditions: []experimentsv1beta1.ExperimentCondition{
+					{
+						Type:   experimentsv1beta1.ExperimentSuspended,
+						Status: corev1.ConditionTrue,
+						Reason: ExperimentSuspendedReason,
+					},
+				},
+				TrialsSucceeded: 2,
+			},
+			wantReason: ExperimentRunningReason,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			instance := &experimentsv1beta1.Experiment{
+				Spec:   tc.spec,
+				Status: tc.status,
+			}
+			instance.Spec.MaxTrialCount = ptr.To[int32](10)
+			UpdateExperimentStatusCondition(NewExpsCollector(nil, prometheus.NewRegistry()), instance, false, tc.suggestionExhausted)
+			condition, err := instance.GetLastConditionType()
+			if err != nil {
+				t.Fatalf("Failed to get Experiment condition: %v", err)
+			}
+			gotReason := ""
+			for _, c := range instance.Status.Conditions {
+				if c.Type == condition {
+					gotReason = c.Reason
+				}
+			}
+			if tc.wantReason != gotReason {
+				t.Errorf("Unexpected Experiment condition reason, want %s, got %s", tc.wantReason, gotReason)
+			}
+		})
+	}
+}
+
+func newFakeTrial(name string, metrics map[string]string) trialsv1beta1.Trial {
+	trial := trialsv1beta1.Trial{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Status: trialsv1beta1.TrialStatus{
+			Conditions: []trialsv1beta1.TrialCondition{
+				{
+					Type:   trialsv1beta1.TrialSucceeded,
+					Status: "True",
+				},
+			},
+			Observation: &commonv1beta1.Observation{},
+		},
+	}
+	for metricName, value := range metrics {
+		trial.Status.Observation.Metrics = append(trial.Status.Observation.Metrics, commonv1beta1.Metric{
+			Name:   metricName,
+			Min:    value,
+			Max:    value,
+			Latest: value,
+		})
+	}
+	return trial
+}
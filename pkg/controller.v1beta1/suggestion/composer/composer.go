@@ -26,6 +26,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
@@ -98,6 +99,7 @@ func (g *General) DesiredDeployment(s *suggestionsv1beta1.Suggestion) (*appsv1.D
 			Annotations: s.Annotations,
 		},
 		Spec: appsv1.DeploymentSpec{
+			Replicas: ptr.To[int32](1),
 			Selector: &metav1.LabelSelector{
 				MatchLabels: util.SuggestionLabels(s),
 			},
@@ -113,6 +115,11 @@ func (g *General) DesiredDeployment(s *suggestionsv1beta1.Suggestion) (*appsv1.D
 		},
 	}
 
+	// Scale down the suggestion deployment while the Experiment is suspended
+	if s.Spec.Suspend {
+		d.Spec.Replicas = ptr.To[int32](0)
+	}
+
 	// Get Suggestion Service Account Name from config
 	if suggestionConfigData.ServiceAccountName != "" {
 		d.Spec.Template.Spec.ServiceAccountName = suggestionConfigData.ServiceAccountName
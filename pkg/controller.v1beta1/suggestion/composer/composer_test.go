@@ -41,6 +41,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/rest"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/envtest"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
@@ -231,6 +232,21 @@ func TestDesiredDeployment(t *testing.T) {
 			err:             false,
 			testDescription: "Desired Deployment valid run with default serviceAccount",
 		},
+		{
+			suggestion: func() *suggestionsv1beta1.Suggestion {
+				s := newFakeSuggestion()
+				s.Spec.Suspend = true
+				return s
+			}(),
+			configMap: newFakeKatibConfig(newFakeSuggestionConfig(), newFakeEarlyStoppingConfig()),
+			expectedDeployment: func() *appsv1.Deployment {
+				deploy := newFakeDeployment()
+				deploy.Spec.Replicas = ptr.To[int32](0)
+				return deploy
+			}(),
+			err:             false,
+			testDescription: "Desired Deployment is scaled down for suspended Suggestion",
+		},
 		{
 			suggestion: newFakeSuggestion(),
 			configMap: func() *corev1.ConfigMap {
@@ -760,6 +776,7 @@ func newFakeDeployment() *appsv1.Deployment {
 			},
 		},
 		Spec: appsv1.DeploymentSpec{
+			Replicas: ptr.To[int32](1),
 			Selector: &metav1.LabelSelector{
 				MatchLabels: deploymentLabels,
 			},
@@ -242,6 +242,11 @@ func (r *ReconcileSuggestion) ReconcileSuggestion(instance *suggestionsv1beta1.S
 
 	if foundDeploy, err := r.reconcileDeployment(deploy, suggestionNsName); err != nil {
 		return err
+	} else if instance.Spec.Suspend {
+		// Assignments are not synced until the Experiment is resumed.
+		msg := "Deployment is scaled down because the Experiment is suspended"
+		instance.MarkSuggestionStatusDeploymentReady(corev1.ConditionFalse, SuggestionSuspendedReason, msg)
+		return nil
 	} else {
 		if !r.checkDeploymentReady(foundDeploy) {
 			// deployment is not ready yet
@@ -291,7 +296,11 @@ func (r *ReconcileSuggestion) ReconcileSuggestion(instance *suggestionsv1beta1.S
 	}
 	logger.Info("Sync assignments", "Suggestion Requests", instance.Spec.Requests,
 		"Suggestion Count", instance.Status.SuggestionCount)
-	if err = r.SyncAssignments(instance, experiment, trials.Items); err != nil {
+	priorTrials, err := r.getPriorTrials(experiment)
+	if err != nil {
+		return err
+	}
+	if err = r.SyncAssignments(instance, experiment, trials.Items, priorTrials); err != nil {
 		return err
 	}
 
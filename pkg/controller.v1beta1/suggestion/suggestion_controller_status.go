@@ -27,6 +27,7 @@ const (
 	SuggestionCreatedReason      = "SuggestionCreated"
 	SuggestionDeploymentReady    = "DeploymentReady"
 	SuggestionDeploymentNotReady = "DeploymentNotReady"
+	SuggestionSuspendedReason    = "SuggestionSuspended"
 	SuggestionRunningReason      = "SuggestionRunning"
 	SuggestionFailedReason       = "SuggestionFailed"
 )
@@ -40,11 +41,19 @@ func (r *ReconcileSuggestion) updateStatus(s *suggestionsv1beta1.Suggestion, old
 	return nil
 }
 
+// updateStatusCondition updates only the conditions and the rejected suggestion and round counts,
+// since the rejected assignments must not be suggested again after the failed reconcile.
 func (r *ReconcileSuggestion) updateStatusCondition(s *suggestionsv1beta1.Suggestion, oldS *suggestionsv1beta1.Suggestion) error {
-	if !equality.Semantic.DeepEqual(s.Status.Conditions, oldS.Status.Conditions) {
+	if !equality.Semantic.DeepEqual(s.Status.Conditions, oldS.Status.Conditions) ||
+		s.Status.RejectedSuggestionCount != oldS.Status.RejectedSuggestionCount ||
+		s.Status.RejectedRoundCount != oldS.Status.RejectedRoundCount {
 		newConditions := s.Status.Conditions
+		rejectedSuggestionCount := s.Status.RejectedSuggestionCount
+		rejectedRoundCount := s.Status.RejectedRoundCount
 		s.Status = oldS.Status
 		s.Status.Conditions = newConditions
+		s.Status.RejectedSuggestionCount = rejectedSuggestionCount
+		s.Status.RejectedRoundCount = rejectedRoundCount
 		if err := r.Status().Update(context.TODO(), s); err != nil {
 			return err
 		}
@@ -125,7 +125,7 @@ func TestReconcile(t *testing.T) {
 			}
 			return nil
 		}).AnyTimes()
-	mockSuggestionClient.EXPECT().SyncAssignments(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	mockSuggestionClient.EXPECT().SyncAssignments(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 
 	instance := newFakeInstance()
 
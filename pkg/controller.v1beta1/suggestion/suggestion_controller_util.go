@@ -18,14 +18,21 @@ package suggestion
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	experimentsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1"
 	"github.com/kubeflow/katib/pkg/apis/controller/suggestions/v1beta1"
+	trialsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1"
+	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
 )
 
 func (r *ReconcileSuggestion) reconcileDeployment(deploy *appsv1.Deployment, suggestionNsName types.NamespacedName) (*appsv1.Deployment, error) {
@@ -39,6 +46,14 @@ func (r *ReconcileSuggestion) reconcileDeployment(deploy *appsv1.Deployment, sug
 	} else if err != nil {
 		return nil, err
 	}
+	// Replicas are changed when the Experiment is suspended or resumed.
+	if !equality.Semantic.DeepEqual(foundDeploy.Spec.Replicas, deploy.Spec.Replicas) {
+		logger.Info("Updating Deployment replicas", "name", deploy.Name, "replicas", deploy.Spec.Replicas)
+		foundDeploy.Spec.Replicas = deploy.Spec.Replicas
+		if err = r.Update(context.TODO(), foundDeploy); err != nil {
+			return nil, err
+		}
+	}
 	return foundDeploy, nil
 }
 
@@ -190,3 +205,79 @@ func (r *ReconcileSuggestion) deleteService(instance *v1beta1.Suggestion, sugges
 
 	return nil
 }
+
+// getPriorTrials returns the succeeded Trials from the warm-start sources of the Experiment.
+// Trials which don't match the Experiment search space or don't report the objective metrics are skipped.
+func (r *ReconcileSuggestion) getPriorTrials(experiment *experimentsv1beta1.Experiment) ([]trialsv1beta1.Trial, error) {
+	warmStart := experiment.Spec.WarmStart
+	if warmStart == nil {
+		return nil, nil
+	}
+
+	var trials []trialsv1beta1.Trial
+	for _, name := range warmStart.SourceExperiments {
+		sourceTrials := &trialsv1beta1.TrialList{}
+		if err := r.List(context.TODO(), sourceTrials, client.InNamespace(experiment.Namespace),
+			client.MatchingLabels{consts.LabelExperimentName: name}); err != nil {
+			return nil, err
+		}
+		trials = append(trials, sourceTrials.Items...)
+	}
+	if source := warmStart.TrialsConfigMap; source != nil {
+		configMapNS := source.ConfigMapNamespace
+		if configMapNS == "" {
+			configMapNS = experiment.Namespace
+		}
+		configMap := &corev1.ConfigMap{}
+		if err := r.Get(context.TODO(), types.NamespacedName{Name: source.ConfigMapName, Namespace: configMapNS}, configMap); err != nil {
+			return nil, err
+		}
+		data, ok := configMap.Data[source.TrialsPath]
+		if !ok {
+			return nil, fmt.Errorf("unable to find Trials in ConfigMap %s/%s, TrialsPath: %v", configMapNS, source.ConfigMapName, source.TrialsPath)
+		}
+		exportedTrials := &trialsv1beta1.TrialList{}
+		if err := json.Unmarshal([]byte(data), exportedTrials); err != nil {
+			return nil, fmt.Errorf("failed to parse Trials in ConfigMap %s/%s: %w", configMapNS, source.ConfigMapName, err)
+		}
+		trials = append(trials, exportedTrials.Items...)
+	}
+
+	priorTrials := make([]trialsv1beta1.Trial, 0, len(trials))
+	names := make(map[string]bool, len(trials))
+	for i := range trials {
+		if names[trials[i].Name] || !isPriorTrial(experiment, &trials[i]) {
+			continue
+		}
+		names[trials[i].Name] = true
+		trial := trials[i].DeepCopy()
+		// Prior Trials are evaluated by the Experiment objective, since the source objective may differ.
+		trial.Spec.Objective = experiment.Spec.Objective.DeepCopy()
+		priorTrials = append(priorTrials, *trial)
+	}
+	return priorTrials, nil
+}
+
+// isPriorTrial returns true if the Trial can be used as the prior history of the Experiment.
+func isPriorTrial(experiment *experimentsv1beta1.Experiment, trial *trialsv1beta1.Trial) bool {
+	if !trial.IsSucceeded() || trial.Status.Observation == nil || experiment.Spec.Objective == nil {
+		return false
+	}
+	metricNames := []string{experiment.Spec.Objective.ObjectiveMetricName}
+	for _, o := range experiment.Spec.Objective.AdditionalObjectives {
+		metricNames = append(metricNames, o.ObjectiveMetricName)
+	}
+	for _, name := range metricNames {
+		found := false
+		for _, m := range trial.Status.Observation.Metrics {
+			if m.Name == name && m.Latest != consts.UnavailableMetricValue {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return experiment.IsFeasibleParameterAssignments(trial.Spec.ParameterAssignments)
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2022 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package suggestionclient
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+
+	common "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1"
+	experimentsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1"
+	suggestionsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/suggestions/v1beta1"
+)
+
+// duplicateDetector finds the earlier suggestion with the same parameter assignments.
+type duplicateDetector struct {
+	// Parameter name -> maximum difference of the same values. It is set only for int and double parameters.
+	tolerances  map[string]float64
+	suggestions []suggestionsv1beta1.TrialAssignment
+}
+
+// newDuplicateDetector returns the detector of the duplicated assignments for the earlier suggestions.
+// It returns nil if the Experiment doesn't detect the duplicated assignments.
+func newDuplicateDetector(e *experimentsv1beta1.Experiment, suggestions []suggestionsv1beta1.TrialAssignment) (*duplicateDetector, error) {
+	if e.Spec.DuplicateSuggestion == nil {
+		return nil, nil
+	}
+	tolerance := 0.0
+	if e.Spec.DuplicateSuggestion.Tolerance != nil {
+		tolerance = *e.Spec.DuplicateSuggestion.Tolerance
+	}
+	tolerances := make(map[string]float64, len(e.Spec.Parameters))
+	for _, p := range e.Spec.Parameters {
+		if p.ParameterType != experimentsv1beta1.ParameterTypeInt && p.ParameterType != experimentsv1beta1.ParameterTypeDouble {
+			continue
+		}
+		min, err := strconv.ParseFloat(p.FeasibleSpace.Min, 64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse min of parameter %s: %w", p.Name, err)
+		}
+		max, err := strconv.ParseFloat(p.FeasibleSpace.Max, 64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse max of parameter %s: %w", p.Name, err)
+		}
+		tolerances[p.Name] = tolerance * (max - min)
+	}
+	return &duplicateDetector{
+		tolerances:  tolerances,
+		suggestions: append([]suggestionsv1beta1.TrialAssignment{}, suggestions...),
+	}, nil
+}
+
+// find returns the name of the earlier suggestion with the same assignments and budget.
+// If the earlier suggestion is the duplicate itself, the name of the original suggestion is returned.
+func (d *duplicateDetector) find(suggestion suggestionsv1beta1.TrialAssignment) (string, bool) {
+	for _, s := range d.suggestions {
+		if s.Budget == suggestion.Budget && d.isSame(s.ParameterAssignments, suggestion.ParameterAssignments) {
+			if s.DuplicateOf != "" {
+				return s.DuplicateOf, true
+			}
+			return s.Name, true
+		}
+	}
+	return "", false
+}
+
+// add adds the suggestion, so the following assignments are compared with it.
+func (d *duplicateDetector) add(suggestion suggestionsv1beta1.TrialAssignment) {
+	d.suggestions = append(d.suggestions, suggestion)
+}
+
+// isSame returns true if both assignments have the same parameters and all values are the same.
+func (d *duplicateDetector) isSame(a, b []common.ParameterAssignment) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	values := make(map[string]string, len(a))
+	for _, pa := range a {
+		values[pa.Name] = pa.Value
+	}
+	for _, pa := range b {
+		value, ok := values[pa.Name]
+		if !ok || !d.isSameValue(pa.Name, value, pa.Value) {
+			return false
+		}
+	}
+	return true
+}
+
+func (d *duplicateDetector) isSameValue(name, a, b string) bool {
+	if a == b {
+		return true
+	}
+	tolerance, ok := d.tolerances[name]
+	if !ok {
+		return false
+	}
+	x, err := strconv.ParseFloat(a, 64)
+	if err != nil {
+		return false
+	}
+	y, err := strconv.ParseFloat(b, 64)
+	if err != nil {
+		return false
+	}
+	return math.Abs(x-y) <= tolerance
+}
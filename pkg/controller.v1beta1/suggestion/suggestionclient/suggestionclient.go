@@ -39,6 +39,7 @@ import (
 	katibmanagerv1beta1 "github.com/kubeflow/katib/pkg/common/v1beta1"
 	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
 	"github.com/kubeflow/katib/pkg/controller.v1beta1/util"
+	"github.com/kubeflow/katib/pkg/util/v1beta1/constraint"
 )
 
 var (
@@ -63,7 +64,7 @@ var (
 // SuggestionClient is the interface to communicate with algorithm services.
 type SuggestionClient interface {
 	SyncAssignments(instance *suggestionsv1beta1.Suggestion, e *experimentsv1beta1.Experiment,
-		ts []trialsv1beta1.Trial) error
+		ts []trialsv1beta1.Trial, priorTrials []trialsv1beta1.Trial) error
 
 	ValidateAlgorithmSettings(instance *suggestionsv1beta1.Suggestion, e *experimentsv1beta1.Experiment) error
 	ValidateEarlyStoppingSettings(instance *suggestionsv1beta1.Suggestion, e *experimentsv1beta1.Experiment) error
@@ -79,14 +80,16 @@ func New() SuggestionClient {
 }
 
 // SyncAssignments syncs assignments from Suggestion and EarlyStopping service.
-// If early stopping is set, we call GetEarlyStoppingRules after GetSuggestions
+// If early stopping is set, we call GetEarlyStoppingRules after GetSuggestions.
+// Prior Trials to warm-start the Experiment are sent to the Suggestion service together with the Experiment Trials.
 func (g *General) SyncAssignments(
 	instance *suggestionsv1beta1.Suggestion,
 	e *experimentsv1beta1.Experiment,
-	ts []trialsv1beta1.Trial) error {
+	ts []trialsv1beta1.Trial,
+	priorTrials []trialsv1beta1.Trial) error {
 	logger := log.WithValues("Suggestion", types.NamespacedName{Name: instance.GetName(), Namespace: instance.GetNamespace()})
 	currentRequestNum := int(instance.Spec.Requests) - int(instance.Status.SuggestionCount)
-	if currentRequestNum <= 0 {
+	if currentRequestNum <= 0 || instance.IsExhausted() {
 		return nil
 	}
 
@@ -108,23 +111,111 @@ func (g *General) SyncAssignments(
 	appendAlgorithmSettingsFromSuggestion(filledE,
 		instance.Status.AlgorithmSettings)
 
-	requestSuggestion := &suggestionapi.GetSuggestionsRequest{
-		Experiment:           g.ConvertExperiment(filledE),
-		Trials:               g.ConvertTrials(ts),
-		CurrentRequestNumber: int32(currentRequestNum),
-		TotalRequestNumber:   int32(instance.Spec.Requests),
+	constraints, err := constraint.CompileAll(e.Spec.ParameterConstraints)
+	if err != nil {
+		return err
 	}
 
-	// Get new suggestions
-	responseSuggestion, err := rpcClientSuggestion.GetSuggestions(ctx, requestSuggestion)
+	// Prior Trials are sent as the history, so the algorithm doesn't start from scratch.
+	trials := append(g.ConvertTrials(priorTrials), g.ConvertTrials(ts)...)
+
+	duplicates, err := newDuplicateDetector(e, instance.Status.Suggestions)
 	if err != nil {
 		return err
 	}
-	logger.Info("Getting suggestions", "endpoint", endpoint, "Number of current request parameters", currentRequestNum, "Number of response parameters", len(responseSuggestion.ParameterAssignments))
-	if len(responseSuggestion.ParameterAssignments) != currentRequestNum {
-		err := fmt.Errorf("The response contains unexpected trials")
-		logger.Error(err, "The response contains unexpected trials")
-		return err
+
+	// Assignments which violate the parameter constraints are dropped and requested again.
+	// Duplicated assignments are dropped too if the Experiment resamples them.
+	var responseSuggestion *suggestionapi.GetSuggestionsReply
+	var trialAssignments []suggestionsv1beta1.TrialAssignment
+	requestedNum := 0
+	exhausted := false
+	for attempt := 0; attempt < consts.DefaultConstraintResampleAttempts && len(trialAssignments) < currentRequestNum && !exhausted; attempt++ {
+		requestNum := currentRequestNum - len(trialAssignments)
+		requestSuggestion := &suggestionapi.GetSuggestionsRequest{
+			Experiment:           g.ConvertExperiment(filledE),
+			Trials:               trials,
+			CurrentRequestNumber: int32(requestNum),
+			// Rejected assignments of the earlier reconciles are counted too, so algorithms like grid don't suggest them again.
+			TotalRequestNumber: instance.Status.SuggestionCount + instance.Status.RejectedSuggestionCount + int32(len(trialAssignments)+requestNum),
+		}
+
+		// Get new suggestions
+		responseSuggestion, err = rpcClientSuggestion.GetSuggestions(ctx, requestSuggestion)
+		if err != nil {
+			return err
+		}
+		logger.Info("Getting suggestions", "endpoint", endpoint, "Number of current request parameters", requestNum, "Number of response parameters", len(responseSuggestion.ParameterAssignments))
+		// The algorithm which has exhausted the search space returns the remaining assignments.
+		exhausted = responseSuggestion.SearchSpaceExhausted
+		if len(responseSuggestion.ParameterAssignments) != requestNum && !(exhausted && len(responseSuggestion.ParameterAssignments) < requestNum) {
+			err := fmt.Errorf("The response contains unexpected trials")
+			logger.Error(err, "The response contains unexpected trials")
+			return err
+		}
+		requestedNum += requestNum
+
+		for _, t := range responseSuggestion.ParameterAssignments {
+			// Algorithms which don't support conditional parameters assign all of them,
+			// so inactive parameters are removed before the Trial is created.
+			// Inactive conditional parameters are not checked, since they are not assigned to the Trial.
+			assignment := suggestionsv1beta1.TrialAssignment{
+				Name:                 t.TrialName,
+				ParameterAssignments: e.GetActiveParameterAssignments(composeParameterAssignments(t.Assignments)),
+				Labels:               t.Labels,
+				Budget:               t.Budget,
+			}
+			if assignment.Name == "" {
+				assignment.Name = fmt.Sprintf("%s-%s", instance.Name, utilrand.String(8))
+			}
+			ok, violated, err := isSatisfiedConstraints(constraints, assignment.ParameterAssignments)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				logger.Info("Parameter assignments violate constraint", "constraint", violated, "assignments", t.Assignments)
+				instance.Status.RejectedSuggestionCount++
+				continue
+			}
+			if duplicates != nil {
+				if name, found := duplicates.find(assignment); found {
+					if e.Spec.DuplicateSuggestion.Policy == experimentsv1beta1.ReuseDuplicate {
+						logger.Info("Parameter assignments are duplicated, observation is reused", "duplicateOf", name, "assignments", t.Assignments)
+						assignment.DuplicateOf = name
+					} else {
+						logger.Info("Parameter assignments are duplicated", "duplicateOf", name, "assignments", t.Assignments)
+						instance.Status.RejectedSuggestionCount++
+						continue
+					}
+				}
+				duplicates.add(assignment)
+			}
+			trialAssignments = append(trialAssignments, assignment)
+		}
+	}
+	if exhausted {
+		msg := "Suggestion has exhausted the search space"
+		instance.MarkSuggestionStatusExhausted(suggestionsv1beta1.SuggestionExhaustedReason, msg)
+		logger.Info(msg, "Number of valid parameters", len(trialAssignments))
+	} else if len(trialAssignments) == 0 {
+		// Only the algorithm knows whether the search space is exhausted, so the round of the duplicated
+		// assignments, e.g. random with the small categorical space, is resampled at the next reconcile.
+		// Suggestion is exhausted after the limit, since such algorithm never reports the exhausted search space.
+		instance.Status.RejectedRoundCount++
+		if instance.Status.RejectedRoundCount < consts.DefaultRejectedRoundsLimit {
+			return fmt.Errorf("all %d suggested parameter assignments violate the parameter constraints or are duplicated", requestedNum)
+		}
+		msg := fmt.Sprintf("Suggestion has exhausted the search space, all suggested parameter assignments "+
+			"violate the parameter constraints or are duplicated in %d consecutive rounds", instance.Status.RejectedRoundCount)
+		instance.MarkSuggestionStatusExhausted(suggestionsv1beta1.SuggestionExhaustedReason, msg)
+		logger.Info(msg)
+		return nil
+	}
+	instance.Status.RejectedRoundCount = 0
+	// Remaining assignments are requested at the next reconcile.
+	if len(trialAssignments) < currentRequestNum && !exhausted {
+		logger.Info("Not enough parameter assignments satisfy the parameter constraints",
+			"Number of current request parameters", currentRequestNum, "Number of valid parameters", len(trialAssignments))
 	}
 
 	earlyStoppingRules := []commonapiv1beta1.EarlyStoppingRule{}
@@ -168,24 +259,8 @@ func (g *General) SyncAssignments(
 		}
 	}
 
-	trialAssignments := []suggestionsv1beta1.TrialAssignment{}
-	for _, t := range responseSuggestion.ParameterAssignments {
-		var trialName string
-		if t.TrialName != "" {
-			trialName = t.TrialName
-		} else {
-			trialName = fmt.Sprintf("%s-%s", instance.Name, utilrand.String(8))
-		}
-
-		assignment := suggestionsv1beta1.TrialAssignment{
-			Name:                 trialName,
-			ParameterAssignments: composeParameterAssignments(t.Assignments),
-			EarlyStoppingRules:   earlyStoppingRules,
-		}
-		if t.Labels != nil {
-			assignment.Labels = t.Labels
-		}
-		trialAssignments = append(trialAssignments, assignment)
+	for i := range trialAssignments {
+		trialAssignments[i].EarlyStoppingRules = earlyStoppingRules
 	}
 
 	instance.Status.Suggestions = append(instance.Status.Suggestions, trialAssignments...)
@@ -197,6 +272,18 @@ func (g *General) SyncAssignments(
 	return nil
 }
 
+// isSatisfiedConstraints checks the assignments against the parameter constraints.
+func isSatisfiedConstraints(constraints []*constraint.Constraint, assignments []commonapiv1beta1.ParameterAssignment) (bool, string, error) {
+	if len(constraints) == 0 {
+		return true, "", nil
+	}
+	assignmentsMap := make(map[string]string, len(assignments))
+	for _, a := range assignments {
+		assignmentsMap[a.Name] = a.Value
+	}
+	return constraint.IsSatisfiedAll(constraints, assignmentsMap)
+}
+
 // ValidateAlgorithmSettings validates if the algorithm specific configurations are valid.
 func (g *General) ValidateAlgorithmSettings(instance *suggestionsv1beta1.Suggestion, e *experimentsv1beta1.Experiment) error {
 	logger := log.WithValues("Suggestion", types.NamespacedName{Name: instance.GetName(), Namespace: instance.GetNamespace()})
@@ -306,10 +393,12 @@ func (g *General) ConvertExperiment(e *experimentsv1beta1.Experiment) *suggestio
 			Type:                  convertObjectiveType(e.Spec.Objective.Type),
 			ObjectiveMetricName:   e.Spec.Objective.ObjectiveMetricName,
 			AdditionalMetricNames: e.Spec.Objective.AdditionalMetricNames,
+			AdditionalObjectives:  convertAdditionalObjectives(e.Spec.Objective.AdditionalObjectives),
 		},
 		ParameterSpecs: &suggestionapi.ExperimentSpec_ParameterSpecs{
 			Parameters: convertParameters(e.Spec.Parameters),
 		},
+		ParameterConstraints: e.Spec.ParameterConstraints,
 	}
 	// Set Goal if user defines it in Objective
 	if e.Spec.Objective.Goal != nil {
@@ -353,9 +442,11 @@ func (g *General) ConvertTrials(ts []trialsv1beta1.Trial) []*suggestionapi.Trial
 					Type:                  convertObjectiveType(t.Spec.Objective.Type),
 					ObjectiveMetricName:   t.Spec.Objective.ObjectiveMetricName,
 					AdditionalMetricNames: t.Spec.Objective.AdditionalMetricNames,
+					AdditionalObjectives:  convertAdditionalObjectives(t.Spec.Objective.AdditionalObjectives),
 				},
 				ParameterAssignments: convertTrialParameterAssignments(
 					t.Spec.ParameterAssignments),
+				Budget: t.Spec.Budget,
 			},
 			Status: &suggestionapi.TrialStatus{
 				StartTime:      convertTrialStatusTime(t.Status.StartTime),
@@ -482,6 +573,17 @@ func convertObjectiveType(typ commonapiv1beta1.ObjectiveType) suggestionapi.Obje
 	}
 }
 
+func convertAdditionalObjectives(objectives []commonapiv1beta1.AdditionalObjective) []*suggestionapi.AdditionalObjective {
+	res := make([]*suggestionapi.AdditionalObjective, 0, len(objectives))
+	for _, o := range objectives {
+		res = append(res, &suggestionapi.AdditionalObjective{
+			Type:                convertObjectiveType(o.Type),
+			ObjectiveMetricName: o.ObjectiveMetricName,
+		})
+	}
+	return res
+}
+
 func convertAlgorithmSettings(as []commonapiv1beta1.AlgorithmSetting) []*suggestionapi.AlgorithmSetting {
 	res := make([]*suggestionapi.AlgorithmSetting, 0)
 	for _, s := range as {
@@ -511,11 +613,22 @@ func convertParameters(ps []experimentsv1beta1.ParameterSpec) []*suggestionapi.P
 			Name:          p.Name,
 			ParameterType: convertParameterType(p.ParameterType),
 			FeasibleSpace: convertFeasibleSpace(p.FeasibleSpace),
+			Condition:     convertParameterCondition(p.Condition),
 		})
 	}
 	return res
 }
 
+func convertParameterCondition(c *experimentsv1beta1.ParameterCondition) *suggestionapi.ParameterCondition {
+	if c == nil {
+		return nil
+	}
+	return &suggestionapi.ParameterCondition{
+		Parent: c.Parent,
+		Values: c.Values,
+	}
+}
+
 func convertParameterType(typ experimentsv1beta1.ParameterType) suggestionapi.ParameterType {
 	switch typ {
 	case experimentsv1beta1.ParameterTypeDiscrete:
@@ -17,6 +17,7 @@ limitations under the License.
 package suggestionclient
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"reflect"
@@ -244,7 +245,7 @@ func TestSyncAssignments(t *testing.T) {
 		},
 	}
 	for _, tc := range tcs {
-		err := suggestionClient.SyncAssignments(tc.suggestion, tc.experiment, tc.trials)
+		err := suggestionClient.SyncAssignments(tc.suggestion, tc.experiment, tc.trials, nil)
 		if !tc.err && err != nil {
 			t.Errorf("Case: %v failed. Expected nil, got %v", tc.testDescription, err)
 		} else if tc.err && err == nil {
@@ -253,6 +254,481 @@ func TestSyncAssignments(t *testing.T) {
 	}
 }
 
+func TestSyncAssignmentsWithParameterConstraints(t *testing.T) {
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	rpcClientSuggestion := suggestionapimock.NewMockSuggestionClient(mockCtrl)
+	getRPCClientSuggestion = func(conn *grpc.ClientConn) suggestionapi.SuggestionClient {
+		return rpcClientSuggestion
+	}
+
+	suggestionClient := New()
+
+	newReply := func(param1Values ...string) *suggestionapi.GetSuggestionsReply {
+		reply := &suggestionapi.GetSuggestionsReply{}
+		for _, v := range param1Values {
+			reply.ParameterAssignments = append(reply.ParameterAssignments, &suggestionapi.GetSuggestionsReply_ParameterAssignments{
+				Assignments: []*suggestionapi.ParameterAssignment{
+					{
+						Name:  "param1-name",
+						Value: v,
+					},
+					{
+						Name:  "param2-name",
+						Value: "0.3",
+					},
+				},
+			})
+		}
+		return reply
+	}
+	requestNumberMatcher := func(current, total int32) gomock.Matcher {
+		return gomock.Cond(func(x any) bool {
+			req := x.(*suggestionapi.GetSuggestionsRequest)
+			return req.CurrentRequestNumber == current && req.TotalRequestNumber == total
+		})
+	}
+
+	gomock.InOrder(
+		// Assignments which violate constraints are requested again.
+		rpcClientSuggestion.EXPECT().GetSuggestions(gomock.Any(), requestNumberMatcher(2, 6)).Return(newReply("1", "4"), nil),
+		rpcClientSuggestion.EXPECT().GetSuggestions(gomock.Any(), requestNumberMatcher(1, 7)).Return(newReply("2"), nil),
+		// All assignments violate constraints.
+		rpcClientSuggestion.EXPECT().GetSuggestions(gomock.Any(), gomock.Any()).Return(newReply("4", "4"), nil),
+		rpcClientSuggestion.EXPECT().GetSuggestions(gomock.Any(), gomock.Any()).Return(newReply("4", "4"), nil).Times(consts.DefaultConstraintResampleAttempts-1),
+	)
+
+	newSuggestion := func() *suggestionsv1beta1.Suggestion {
+		s := newFakeSuggestion()
+		s.Spec.EarlyStopping = nil
+		return s
+	}
+	experiment := newFakeExperiment()
+	experiment.Spec.ParameterConstraints = []string{`params["param1-name"] < 3`}
+
+	tcs := []struct {
+		suggestion      *suggestionsv1beta1.Suggestion
+		wantAssignments []string
+		err             bool
+		testDescription string
+	}{
+		{
+			suggestion:      newSuggestion(),
+			wantAssignments: []string{"1", "2"},
+			testDescription: "Assignments which violate constraints are resampled",
+		},
+		{
+			suggestion:      newSuggestion(),
+			err:             true,
+			testDescription: "All assignments violate constraints",
+		},
+	}
+	for _, tc := range tcs {
+		err := suggestionClient.SyncAssignments(tc.suggestion, experiment, newFakeTrials(), nil)
+		if !tc.err && err != nil {
+			t.Errorf("Case: %v failed. Expected nil, got %v", tc.testDescription, err)
+		} else if tc.err && err == nil {
+			t.Errorf("Case: %v failed. Expected err, got nil", tc.testDescription)
+		}
+		var gotAssignments []string
+		for _, s := range tc.suggestion.Status.Suggestions {
+			gotAssignments = append(gotAssignments, s.ParameterAssignments[0].Value)
+		}
+		if diff := cmp.Diff(tc.wantAssignments, gotAssignments); diff != "" {
+			t.Errorf("Case: %v failed. Unexpected difference (-want +got):\n%s", tc.testDescription, diff)
+		}
+	}
+}
+
+func TestSyncAssignmentsWithGridParameterConstraints(t *testing.T) {
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	rpcClientSuggestion := suggestionapimock.NewMockSuggestionClient(mockCtrl)
+	getRPCClientSuggestion = func(conn *grpc.ClientConn) suggestionapi.SuggestionClient {
+		return rpcClientSuggestion
+	}
+
+	suggestionClient := New()
+
+	// Grid algorithm suggests the points in order, starting from the number of the earlier requested points.
+	grid := []string{"1", "2", "3", "4", "5", "6", "7", "8", "9"}
+	rpcClientSuggestion.EXPECT().GetSuggestions(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *suggestionapi.GetSuggestionsRequest, _ ...grpc.CallOption) (*suggestionapi.GetSuggestionsReply, error) {
+			reply := &suggestionapi.GetSuggestionsReply{}
+			for i := req.TotalRequestNumber - req.CurrentRequestNumber; i < req.TotalRequestNumber; i++ {
+				reply.ParameterAssignments = append(reply.ParameterAssignments, &suggestionapi.GetSuggestionsReply_ParameterAssignments{
+					Assignments: []*suggestionapi.ParameterAssignment{
+						{
+							Name:  "param1-name",
+							Value: grid[i],
+						},
+					},
+				})
+			}
+			return reply, nil
+		}).AnyTimes()
+
+	experiment := newFakeExperiment()
+	// Points from 2 to 7 violate constraints, which needs more resample attempts than a single reconcile has.
+	experiment.Spec.ParameterConstraints = []string{`params["param1-name"] < 2 || params["param1-name"] > 7`}
+
+	suggestion := newFakeSuggestion()
+	suggestion.Spec.EarlyStopping = nil
+	suggestion.Status.SuggestionCount = 0
+
+	tcs := []struct {
+		requests        int32
+		wantAssignments []string
+		wantRejected    int32
+		err             bool
+		testDescription string
+	}{
+		{
+			requests:        1,
+			wantAssignments: []string{"1"},
+			testDescription: "First point satisfies constraints",
+		},
+		{
+			requests:        2,
+			wantAssignments: []string{"1"},
+			wantRejected:    consts.DefaultConstraintResampleAttempts,
+			err:             true,
+			testDescription: "All resampled points violate constraints",
+		},
+		{
+			requests:        2,
+			wantAssignments: []string{"1", "8"},
+			wantRejected:    6,
+			testDescription: "Rejected points of the earlier reconcile are not suggested again",
+		},
+	}
+	for _, tc := range tcs {
+		suggestion.Spec.Requests = tc.requests
+		err := suggestionClient.SyncAssignments(suggestion, experiment, newFakeTrials(), nil)
+		if !tc.err && err != nil {
+			t.Errorf("Case: %v failed. Expected nil, got %v", tc.testDescription, err)
+		} else if tc.err && err == nil {
+			t.Errorf("Case: %v failed. Expected err, got nil", tc.testDescription)
+		}
+		var gotAssignments []string
+		for _, s := range suggestion.Status.Suggestions {
+			gotAssignments = append(gotAssignments, s.ParameterAssignments[0].Value)
+		}
+		if diff := cmp.Diff(tc.wantAssignments, gotAssignments); diff != "" {
+			t.Errorf("Case: %v failed. Unexpected difference (-want +got):\n%s", tc.testDescription, diff)
+		}
+		if suggestion.Status.RejectedSuggestionCount != tc.wantRejected {
+			t.Errorf("Case: %v failed. Expected %d rejected suggestions, got %d", tc.testDescription, tc.wantRejected, suggestion.Status.RejectedSuggestionCount)
+		}
+	}
+}
+
+func TestSyncAssignmentsWithExhaustedSearchSpace(t *testing.T) {
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	rpcClientSuggestion := suggestionapimock.NewMockSuggestionClient(mockCtrl)
+	getRPCClientSuggestion = func(conn *grpc.ClientConn) suggestionapi.SuggestionClient {
+		return rpcClientSuggestion
+	}
+
+	suggestionClient := New()
+
+	reply := &suggestionapi.GetSuggestionsReply{
+		ParameterAssignments: []*suggestionapi.GetSuggestionsReply_ParameterAssignments{
+			{
+				Assignments: []*suggestionapi.ParameterAssignment{
+					{
+						Name:  "param1-name",
+						Value: "1",
+					},
+				},
+			},
+		},
+	}
+	exhaustedReply := proto.Clone(reply).(*suggestionapi.GetSuggestionsReply)
+	exhaustedReply.SearchSpaceExhausted = true
+
+	gomock.InOrder(
+		rpcClientSuggestion.EXPECT().GetSuggestions(gomock.Any(), gomock.Any()).Return(exhaustedReply, nil),
+		rpcClientSuggestion.EXPECT().GetSuggestions(gomock.Any(), gomock.Any()).Return(reply, nil),
+	)
+
+	newSuggestion := func(exhausted bool) *suggestionsv1beta1.Suggestion {
+		s := newFakeSuggestion()
+		s.Spec.EarlyStopping = nil
+		if exhausted {
+			s.MarkSuggestionStatusExhausted(suggestionsv1beta1.SuggestionExhaustedReason, "Suggestion has exhausted the search space")
+		}
+		return s
+	}
+
+	tcs := []struct {
+		suggestion      *suggestionsv1beta1.Suggestion
+		wantSuggestions int
+		wantExhausted   bool
+		err             bool
+		testDescription string
+	}{
+		{
+			suggestion:      newSuggestion(false),
+			wantSuggestions: 1,
+			wantExhausted:   true,
+			testDescription: "Fewer assignments are accepted if the search space is exhausted",
+		},
+		{
+			suggestion:      newSuggestion(true),
+			wantExhausted:   true,
+			testDescription: "Exhausted Suggestion doesn't request assignments",
+		},
+		{
+			suggestion:      newSuggestion(false),
+			err:             true,
+			testDescription: "Fewer assignments are unexpected if the search space is not exhausted",
+		},
+	}
+	for _, tc := range tcs {
+		err := suggestionClient.SyncAssignments(tc.suggestion, newFakeExperiment(), newFakeTrials(), nil)
+		if !tc.err && err != nil {
+			t.Errorf("Case: %v failed. Expected nil, got %v", tc.testDescription, err)
+		} else if tc.err && err == nil {
+			t.Errorf("Case: %v failed. Expected err, got nil", tc.testDescription)
+		}
+		if len(tc.suggestion.Status.Suggestions) != tc.wantSuggestions {
+			t.Errorf("Case: %v failed. Expected %d suggestions, got %d", tc.testDescription, tc.wantSuggestions, len(tc.suggestion.Status.Suggestions))
+		}
+		if tc.suggestion.IsExhausted() != tc.wantExhausted {
+			t.Errorf("Case: %v failed. Expected exhausted %v, got %v", tc.testDescription, tc.wantExhausted, tc.suggestion.IsExhausted())
+		}
+	}
+}
+
+func TestSyncAssignmentsWithDuplicateSuggestion(t *testing.T) {
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	rpcClientSuggestion := suggestionapimock.NewMockSuggestionClient(mockCtrl)
+	getRPCClientSuggestion = func(conn *grpc.ClientConn) suggestionapi.SuggestionClient {
+		return rpcClientSuggestion
+	}
+
+	suggestionClient := New()
+
+	newReply := func(param1Values ...string) *suggestionapi.GetSuggestionsReply {
+		reply := &suggestionapi.GetSuggestionsReply{}
+		for _, v := range param1Values {
+			reply.ParameterAssignments = append(reply.ParameterAssignments, &suggestionapi.GetSuggestionsReply_ParameterAssignments{
+				Assignments: []*suggestionapi.ParameterAssignment{
+					{
+						Name:  "param1-name",
+						Value: v,
+					},
+					{
+						Name:  "param2-name",
+						Value: "0.3",
+					},
+				},
+			})
+		}
+		return reply
+	}
+
+	gomock.InOrder(
+		// Duplicated assignments are requested again.
+		rpcClientSuggestion.EXPECT().GetSuggestions(gomock.Any(), gomock.Any()).Return(newReply("1", "2"), nil),
+		rpcClientSuggestion.EXPECT().GetSuggestions(gomock.Any(), gomock.Any()).Return(newReply("3"), nil),
+		// Duplicated assignments reuse the observation.
+		rpcClientSuggestion.EXPECT().GetSuggestions(gomock.Any(), gomock.Any()).Return(newReply("1", "1"), nil),
+		// Assignments within the tolerance are duplicated.
+		rpcClientSuggestion.EXPECT().GetSuggestions(gomock.Any(), gomock.Any()).Return(newReply("2", "4"), nil),
+		// All assignments are duplicated.
+		rpcClientSuggestion.EXPECT().GetSuggestions(gomock.Any(), gomock.Any()).Return(newReply("1", "1"), nil).Times(consts.DefaultConstraintResampleAttempts),
+		// Duplicated assignments are resampled at the next reconcile.
+		rpcClientSuggestion.EXPECT().GetSuggestions(gomock.Any(), gomock.Any()).Return(newReply("2", "3"), nil),
+		// All assignments are duplicated in the consecutive rounds.
+		rpcClientSuggestion.EXPECT().GetSuggestions(gomock.Any(), gomock.Any()).Return(newReply("1", "1"), nil).Times(consts.DefaultConstraintResampleAttempts),
+	)
+
+	newSuggestion := func() *suggestionsv1beta1.Suggestion {
+		s := newFakeSuggestion()
+		s.Spec.EarlyStopping = nil
+		s.Status.Suggestions = []suggestionsv1beta1.TrialAssignment{
+			{
+				Name: "trial-name",
+				ParameterAssignments: []commonv1beta1.ParameterAssignment{
+					{
+						Name:  "param1-name",
+						Value: "1",
+					},
+					{
+						Name:  "param2-name",
+						Value: "0.3",
+					},
+				},
+			},
+		}
+		return s
+	}
+	newExperiment := func(policy experimentsv1beta1.DuplicateSuggestionPolicyType, tolerance *float64) *experimentsv1beta1.Experiment {
+		e := newFakeExperiment()
+		e.Spec.DuplicateSuggestion = &experimentsv1beta1.DuplicateSuggestionSpec{
+			Policy:    policy,
+			Tolerance: tolerance,
+		}
+		return e
+	}
+	tolerance := 0.25
+	duplicatedSuggestion := newSuggestion()
+	rejectedSuggestion := newSuggestion()
+	rejectedSuggestion.Status.RejectedRoundCount = consts.DefaultRejectedRoundsLimit - 1
+
+	type assignment struct {
+		Value       string
+		DuplicateOf string
+	}
+	tcs := []struct {
+		experiment             *experimentsv1beta1.Experiment
+		suggestion             *suggestionsv1beta1.Suggestion
+		wantAssignments        []assignment
+		wantExhausted          bool
+		wantRejectedRoundCount int32
+		err                    bool
+		testDescription        string
+	}{
+		{
+			experiment:      newExperiment(experimentsv1beta1.ResampleDuplicate, nil),
+			suggestion:      newSuggestion(),
+			wantAssignments: []assignment{{Value: "1"}, {Value: "2"}, {Value: "3"}},
+			testDescription: "Duplicated assignments are resampled",
+		},
+		{
+			experiment: newExperiment(experimentsv1beta1.ReuseDuplicate, nil),
+			suggestion: newSuggestion(),
+			wantAssignments: []assignment{
+				{Value: "1"},
+				{Value: "1", DuplicateOf: "trial-name"},
+				{Value: "1", DuplicateOf: "trial-name"},
+			},
+			testDescription: "Duplicated assignments reuse the observation of the original Trial",
+		},
+		{
+			experiment: newExperiment(experimentsv1beta1.ReuseDuplicate, &tolerance),
+			suggestion: newSuggestion(),
+			wantAssignments: []assignment{
+				{Value: "1"},
+				{Value: "2", DuplicateOf: "trial-name"},
+				{Value: "4"},
+			},
+			testDescription: "Assignments within the tolerance are duplicated",
+		},
+		{
+			experiment:             newExperiment(experimentsv1beta1.ResampleDuplicate, nil),
+			suggestion:             duplicatedSuggestion,
+			wantAssignments:        []assignment{{Value: "1"}},
+			wantRejectedRoundCount: 1,
+			err:                    true,
+			testDescription:        "Suggestion which returns only duplicated assignments is not exhausted",
+		},
+		{
+			experiment:      newExperiment(experimentsv1beta1.ResampleDuplicate, nil),
+			suggestion:      duplicatedSuggestion,
+			wantAssignments: []assignment{{Value: "1"}, {Value: "2"}, {Value: "3"}},
+			testDescription: "Duplicated assignments are resampled at the next reconcile",
+		},
+		{
+			experiment:             newExperiment(experimentsv1beta1.ResampleDuplicate, nil),
+			suggestion:             rejectedSuggestion,
+			wantAssignments:        []assignment{{Value: "1"}},
+			wantExhausted:          true,
+			wantRejectedRoundCount: consts.DefaultRejectedRoundsLimit,
+			testDescription:        "Suggestion is exhausted after the limit of rounds with only duplicated assignments",
+		},
+	}
+	for _, tc := range tcs {
+		err := suggestionClient.SyncAssignments(tc.suggestion, tc.experiment, newFakeTrials(), nil)
+		if !tc.err && err != nil {
+			t.Errorf("Case: %v failed. Expected nil, got %v", tc.testDescription, err)
+		} else if tc.err && err == nil {
+			t.Errorf("Case: %v failed. Expected err, got nil", tc.testDescription)
+		}
+		var gotAssignments []assignment
+		for _, s := range tc.suggestion.Status.Suggestions {
+			gotAssignments = append(gotAssignments, assignment{Value: s.ParameterAssignments[0].Value, DuplicateOf: s.DuplicateOf})
+		}
+		if diff := cmp.Diff(tc.wantAssignments, gotAssignments); diff != "" {
+			t.Errorf("Case: %v failed. Unexpected difference (-want +got):\n%s", tc.testDescription, diff)
+		}
+		if tc.suggestion.IsExhausted() != tc.wantExhausted {
+			t.Errorf("Case: %v failed. Expected exhausted %v, got %v", tc.testDescription, tc.wantExhausted, tc.suggestion.IsExhausted())
+		}
+		if tc.suggestion.Status.RejectedRoundCount != tc.wantRejectedRoundCount {
+			t.Errorf("Case: %v failed. Expected rejected round count %v, got %v", tc.testDescription, tc.wantRejectedRoundCount, tc.suggestion.Status.RejectedRoundCount)
+		}
+	}
+}
+
+func TestSyncAssignmentsWithPriorTrials(t *testing.T) {
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	rpcClientSuggestion := suggestionapimock.NewMockSuggestionClient(mockCtrl)
+	getRPCClientSuggestion = func(conn *grpc.ClientConn) suggestionapi.SuggestionClient {
+		return rpcClientSuggestion
+	}
+
+	suggestionClient := New()
+
+	priorTrials := newFakeTrials()[:1]
+	priorTrials[0].Name = "prior-trial-name"
+
+	// Prior Trials are sent before the Experiment Trials.
+	var wantTrialNames []string
+	for _, trial := range suggestionClient.(*General).ConvertTrials(append(priorTrials, newFakeTrials()...)) {
+		wantTrialNames = append(wantTrialNames, trial.Name)
+	}
+	var gotTrialNames []string
+	rpcClientSuggestion.EXPECT().GetSuggestions(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *suggestionapi.GetSuggestionsRequest, _ ...grpc.CallOption) (*suggestionapi.GetSuggestionsReply, error) {
+			for _, trial := range req.Trials {
+				gotTrialNames = append(gotTrialNames, trial.Name)
+			}
+			return &suggestionapi.GetSuggestionsReply{
+				ParameterAssignments: []*suggestionapi.GetSuggestionsReply_ParameterAssignments{
+					{
+						Assignments: []*suggestionapi.ParameterAssignment{
+							{
+								Name:  "param1-name",
+								Value: "3",
+							},
+						},
+					},
+				},
+			}, nil
+		})
+
+	suggestion := newFakeSuggestion()
+	suggestion.Spec.EarlyStopping = nil
+	suggestion.Spec.Requests = suggestion.Status.SuggestionCount + 1
+	suggestionCount := len(suggestion.Status.Suggestions)
+	if err := suggestionClient.SyncAssignments(suggestion, newFakeExperiment(), newFakeTrials(), priorTrials); err != nil {
+		t.Fatalf("Unexpected error from SyncAssignments: %v", err)
+	}
+	if diff := cmp.Diff(wantTrialNames, gotTrialNames); diff != "" {
+		t.Errorf("Unexpected Trials in the request (-want +got):\n%s", diff)
+	}
+	// Trials are created only for the new assignments.
+	if got := len(suggestion.Status.Suggestions) - suggestionCount; got != 1 {
+		t.Errorf("Expected 1 new suggestion, got %v", got)
+	}
+}
+
 func TestValidateAlgorithmSettings(t *testing.T) {
 
 	mockCtrl := gomock.NewController(t)
@@ -589,6 +1065,69 @@ func TestConvertFeasibleSpace(t *testing.T) {
 	}
 }
 
+func TestConvertParameters(t *testing.T) {
+
+	tcs := []struct {
+		inParameters       []experimentsv1beta1.ParameterSpec
+		expectedParameters []*suggestionapi.ParameterSpec
+		testDescription    string
+	}{
+		{
+			inParameters: []experimentsv1beta1.ParameterSpec{
+				{
+					Name:          "optimizer",
+					ParameterType: experimentsv1beta1.ParameterTypeCategorical,
+					FeasibleSpace: experimentsv1beta1.FeasibleSpace{
+						List: []string{"sgd", "adam"},
+					},
+				},
+				{
+					Name:          "momentum",
+					ParameterType: experimentsv1beta1.ParameterTypeDouble,
+					FeasibleSpace: experimentsv1beta1.FeasibleSpace{
+						Max: "0.9",
+						Min: "0.5",
+					},
+					Condition: &experimentsv1beta1.ParameterCondition{
+						Parent: "optimizer",
+						Values: []string{"sgd"},
+					},
+				},
+			},
+			expectedParameters: []*suggestionapi.ParameterSpec{
+				{
+					Name:          "optimizer",
+					ParameterType: suggestionapi.ParameterType_CATEGORICAL,
+					FeasibleSpace: &suggestionapi.FeasibleSpace{
+						List: []string{"sgd", "adam"},
+					},
+				},
+				{
+					Name:          "momentum",
+					ParameterType: suggestionapi.ParameterType_DOUBLE,
+					FeasibleSpace: &suggestionapi.FeasibleSpace{
+						Max: "0.9",
+						Min: "0.5",
+					},
+					Condition: &suggestionapi.ParameterCondition{
+						Parent: "optimizer",
+						Values: []string{"sgd"},
+					},
+				},
+			},
+			testDescription: "Convert conditional parameters",
+		},
+	}
+
+	for _, tc := range tcs {
+		actualParameters := convertParameters(tc.inParameters)
+		if diff := cmp.Diff(tc.expectedParameters, actualParameters, cmpopts.IgnoreUnexported(
+			suggestionapi.ParameterSpec{}, suggestionapi.FeasibleSpace{}, suggestionapi.ParameterCondition{})); diff != "" {
+			t.Errorf("Case: %v failed. Unexpected difference (-want +got):\n%s", tc.testDescription, diff)
+		}
+	}
+}
+
 func TestConvertDistribution(t *testing.T) {
 
 	tcs := []struct {
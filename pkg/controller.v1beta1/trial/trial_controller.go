@@ -61,6 +61,10 @@ var (
 	errMetricsNotReported = fmt.Errorf("metrics are not reported yet")
 	// errReportMetricsFailed is the error when `unavailable` metrics value can't be inserted to the Katib DB.
 	errReportMetricsFailed = fmt.Errorf("failed to report unavailable metrics")
+	// errReusedTrialNotCompleted is the error when the Trial which observation is reused is not completed yet
+	errReusedTrialNotCompleted = fmt.Errorf("trial to reuse observation is not completed yet")
+	// errRetryBackoff is the error when the failed Trial job is deleted but the retry backoff is not elapsed yet
+	errRetryBackoff = fmt.Errorf("retry backoff is not elapsed yet")
 )
 
 // Add creates a new Trial Controller and adds it to the Manager with default RBAC. The Manager will set fields on the Controller
@@ -73,6 +77,7 @@ func Add(mgr manager.Manager) error {
 func newReconciler(mgr manager.Manager) reconcile.Reconciler {
 	r := &ReconcileTrial{
 		Client:        mgr.GetClient(),
+		apiReader:     mgr.GetAPIReader(),
 		scheme:        mgr.GetScheme(),
 		ManagerClient: managerclient.New(),
 		recorder:      mgr.GetEventRecorderFor(ControllerName),
@@ -141,8 +146,10 @@ var _ reconcile.Reconciler = &ReconcileTrial{}
 // ReconcileTrial reconciles a Trial object
 type ReconcileTrial struct {
 	client.Client
-	scheme   *runtime.Scheme
-	recorder record.EventRecorder
+	// apiReader reads the pods of the Trials, so they are not cached.
+	apiReader client.Reader
+	scheme    *runtime.Scheme
+	recorder  record.EventRecorder
 
 	managerclient.ManagerClient
 	// updateStatusHandler is defined for test purpose.
@@ -195,6 +202,16 @@ func (r *ReconcileTrial) Reconcile(ctx context.Context, request reconcile.Reques
 					RequeueAfter: time.Second * 1,
 				}, nil
 			}
+			if errors.Is(err, errReusedTrialNotCompleted) {
+				return reconcile.Result{
+					RequeueAfter: time.Second * 10,
+				}, nil
+			}
+			if errors.Is(err, errRetryBackoff) {
+				return reconcile.Result{
+					RequeueAfter: max(trialutil.GetRetryBackoff(instance, time.Now()), time.Second),
+				}, nil
+			}
 			logger.Error(err, "Reconcile trial error")
 			r.recorder.Eventf(instance,
 				corev1.EventTypeWarning, consts.ReconcileErrorReason,
@@ -214,19 +231,38 @@ func (r *ReconcileTrial) Reconcile(ctx context.Context, request reconcile.Reques
 		}
 	}
 
-	return reconcile.Result{}, nil
+	// Deadlines of the Trial run are exceeded over time, so they are checked again without any event.
+	return reconcile.Result{RequeueAfter: trialutil.GetDeadlineRequeueAfter(instance, time.Now())}, nil
 }
 
 func (r *ReconcileTrial) reconcileTrial(instance *trialsv1beta1.Trial) error {
 
 	var err error
 	logger := log.WithValues("Trial", types.NamespacedName{Name: instance.GetName(), Namespace: instance.GetNamespace()})
+	// Trial with the duplicated parameter assignments reuses the observation instead of creating the job.
+	if instance.Spec.ReuseObservationFrom != "" {
+		return r.reconcileReusedTrial(instance)
+	}
+
 	desiredJob, err := r.getDesiredJobSpec(instance)
 	if err != nil {
 		logger.Error(err, "Job Spec Get error")
 		return err
 	}
 
+	// Trial with the cache reuses the observation log of the earlier Trial with the same fingerprint
+	// instead of creating the job.
+	if instance.Spec.Cache != nil && !instance.IsCompleted() {
+		cached, err := r.reconcileCachedTrial(instance, desiredJob)
+		if err != nil {
+			logger.Error(err, "Reconcile cached trial error")
+			return err
+		}
+		if cached {
+			return nil
+		}
+	}
+
 	deployedJob, err := r.reconcileJob(instance, desiredJob)
 	if err != nil {
 		logger.Error(err, "Reconcile job error")
@@ -242,11 +278,31 @@ func (r *ReconcileTrial) reconcileTrial(instance *trialsv1beta1.Trial) error {
 			logger.Error(err, "GetDeployedJobStatus error")
 		}
 
+		// Run which exceeds its deadline is deleted regardless of its kind.
+		if !instance.IsCompleted() && (jobStatus == nil || jobStatus.Condition == trialutil.JobRunning) {
+			deadlineStatus, err := r.getExceededDeadline(instance, deployedJob)
+			if err != nil {
+				logger.Error(err, "Get exceeded deadline error")
+				return err
+			}
+			if deadlineStatus != nil {
+				if trialutil.IsRetryRequired(instance, deadlineStatus) {
+					return r.retryJob(instance, deployedJob, deadlineStatus)
+				}
+				return r.failExceededDeadlineJob(instance, deployedJob, deadlineStatus)
+			}
+		}
+
 		// Not needed to update status if jobStatus is nil.
 		if jobStatus == nil {
 			return nil
 		}
 
+		// Failed job is recreated if the retry policy allows it.
+		if !instance.IsCompleted() && trialutil.IsRetryRequired(instance, jobStatus) {
+			return r.retryJob(instance, deployedJob, jobStatus)
+		}
+
 		// If Job status is succeeded or Trial is early stopped, update Trial observation.
 		if jobStatus.Condition == trialutil.JobSucceeded || instance.IsEarlyStopped() {
 			if err = r.UpdateTrialStatusObservation(instance); err != nil {
@@ -289,6 +345,9 @@ func (r *ReconcileTrial) reconcileJob(instance *trialsv1beta1.Trial, desiredJob
 			if instance.IsCompleted() {
 				return nil, nil
 			}
+			if trialutil.GetRetryBackoff(instance, time.Now()) > 0 {
+				return nil, errRetryBackoff
+			}
 
 			logger.Info("Creating Job", "kind", kind,
 				"name", desiredJob.GetName())
@@ -308,7 +367,12 @@ func (r *ReconcileTrial) reconcileJob(instance *trialsv1beta1.Trial, desiredJob
 			return nil, err
 		}
 	} else {
-		if instance.IsCompleted() && !instance.Spec.RetainRun {
+		// Failed job of the retried Trial is still being deleted.
+		if !instance.IsCompleted() && len(instance.Status.Attempts) > 0 && deployedJob.GetDeletionTimestamp() != nil {
+			return nil, nil
+		}
+		// Run of the killed Trial is deleted even if it is retained, since it may be still running.
+		if instance.IsCompleted() && (!instance.Spec.RetainRun || instance.IsKilled()) {
 			if err = r.Delete(context.TODO(), desiredJob, client.PropagationPolicy(metav1.DeletePropagationForeground)); err != nil {
 				logger.Error(err, "Delete job error")
 				return nil, err
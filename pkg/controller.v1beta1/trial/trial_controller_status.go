@@ -29,6 +29,8 @@ const (
 	TrialSucceededReason          = "TrialSucceeded"
 	TrialMetricsUnavailableReason = "MetricsUnavailable"
 	TrialFailedReason             = "TrialFailed"
+	TrialCachedReason             = "TrialCached"
+	TrialRetryingReason           = "TrialRetrying"
 
 	// For Jobs
 	JobCreatedReason            = "JobCreated"
@@ -31,18 +31,24 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/config"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	commonv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1"
 	experimentsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/experiments/v1beta1"
 	trialsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1"
 	api_pb "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
 	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
+	"github.com/kubeflow/katib/pkg/controller.v1beta1/trial/managerclient"
 	trialutil "github.com/kubeflow/katib/pkg/controller.v1beta1/trial/util"
 	"github.com/kubeflow/katib/pkg/controller.v1beta1/util"
 	managerclientmock "github.com/kubeflow/katib/pkg/mock/v1beta1/trial/managerclient"
@@ -143,6 +149,7 @@ func TestReconcileBatchJob(t *testing.T) {
 
 	r := &ReconcileTrial{
 		Client:        mgr.GetClient(),
+		apiReader:     mgr.GetAPIReader(),
 		scheme:        mgr.GetScheme(),
 		ManagerClient: mockManagerClient,
 		recorder:      mgr.GetEventRecorderFor(ControllerName),
@@ -400,6 +407,302 @@ func TestReconcileBatchJob(t *testing.T) {
 
 }
 
+func TestReconcileReusedTrial(t *testing.T) {
+	const (
+		reusedTrialName = "test-reused-trial"
+		sourceTrialName = "test-source-trial"
+	)
+	sourceObservation := &commonv1beta1.Observation{
+		Metrics: []commonv1beta1.Metric{
+			{
+				Name:   objectiveMetric,
+				Min:    "0.11",
+				Max:    "0.99",
+				Latest: "0.11",
+			},
+		},
+	}
+	newSourceTrial := func(succeeded bool) *trialsv1beta1.Trial {
+		trial := newFakeCreatedTrial(sourceTrialName, succeededBatchJobName)
+		trial.MarkTrialStatusRunning(TrialRunningReason, "Trial is running")
+		if succeeded {
+			trial.Status.Observation = sourceObservation.DeepCopy()
+			trial.MarkTrialStatusSucceeded(corev1.ConditionTrue, TrialSucceededReason, "Trial has succeeded")
+		}
+		return trial
+	}
+	emptyObservationLog := &api_pb.GetObservationLogReply{ObservationLog: &api_pb.ObservationLog{}}
+
+	cases := map[string]struct {
+		source          *trialsv1beta1.Trial
+		mockCalls       func(m *managerclientmock.MockManagerClient)
+		wantResult      reconcile.Result
+		wantErr         bool
+		wantSucceeded   bool
+		wantFailed      bool
+		wantObservation *commonv1beta1.Observation
+	}{
+		"Observation log of the reused Trial is copied": {
+			source: newSourceTrial(true),
+			mockCalls: func(m *managerclientmock.MockManagerClient) {
+				gomock.InOrder(
+					m.EXPECT().GetTrialObservationLog(trialNamed(sourceTrialName)).Return(observationLogAvailable, nil),
+					m.EXPECT().DeleteTrialObservationLog(trialNamed(reusedTrialName)).Return(nil, nil),
+					m.EXPECT().ReportTrialObservationLog(trialNamed(reusedTrialName), observationLogAvailable.ObservationLog).Return(nil, nil),
+				)
+			},
+			wantSucceeded:   true,
+			wantObservation: sourceObservation,
+		},
+		"Observation is reused without the observation log": {
+			source: newSourceTrial(true),
+			mockCalls: func(m *managerclientmock.MockManagerClient) {
+				m.EXPECT().GetTrialObservationLog(trialNamed(sourceTrialName)).Return(emptyObservationLog, nil)
+			},
+			wantSucceeded:   true,
+			wantObservation: sourceObservation,
+		},
+		"Trial is not completed if the observation log is not copied": {
+			source: newSourceTrial(true),
+			mockCalls: func(m *managerclientmock.MockManagerClient) {
+				gomock.InOrder(
+					m.EXPECT().GetTrialObservationLog(trialNamed(sourceTrialName)).Return(observationLogAvailable, nil),
+					m.EXPECT().DeleteTrialObservationLog(trialNamed(reusedTrialName)).Return(nil, nil),
+					m.EXPECT().ReportTrialObservationLog(trialNamed(reusedTrialName), gomock.Any()).Return(nil, errors.NewBadRequest("fake-error")),
+				)
+			},
+			wantErr: true,
+		},
+		"Trial waits until the reused Trial is completed": {
+			source:     newSourceTrial(false),
+			mockCalls:  func(m *managerclientmock.MockManagerClient) {},
+			wantResult: reconcile.Result{RequeueAfter: time.Second * 10},
+		},
+		"Trial fails if the reused Trial is not found": {
+			mockCalls:  func(m *managerclientmock.MockManagerClient) {},
+			wantFailed: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			g := gomega.NewGomegaWithT(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockManagerClient := managerclientmock.NewMockManagerClient(mockCtrl)
+			tc.mockCalls(mockManagerClient)
+
+			trial := newFakeCreatedTrial(reusedTrialName, succeededBatchJobName)
+			trial.Spec.ReuseObservationFrom = sourceTrialName
+			objects := []client.Object{trial}
+			if tc.source != nil {
+				objects = append(objects, tc.source)
+			}
+			r := newFakeReconcileTrial(mockManagerClient, objects...)
+
+			trialKey := types.NamespacedName{Name: reusedTrialName, Namespace: namespace}
+			result, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: trialKey})
+			if tc.wantErr {
+				g.Expect(err).To(gomega.HaveOccurred())
+			} else {
+				g.Expect(err).NotTo(gomega.HaveOccurred())
+			}
+			g.Expect(result).To(gomega.Equal(tc.wantResult))
+
+			g.Expect(r.Get(ctx, trialKey, trial)).To(gomega.Succeed())
+			g.Expect(trial.IsSucceeded()).To(gomega.Equal(tc.wantSucceeded))
+			g.Expect(trial.IsFailed()).To(gomega.Equal(tc.wantFailed))
+			g.Expect(trial.Status.Observation).To(gomega.BeComparableTo(tc.wantObservation))
+		})
+	}
+}
+
+func TestReconcileCachedTrial(t *testing.T) {
+	const (
+		cachedTrialName = "test-cached-trial"
+		sourceTrialName = "test-source-trial"
+	)
+	cachedObservation := &commonv1beta1.Observation{
+		Metrics: []commonv1beta1.Metric{
+			{
+				Name:   objectiveMetric,
+				Min:    "0.11",
+				Max:    "0.99",
+				Latest: "0.11",
+			},
+		},
+	}
+	newCachedTrial := func() *trialsv1beta1.Trial {
+		trial := newFakeCreatedTrial(cachedTrialName, cachedTrialName)
+		trial.Spec.Cache = &commonv1beta1.TrialCacheSpec{Scope: commonv1beta1.TrialCacheScopeNamespace}
+		return trial
+	}
+	newSourceTrial := func(succeeded bool) *trialsv1beta1.Trial {
+		trial := newFakeCreatedTrial(sourceTrialName, sourceTrialName)
+		fingerprint, err := trialutil.GetTrialFingerprint(newCachedTrial())
+		if err != nil {
+			t.Fatalf("Failed to get Trial fingerprint: %v", err)
+		}
+		trial.Labels = map[string]string{consts.LabelTrialFingerprint: fingerprint}
+		now := metav1.Now()
+		trial.Status.CompletionTime = &now
+		if succeeded {
+			trial.Status.Observation = cachedObservation.DeepCopy()
+			trial.MarkTrialStatusSucceeded(corev1.ConditionTrue, TrialSucceededReason, "Trial has succeeded")
+		} else {
+			trial.MarkTrialStatusFailed(TrialFailedReason, "Trial has failed")
+		}
+		return trial
+	}
+	emptyObservationLog := &api_pb.GetObservationLogReply{ObservationLog: &api_pb.ObservationLog{}}
+	trialKey := types.NamespacedName{Name: cachedTrialName, Namespace: namespace}
+
+	cases := map[string]struct {
+		source          *trialsv1beta1.Trial
+		mockCalls       func(m *managerclientmock.MockManagerClient)
+		wantSucceeded   bool
+		wantObservation *commonv1beta1.Observation
+		wantJobCreated  bool
+	}{
+		"Observation log of the cached Trial is copied": {
+			source: newSourceTrial(true),
+			mockCalls: func(m *managerclientmock.MockManagerClient) {
+				gomock.InOrder(
+					m.EXPECT().GetTrialObservationLog(trialNamed(sourceTrialName)).Return(observationLogAvailable, nil),
+					m.EXPECT().DeleteTrialObservationLog(trialNamed(cachedTrialName)).Return(nil, nil),
+					m.EXPECT().ReportTrialObservationLog(trialNamed(cachedTrialName), observationLogAvailable.ObservationLog).Return(nil, nil),
+				)
+			},
+			wantSucceeded:   true,
+			wantObservation: cachedObservation,
+		},
+		"Job is created if the cached Trial has no observation log": {
+			source: newSourceTrial(true),
+			mockCalls: func(m *managerclientmock.MockManagerClient) {
+				m.EXPECT().GetTrialObservationLog(trialNamed(sourceTrialName)).Return(emptyObservationLog, nil)
+			},
+			wantJobCreated: true,
+		},
+		"Job is created if the Trial with the same fingerprint is not succeeded": {
+			source:         newSourceTrial(false),
+			mockCalls:      func(m *managerclientmock.MockManagerClient) {},
+			wantJobCreated: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			g := gomega.NewGomegaWithT(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockManagerClient := managerclientmock.NewMockManagerClient(mockCtrl)
+			tc.mockCalls(mockManagerClient)
+
+			r := newFakeReconcileTrial(mockManagerClient, newCachedTrial(), tc.source)
+			_, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: trialKey})
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+
+			trial := &trialsv1beta1.Trial{}
+			g.Expect(r.Get(ctx, trialKey, trial)).To(gomega.Succeed())
+			g.Expect(trial.IsSucceeded()).To(gomega.Equal(tc.wantSucceeded))
+			g.Expect(trial.Status.Observation).To(gomega.BeComparableTo(tc.wantObservation))
+			err = r.Get(ctx, trialKey, &batchv1.Job{})
+			g.Expect(errors.IsNotFound(err)).To(gomega.Equal(!tc.wantJobCreated))
+		})
+	}
+
+	t.Run("Observation log is copied again after the failed status update", func(t *testing.T) {
+		g := gomega.NewGomegaWithT(t)
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		mockManagerClient := managerclientmock.NewMockManagerClient(mockCtrl)
+		mockManagerClient.EXPECT().GetTrialObservationLog(trialNamed(sourceTrialName)).Return(observationLogAvailable, nil).Times(2)
+		gomock.InOrder(
+			mockManagerClient.EXPECT().DeleteTrialObservationLog(trialNamed(cachedTrialName)).Return(nil, nil),
+			mockManagerClient.EXPECT().ReportTrialObservationLog(trialNamed(cachedTrialName), gomock.Any()).Return(nil, nil),
+			mockManagerClient.EXPECT().DeleteTrialObservationLog(trialNamed(cachedTrialName)).Return(nil, nil),
+			mockManagerClient.EXPECT().ReportTrialObservationLog(trialNamed(cachedTrialName), gomock.Any()).Return(nil, nil),
+		)
+
+		r := newFakeReconcileTrial(mockManagerClient, newCachedTrial(), newSourceTrial(true))
+		r.updateStatusHandler = func(instance *trialsv1beta1.Trial) error {
+			return errors.NewBadRequest("fake-error")
+		}
+		result, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: trialKey})
+		g.Expect(err).NotTo(gomega.HaveOccurred())
+		g.Expect(result).To(gomega.Equal(reconcile.Result{Requeue: true}))
+
+		trial := &trialsv1beta1.Trial{}
+		g.Expect(r.Get(ctx, trialKey, trial)).To(gomega.Succeed())
+		g.Expect(trial.IsSucceeded()).To(gomega.BeFalse())
+
+		r.updateStatusHandler = r.updateStatus
+		_, err = r.Reconcile(ctx, reconcile.Request{NamespacedName: trialKey})
+		g.Expect(err).NotTo(gomega.HaveOccurred())
+		g.Expect(r.Get(ctx, trialKey, trial)).To(gomega.Succeed())
+		g.Expect(trial.IsSucceeded()).To(gomega.BeTrue())
+		g.Expect(trial.Status.Observation).To(gomega.BeComparableTo(cachedObservation))
+	})
+}
+
+func TestReconcileRetriedTrial(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockManagerClient := managerclientmock.NewMockManagerClient(mockCtrl)
+
+	const retriedTrialName = "test-retried-trial"
+	backoff := time.Hour
+	trial := newFakeCreatedTrial(retriedTrialName, failedBatchJobName)
+	trial.Spec.RetryPolicy = &commonv1beta1.RetryPolicy{
+		MaxRetries: 2,
+		Backoff:    &metav1.Duration{Duration: backoff},
+	}
+	batchJobFailedReason := "BackoffLimitExceeded"
+	batchJob := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      failedBatchJobName,
+			Namespace: namespace,
+		},
+		Status: batchv1.JobStatus{
+			Conditions: []batchv1.JobCondition{
+				{
+					Type:   batchv1.JobFailed,
+					Status: corev1.ConditionTrue,
+					Reason: batchJobFailedReason,
+				},
+			},
+		},
+	}
+	r := newFakeReconcileTrial(mockManagerClient, trial, batchJob)
+	trialKey := types.NamespacedName{Name: retriedTrialName, Namespace: namespace}
+
+	// Metrics of the failed run are deleted with the failed job.
+	mockManagerClient.EXPECT().DeleteTrialObservationLog(trialNamed(retriedTrialName)).Return(nil, nil)
+	_, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: trialKey})
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(errors.IsNotFound(r.Get(ctx, failedBatchJobKey, &batchv1.Job{}))).To(gomega.BeTrue())
+	g.Expect(r.Get(ctx, trialKey, trial)).To(gomega.Succeed())
+	g.Expect(trial.IsCompleted()).To(gomega.BeFalse())
+	g.Expect(trial.Status.Attempts).To(gomega.HaveLen(1))
+	g.Expect(trial.Status.Attempts[0].Reason).To(gomega.Equal(batchJobFailedReason))
+
+	// Job is not created until the retry backoff is elapsed.
+	result, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: trialKey})
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(result.RequeueAfter).To(gomega.And(gomega.BeNumerically(">", 0), gomega.BeNumerically("<=", backoff)))
+	g.Expect(errors.IsNotFound(r.Get(ctx, failedBatchJobKey, &batchv1.Job{}))).To(gomega.BeTrue())
+
+	// Job is created again after the retry backoff.
+	completionTime := metav1.NewTime(time.Now().Add(-backoff))
+	trial.Status.Attempts[0].CompletionTime = &completionTime
+	g.Expect(r.Status().Update(ctx, trial)).To(gomega.Succeed())
+	_, err = r.Reconcile(ctx, reconcile.Request{NamespacedName: trialKey})
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(r.Get(ctx, failedBatchJobKey, &batchv1.Job{})).To(gomega.Succeed())
+	g.Expect(r.Get(ctx, trialKey, trial)).To(gomega.Succeed())
+	g.Expect(trial.IsCompleted()).To(gomega.BeFalse())
+	g.Expect(trial.Status.Attempts).To(gomega.HaveLen(1))
+}
+
 func TestGetObjectiveMetricValue(t *testing.T) {
 	g := gomega.NewGomegaWithT(t)
 	metricLogs := []*api_pb.MetricLog{
@@ -521,3 +824,39 @@ func newFakeTrialBatchJob(mcType commonv1beta1.CollectorKind, trialName, jobName
 		},
 	}
 }
+
+// newFakeCreatedTrial returns the Trial which has been already created by the controller.
+func newFakeCreatedTrial(trialName, jobName string) *trialsv1beta1.Trial {
+	trial := newFakeTrialBatchJob(commonv1beta1.StdOutCollector, trialName, jobName)
+	trial.UID = types.UID(trialName)
+	trial.Finalizers = []string{cleanMetricsFinalizer}
+	trial.MarkTrialStatusCreated(TrialCreatedReason, "Trial is created")
+	return trial
+}
+
+// newFakeReconcileTrial returns the Trial reconciler with the fake client,
+// so the reconcile of the single Trial is tested without the running manager.
+func newFakeReconcileTrial(managerClient managerclient.ManagerClient, objects ...client.Object) *ReconcileTrial {
+	c := fake.NewClientBuilder().
+		WithScheme(scheme.Scheme).
+		WithObjects(objects...).
+		WithStatusSubresource(&trialsv1beta1.Trial{}).
+		Build()
+	r := &ReconcileTrial{
+		Client:        c,
+		apiReader:     c,
+		scheme:        scheme.Scheme,
+		ManagerClient: managerClient,
+		recorder:      record.NewFakeRecorder(100),
+		collector:     trialutil.NewTrialsCollector(nil, prometheus.NewRegistry()),
+	}
+	r.updateStatusHandler = r.updateStatus
+	return r
+}
+
+// trialNamed matches the Trial with the name.
+func trialNamed(name string) gomock.Matcher {
+	return gomock.Cond(func(x any) bool {
+		return x.(*trialsv1beta1.Trial).GetName() == name
+	})
+}
@@ -23,8 +23,11 @@ import (
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	commonv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1"
@@ -149,6 +152,224 @@ func (r *ReconcileTrial) UpdateTrialStatusObservation(instance *trialsv1beta1.Tr
 	return nil
 }
 
+// reconcileReusedTrial completes the Trial with the observation of the Trial which has the same parameter assignments.
+// The Trial waits until the referenced Trial is completed. If the referenced Trial has no observation, the Trial fails.
+func (r *ReconcileTrial) reconcileReusedTrial(instance *trialsv1beta1.Trial) error {
+	if instance.IsCompleted() {
+		return nil
+	}
+	logger := log.WithValues("Trial", types.NamespacedName{Name: instance.GetName(), Namespace: instance.GetNamespace()})
+	sourceName := instance.Spec.ReuseObservationFrom
+
+	source := &trialsv1beta1.Trial{}
+	err := r.Get(context.TODO(), types.NamespacedName{Name: sourceName, Namespace: instance.GetNamespace()}, source)
+	if err != nil && !apierrors.IsNotFound(err) {
+		logger.Error(err, "Get trial to reuse observation error", "source", sourceName)
+		return err
+	}
+	if err == nil && !source.IsCompleted() {
+		return errReusedTrialNotCompleted
+	}
+
+	timeNow := metav1.Now()
+	instance.Status.CompletionTime = &timeNow
+	if err == nil && source.IsObservationAvailable() {
+		// Observation log is copied like the log of the cached Trial, so the metrics of the Trial are in the DB.
+		reply, err := r.GetTrialObservationLog(source)
+		if err != nil {
+			logger.Error(err, "Get reused trial observation log error", "source", sourceName)
+			return err
+		}
+		if len(reply.ObservationLog.MetricLogs) != 0 {
+			if err = r.copyObservationLog(instance, reply.ObservationLog); err != nil {
+				logger.Error(err, "Report reused observation log error", "source", sourceName)
+				return err
+			}
+		}
+		instance.Status.Observation = source.Status.Observation.DeepCopy()
+		msg := fmt.Sprintf("Trial has succeeded with the observation of Trial %v", sourceName)
+		instance.MarkTrialStatusSucceeded(corev1.ConditionTrue, TrialCachedReason, msg)
+		r.recorder.Eventf(instance, corev1.EventTypeNormal, TrialCachedReason, msg)
+		r.collector.IncreaseTrialsSucceededCount(instance.Namespace)
+		logger.Info("Trial status changed to Succeeded", "source", sourceName)
+		return nil
+	}
+
+	msg := fmt.Sprintf("Trial %v to reuse observation is not found", sourceName)
+	if err == nil {
+		msg = fmt.Sprintf("Trial %v to reuse observation has no observation", sourceName)
+	}
+	instance.MarkTrialStatusFailed(TrialCachedReason, msg)
+	r.recorder.Eventf(instance, corev1.EventTypeWarning, TrialCachedReason, msg)
+	r.collector.IncreaseTrialsFailedCount(instance.Namespace)
+	logger.Info("Trial status changed to Failed", "source", sourceName)
+	return nil
+}
+
+// reconcileCachedTrial completes the Trial with the observation log of the succeeded Trial with the same fingerprint.
+// It returns false if the job of the Trial has been already created or such Trial is not found.
+func (r *ReconcileTrial) reconcileCachedTrial(instance *trialsv1beta1.Trial, desiredJob *unstructured.Unstructured) (bool, error) {
+	logger := log.WithValues("Trial", types.NamespacedName{Name: instance.GetName(), Namespace: instance.GetNamespace()})
+
+	deployedJob := &unstructured.Unstructured{}
+	deployedJob.SetGroupVersionKind(desiredJob.GroupVersionKind())
+	err := r.Get(context.TODO(), types.NamespacedName{Name: desiredJob.GetName(), Namespace: desiredJob.GetNamespace()}, deployedJob)
+	if err == nil {
+		return false, nil
+	} else if !apierrors.IsNotFound(err) {
+		return false, err
+	}
+
+	source, err := r.getCachedTrial(instance)
+	if err != nil || source == nil {
+		return false, err
+	}
+	sourceName := types.NamespacedName{Name: source.GetName(), Namespace: source.GetNamespace()}
+
+	reply, err := r.GetTrialObservationLog(source)
+	if err != nil {
+		logger.Error(err, "Get cached trial observation log error", "source", sourceName)
+		return false, err
+	}
+	if len(reply.ObservationLog.MetricLogs) == 0 {
+		logger.Info("Cached trial has no observation log, job is created", "source", sourceName)
+		return false, nil
+	}
+	observation, err := getMetrics(reply.ObservationLog.MetricLogs, instance.Spec.Objective.MetricStrategies)
+	if err != nil {
+		logger.Error(err, "Get metrics from cached logs error", "source", sourceName)
+		return false, err
+	}
+	if err = r.copyObservationLog(instance, reply.ObservationLog); err != nil {
+		logger.Error(err, "Report cached observation log error", "source", sourceName)
+		return false, err
+	}
+
+	timeNow := metav1.Now()
+	instance.Status.Observation = observation
+	instance.Status.CompletionTime = &timeNow
+	msg := fmt.Sprintf("Trial has succeeded with the cached observation of Trial %v", sourceName)
+	instance.MarkTrialStatusSucceeded(corev1.ConditionTrue, TrialCachedReason, msg)
+	r.recorder.Eventf(instance, corev1.EventTypeNormal, TrialCachedReason, msg)
+	r.collector.IncreaseTrialsSucceededCount(instance.Namespace)
+	logger.Info("Trial status changed to Succeeded", "source", sourceName)
+	return true, nil
+}
+
+// copyObservationLog reports the observation log of the other Trial as the observation log of the Trial.
+// Observation log is copied again if the Trial status update fails, so the earlier copy is deleted.
+func (r *ReconcileTrial) copyObservationLog(instance *trialsv1beta1.Trial, observationLog *api_pb.ObservationLog) error {
+	if _, err := r.DeleteTrialObservationLog(instance); err != nil {
+		return err
+	}
+	_, err := r.ReportTrialObservationLog(instance, observationLog)
+	return err
+}
+
+// retryJob deletes the failed job of the Trial and records the failed attempt.
+// The job is created again once it is deleted and the retry backoff is elapsed.
+func (r *ReconcileTrial) retryJob(instance *trialsv1beta1.Trial, deployedJob *unstructured.Unstructured, jobStatus *trialutil.TrialJobStatus) error {
+	logger := log.WithValues("Trial", types.NamespacedName{Name: instance.GetName(), Namespace: instance.GetNamespace()})
+
+	if err := r.Delete(context.TODO(), deployedJob, client.PropagationPolicy(metav1.DeletePropagationForeground)); err != nil && !apierrors.IsNotFound(err) {
+		logger.Error(err, "Delete failed job error")
+		return err
+	}
+	// Metrics of the failed run must not be mixed with the metrics of the next attempt.
+	if _, err := r.DeleteTrialObservationLog(instance); err != nil {
+		return err
+	}
+
+	startTime := deployedJob.GetCreationTimestamp()
+	timeNow := metav1.Now()
+	instance.Status.Attempts = append(instance.Status.Attempts, trialsv1beta1.TrialAttempt{
+		StartTime:      &startTime,
+		CompletionTime: &timeNow,
+		Reason:         jobStatus.Reason,
+		Message:        jobStatus.Message,
+	})
+	msg := fmt.Sprintf("Job %s has failed, retry %d of %d", deployedJob.GetName(),
+		len(instance.Status.Attempts), instance.Spec.RetryPolicy.MaxRetries)
+	if jobStatus.Message != "" {
+		msg = fmt.Sprintf("%s: %s", msg, jobStatus.Message)
+	}
+	instance.MarkTrialStatusRetrying(TrialRetryingReason, msg)
+	r.recorder.Eventf(instance, corev1.EventTypeNormal, TrialRetryingReason, msg)
+	logger.Info("Trial job is retried", "attempt", len(instance.Status.Attempts))
+	return nil
+}
+
+// getExceededDeadline returns the failure status of the Trial run if it has exceeded its deadline.
+// The run is pending until any pod owned by the Trial job is started.
+func (r *ReconcileTrial) getExceededDeadline(instance *trialsv1beta1.Trial, deployedJob *unstructured.Unstructured) (*trialutil.TrialJobStatus, error) {
+	if instance.Spec.ActiveDeadlineSeconds == nil && instance.Spec.PendingDeadlineSeconds == nil {
+		return nil, nil
+	}
+	// Job which has been just created doesn't have the creation timestamp yet.
+	runCreated := deployedJob.GetCreationTimestamp()
+	if runCreated.IsZero() {
+		return nil, nil
+	}
+
+	podStarted := true
+	if instance.Spec.PendingDeadlineSeconds != nil {
+		startedTrials, err := trialutil.GetStartedTrials(context.TODO(), r.apiReader, instance.GetNamespace())
+		if err != nil {
+			return nil, err
+		}
+		podStarted = startedTrials[instance.GetName()]
+	}
+	return trialutil.GetExceededDeadline(instance, runCreated.Time, podStarted, time.Now()), nil
+}
+
+// failExceededDeadlineJob deletes the job which has exceeded its deadline and marks the Trial failed.
+// The job is deleted even if it is retained, since it may be still running.
+func (r *ReconcileTrial) failExceededDeadlineJob(instance *trialsv1beta1.Trial, deployedJob *unstructured.Unstructured, jobStatus *trialutil.TrialJobStatus) error {
+	logger := log.WithValues("Trial", types.NamespacedName{Name: instance.GetName(), Namespace: instance.GetNamespace()})
+
+	if err := r.Delete(context.TODO(), deployedJob, client.PropagationPolicy(metav1.DeletePropagationForeground)); err != nil && !apierrors.IsNotFound(err) {
+		logger.Error(err, "Delete job error")
+		return err
+	}
+
+	timeNow := metav1.Now()
+	instance.MarkTrialStatusFailed(trialutil.DeadlineExceededReason, jobStatus.Message)
+	instance.Status.CompletionTime = &timeNow
+
+	eventMsg := fmt.Sprintf("Job %v has been deleted. %v", deployedJob.GetName(), jobStatus.Message)
+	r.recorder.Eventf(instance, corev1.EventTypeWarning, trialutil.DeadlineExceededReason, eventMsg)
+	r.collector.IncreaseTrialsFailedCount(instance.Namespace)
+	logger.Info("Trial status changed to Failed", "reason", trialutil.DeadlineExceededReason)
+	return nil
+}
+
+// getCachedTrial returns the earliest succeeded Trial with the same fingerprint and objective metric in the Trial namespace.
+// It returns nil if such Trial is not found.
+func (r *ReconcileTrial) getCachedTrial(instance *trialsv1beta1.Trial) (*trialsv1beta1.Trial, error) {
+	fingerprint, err := trialutil.GetTrialFingerprint(instance)
+	if err != nil {
+		return nil, err
+	}
+	trials := &trialsv1beta1.TrialList{}
+	if err = r.List(context.TODO(), trials, client.InNamespace(instance.GetNamespace()),
+		client.MatchingLabels{consts.LabelTrialFingerprint: fingerprint}); err != nil {
+		return nil, err
+	}
+
+	var source *trialsv1beta1.Trial
+	for i := range trials.Items {
+		t := &trials.Items[i]
+		if t.GetUID() == instance.GetUID() || !t.IsSucceeded() || t.Spec.Objective == nil ||
+			t.Spec.Objective.ObjectiveMetricName != instance.Spec.Objective.ObjectiveMetricName {
+			continue
+		}
+		if source == nil || t.Status.CompletionTime.Before(source.Status.CompletionTime) {
+			source = t
+		}
+	}
+	return source, nil
+}
+
 func (r *ReconcileTrial) updateFinalizers(instance *trialsv1beta1.Trial, finalizers []string) (reconcile.Result, error) {
 	isDelete := true
 	if !instance.ObjectMeta.DeletionTimestamp.IsZero() {
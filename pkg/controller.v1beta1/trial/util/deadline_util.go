@@ -0,0 +1,90 @@
+/*
+Copyright 2022 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	trialsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1"
+)
+
+// DeadlineExceededReason is the reason of the Trial run failure when the run exceeds its deadline.
+const DeadlineExceededReason = "DeadlineExceeded"
+
+// GetExceededDeadline returns the failure status of the Trial run if the run has exceeded its active deadline,
+// or it has exceeded its pending deadline and none of its pods has started yet.
+// It returns nil if the run is within its deadlines.
+func GetExceededDeadline(trial *trialsv1beta1.Trial, runCreated time.Time, podStarted bool, now time.Time) *TrialJobStatus {
+	elapsed := now.Sub(runCreated)
+	if d := trial.Spec.ActiveDeadlineSeconds; d != nil && elapsed >= time.Duration(*d)*time.Second {
+		return &TrialJobStatus{
+			Condition: JobFailed,
+			Reason:    DeadlineExceededReason,
+			Message:   fmt.Sprintf("Trial run has been active longer than %ds", *d),
+		}
+	}
+	if d := trial.Spec.PendingDeadlineSeconds; d != nil && !podStarted && elapsed >= time.Duration(*d)*time.Second {
+		return &TrialJobStatus{
+			Condition: JobFailed,
+			Reason:    DeadlineExceededReason,
+			Message:   fmt.Sprintf("Trial run has been pending longer than %ds", *d),
+		}
+	}
+	return nil
+}
+
+// GetDeadlineRequeueAfter returns the duration after which the deadlines of the running Trial are checked again.
+// The run is considered to be created when the Trial has become running.
+func GetDeadlineRequeueAfter(trial *trialsv1beta1.Trial, now time.Time) time.Duration {
+	if trial.IsCompleted() || (trial.Spec.ActiveDeadlineSeconds == nil && trial.Spec.PendingDeadlineSeconds == nil) {
+		return 0
+	}
+	var runCreated time.Time
+	for _, c := range trial.Status.Conditions {
+		if c.Type == trialsv1beta1.TrialRunning && c.Status == corev1.ConditionTrue {
+			runCreated = c.LastTransitionTime.Time
+		}
+	}
+	if runCreated.IsZero() {
+		return 0
+	}
+
+	var requeueAfter time.Duration
+	for _, d := range []*int64{trial.Spec.ActiveDeadlineSeconds, trial.Spec.PendingDeadlineSeconds} {
+		if d == nil {
+			continue
+		}
+		if remaining := runCreated.Add(time.Duration(*d) * time.Second).Sub(now); remaining > 0 &&
+			(requeueAfter == 0 || remaining < requeueAfter) {
+			requeueAfter = remaining
+		}
+	}
+	return requeueAfter
+}
+
+// IsPodStarted returns true if any of the pods has been scheduled and its containers have started.
+func IsPodStarted(pods []corev1.Pod) bool {
+	for _, pod := range pods {
+		if pod.Status.Phase == corev1.PodRunning || pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,154 @@
+/*
+Copyright 2022 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	trialsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1"
+)
+
+func TestGetExceededDeadline(t *testing.T) {
+	now := time.Now()
+
+	testCases := map[string]struct {
+		activeDeadline  *int64
+		pendingDeadline *int64
+		runCreated      time.Time
+		podStarted      bool
+		wantStatus      *TrialJobStatus
+	}{
+		"Run without deadlines": {
+			runCreated: now.Add(-time.Hour),
+		},
+		"Run within active deadline": {
+			activeDeadline: ptr.To[int64](60),
+			runCreated:     now.Add(-30 * time.Second),
+			podStarted:     true,
+		},
+		"Run exceeds active deadline": {
+			activeDeadline: ptr.To[int64](60),
+			runCreated:     now.Add(-time.Minute),
+			podStarted:     true,
+			wantStatus: &TrialJobStatus{
+				Condition: JobFailed,
+				Reason:    DeadlineExceededReason,
+				Message:   "Trial run has been active longer than 60s",
+			},
+		},
+		"Run exceeds pending deadline": {
+			activeDeadline:  ptr.To[int64](3600),
+			pendingDeadline: ptr.To[int64](60),
+			runCreated:      now.Add(-2 * time.Minute),
+			wantStatus: &TrialJobStatus{
+				Condition: JobFailed,
+				Reason:    DeadlineExceededReason,
+				Message:   "Trial run has been pending longer than 60s",
+			},
+		},
+		"Started run ignores pending deadline": {
+			pendingDeadline: ptr.To[int64](60),
+			runCreated:      now.Add(-2 * time.Minute),
+			podStarted:      true,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			trial := &trialsv1beta1.Trial{
+				Spec: trialsv1beta1.TrialSpec{
+					ActiveDeadlineSeconds:  tc.activeDeadline,
+					PendingDeadlineSeconds: tc.pendingDeadline,
+				},
+			}
+			got := GetExceededDeadline(trial, tc.runCreated, tc.podStarted, now)
+			if diff := cmp.Diff(tc.wantStatus, got); len(diff) != 0 {
+				t.Errorf("Unexpected exceeded deadline (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetDeadlineRequeueAfter(t *testing.T) {
+	now := time.Now()
+	runningConditions := func(runCreated time.Time) []trialsv1beta1.TrialCondition {
+		return []trialsv1beta1.TrialCondition{
+			{
+				Type:               trialsv1beta1.TrialRunning,
+				Status:             corev1.ConditionTrue,
+				LastTransitionTime: metav1.NewTime(runCreated),
+			},
+		}
+	}
+
+	testCases := map[string]struct {
+		activeDeadline   *int64
+		pendingDeadline  *int64
+		conditions       []trialsv1beta1.TrialCondition
+		wantRequeueAfter time.Duration
+	}{
+		"No requeue without deadlines": {
+			conditions:       runningConditions(now),
+			wantRequeueAfter: 0,
+		},
+		"No requeue before the run is created": {
+			activeDeadline:   ptr.To[int64](60),
+			wantRequeueAfter: 0,
+		},
+		"Requeue at the pending deadline": {
+			activeDeadline:   ptr.To[int64](600),
+			pendingDeadline:  ptr.To[int64](60),
+			conditions:       runningConditions(now.Add(-20 * time.Second)),
+			wantRequeueAfter: 40 * time.Second,
+		},
+		"Requeue at the active deadline after the pending deadline": {
+			activeDeadline:   ptr.To[int64](600),
+			pendingDeadline:  ptr.To[int64](60),
+			conditions:       runningConditions(now.Add(-100 * time.Second)),
+			wantRequeueAfter: 500 * time.Second,
+		},
+		"No requeue for the completed Trial": {
+			activeDeadline: ptr.To[int64](600),
+			conditions: append(runningConditions(now), trialsv1beta1.TrialCondition{
+				Type:   trialsv1beta1.TrialFailed,
+				Status: corev1.ConditionTrue,
+			}),
+			wantRequeueAfter: 0,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			trial := &trialsv1beta1.Trial{
+				Spec: trialsv1beta1.TrialSpec{
+					ActiveDeadlineSeconds:  tc.activeDeadline,
+					PendingDeadlineSeconds: tc.pendingDeadline,
+				},
+				Status: trialsv1beta1.TrialStatus{
+					Conditions: tc.conditions,
+				},
+			}
+			if got := GetDeadlineRequeueAfter(trial, now); tc.wantRequeueAfter != got {
+				t.Errorf("Unexpected requeue after, want %v, got %v", tc.wantRequeueAfter, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2022 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	commonv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1"
+	trialsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1"
+)
+
+// fingerprintLength is the length of the fingerprint, it must not exceed the max length of the label value.
+const fingerprintLength = 32
+
+// trialNamePlaceholder replaces the Trial name in the run spec, e.g. the name substituted for ${trialSpec.Name}.
+const trialNamePlaceholder = "${trialSpec.Name}"
+
+// GetTrialFingerprint returns the fingerprint of the Trial run spec and parameter assignments.
+// Name, namespace and owner references of the run and the Trial name in the run spec are not the part of the fingerprint,
+// so the Trials of the different Experiments with the same Trial template and assignments have the same fingerprint.
+// Owner references are set to the run spec of the Trial by the Trial controller before the job is created.
+func GetTrialFingerprint(trial *trialsv1beta1.Trial) (string, error) {
+	if trial.Spec.RunSpec == nil {
+		return "", fmt.Errorf("trial %s has no run spec", trial.GetName())
+	}
+	runSpec := trial.Spec.RunSpec.DeepCopy()
+	unstructured.RemoveNestedField(runSpec.Object, "metadata", "name")
+	unstructured.RemoveNestedField(runSpec.Object, "metadata", "namespace")
+	unstructured.RemoveNestedField(runSpec.Object, "metadata", "ownerReferences")
+
+	assignments := make([]commonv1beta1.ParameterAssignment, len(trial.Spec.ParameterAssignments))
+	copy(assignments, trial.Spec.ParameterAssignments)
+	sort.Slice(assignments, func(i, j int) bool {
+		return assignments[i].Name < assignments[j].Name
+	})
+
+	// Map keys are sorted by json.Marshal, so the fingerprint is deterministic.
+	content, err := json.Marshal(struct {
+		RunSpec     interface{}                         `json:"runSpec"`
+		Assignments []commonv1beta1.ParameterAssignment `json:"assignments"`
+	}{
+		RunSpec:     replaceTrialName(runSpec.Object, trial.GetName()),
+		Assignments: assignments,
+	})
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])[:fingerprintLength], nil
+}
+
+// replaceTrialName replaces the Trial name in all string values of the run spec with the placeholder.
+func replaceTrialName(value interface{}, trialName string) interface{} {
+	if trialName == "" {
+		return value
+	}
+	switch v := value.(type) {
+	case string:
+		return strings.ReplaceAll(v, trialName, trialNamePlaceholder)
+	case map[string]interface{}:
+		replaced := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			replaced[key] = replaceTrialName(item, trialName)
+		}
+		return replaced
+	case []interface{}:
+		replaced := make([]interface{}, len(v))
+		for i, item := range v {
+			replaced[i] = replaceTrialName(item, trialName)
+		}
+		return replaced
+	}
+	return value
+}
@@ -0,0 +1,151 @@
+/*
+Copyright 2022 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	commonv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1"
+	trialsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1"
+)
+
+func TestGetTrialFingerprint(t *testing.T) {
+
+	newTrial := func(name, namespace, lr, optimizer string) *trialsv1beta1.Trial {
+		return &trialsv1beta1.Trial{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace,
+			},
+			Spec: trialsv1beta1.TrialSpec{
+				ParameterAssignments: []commonv1beta1.ParameterAssignment{
+					{
+						Name:  "lr",
+						Value: lr,
+					},
+					{
+						Name:  "optimizer",
+						Value: optimizer,
+					},
+				},
+				RunSpec: &unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"apiVersion": "batch/v1",
+						"kind":       "Job",
+						"metadata": map[string]interface{}{
+							"name":      name,
+							"namespace": namespace,
+						},
+						"spec": map[string]interface{}{
+							"template": map[string]interface{}{
+								"spec": map[string]interface{}{
+									"containers": []interface{}{
+										map[string]interface{}{
+											"name":  "training-container",
+											"image": "docker.io/kubeflowkatib/mxnet-mnist:latest",
+											"command": []interface{}{
+												"python3",
+												"--lr=" + lr,
+												"--optimizer=" + optimizer,
+												"--output=/output/" + name,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+	reverseAssignments := func(trial *trialsv1beta1.Trial) *trialsv1beta1.Trial {
+		assignments := trial.Spec.ParameterAssignments
+		trial.Spec.ParameterAssignments = []commonv1beta1.ParameterAssignment{assignments[1], assignments[0]}
+		return trial
+	}
+
+	fingerprint, err := GetTrialFingerprint(newTrial("trial-a", "namespace-a", "0.01", "sgd"))
+	if err != nil {
+		t.Fatalf("Unexpected error from GetTrialFingerprint: %v", err)
+	}
+	if len(fingerprint) != fingerprintLength {
+		t.Errorf("Unexpected length of the fingerprint %q: %d", fingerprint, len(fingerprint))
+	}
+
+	cases := map[string]struct {
+		trial     *trialsv1beta1.Trial
+		wantSame  bool
+		wantError bool
+	}{
+		"Trial with the different name and namespace has the same fingerprint": {
+			trial:    newTrial("trial-b", "namespace-b", "0.01", "sgd"),
+			wantSame: true,
+		},
+		"Order of the parameter assignments doesn't change the fingerprint": {
+			trial:    reverseAssignments(newTrial("trial-b", "namespace-a", "0.01", "sgd")),
+			wantSame: true,
+		},
+		"Owner references of the run don't change the fingerprint": {
+			trial: func() *trialsv1beta1.Trial {
+				trial := newTrial("trial-b", "namespace-a", "0.01", "sgd")
+				trial.Spec.RunSpec.SetOwnerReferences([]metav1.OwnerReference{
+					{
+						APIVersion: "kubeflow.org/v1beta1",
+						Kind:       "Trial",
+						Name:       "trial-b",
+						UID:        "trial-b-uid",
+					},
+				})
+				return trial
+			}(),
+			wantSame: true,
+		},
+		"Trial with the different parameter value has the different fingerprint": {
+			trial: newTrial("trial-b", "namespace-a", "0.02", "sgd"),
+		},
+		"Trial with the different run spec has the different fingerprint": {
+			trial: func() *trialsv1beta1.Trial {
+				trial := newTrial("trial-b", "namespace-a", "0.01", "sgd")
+				trial.Spec.RunSpec.SetLabels(map[string]string{"key": "value"})
+				return trial
+			}(),
+		},
+		"Trial without run spec": {
+			trial: func() *trialsv1beta1.Trial {
+				trial := newTrial("trial-b", "namespace-a", "0.01", "sgd")
+				trial.Spec.RunSpec = nil
+				return trial
+			}(),
+			wantError: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := GetTrialFingerprint(tc.trial)
+			if tc.wantError != (err != nil) {
+				t.Fatalf("Unexpected error from GetTrialFingerprint: %v", err)
+			}
+			if err == nil && tc.wantSame != (got == fingerprint) {
+				t.Errorf("Unexpected fingerprint, want same: %v, fingerprint: %s, got: %s", tc.wantSame, fingerprint, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2022 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	trialsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1"
+)
+
+// trialKind is the kind of the Trial in the owner references.
+const trialKind = "Trial"
+
+// maxOwnerDepth is the maximum number of the owners between the pod and the Trial,
+// e.g. Pod -> Job -> JobSet -> TrainJob -> Trial.
+const maxOwnerDepth = 5
+
+// GetStartedTrials returns the names of the Trials in the namespace which have any pod started.
+// Pods are matched to the Trials through their owner references, since the Trial name label
+// is added only to the pods in the namespaces with the metrics collector injection.
+// The reader should be the API reader, so the pods of the cluster are not cached.
+func GetStartedTrials(ctx context.Context, reader client.Reader, namespace string) (map[string]bool, error) {
+	pods := &corev1.PodList{}
+	if err := reader.List(ctx, pods, client.InNamespace(namespace)); err != nil {
+		return nil, err
+	}
+	// Owner UID -> name of the Trial which owns it, it is empty if the owner doesn't belong to any Trial.
+	owners := map[types.UID]string{}
+	startedTrials := map[string]bool{}
+	for i := range pods.Items {
+		if !IsPodStarted(pods.Items[i : i+1]) {
+			continue
+		}
+		trialName, err := getOwnerTrialName(ctx, reader, &pods.Items[i], owners, 0)
+		if err != nil {
+			return nil, err
+		}
+		if trialName != "" {
+			startedTrials[trialName] = true
+		}
+	}
+	return startedTrials, nil
+}
+
+// getOwnerTrialName returns the name of the Trial which owns the object directly or through its owners.
+func getOwnerTrialName(ctx context.Context, reader client.Reader, object metav1.Object, owners map[types.UID]string, depth int) (string, error) {
+	for _, owner := range object.GetOwnerReferences() {
+		if owner.Kind == trialKind && owner.APIVersion == trialsv1beta1.SchemeGroupVersion.String() {
+			return owner.Name, nil
+		}
+	}
+	if depth >= maxOwnerDepth {
+		return "", nil
+	}
+	for _, owner := range object.GetOwnerReferences() {
+		trialName, ok := owners[owner.UID]
+		if !ok {
+			ownerObject := &unstructured.Unstructured{}
+			ownerObject.SetGroupVersionKind(schema.FromAPIVersionAndKind(owner.APIVersion, owner.Kind))
+			err := reader.Get(ctx, types.NamespacedName{Name: owner.Name, Namespace: object.GetNamespace()}, ownerObject)
+			// Owners which can't be read by Katib, e.g. ReplicaSets of the Deployments, don't belong to the Trials.
+			if err != nil && !apierrors.IsNotFound(err) && !apierrors.IsForbidden(err) && !meta.IsNoMatchError(err) {
+				return "", err
+			}
+			if err == nil {
+				if trialName, err = getOwnerTrialName(ctx, reader, ownerObject, owners, depth+1); err != nil {
+					return "", err
+				}
+			}
+			owners[owner.UID] = trialName
+		}
+		if trialName != "" {
+			return trialName, nil
+		}
+	}
+	return "", nil
+}
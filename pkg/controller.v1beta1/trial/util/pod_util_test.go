@@ -0,0 +1,106 @@
+/*
+Copyright 2022 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestGetStartedTrials(t *testing.T) {
+	const namespace = "test"
+	newOwnerReference := func(apiVersion, kind, name string) metav1.OwnerReference {
+		return metav1.OwnerReference{
+			APIVersion: apiVersion,
+			Kind:       kind,
+			Name:       name,
+			UID:        types.UID(kind + "-" + name),
+		}
+	}
+	newJob := func(name string, owner metav1.OwnerReference) *batchv1.Job {
+		return &batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            name,
+				Namespace:       namespace,
+				OwnerReferences: []metav1.OwnerReference{owner},
+			},
+		}
+	}
+	newPod := func(name string, phase corev1.PodPhase, owner metav1.OwnerReference) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            name,
+				Namespace:       namespace,
+				OwnerReferences: []metav1.OwnerReference{owner},
+			},
+			Status: corev1.PodStatus{Phase: phase},
+		}
+	}
+
+	testCases := map[string]struct {
+		objects          []client.Object
+		wantStartedTrial map[string]bool
+	}{
+		"Pods of the Trial jobs without the Trial name label": {
+			objects: []client.Object{
+				newJob("trial-1", newOwnerReference("kubeflow.org/v1beta1", "Trial", "trial-1")),
+				newPod("trial-1-worker", corev1.PodRunning, newOwnerReference("batch/v1", "Job", "trial-1")),
+				newJob("trial-2", newOwnerReference("kubeflow.org/v1beta1", "Trial", "trial-2")),
+				newPod("trial-2-worker", corev1.PodSucceeded, newOwnerReference("batch/v1", "Job", "trial-2")),
+			},
+			wantStartedTrial: map[string]bool{"trial-1": true, "trial-2": true},
+		},
+		"Trials with only pending pods are not started": {
+			objects: []client.Object{
+				newJob("trial-1", newOwnerReference("kubeflow.org/v1beta1", "Trial", "trial-1")),
+				newPod("trial-1-worker", corev1.PodPending, newOwnerReference("batch/v1", "Job", "trial-1")),
+			},
+			wantStartedTrial: map[string]bool{},
+		},
+		"Pods owned by the Trial and by other jobs": {
+			objects: []client.Object{
+				newPod("trial-1", corev1.PodRunning, newOwnerReference("kubeflow.org/v1beta1", "Trial", "trial-1")),
+				newJob("other", newOwnerReference("batch/v1", "CronJob", "other")),
+				newPod("other-worker", corev1.PodRunning, newOwnerReference("batch/v1", "Job", "other")),
+				newPod("deployment-pod", corev1.PodRunning, newOwnerReference("apps/v1", "ReplicaSet", "deleted")),
+				newPod("unknown-kind-pod", corev1.PodRunning, newOwnerReference("example.com/v1", "Unknown", "unknown")),
+			},
+			wantStartedTrial: map[string]bool{"trial-1": true},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			reader := fake.NewClientBuilder().WithScheme(clientgoscheme.Scheme).WithObjects(tc.objects...).Build()
+			got, err := GetStartedTrials(context.TODO(), reader, namespace)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantStartedTrial, got); len(diff) != 0 {
+				t.Errorf("Unexpected started Trials (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
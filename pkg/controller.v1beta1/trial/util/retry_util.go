@@ -0,0 +1,67 @@
+/*
+Copyright 2022 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"regexp"
+	"time"
+
+	trialsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1"
+)
+
+// maxRetryBackoff is the upper bound of the delay before the retry of the failed Trial run.
+const maxRetryBackoff = time.Hour
+
+// IsRetryRequired returns true if the failed run of the Trial must be recreated according to its retry policy.
+func IsRetryRequired(trial *trialsv1beta1.Trial, jobStatus *TrialJobStatus) bool {
+	policy := trial.Spec.RetryPolicy
+	if policy == nil || jobStatus.Condition != JobFailed || int32(len(trial.Status.Attempts)) >= policy.MaxRetries {
+		return false
+	}
+	if len(policy.OnReasons) == 0 && len(policy.OnMessages) == 0 {
+		return true
+	}
+	return matchesAny(policy.OnReasons, jobStatus.Reason) || matchesAny(policy.OnMessages, jobStatus.Message)
+}
+
+// GetRetryBackoff returns how long the Trial waits before its failed run is recreated.
+// The delay is doubled for every retry.
+func GetRetryBackoff(trial *trialsv1beta1.Trial, now time.Time) time.Duration {
+	policy := trial.Spec.RetryPolicy
+	if policy == nil || policy.Backoff == nil || len(trial.Status.Attempts) == 0 {
+		return 0
+	}
+	lastAttempt := trial.Status.Attempts[len(trial.Status.Attempts)-1]
+	if lastAttempt.CompletionTime == nil {
+		return 0
+	}
+	backoff := policy.Backoff.Duration
+	for i := 1; i < len(trial.Status.Attempts) && backoff < maxRetryBackoff; i++ {
+		backoff *= 2
+	}
+	backoff = min(backoff, maxRetryBackoff)
+	return max(lastAttempt.CompletionTime.Add(backoff).Sub(now), 0)
+}
+
+func matchesAny(patterns []string, value string) bool {
+	for _, p := range patterns {
+		if matched, err := regexp.MatchString(p, value); err == nil && matched {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,168 @@
+/*
+Copyright 2022 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	commonv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1"
+	trialsv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/trials/v1beta1"
+)
+
+func TestIsRetryRequired(t *testing.T) {
+	failedJob := &TrialJobStatus{
+		Condition: JobFailed,
+		Reason:    "BackoffLimitExceeded",
+		Message:   "Pod was evicted",
+	}
+
+	testCases := map[string]struct {
+		policy       *commonv1beta1.RetryPolicy
+		attempts     int
+		jobStatus    *TrialJobStatus
+		wantRequired bool
+	}{
+		"Run is not retried without retry policy": {
+			jobStatus:    failedJob,
+			wantRequired: false,
+		},
+		"Failed run is retried": {
+			policy:       &commonv1beta1.RetryPolicy{MaxRetries: 2},
+			attempts:     1,
+			jobStatus:    failedJob,
+			wantRequired: true,
+		},
+		"Running run is not retried": {
+			policy:       &commonv1beta1.RetryPolicy{MaxRetries: 2},
+			jobStatus:    &TrialJobStatus{Condition: JobRunning},
+			wantRequired: false,
+		},
+		"Run is not retried after max retries": {
+			policy:       &commonv1beta1.RetryPolicy{MaxRetries: 2},
+			attempts:     2,
+			jobStatus:    failedJob,
+			wantRequired: false,
+		},
+		"Run is retried if reason matches": {
+			policy: &commonv1beta1.RetryPolicy{
+				MaxRetries: 1,
+				OnReasons:  []string{"^Backoff"},
+			},
+			jobStatus:    failedJob,
+			wantRequired: true,
+		},
+		"Run is retried if message matches": {
+			policy: &commonv1beta1.RetryPolicy{
+				MaxRetries: 1,
+				OnReasons:  []string{"DeadlineExceeded"},
+				OnMessages: []string{"(?i)evicted"},
+			},
+			jobStatus:    failedJob,
+			wantRequired: true,
+		},
+		"Run is not retried if nothing matches": {
+			policy: &commonv1beta1.RetryPolicy{
+				MaxRetries: 1,
+				OnReasons:  []string{"DeadlineExceeded"},
+				OnMessages: []string{"OOMKilled"},
+			},
+			jobStatus:    failedJob,
+			wantRequired: false,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			trial := &trialsv1beta1.Trial{
+				Spec: trialsv1beta1.TrialSpec{
+					RetryPolicy: tc.policy,
+				},
+				Status: trialsv1beta1.TrialStatus{
+					Attempts: make([]trialsv1beta1.TrialAttempt, tc.attempts),
+				},
+			}
+			if got := IsRetryRequired(trial, tc.jobStatus); tc.wantRequired != got {
+				t.Errorf("Unexpected retry required, want %v, got %v", tc.wantRequired, got)
+			}
+		})
+	}
+}
+
+func TestGetRetryBackoff(t *testing.T) {
+	now := time.Now()
+	policy := &commonv1beta1.RetryPolicy{
+		MaxRetries: 10,
+		Backoff:    &metav1.Duration{Duration: 10 * time.Second},
+	}
+	attempts := func(count int, lastCompleted time.Time) []trialsv1beta1.TrialAttempt {
+		attempts := make([]trialsv1beta1.TrialAttempt, count)
+		attempts[count-1].CompletionTime = &metav1.Time{Time: lastCompleted}
+		return attempts
+	}
+
+	testCases := map[string]struct {
+		policy      *commonv1beta1.RetryPolicy
+		attempts    []trialsv1beta1.TrialAttempt
+		wantBackoff time.Duration
+	}{
+		"No backoff without retry policy": {
+			attempts:    attempts(1, now),
+			wantBackoff: 0,
+		},
+		"No backoff before the first retry": {
+			policy:      policy,
+			wantBackoff: 0,
+		},
+		"Backoff after the first failure": {
+			policy:      policy,
+			attempts:    attempts(1, now.Add(-4*time.Second)),
+			wantBackoff: 6 * time.Second,
+		},
+		"Backoff is doubled for every retry": {
+			policy:      policy,
+			attempts:    attempts(3, now),
+			wantBackoff: 40 * time.Second,
+		},
+		"Backoff is capped": {
+			policy:      policy,
+			attempts:    attempts(10, now),
+			wantBackoff: maxRetryBackoff,
+		},
+		"Backoff is elapsed": {
+			policy:      policy,
+			attempts:    attempts(1, now.Add(-time.Minute)),
+			wantBackoff: 0,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			trial := &trialsv1beta1.Trial{
+				Spec: trialsv1beta1.TrialSpec{
+					RetryPolicy: tc.policy,
+				},
+				Status: trialsv1beta1.TrialStatus{
+					Attempts: tc.attempts,
+				},
+			}
+			if got := GetRetryBackoff(trial, now); tc.wantBackoff != got {
+				t.Errorf("Unexpected retry backoff, want %v, got %v", tc.wantBackoff, got)
+			}
+		})
+	}
+}
@@ -24,7 +24,9 @@ type KatibDBInterface interface {
 	DBInit()
 	SelectOne() error
 
-	RegisterObservationLog(trialName string, observationLog *v1beta1.ObservationLog) error
-	GetObservationLog(trialName string, metricName string, startTime string, endTime string) (*v1beta1.ObservationLog, error)
+	// RegisterObservationLog stores the observation log. If the idempotency key is set and the observation log
+	// with the same key has been stored for the Trial, the observation log is not stored again.
+	RegisterObservationLog(trialName string, observationLog *v1beta1.ObservationLog, idempotencyKey string) error
+	GetObservationLog(trialName string, metricName string, startTime string, endTime string, startStep *int64, endStep *int64) (*v1beta1.ObservationLog, error)
 	DeleteObservationLog(trialName string) error
 }
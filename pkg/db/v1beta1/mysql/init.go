@@ -17,6 +17,7 @@ limitations under the License.
 package mysql
 
 import (
+	"database/sql"
 	"fmt"
 
 	"k8s.io/klog/v2"
@@ -37,20 +38,88 @@ func (d *dbConn) DBInit() {
 		id INT AUTO_INCREMENT PRIMARY KEY,
 		time DATETIME(6),
 		metric_name VARCHAR(255) NOT NULL,
-		value TEXT NOT NULL)`)
+		value TEXT NOT NULL,
+		step BIGINT,
+		source VARCHAR(255) NOT NULL DEFAULT '',
+		INDEX observation_logs_trial_name_step (trial_name, step))`)
 		if err != nil {
 			klog.Fatalf("Error creating observation_logs table: %v", err)
 		}
+
+		// Table created by the earlier versions doesn't have the step and source columns.
+		for _, m := range migrations {
+			found, err := hasColumn(db, m.column)
+			if err != nil {
+				klog.Fatalf("Error checking %s column of observation_logs table: %v", m.column, err)
+			}
+			if !found {
+				if _, err = db.Exec(m.query); err != nil {
+					klog.Fatalf("Error adding %s column to observation_logs table: %v", m.column, err)
+				}
+			}
+		}
+
+		if _, err = db.Exec(createObservationLogReportsQuery); err != nil {
+			klog.Fatalf("Error creating observation_log_reports table: %v", err)
+		}
 	} else {
 		klog.Info("Skipping v1beta1 DB schema initialization.")
 
-		_, err := db.Query(`SELECT trial_name, id, time, metric_name, value FROM observation_logs LIMIT 1`)
+		rows, err := db.Query(`SELECT trial_name, id, time, metric_name, value FROM observation_logs LIMIT 1`)
 		if err != nil {
 			klog.Fatalf("Error validating observation_logs table: %v", err)
 		}
+		rows.Close()
+
+		// Externally managed table must be migrated by its owner, since the columns are not added here.
+		// Observation logs can't be reported without the columns, so DB manager fails fast.
+		for _, m := range migrations {
+			found, err := hasColumn(db, m.column)
+			if err != nil {
+				klog.Fatalf("Error checking %s column of observation_logs table: %v", m.column, err)
+			}
+			if !found {
+				klog.Fatalf("observation_logs table doesn't have %s column, migrate the table with: %s", m.column, m.query)
+			}
+		}
+
+		// Externally managed DB must also have the table which stores the idempotency keys of the reports.
+		rows, err = db.Query(`SELECT trial_name, idempotency_key FROM observation_log_reports LIMIT 1`)
+		if err != nil {
+			klog.Fatalf("Error validating observation_log_reports table: %v, create the table with: %s", err, createObservationLogReportsQuery)
+		}
+		rows.Close()
 	}
 }
 
+// createObservationLogReportsQuery creates the table to store the idempotency keys of the reported observation logs.
+const createObservationLogReportsQuery = `CREATE TABLE IF NOT EXISTS observation_log_reports
+(trial_name VARCHAR(255) NOT NULL,
+idempotency_key VARCHAR(255) NOT NULL,
+PRIMARY KEY (trial_name, idempotency_key))`
+
+// migrations add the columns which the tables created by the earlier versions don't have.
+var migrations = []struct {
+	column string
+	query  string
+}{
+	{
+		column: "step",
+		query:  "ALTER TABLE observation_logs ADD COLUMN step BIGINT, ADD INDEX observation_logs_trial_name_step (trial_name, step)",
+	},
+	{
+		column: "source",
+		query:  "ALTER TABLE observation_logs ADD COLUMN source VARCHAR(255) NOT NULL DEFAULT ''",
+	},
+}
+
+func hasColumn(db *sql.DB, column string) (bool, error) {
+	var columns int
+	err := db.QueryRow(`SELECT COUNT(*) FROM information_schema.COLUMNS
+	WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = 'observation_logs' AND COLUMN_NAME = ?`, column).Scan(&columns)
+	return columns > 0, err
+}
+
 func (d *dbConn) SelectOne() error {
 	db := d.db
 	_, err := db.Exec(`SELECT 1`)
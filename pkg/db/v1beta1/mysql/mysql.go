@@ -64,8 +64,29 @@ func NewDBInterface(connectTimeout time.Duration) (common.KatibDBInterface, erro
 	return &dbConn{db: db}, nil
 }
 
-func (d *dbConn) RegisterObservationLog(trialName string, observationLog *v1beta1.ObservationLog) error {
-	sqlQuery := "INSERT INTO observation_logs (trial_name, time, metric_name, value) VALUES "
+func (d *dbConn) RegisterObservationLog(trialName string, observationLog *v1beta1.ObservationLog, idempotencyKey string) error {
+	tx, err := d.db.Begin()
+	if err != nil {
+		return fmt.Errorf("Begin SQL transaction failed: %v", err)
+	}
+	// Rollback is no-op after the commit.
+	defer tx.Rollback()
+
+	if idempotencyKey != "" {
+		result, err := tx.Exec("INSERT IGNORE INTO observation_log_reports (trial_name, idempotency_key) VALUES (?, ?)", trialName, idempotencyKey)
+		if err != nil {
+			return fmt.Errorf("Execute SQL INSERT of the idempotency key failed: %v", err)
+		}
+		if inserted, err := result.RowsAffected(); err != nil {
+			return err
+		} else if inserted == 0 {
+			// The report has been stored, e.g. by the request which timed out in the client.
+			klog.Infof("Skip the observation log of Trial %s stored with the idempotency key %s", trialName, idempotencyKey)
+			return nil
+		}
+	}
+
+	sqlQuery := "INSERT INTO observation_logs (trial_name, time, metric_name, value, step, source) VALUES "
 	values := []interface{}{}
 
 	for _, mlog := range observationLog.MetricLogs {
@@ -78,13 +99,13 @@ func (d *dbConn) RegisterObservationLog(trialName string, observationLog *v1beta
 		}
 		sqlTimeStr := t.UTC().Format(mysqlTimeFmt)
 
-		sqlQuery += "(?, ?, ?, ?),"
-		values = append(values, trialName, sqlTimeStr, mlog.Metric.Name, mlog.Metric.Value)
+		sqlQuery += "(?, ?, ?, ?, ?, ?),"
+		values = append(values, trialName, sqlTimeStr, mlog.Metric.Name, mlog.Metric.Value, sql.NullInt64{Int64: mlog.GetStep(), Valid: mlog.Step != nil}, mlog.Source)
 	}
 	sqlQuery = sqlQuery[0 : len(sqlQuery)-1]
 
 	// Prepare the statement
-	stmt, err := d.db.Prepare(sqlQuery)
+	stmt, err := tx.Prepare(sqlQuery)
 	if err != nil {
 		return fmt.Errorf("Prepare SQL statement failed: %v", err)
 	}
@@ -98,15 +119,18 @@ func (d *dbConn) RegisterObservationLog(trialName string, observationLog *v1beta
 		return fmt.Errorf("Execute SQL INSERT failed: %v", err)
 	}
 
-	return nil
+	return tx.Commit()
 }
 
 func (d *dbConn) DeleteObservationLog(trialName string) error {
-	_, err := d.db.Exec("DELETE FROM observation_logs WHERE trial_name = ?", trialName)
+	if _, err := d.db.Exec("DELETE FROM observation_logs WHERE trial_name = ?", trialName); err != nil {
+		return err
+	}
+	_, err := d.db.Exec("DELETE FROM observation_log_reports WHERE trial_name = ?", trialName)
 	return err
 }
 
-func (d *dbConn) GetObservationLog(trialName string, metricName string, startTime string, endTime string) (*v1beta1.ObservationLog, error) {
+func (d *dbConn) GetObservationLog(trialName string, metricName string, startTime string, endTime string, startStep *int64, endStep *int64) (*v1beta1.ObservationLog, error) {
 	qfield := []interface{}{trialName}
 	qstr := ""
 	if metricName != "" {
@@ -131,7 +155,15 @@ func (d *dbConn) GetObservationLog(trialName string, metricName string, startTim
 		qstr += " AND time <= ?"
 		qfield = append(qfield, formattedEndTime)
 	}
-	rows, err := d.db.Query("SELECT time, metric_name, value FROM observation_logs WHERE trial_name = ?"+qstr+" ORDER BY time",
+	if startStep != nil {
+		qstr += " AND step >= ?"
+		qfield = append(qfield, *startStep)
+	}
+	if endStep != nil {
+		qstr += " AND step <= ?"
+		qfield = append(qfield, *endStep)
+	}
+	rows, err := d.db.Query("SELECT time, metric_name, value, step, source FROM observation_logs WHERE trial_name = ?"+qstr+" ORDER BY time",
 		qfield...)
 	if err != nil {
 		return nil, fmt.Errorf("Failed to get ObservationLogs %v", err)
@@ -142,8 +174,9 @@ func (d *dbConn) GetObservationLog(trialName string, metricName string, startTim
 		MetricLogs: []*v1beta1.MetricLog{},
 	}
 	for rows.Next() {
-		var mname, mvalue, sqlTimeStr string
-		err := rows.Scan(&sqlTimeStr, &mname, &mvalue)
+		var mname, mvalue, sqlTimeStr, source string
+		var step sql.NullInt64
+		err := rows.Scan(&sqlTimeStr, &mname, &mvalue, &step, &source)
 		if err != nil {
 			klog.Errorf("Error scanning log: %v", err)
 			continue
@@ -154,13 +187,18 @@ func (d *dbConn) GetObservationLog(trialName string, metricName string, startTim
 			continue
 		}
 		timeStamp := ptime.UTC().Format(time.RFC3339Nano)
-		result.MetricLogs = append(result.MetricLogs, &v1beta1.MetricLog{
+		mlog := &v1beta1.MetricLog{
 			TimeStamp: timeStamp,
 			Metric: &v1beta1.Metric{
 				Name:  mname,
 				Value: mvalue,
 			},
-		})
+			Source: source,
+		}
+		if step.Valid {
+			mlog.Step = &step.Int64
+		}
+		result.MetricLogs = append(result.MetricLogs, mlog)
 	}
 	return result, nil
 }
@@ -23,6 +23,7 @@ import (
 
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
 	_ "github.com/go-sql-driver/mysql"
+	"k8s.io/utils/ptr"
 
 	api_pb "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
 	"github.com/kubeflow/katib/pkg/db/v1beta1/common"
@@ -40,6 +41,12 @@ func TestMain(m *testing.M) {
 	}
 	dbInterface = &dbConn{db: db}
 	mock.ExpectExec("CREATE TABLE IF NOT EXISTS observation_logs").WithArgs().WillReturnResult(sqlmock.NewResult(1, 1))
+	// Table created by the earlier versions is migrated to have the step and source columns.
+	mock.ExpectQuery("SELECT COUNT").WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	mock.ExpectExec("ALTER TABLE observation_logs").WithArgs().WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery("SELECT COUNT").WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	mock.ExpectExec("ALTER TABLE observation_logs ADD COLUMN source").WithArgs().WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("CREATE TABLE IF NOT EXISTS observation_log_reports").WithArgs().WillReturnResult(sqlmock.NewResult(0, 0))
 	dbInterface.DBInit()
 	err = dbInterface.SelectOne()
 	if err != nil {
@@ -57,6 +64,8 @@ func TestRegisterObservationLog(t *testing.T) {
 					Name:  "f1_score",
 					Value: "88.95",
 				},
+				Step:   ptr.To[int64](1),
+				Source: "metrics-0.log",
 			},
 			{
 				TimeStamp: "2016-12-31T20:02:05.123456Z",
@@ -67,6 +76,7 @@ func TestRegisterObservationLog(t *testing.T) {
 			},
 		},
 	}
+	mock.ExpectBegin()
 	mock.ExpectPrepare("INSERT")
 	mock.ExpectExec(
 		"INSERT",
@@ -75,29 +85,74 @@ func TestRegisterObservationLog(t *testing.T) {
 		"2016-12-31 20:02:05.123456",
 		"f1_score",
 		"88.95",
+		int64(1),
+		"metrics-0.log",
 		"test1_trial1",
 		"2016-12-31 20:02:05.123456",
 		"loss",
 		"0.5",
+		nil,
+		"",
 	).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
 
-	err := dbInterface.RegisterObservationLog("test1_trial1", obsLog)
+	err := dbInterface.RegisterObservationLog("test1_trial1", obsLog, "")
 	if err != nil {
 		t.Errorf("RegisterExperiment failed: %v", err)
 	}
 
 }
 
+func TestRegisterObservationLogWithIdempotencyKey(t *testing.T) {
+	obsLog := &api_pb.ObservationLog{
+		MetricLogs: []*api_pb.MetricLog{
+			{
+				TimeStamp: "2016-12-31T20:02:05.123456Z",
+				Metric: &api_pb.Metric{
+					Name:  "loss",
+					Value: "0.5",
+				},
+			},
+		},
+	}
+
+	// The report with the new key is stored.
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT IGNORE INTO observation_log_reports").WithArgs("test1_trial1", "key-1").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectPrepare("INSERT INTO observation_logs")
+	mock.ExpectExec("INSERT INTO observation_logs").WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	if err := dbInterface.RegisterObservationLog("test1_trial1", obsLog, "key-1"); err != nil {
+		t.Errorf("RegisterObservationLog failed: %v", err)
+	}
+
+	// The retried report with the stored key is skipped.
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT IGNORE INTO observation_log_reports").WithArgs("test1_trial1", "key-1").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectRollback()
+	if err := dbInterface.RegisterObservationLog("test1_trial1", obsLog, "key-1"); err != nil {
+		t.Errorf("RegisterObservationLog failed: %v", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("RegisterObservationLog didn't skip the stored report: %v", err)
+	}
+}
+
 func TestGetObservationLog(t *testing.T) {
 	mock.ExpectQuery("SELECT").WillReturnRows(
-		sqlmock.NewRows([]string{"time", "metric_name", "value"}).AddRow(
+		sqlmock.NewRows([]string{"time", "metric_name", "value", "step", "source"}).AddRow(
 			"2016-12-31 21:02:05.123456",
 			"loss",
 			"0.9",
+			int64(1),
+			"metrics-0.log",
 		).AddRow(
 			"2016-12-31 22:02:05.123456",
 			"loss",
 			"0.9",
+			int64(2),
+			"metrics-1.log",
 		),
 	)
 	obsLog, err := dbInterface.GetObservationLog(
@@ -105,10 +160,13 @@ func TestGetObservationLog(t *testing.T) {
 		"loss",
 		"2016-12-31T21:01:05.123456Z",
 		"2016-12-31T22:10:20.123456Z",
+		ptr.To[int64](1),
+		ptr.To[int64](2),
 	)
 	if err != nil {
 		t.Errorf("GetObservationLog failed %v", err)
-	} else if len(obsLog.MetricLogs) != 2 {
+	} else if len(obsLog.MetricLogs) != 2 || obsLog.MetricLogs[0].GetStep() != 1 || obsLog.MetricLogs[1].GetStep() != 2 ||
+		obsLog.MetricLogs[0].GetSource() != "metrics-0.log" || obsLog.MetricLogs[1].GetSource() != "metrics-1.log" {
 		t.Errorf("GetObservationLog incorrect return %v", obsLog)
 	}
 
@@ -120,6 +178,9 @@ func TestDeleteObservationLog(t *testing.T) {
 	mock.ExpectExec(
 		"DELETE FROM observation_logs",
 	).WithArgs(trialName).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(
+		"DELETE FROM observation_log_reports",
+	).WithArgs(trialName).WillReturnResult(sqlmock.NewResult(1, 1))
 
 	err := dbInterface.DeleteObservationLog(trialName)
 	if err != nil {
@@ -17,7 +17,9 @@ limitations under the License.
 package postgres
 
 import (
+	"database/sql"
 	"fmt"
+	"strings"
 
 	"k8s.io/klog/v2"
 
@@ -37,20 +39,88 @@ func (d *dbConn) DBInit() {
 		id serial PRIMARY KEY,
 		time TIMESTAMP(6),
 		metric_name VARCHAR(255) NOT NULL,
-		value TEXT NOT NULL)`)
+		value TEXT NOT NULL,
+		step BIGINT,
+		source VARCHAR(255) NOT NULL DEFAULT '')`)
 		if err != nil {
 			klog.Fatalf("Error creating observation_logs table: %v", err)
 		}
+
+		// Table created by the earlier versions doesn't have the step and source columns.
+		for _, m := range migrations {
+			for _, query := range m.queries {
+				if _, err = db.Exec(query); err != nil {
+					klog.Fatalf("Error adding %s column to observation_logs table: %v", m.column, err)
+				}
+			}
+		}
+
+		if _, err = db.Exec(createObservationLogReportsQuery); err != nil {
+			klog.Fatalf("Error creating observation_log_reports table: %v", err)
+		}
 	} else {
 		klog.Info("Skipping v1beta1 DB schema initialization.")
 
-		_, err := db.Query(`SELECT trial_name, id, time, metric_name, value FROM observation_logs LIMIT 1`)
+		rows, err := db.Query(`SELECT trial_name, id, time, metric_name, value FROM observation_logs LIMIT 1`)
 		if err != nil {
 			klog.Fatalf("Error validating observation_logs table: %v", err)
 		}
+		rows.Close()
+
+		// Externally managed table must be migrated by its owner, since the columns are not added here.
+		// Observation logs can't be reported without the columns, so DB manager fails fast.
+		for _, m := range migrations {
+			found, err := hasColumn(db, m.column)
+			if err != nil {
+				klog.Fatalf("Error checking %s column of observation_logs table: %v", m.column, err)
+			}
+			if !found {
+				klog.Fatalf("observation_logs table doesn't have %s column, migrate the table with: %s", m.column, strings.Join(m.queries, "; "))
+			}
+		}
+
+		// Externally managed DB must also have the table which stores the idempotency keys of the reports.
+		rows, err = db.Query(`SELECT trial_name, idempotency_key FROM observation_log_reports LIMIT 1`)
+		if err != nil {
+			klog.Fatalf("Error validating observation_log_reports table: %v, create the table with: %s", err, createObservationLogReportsQuery)
+		}
+		rows.Close()
 	}
 }
 
+// createObservationLogReportsQuery creates the table to store the idempotency keys of the reported observation logs.
+const createObservationLogReportsQuery = `CREATE TABLE IF NOT EXISTS observation_log_reports
+(trial_name VARCHAR(255) NOT NULL,
+idempotency_key VARCHAR(255) NOT NULL,
+PRIMARY KEY (trial_name, idempotency_key))`
+
+// migrations add the columns which the tables created by the earlier versions don't have.
+var migrations = []struct {
+	column  string
+	queries []string
+}{
+	{
+		column: "step",
+		queries: []string{
+			"ALTER TABLE observation_logs ADD COLUMN IF NOT EXISTS step BIGINT",
+			"CREATE INDEX IF NOT EXISTS observation_logs_trial_name_step ON observation_logs (trial_name, step)",
+		},
+	},
+	{
+		column: "source",
+		queries: []string{
+			"ALTER TABLE observation_logs ADD COLUMN IF NOT EXISTS source VARCHAR(255) NOT NULL DEFAULT ''",
+		},
+	},
+}
+
+func hasColumn(db *sql.DB, column string) (bool, error) {
+	var columns int
+	err := db.QueryRow(`SELECT COUNT(*) FROM information_schema.columns
+	WHERE table_schema = current_schema() AND table_name = 'observation_logs' AND column_name = $1`, column).Scan(&columns)
+	return columns > 0, err
+}
+
 func (d *dbConn) SelectOne() error {
 	db := d.db
 	_, err := db.Exec(`SELECT 1`)
@@ -66,8 +66,29 @@ func NewDBInterface(connectTimeout time.Duration) (common.KatibDBInterface, erro
 	return &dbConn{db: db}, nil
 }
 
-func (d *dbConn) RegisterObservationLog(trialName string, observationLog *v1beta1.ObservationLog) error {
-	statement := "INSERT INTO observation_logs (trial_name, time, metric_name, value) VALUES "
+func (d *dbConn) RegisterObservationLog(trialName string, observationLog *v1beta1.ObservationLog, idempotencyKey string) error {
+	tx, err := d.db.Begin()
+	if err != nil {
+		return fmt.Errorf("Begin SQL transaction failed: %v", err)
+	}
+	// Rollback is no-op after the commit.
+	defer tx.Rollback()
+
+	if idempotencyKey != "" {
+		result, err := tx.Exec("INSERT INTO observation_log_reports (trial_name, idempotency_key) VALUES ($1, $2) ON CONFLICT DO NOTHING", trialName, idempotencyKey)
+		if err != nil {
+			return fmt.Errorf("Execute SQL INSERT of the idempotency key failed: %v", err)
+		}
+		if inserted, err := result.RowsAffected(); err != nil {
+			return err
+		} else if inserted == 0 {
+			// The report has been stored, e.g. by the request which timed out in the client.
+			klog.Infof("Skip the observation log of Trial %s stored with the idempotency key %s", trialName, idempotencyKey)
+			return nil
+		}
+	}
+
+	statement := "INSERT INTO observation_logs (trial_name, time, metric_name, value, step, source) VALUES "
 	values := []interface{}{}
 
 	index_of_qparam := 1
@@ -81,17 +102,17 @@ func (d *dbConn) RegisterObservationLog(trialName string, observationLog *v1beta
 		}
 		sqlTimeStr := t.UTC().Format(time.RFC3339Nano)
 
-		statement += fmt.Sprintf("($%d, $%d, $%d, $%d),",
-			index_of_qparam, index_of_qparam+1, index_of_qparam+2, index_of_qparam+3,
+		statement += fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, $%d),",
+			index_of_qparam, index_of_qparam+1, index_of_qparam+2, index_of_qparam+3, index_of_qparam+4, index_of_qparam+5,
 		)
-		values = append(values, trialName, sqlTimeStr, mlog.Metric.Name, mlog.Metric.Value)
-		index_of_qparam += 4
+		values = append(values, trialName, sqlTimeStr, mlog.Metric.Name, mlog.Metric.Value, sql.NullInt64{Int64: mlog.GetStep(), Valid: mlog.Step != nil}, mlog.Source)
+		index_of_qparam += 6
 	}
 
 	statement = statement[:len(statement)-1]
 
 	// Prepare the statement
-	stmt, err := d.db.Prepare(statement)
+	stmt, err := tx.Prepare(statement)
 	if err != nil {
 		return fmt.Errorf("Prepare SQL statement failed: %v", err)
 	}
@@ -105,15 +126,15 @@ func (d *dbConn) RegisterObservationLog(trialName string, observationLog *v1beta
 		return fmt.Errorf("Execute SQL INSERT failed: %v", err)
 	}
 
-	return nil
+	return tx.Commit()
 }
 
-func (d *dbConn) GetObservationLog(trialName string, metricName string, startTime string, endTime string) (*v1beta1.ObservationLog, error) {
+func (d *dbConn) GetObservationLog(trialName string, metricName string, startTime string, endTime string, startStep *int64, endStep *int64) (*v1beta1.ObservationLog, error) {
 	qfield := []interface{}{trialName}
 	qstr := ""
 	index_of_qparam := 1
 
-	base_stmt := fmt.Sprintf("SELECT time, metric_name, value FROM observation_logs WHERE trial_name = $%d", index_of_qparam)
+	base_stmt := fmt.Sprintf("SELECT time, metric_name, value, step, source FROM observation_logs WHERE trial_name = $%d", index_of_qparam)
 	index_of_qparam += 1
 
 	if metricName != "" {
@@ -140,6 +161,16 @@ func (d *dbConn) GetObservationLog(trialName string, metricName string, startTim
 		formattedEndTime := e_time.UTC().Format(time.RFC3339Nano)
 		qstr += fmt.Sprintf(" AND time <= $%d", index_of_qparam)
 		qfield = append(qfield, formattedEndTime)
+		index_of_qparam += 1
+	}
+	if startStep != nil {
+		qstr += fmt.Sprintf(" AND step >= $%d", index_of_qparam)
+		qfield = append(qfield, *startStep)
+		index_of_qparam += 1
+	}
+	if endStep != nil {
+		qstr += fmt.Sprintf(" AND step <= $%d", index_of_qparam)
+		qfield = append(qfield, *endStep)
 		// index_of_qparam += 1  // if any other filters are added, this should be incremented
 	}
 
@@ -155,8 +186,9 @@ func (d *dbConn) GetObservationLog(trialName string, metricName string, startTim
 		MetricLogs: []*v1beta1.MetricLog{},
 	}
 	for rows.Next() {
-		var mname, mvalue, sqlTimeStr string
-		err := rows.Scan(&sqlTimeStr, &mname, &mvalue)
+		var mname, mvalue, sqlTimeStr, source string
+		var step sql.NullInt64
+		err := rows.Scan(&sqlTimeStr, &mname, &mvalue, &step, &source)
 		if err != nil {
 			klog.Errorf("Error scanning log: %v", err)
 			continue
@@ -167,20 +199,28 @@ func (d *dbConn) GetObservationLog(trialName string, metricName string, startTim
 			continue
 		}
 		timeStamp := ptime.UTC().Format(time.RFC3339Nano)
-		result.MetricLogs = append(result.MetricLogs, &v1beta1.MetricLog{
+		mlog := &v1beta1.MetricLog{
 			TimeStamp: timeStamp,
 			Metric: &v1beta1.Metric{
 				Name:  mname,
 				Value: mvalue,
 			},
-		})
+			Source: source,
+		}
+		if step.Valid {
+			mlog.Step = &step.Int64
+		}
+		result.MetricLogs = append(result.MetricLogs, mlog)
 	}
 
 	return result, nil
 }
 
 func (d *dbConn) DeleteObservationLog(trialName string) error {
-	_, err := d.db.Exec("DELETE FROM observation_logs WHERE trial_name = $1", trialName)
+	if _, err := d.db.Exec("DELETE FROM observation_logs WHERE trial_name = $1", trialName); err != nil {
+		return err
+	}
+	_, err := d.db.Exec("DELETE FROM observation_log_reports WHERE trial_name = $1", trialName)
 
 	return err
 }
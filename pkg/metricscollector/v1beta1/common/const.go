@@ -30,6 +30,15 @@ const (
 	DefaultTimeout = 0
 	// DefaultWaitAll is the default value whether wait for all other main process of container exiting
 	DefaultWaitAllProcesses = "true"
+	// DefaultScrapeInterval is the default value for interval between Prometheus metrics endpoint scrapes
+	DefaultScrapeInterval = 10 * time.Second
+	// DefaultScrapeTimeout is the default value for timeout of the single Prometheus metrics endpoint scrape
+	DefaultScrapeTimeout = 5 * time.Second
+	// DefaultFlushInterval is the default value for interval between reports of the metrics parsed during the training
+	// To report metrics only when the training is completed set value to 0
+	DefaultFlushInterval = 10 * time.Second
+	// DefaultReportTimeout is the default value for timeout of the single report of the metrics to the DB manager
+	DefaultReportTimeout = 30 * time.Second
 	// TrainingCompleted is the job finished marker in $$$$.pid file when main training process is completed
 	TrainingCompleted = "completed"
 
@@ -48,6 +57,12 @@ const (
 
 	TimeStampJsonKey = "timestamp"
 
+	// StepKey is the name of the step of the metrics from the same log line.
+	// In JSON format it is the key of the step, e.g. {"step": 10, "loss": "0.3"}.
+	// In TEXT format it is the metric name matched by the filter, e.g. step=10 loss=0.3,
+	// or the named group of the filter, e.g. (?P<step>\d+).
+	StepKey = "step"
+
 	// TODO (andreyvelich): Do we need to maintain 2 names? Should we leave only 1?
 	MetricCollectorContainerName       = "metrics-collector"
 	MetricLoggerCollectorContainerName = "metrics-logger-and-collector"
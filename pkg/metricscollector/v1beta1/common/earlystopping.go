@@ -0,0 +1,322 @@
+/*
+Copyright 2022 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	psutil "github.com/shirou/gopsutil/v3/process"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"k8s.io/klog/v2"
+
+	commonv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1"
+	api "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+)
+
+// StopRulesFlag is the flag value for the list of early stopping rules.
+// Each rule is set in name;value;comparison;startStep format, e.g. accuracy;0.8;less;4.
+type StopRulesFlag []commonv1beta1.EarlyStoppingRule
+
+func (flag *StopRulesFlag) String() string {
+	stopRuleStrings := []string{}
+	for _, r := range *flag {
+		stopRuleStrings = append(stopRuleStrings, r.Name)
+		stopRuleStrings = append(stopRuleStrings, r.Value)
+		stopRuleStrings = append(stopRuleStrings, string(r.Comparison))
+		stopRuleStrings = append(stopRuleStrings, strconv.Itoa(r.StartStep))
+	}
+	return strings.Join(stopRuleStrings, ";")
+}
+
+func (flag *StopRulesFlag) Set(value string) error {
+	stopRuleParsed := strings.Split(value, ";")
+	if len(stopRuleParsed) != 4 {
+		return fmt.Errorf("Invalid Early Stopping rule: %v", value)
+	}
+
+	// Get int start step.
+	startStep, err := strconv.Atoi(stopRuleParsed[3])
+	if err != nil {
+		return fmt.Errorf("Parse start step: %v to int error: %v", stopRuleParsed[3], err)
+	}
+
+	// For each stop rule this order: 1 - metric name, 2 - metric value, 3 - comparison type, 4 - start step.
+	// Start step is equal to 0, if it's not defined.
+	stopRule := commonv1beta1.EarlyStoppingRule{
+		Name:       stopRuleParsed[0],
+		Value:      stopRuleParsed[1],
+		Comparison: commonv1beta1.ComparisonType(stopRuleParsed[2]),
+		StartStep:  startStep,
+	}
+
+	*flag = append(*flag, stopRule)
+	return nil
+}
+
+// StopRules tracks early stopping rules that have not been reached yet.
+// After rule is reached it is deleted from the list.
+type StopRules struct {
+	rules         []commonv1beta1.EarlyStoppingRule
+	objectiveName string
+	objectiveType commonv1beta1.ObjectiveType
+
+	// metricStartStep is the dict where key = metric name, value = start step.
+	// We should apply early stopping rule only if metric is reported at least "start_step" times.
+	// It is used only for the metrics which are reported without step.
+	metricStartStep map[string]int
+
+	// For objective metric we calculate best optimal value from the recorded metrics.
+	// This is workaround for Median Stop algorithm.
+	// TODO (andreyvelich): Think about it, maybe define latest, max or min strategy type in stop-rule as well ?
+	optimalObjValue *float64
+}
+
+// NewStopRules creates StopRules for the given rules and Experiment's objective.
+func NewStopRules(rules []commonv1beta1.EarlyStoppingRule, objectiveName string, objectiveType commonv1beta1.ObjectiveType) *StopRules {
+	metricStartStep := make(map[string]int)
+	for _, stopRule := range rules {
+		if stopRule.StartStep != 0 {
+			metricStartStep[stopRule.Name] = stopRule.StartStep
+		}
+	}
+	return &StopRules{
+		rules:           append([]commonv1beta1.EarlyStoppingRule{}, rules...),
+		objectiveName:   objectiveName,
+		objectiveType:   objectiveType,
+		metricStartStep: metricStartStep,
+	}
+}
+
+// Rules returns the copy of rules that have not been reached yet.
+func (s *StopRules) Rules() []commonv1beta1.EarlyStoppingRule {
+	return append([]commonv1beta1.EarlyStoppingRule{}, s.rules...)
+}
+
+// IsReached returns true if all rules are reached, which means that training must be early stopped.
+func (s *StopRules) IsReached() bool {
+	return len(s.rules) == 0
+}
+
+// Update applies the reported metric value to the rules for this metric.
+// Start step of the rules is the number of the reported values.
+func (s *StopRules) Update(metricName string, metricValue float64) error {
+	return s.update(metricName, metricValue, nil)
+}
+
+// UpdateAtStep applies the metric value reported at the training step to the rules for this metric.
+// Rules are applied only if the step is greater or equal than their start step.
+func (s *StopRules) UpdateAtStep(metricName string, metricValue float64, step int64) error {
+	return s.update(metricName, metricValue, &step)
+}
+
+// UpdateMetricLogs applies the parsed metric logs to the rules.
+// Metric logs with step are applied at their step. Non-numeric metric values are not evaluated by the rules.
+func (s *StopRules) UpdateMetricLogs(mlogs []*api.MetricLog) error {
+	for _, mlog := range mlogs {
+		metricValue, err := strconv.ParseFloat(strings.TrimSpace(mlog.Metric.Value), 64)
+		if err != nil {
+			klog.Warningf("Stop rules are not evaluated for metric %v since value %v is not float", mlog.Metric.Name, mlog.Metric.Value)
+			continue
+		}
+		if mlog.Step != nil {
+			err = s.UpdateAtStep(mlog.Metric.Name, metricValue, *mlog.Step)
+		} else {
+			err = s.Update(mlog.Metric.Name, metricValue)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *StopRules) update(metricName string, metricValue float64, step *int64) error {
+	for idx := 0; idx < len(s.rules); idx++ {
+		rule := s.rules[idx]
+		if rule.Name != metricName {
+			continue
+		}
+		isReached, err := s.isRuleReached(rule, metricValue, step)
+		if err != nil {
+			return err
+		}
+		if isReached {
+			s.rules = append(s.rules[:idx], s.rules[idx+1:]...)
+			idx--
+		}
+	}
+	return nil
+}
+
+func (s *StopRules) isRuleReached(rule commonv1beta1.EarlyStoppingRule, metricValue float64, step *int64) (bool, error) {
+	// Calculate optimalObjValue.
+	if rule.Name == s.objectiveName {
+		if s.optimalObjValue == nil {
+			s.optimalObjValue = &metricValue
+		} else if s.objectiveType == commonv1beta1.ObjectiveTypeMaximize && metricValue > *s.optimalObjValue {
+			s.optimalObjValue = &metricValue
+		} else if s.objectiveType == commonv1beta1.ObjectiveTypeMinimize && metricValue < *s.optimalObjValue {
+			s.optimalObjValue = &metricValue
+		}
+		// Assign best optimal value to metric value.
+		metricValue = *s.optimalObjValue
+	}
+
+	// If the metric is reported with step, apply early stopping rule from the start step.
+	// Otherwise, reduce steps if appropriate metric is reported.
+	// Once rest steps are empty we apply early stopping rule.
+	if step != nil {
+		if *step < int64(rule.StartStep) {
+			return false, nil
+		}
+	} else if _, ok := s.metricStartStep[rule.Name]; ok {
+		s.metricStartStep[rule.Name]--
+		if s.metricStartStep[rule.Name] != 0 {
+			return false, nil
+		}
+	}
+
+	ruleValue, err := strconv.ParseFloat(rule.Value, 64)
+	if err != nil {
+		return false, fmt.Errorf("unable to parse value %v to float for rule metric %v", rule.Value, rule.Name)
+	}
+
+	// Metric value can be equal, less or greater than stop rule.
+	switch rule.Comparison {
+	case commonv1beta1.ComparisonTypeEqual:
+		return metricValue == ruleValue, nil
+	case commonv1beta1.ComparisonTypeLess:
+		return metricValue < ruleValue, nil
+	case commonv1beta1.ComparisonTypeGreater:
+		return metricValue > ruleValue, nil
+	}
+	return false, nil
+}
+
+// StopTraining creates the ".pid" file with "early-stopped" line for the main process in markDir
+// and terminates the child process of the main process.
+// "early-stopped" marker means that training is early stopped and Trial status is updated.
+func StopTraining(markDir string, mainProc *psutil.Process) error {
+	markFile := filepath.Join(markDir, fmt.Sprintf("%d.pid", mainProc.Pid))
+	if err := os.WriteFile(markFile, []byte(TrainingEarlyStopped), 0644); err != nil {
+		return fmt.Errorf("write to file %v error: %v", markFile, err)
+	}
+
+	// Get child process from main PID.
+	childProc, err := mainProc.Children()
+	if err != nil {
+		return fmt.Errorf("get children proceses for main PID: %v failed: %v", mainProc.Pid, err)
+	}
+
+	// TODO (andreyvelich): Currently support only single child process.
+	if len(childProc) != 1 {
+		return fmt.Errorf("multiple children processes are not supported. Children processes: %v", childProc)
+	}
+
+	// Terminate the child process.
+	if err := childProc[0].Terminate(); err != nil {
+		return fmt.Errorf("unable to terminate child process %v, error: %v", childProc[0], err)
+	}
+	return nil
+}
+
+// WaitProcessCompleted waits until the process is completed or timeout is reached.
+func WaitProcessCompleted(proc *psutil.Process, timeout time.Duration) error {
+	endTime := time.Now().Add(timeout)
+	isProcRunning := true
+	for isProcRunning && time.Now().Before(endTime) {
+		var err error
+		isProcRunning, err = proc.IsRunning()
+		// Ignore "no such file error". It means that process is complete.
+		if err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("check process status for PID: %v failed: %v", proc.Pid, err)
+		}
+	}
+	return nil
+}
+
+// SetTrialEarlyStopped sends request to the Early Stopping service to change Trial status to early stopped.
+func SetTrialEarlyStopped(earlyStopServiceAddr, trialName string) error {
+	conn, err := grpc.NewClient(earlyStopServiceAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return fmt.Errorf("could not connect to Early Stopping service, error: %v", err)
+	}
+	defer conn.Close()
+	c := api.NewEarlyStoppingClient(conn)
+
+	setTrialStatusReq := &api.SetTrialStatusRequest{
+		TrialName: trialName,
+	}
+
+	// Send request to change Trial status to early stopped.
+	if _, err = c.SetTrialStatus(context.Background(), setTrialStatusReq); err != nil {
+		return fmt.Errorf("set Trial status error: %v", err)
+	}
+	return nil
+}
+
+// EarlyStopOpts is the set of options to early stop the training.
+type EarlyStopOpts struct {
+	// MarkDir is the directory of the ".pid" files of the main processes.
+	MarkDir string
+	// MainProc is the main process of the training container.
+	MainProc *psutil.Process
+	// ReportMetrics reports the collected metrics before the Trial status is changed.
+	ReportMetrics        func()
+	EarlyStopServiceAddr string
+	TrialName            string
+}
+
+// EarlyStopIfReached applies the metric logs to the rules and early stops the training if all rules are reached.
+// Training process is terminated, the metrics are reported, and Trial status is changed to early stopped
+// after the main process is completed. It returns true if the training is early stopped.
+func (s *StopRules) EarlyStopIfReached(mlogs []*api.MetricLog, opts EarlyStopOpts) (bool, error) {
+	if err := s.UpdateMetricLogs(mlogs); err != nil {
+		return false, fmt.Errorf("update stop rules error: %v", err)
+	}
+	if !s.IsReached() {
+		return false, nil
+	}
+	klog.Info("Training container is early stopped")
+
+	// Mark main process as early stopped and terminate the training process.
+	if err := StopTraining(opts.MarkDir, opts.MainProc); err != nil {
+		return true, fmt.Errorf("stop training error: %v", err)
+	}
+
+	// Report metrics to DB.
+	opts.ReportMetrics()
+
+	// Wait until main process is completed.
+	if err := WaitProcessCompleted(opts.MainProc, 60*time.Second); err != nil {
+		return true, fmt.Errorf("wait for main process error: %v", err)
+	}
+
+	// Send request to change Trial status to early stopped.
+	if err := SetTrialEarlyStopped(opts.EarlyStopServiceAddr, opts.TrialName); err != nil {
+		return true, err
+	}
+	klog.Infof("Trial status is successfully updated")
+	return true, nil
+}
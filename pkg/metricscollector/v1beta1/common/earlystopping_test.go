@@ -0,0 +1,254 @@
+/*
+Copyright 2022 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+	"time"
+
+	psutil "github.com/shirou/gopsutil/v3/process"
+	"k8s.io/utils/ptr"
+
+	commonv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1"
+	api "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+)
+
+func TestStopRulesUpdate(t *testing.T) {
+	type report struct {
+		value float64
+		step  *int64
+	}
+	step := func(s int64) *int64 { return &s }
+	rule := commonv1beta1.EarlyStoppingRule{
+		Name:       "loss",
+		Value:      "0.5",
+		Comparison: commonv1beta1.ComparisonTypeGreater,
+		StartStep:  10,
+	}
+
+	testCases := map[string]struct {
+		reports     []report
+		wantReached bool
+	}{
+		"Rule is not applied before the start step": {
+			reports: []report{
+				{value: 0.9, step: step(1)},
+				{value: 0.9, step: step(9)},
+			},
+			wantReached: false,
+		},
+		"Rule is applied at the start step": {
+			reports: []report{
+				{value: 0.9, step: step(10)},
+			},
+			wantReached: true,
+		},
+		"Rule is applied after the start step although the metric is logged in bursts": {
+			reports: []report{
+				{value: 0.4, step: step(10)},
+				{value: 0.9, step: step(20)},
+			},
+			wantReached: true,
+		},
+		"Rule is applied at the start step count without step": {
+			reports: func() []report {
+				reports := make([]report, 10)
+				for i := range reports {
+					reports[i] = report{value: 0.9}
+				}
+				return reports
+			}(),
+			wantReached: true,
+		},
+		"Rule is not applied before the start step count without step": {
+			reports: []report{
+				{value: 0.9},
+				{value: 0.9},
+			},
+			wantReached: false,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			stopRules := NewStopRules([]commonv1beta1.EarlyStoppingRule{rule}, "accuracy", commonv1beta1.ObjectiveTypeMaximize)
+			for _, r := range tc.reports {
+				var err error
+				if r.step != nil {
+					err = stopRules.UpdateAtStep(rule.Name, r.value, *r.step)
+				} else {
+					err = stopRules.Update(rule.Name, r.value)
+				}
+				if err != nil {
+					t.Fatalf("Unexpected error from update: %v", err)
+				}
+			}
+			if got := stopRules.IsReached(); got != tc.wantReached {
+				t.Errorf("Unexpected reached rules, want %v, got %v", tc.wantReached, got)
+			}
+		})
+	}
+}
+
+func TestStopRulesUpdateMetricLogs(t *testing.T) {
+	rule := commonv1beta1.EarlyStoppingRule{
+		Name:       "loss",
+		Value:      "0.5",
+		Comparison: commonv1beta1.ComparisonTypeGreater,
+		StartStep:  2,
+	}
+	newMetricLog := func(value string, step *int64) *api.MetricLog {
+		return &api.MetricLog{
+			Metric: &api.Metric{Name: "loss", Value: value},
+			Step:   step,
+		}
+	}
+
+	testCases := map[string]struct {
+		mlogs       []*api.MetricLog
+		wantReached bool
+	}{
+		"Metric logs with step are applied at their step": {
+			mlogs: []*api.MetricLog{
+				newMetricLog("0.9", ptr.To[int64](1)),
+				newMetricLog("0.9", ptr.To[int64](2)),
+			},
+			wantReached: true,
+		},
+		"Metric logs with step are not applied before the start step": {
+			mlogs: []*api.MetricLog{
+				newMetricLog("0.9", ptr.To[int64](0)),
+				newMetricLog("0.9", ptr.To[int64](1)),
+			},
+			wantReached: false,
+		},
+		"Non-numeric metric values are skipped": {
+			mlogs: []*api.MetricLog{
+				newMetricLog("0.9", nil),
+				newMetricLog("nan-value", nil),
+			},
+			wantReached: false,
+		},
+		"Metric logs without step are applied at the start step count": {
+			mlogs: []*api.MetricLog{
+				newMetricLog("0.9", nil),
+				newMetricLog(" 0.9 ", nil),
+			},
+			wantReached: true,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			stopRules := NewStopRules([]commonv1beta1.EarlyStoppingRule{rule}, "accuracy", commonv1beta1.ObjectiveTypeMaximize)
+			if err := stopRules.UpdateMetricLogs(tc.mlogs); err != nil {
+				t.Fatalf("Unexpected error from update: %v", err)
+			}
+			if got := stopRules.IsReached(); got != tc.wantReached {
+				t.Errorf("Unexpected reached rules, want %v, got %v", tc.wantReached, got)
+			}
+		})
+	}
+}
+
+func TestStopRulesEarlyStopIfReached(t *testing.T) {
+	rule := commonv1beta1.EarlyStoppingRule{
+		Name:       "loss",
+		Value:      "0.5",
+		Comparison: commonv1beta1.ComparisonTypeGreater,
+	}
+	mlogs := func(value string) []*api.MetricLog {
+		return []*api.MetricLog{{Metric: &api.Metric{Name: "loss", Value: value}}}
+	}
+	// Main process of the training with the single child process.
+	newMainProc := func(t *testing.T) *psutil.Process {
+		cmd := exec.Command("sh", "-c", "sleep 60 & wait")
+		if err := cmd.Start(); err != nil {
+			t.Fatalf("Failed to start main process: %v", err)
+		}
+		go cmd.Wait()
+		t.Cleanup(func() { cmd.Process.Kill() })
+		mainProc, err := psutil.NewProcess(int32(cmd.Process.Pid))
+		if err != nil {
+			t.Fatalf("Failed to get main process: %v", err)
+		}
+		// Wait until the child process is started.
+		for children, _ := mainProc.Children(); len(children) == 0; children, _ = mainProc.Children() {
+			time.Sleep(10 * time.Millisecond)
+		}
+		return mainProc
+	}
+
+	testCases := map[string]struct {
+		mlogs        []*api.MetricLog
+		markDir      func(t *testing.T) string
+		wantStopped  bool
+		wantReported bool
+		wantErr      bool
+	}{
+		"Training is not stopped until all rules are reached": {
+			mlogs:   mlogs("0.1"),
+			markDir: func(t *testing.T) string { return t.TempDir() },
+		},
+		"Metrics are not reported if training is not stopped": {
+			mlogs:       mlogs("0.9"),
+			markDir:     func(t *testing.T) string { return filepath.Join(t.TempDir(), "missing") },
+			wantStopped: true,
+			wantErr:     true,
+		},
+		"Metrics are reported before Trial status is changed": {
+			mlogs:        mlogs("0.9"),
+			markDir:      func(t *testing.T) string { return t.TempDir() },
+			wantStopped:  true,
+			wantReported: true,
+			// Early Stopping service is not running.
+			wantErr: true,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			stopRules := NewStopRules([]commonv1beta1.EarlyStoppingRule{rule}, "accuracy", commonv1beta1.ObjectiveTypeMaximize)
+			mainProc := newMainProc(t)
+			markDir := tc.markDir(t)
+			reported := false
+			stopped, err := stopRules.EarlyStopIfReached(tc.mlogs, EarlyStopOpts{
+				MarkDir:              markDir,
+				MainProc:             mainProc,
+				ReportMetrics:        func() { reported = true },
+				EarlyStopServiceAddr: "localhost:1",
+				TrialName:            "test-trial",
+			})
+			if tc.wantErr != (err != nil) {
+				t.Errorf("Unexpected error, want error %v, got %v", tc.wantErr, err)
+			}
+			if stopped != tc.wantStopped {
+				t.Errorf("Unexpected stopped training, want %v, got %v", tc.wantStopped, stopped)
+			}
+			if reported != tc.wantReported {
+				t.Errorf("Unexpected reported metrics, want %v, got %v", tc.wantReported, reported)
+			}
+			if tc.wantReported {
+				mark, err := os.ReadFile(filepath.Join(markDir, fmt.Sprintf("%d.pid", mainProc.Pid)))
+				if err != nil || string(mark) != TrainingEarlyStopped {
+					t.Errorf("Main process is not marked as early stopped, got %q, error %v", mark, err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,231 @@
+/*
+Copyright 2022 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	utilrand "k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/klog/v2"
+
+	api "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+)
+
+// MetricLogReporter batches the metric logs parsed during the training and reports them periodically.
+// It tracks the successfully reported metric logs, so they are not reported again by the final report.
+// Every batch is reported with its idempotency key. The failed batch is reported again with the same key,
+// so the DB manager skips it if the failed report has stored it, e.g. by the timeout.
+type MetricLogReporter struct {
+	report   func(olog *api.ObservationLog, idempotencyKey string) error
+	interval time.Duration
+	// id is the random prefix of the idempotency keys, so the keys of the restarted reporter are not skipped.
+	id string
+
+	// reportMu serializes the reports, so the final report waits for the flush in progress.
+	reportMu sync.Mutex
+	// mu guards the pending and reported metric logs. It is not held during the report,
+	// so the metric logs are added while the report is in progress.
+	mu      sync.Mutex
+	pending []*api.MetricLog
+	// failed is the batch of the failed report, which is reported again before the pending metric logs.
+	failed *metricLogBatch
+	// batches is the number of the batches, which is the sequence of the idempotency keys.
+	batches int
+	// Metric log -> number of the reported metric logs with the same timestamp, name, value, step and source.
+	reported map[metricLogKey]int
+	closed   bool
+}
+
+type metricLogBatch struct {
+	idempotencyKey string
+	metricLogs     []*api.MetricLog
+}
+
+type metricLogKey struct {
+	timestamp string
+	name      string
+	value     string
+	step      int64
+	hasStep   bool
+	source    string
+}
+
+// NewMetricLogReporter creates a new MetricLogReporter which flushes the metric logs on the interval.
+// If the interval is not positive, the metric logs are reported only by ReportRemaining.
+func NewMetricLogReporter(report func(olog *api.ObservationLog, idempotencyKey string) error, interval time.Duration) *MetricLogReporter {
+	return &MetricLogReporter{
+		report:   report,
+		interval: interval,
+		id:       utilrand.String(16),
+		reported: map[metricLogKey]int{},
+	}
+}
+
+// Add adds the metric logs to the next batch.
+func (r *MetricLogReporter) Add(mlogs ...*api.MetricLog) {
+	if r.interval <= 0 || len(mlogs) == 0 {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.closed {
+		return
+	}
+	r.pending = append(r.pending, mlogs...)
+}
+
+// Flush reports the batch of the failed report and then the pending metric logs.
+// The metric logs are added while the report is in progress.
+// If the report fails, the batch is kept with its idempotency key for the next flush.
+func (r *MetricLogReporter) Flush() error {
+	r.reportMu.Lock()
+	defer r.reportMu.Unlock()
+
+	r.mu.Lock()
+	if r.closed {
+		r.mu.Unlock()
+		return nil
+	}
+	failed := r.failed
+	r.mu.Unlock()
+
+	if failed != nil {
+		if err := r.reportBatch(failed); err != nil {
+			return err
+		}
+	}
+
+	r.mu.Lock()
+	if r.closed || len(r.pending) == 0 {
+		r.mu.Unlock()
+		return nil
+	}
+	batch := r.newBatch(r.pending)
+	r.pending = nil
+	r.mu.Unlock()
+
+	return r.reportBatch(batch)
+}
+
+// newBatch creates the batch with the next idempotency key. It must be called with mu held.
+func (r *MetricLogReporter) newBatch(mlogs []*api.MetricLog) *metricLogBatch {
+	r.batches++
+	return &metricLogBatch{
+		idempotencyKey: fmt.Sprintf("%s-%d", r.id, r.batches),
+		metricLogs:     mlogs,
+	}
+}
+
+// reportBatch reports the batch and tracks its metric logs as reported.
+// If the report fails, the batch is kept as the failed batch. It must be called with reportMu held.
+func (r *MetricLogReporter) reportBatch(batch *metricLogBatch) error {
+	err := r.report(&api.ObservationLog{MetricLogs: batch.metricLogs}, batch.idempotencyKey)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err != nil {
+		r.failed = batch
+		return err
+	}
+	r.failed = nil
+	for _, mlog := range batch.metricLogs {
+		r.reported[newMetricLogKey(mlog)]++
+	}
+	return nil
+}
+
+// Run flushes the pending metric logs on the interval until the context is done.
+func (r *MetricLogReporter) Run(ctx context.Context) {
+	if r.interval <= 0 {
+		return
+	}
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		if err := r.Flush(); err != nil {
+			// Metric logs are reported again by the next flush or by the final report.
+			klog.Warningf("Failed to report metrics: %v", err)
+		}
+	}
+}
+
+// ReportRemaining reports the metric logs of the complete observation log which have not been reported yet
+// and returns them. The metric logs are not reported after it is called.
+// The batch of the failed report is reported again with its idempotency key first,
+// since the DB manager might have stored it.
+func (r *MetricLogReporter) ReportRemaining(olog *api.ObservationLog) (*api.ObservationLog, error) {
+	// Report in progress is completed first, so its metric logs are not reported again.
+	r.reportMu.Lock()
+	defer r.reportMu.Unlock()
+
+	r.mu.Lock()
+	r.closed = true
+	r.pending = nil
+	failed := r.failed
+	r.mu.Unlock()
+
+	if failed != nil {
+		if err := r.reportBatch(failed); err != nil {
+			return nil, err
+		}
+	}
+
+	r.mu.Lock()
+	reported := make(map[metricLogKey]int, len(r.reported))
+	for k, n := range r.reported {
+		reported[k] = n
+	}
+	remaining := &api.ObservationLog{}
+	for _, mlog := range olog.GetMetricLogs() {
+		k := newMetricLogKey(mlog)
+		if reported[k] > 0 {
+			reported[k]--
+			continue
+		}
+		remaining.MetricLogs = append(remaining.MetricLogs, mlog)
+	}
+	if len(remaining.MetricLogs) == 0 {
+		r.mu.Unlock()
+		return remaining, nil
+	}
+	batch := r.newBatch(remaining.MetricLogs)
+	r.mu.Unlock()
+
+	if err := r.reportBatch(batch); err != nil {
+		return nil, err
+	}
+	return remaining, nil
+}
+
+func newMetricLogKey(mlog *api.MetricLog) metricLogKey {
+	return metricLogKey{
+		timestamp: mlog.GetTimeStamp(),
+		name:      mlog.GetMetric().GetName(),
+		value:     mlog.GetMetric().GetValue(),
+		step:      mlog.GetStep(),
+		hasStep:   mlog.Step != nil,
+		source:    mlog.GetSource(),
+	}
+}
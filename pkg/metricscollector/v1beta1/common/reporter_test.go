@@ -0,0 +1,280 @@
+/*
+Copyright 2022 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	api "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+)
+
+func newMetricLog(timestamp, name, value string) *api.MetricLog {
+	return &api.MetricLog{
+		TimeStamp: timestamp,
+		Metric: &api.Metric{
+			Name:  name,
+			Value: value,
+		},
+	}
+}
+
+func TestMetricLogReporter(t *testing.T) {
+	zeroTime := time.Time{}.UTC().Format(time.RFC3339)
+	errReport := errors.New("report failed")
+
+	testCases := map[string]struct {
+		interval      time.Duration
+		batches       [][]*api.MetricLog
+		failedFlushes int
+		final         []*api.MetricLog
+		wantReports   [][]*api.MetricLog
+		wantRemaining []*api.MetricLog
+	}{
+		"Final report contains only the metrics which have not been flushed": {
+			interval: time.Second,
+			batches: [][]*api.MetricLog{
+				{newMetricLog("2024-03-04T17:55:08Z", "loss", "0.5")},
+				{newMetricLog("2024-03-04T17:55:09Z", "loss", "0.4")},
+			},
+			final: []*api.MetricLog{
+				newMetricLog("2024-03-04T17:55:08Z", "loss", "0.5"),
+				newMetricLog("2024-03-04T17:55:09Z", "loss", "0.4"),
+				newMetricLog("2024-03-04T17:55:10Z", "accuracy", "0.9"),
+			},
+			wantReports: [][]*api.MetricLog{
+				{newMetricLog("2024-03-04T17:55:08Z", "loss", "0.5")},
+				{newMetricLog("2024-03-04T17:55:09Z", "loss", "0.4")},
+				{newMetricLog("2024-03-04T17:55:10Z", "accuracy", "0.9")},
+			},
+			wantRemaining: []*api.MetricLog{
+				newMetricLog("2024-03-04T17:55:10Z", "accuracy", "0.9"),
+			},
+		},
+		"Repeated metrics without timestamp are reported as many times as they are logged": {
+			interval: time.Second,
+			batches: [][]*api.MetricLog{
+				{newMetricLog(zeroTime, "loss", "0.5")},
+			},
+			final: []*api.MetricLog{
+				newMetricLog(zeroTime, "loss", "0.5"),
+				newMetricLog(zeroTime, "loss", "0.5"),
+			},
+			wantReports: [][]*api.MetricLog{
+				{newMetricLog(zeroTime, "loss", "0.5")},
+				{newMetricLog(zeroTime, "loss", "0.5")},
+			},
+			wantRemaining: []*api.MetricLog{
+				newMetricLog(zeroTime, "loss", "0.5"),
+			},
+		},
+		"Metrics of the failed flush are reported by the next flush before the new metrics": {
+			interval: time.Second,
+			batches: [][]*api.MetricLog{
+				{newMetricLog("2024-03-04T17:55:08Z", "loss", "0.5")},
+				{newMetricLog("2024-03-04T17:55:09Z", "loss", "0.4")},
+			},
+			failedFlushes: 1,
+			final: []*api.MetricLog{
+				newMetricLog("2024-03-04T17:55:08Z", "loss", "0.5"),
+				newMetricLog("2024-03-04T17:55:09Z", "loss", "0.4"),
+			},
+			wantReports: [][]*api.MetricLog{
+				{newMetricLog("2024-03-04T17:55:08Z", "loss", "0.5")},
+				{newMetricLog("2024-03-04T17:55:09Z", "loss", "0.4")},
+			},
+		},
+		"All metrics are reported by the final report without flush interval": {
+			batches: [][]*api.MetricLog{
+				{newMetricLog("2024-03-04T17:55:08Z", "loss", "0.5")},
+			},
+			final: []*api.MetricLog{
+				newMetricLog("2024-03-04T17:55:08Z", "loss", "0.5"),
+			},
+			wantReports: [][]*api.MetricLog{
+				{newMetricLog("2024-03-04T17:55:08Z", "loss", "0.5")},
+			},
+			wantRemaining: []*api.MetricLog{
+				newMetricLog("2024-03-04T17:55:08Z", "loss", "0.5"),
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var gotReports [][]*api.MetricLog
+			failedFlushes := tc.failedFlushes
+			reporter := NewMetricLogReporter(func(olog *api.ObservationLog, _ string) error {
+				if failedFlushes > 0 {
+					failedFlushes--
+					return errReport
+				}
+				gotReports = append(gotReports, olog.MetricLogs)
+				return nil
+			}, tc.interval)
+
+			for _, batch := range tc.batches {
+				reporter.Add(batch...)
+				// Failed flush keeps the metrics for the next flush.
+				_ = reporter.Flush()
+			}
+			remaining, err := reporter.ReportRemaining(&api.ObservationLog{MetricLogs: tc.final})
+			if err != nil {
+				t.Fatalf("Unexpected error from ReportRemaining: %v", err)
+			}
+			// Metrics are not reported after the final report.
+			reporter.Add(tc.final...)
+			if err := reporter.Flush(); err != nil {
+				t.Fatalf("Unexpected error from Flush: %v", err)
+			}
+
+			if diff := cmp.Diff(tc.wantReports, gotReports, cmpopts.IgnoreUnexported(api.MetricLog{}, api.Metric{})); len(diff) != 0 {
+				t.Errorf("Unexpected reports (-want,+got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantRemaining, remaining.MetricLogs, cmpopts.IgnoreUnexported(api.MetricLog{}, api.Metric{})); len(diff) != 0 {
+				t.Errorf("Unexpected remaining metrics (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMetricLogReporterAddDuringReport(t *testing.T) {
+	reportStarted := make(chan struct{})
+	releaseReport := make(chan struct{})
+	var gotReports [][]*api.MetricLog
+	reporter := NewMetricLogReporter(func(olog *api.ObservationLog, _ string) error {
+		if len(gotReports) == 0 {
+			close(reportStarted)
+			<-releaseReport
+		}
+		gotReports = append(gotReports, olog.MetricLogs)
+		return nil
+	}, time.Second)
+
+	reporter.Add(newMetricLog("2024-03-04T17:55:08Z", "loss", "0.5"))
+	flushed := make(chan error)
+	go func() {
+		flushed <- reporter.Flush()
+	}()
+	<-reportStarted
+
+	// Metric logs are added while the report is in progress.
+	added := make(chan struct{})
+	go func() {
+		reporter.Add(newMetricLog("2024-03-04T17:55:09Z", "loss", "0.4"))
+		close(added)
+	}()
+	select {
+	case <-added:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Add is blocked by the report in progress")
+	}
+	close(releaseReport)
+	if err := <-flushed; err != nil {
+		t.Fatalf("Unexpected error from Flush: %v", err)
+	}
+	if err := reporter.Flush(); err != nil {
+		t.Fatalf("Unexpected error from Flush: %v", err)
+	}
+
+	wantReports := [][]*api.MetricLog{
+		{newMetricLog("2024-03-04T17:55:08Z", "loss", "0.5")},
+		{newMetricLog("2024-03-04T17:55:09Z", "loss", "0.4")},
+	}
+	if diff := cmp.Diff(wantReports, gotReports, cmpopts.IgnoreUnexported(api.MetricLog{}, api.Metric{})); len(diff) != 0 {
+		t.Errorf("Unexpected reports (-want,+got):\n%s", diff)
+	}
+}
+
+func TestMetricLogReporterIdempotencyKey(t *testing.T) {
+	errTimeout := errors.New("report timed out")
+
+	testCases := map[string]struct {
+		flushes     int
+		wantStored  []*api.MetricLog
+		wantReports int
+	}{
+		"Failed batch stored by the DB manager is not duplicated by the next flush": {
+			flushes: 2,
+			wantStored: []*api.MetricLog{
+				newMetricLog("2024-03-04T17:55:08Z", "loss", "0.5"),
+				newMetricLog("2024-03-04T17:55:09Z", "loss", "0.4"),
+			},
+			// Failed report, retry of the failed batch and report of the new batch.
+			wantReports: 3,
+		},
+		"Failed batch stored by the DB manager is not duplicated by the final report": {
+			flushes: 1,
+			wantStored: []*api.MetricLog{
+				newMetricLog("2024-03-04T17:55:08Z", "loss", "0.5"),
+				newMetricLog("2024-03-04T17:55:09Z", "loss", "0.4"),
+			},
+			// Failed report, retry of the failed batch and final report.
+			wantReports: 3,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// DB manager stores the first report, but the reply times out.
+			var stored []*api.MetricLog
+			storedKeys := map[string]bool{}
+			reports := 0
+			reporter := NewMetricLogReporter(func(olog *api.ObservationLog, idempotencyKey string) error {
+				reports++
+				if idempotencyKey == "" {
+					t.Errorf("Metric logs are reported without the idempotency key")
+				}
+				if !storedKeys[idempotencyKey] {
+					storedKeys[idempotencyKey] = true
+					stored = append(stored, olog.MetricLogs...)
+				}
+				if reports == 1 {
+					return errTimeout
+				}
+				return nil
+			}, time.Second)
+
+			reporter.Add(newMetricLog("2024-03-04T17:55:08Z", "loss", "0.5"))
+			if err := reporter.Flush(); !errors.Is(err, errTimeout) {
+				t.Fatalf("Unexpected error from Flush: %v", err)
+			}
+			reporter.Add(newMetricLog("2024-03-04T17:55:09Z", "loss", "0.4"))
+			for i := 1; i < tc.flushes; i++ {
+				if err := reporter.Flush(); err != nil {
+					t.Fatalf("Unexpected error from Flush: %v", err)
+				}
+			}
+			_, err := reporter.ReportRemaining(&api.ObservationLog{MetricLogs: []*api.MetricLog{
+				newMetricLog("2024-03-04T17:55:08Z", "loss", "0.5"),
+				newMetricLog("2024-03-04T17:55:09Z", "loss", "0.4"),
+			}})
+			if err != nil {
+				t.Fatalf("Unexpected error from ReportRemaining: %v", err)
+			}
+
+			if diff := cmp.Diff(tc.wantStored, stored, cmpopts.IgnoreUnexported(api.MetricLog{}, api.Metric{})); len(diff) != 0 {
+				t.Errorf("Unexpected stored metrics (-want,+got):\n%s", diff)
+			}
+			if reports != tc.wantReports {
+				t.Errorf("Unexpected number of reports, want: %d, got: %d", tc.wantReports, reports)
+			}
+		})
+	}
+}
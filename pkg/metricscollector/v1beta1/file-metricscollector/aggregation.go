@@ -0,0 +1,129 @@
+/*
+Copyright 2022 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sidecarmetricscollector
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"k8s.io/klog/v2"
+
+	commonv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1"
+	v1beta1 "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+)
+
+var errAggregation = errors.New("unknown metrics aggregation")
+
+// aggregationKey identifies the metric logs of the different sources which are aggregated together.
+// If the step is not logged, the index is the number of the previous metric logs with the same name in the source.
+type aggregationKey struct {
+	name    string
+	step    int64
+	hasStep bool
+	index   int
+}
+
+// AggregateMetricLogs aggregates the metric logs of the different sources by the metric name and the step.
+// The aggregated metric logs have the latest timestamp of the group and they don't have the source.
+// Non-numeric metric values are not aggregated.
+func AggregateMetricLogs(mlogs []*v1beta1.MetricLog, aggregation commonv1beta1.MetricsAggregationType) ([]*v1beta1.MetricLog, error) {
+	var aggregate func(values []float64) float64
+	switch aggregation {
+	case "", commonv1beta1.MetricsAggregationNone:
+		return mlogs, nil
+	case commonv1beta1.MetricsAggregationMean:
+		aggregate = func(values []float64) float64 {
+			sum := 0.0
+			for _, v := range values {
+				sum += v
+			}
+			return sum / float64(len(values))
+		}
+	case commonv1beta1.MetricsAggregationMin:
+		aggregate = func(values []float64) float64 {
+			result := values[0]
+			for _, v := range values[1:] {
+				result = min(result, v)
+			}
+			return result
+		}
+	case commonv1beta1.MetricsAggregationMax:
+		aggregate = func(values []float64) float64 {
+			result := values[0]
+			for _, v := range values[1:] {
+				result = max(result, v)
+			}
+			return result
+		}
+	default:
+		return nil, fmt.Errorf("%w: %v", errAggregation, aggregation)
+	}
+
+	type group struct {
+		step      *int64
+		timestamp time.Time
+		values    []float64
+	}
+	var keys []aggregationKey
+	groups := map[aggregationKey]*group{}
+	// Source -> metric name -> number of the metric logs without step.
+	counts := map[string]map[string]int{}
+	for _, mlog := range mlogs {
+		value, err := strconv.ParseFloat(strings.TrimSpace(mlog.Metric.Value), 64)
+		if err != nil {
+			klog.Warningf("Metric %v from %v is not aggregated since value %v is not float", mlog.Metric.Name, mlog.Source, mlog.Metric.Value)
+			continue
+		}
+		key := aggregationKey{name: mlog.Metric.Name}
+		if mlog.Step != nil {
+			key.step, key.hasStep = *mlog.Step, true
+		} else {
+			if counts[mlog.Source] == nil {
+				counts[mlog.Source] = map[string]int{}
+			}
+			key.index = counts[mlog.Source][mlog.Metric.Name]
+			counts[mlog.Source][mlog.Metric.Name]++
+		}
+		g, exist := groups[key]
+		if !exist {
+			g = &group{step: mlog.Step}
+			groups[key] = g
+			keys = append(keys, key)
+		}
+		if t, err := time.Parse(time.RFC3339Nano, mlog.TimeStamp); err == nil && t.After(g.timestamp) {
+			g.timestamp = t
+		}
+		g.values = append(g.values, value)
+	}
+
+	aggregated := make([]*v1beta1.MetricLog, 0, len(keys))
+	for _, key := range keys {
+		g := groups[key]
+		aggregated = append(aggregated, &v1beta1.MetricLog{
+			TimeStamp: g.timestamp.UTC().Format(time.RFC3339Nano),
+			Metric: &v1beta1.Metric{
+				Name:  key.name,
+				Value: strconv.FormatFloat(aggregate(g.values), 'f', -1, 64),
+			},
+			Step: g.step,
+		})
+	}
+	return aggregated, nil
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2022 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sidecarmetricscollector
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"k8s.io/utils/ptr"
+
+	commonv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1"
+	v1beta1 "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+)
+
+func newMetricLog(timestamp, name, value string, step *int64, source string) *v1beta1.MetricLog {
+	return &v1beta1.MetricLog{
+		TimeStamp: timestamp,
+		Metric: &v1beta1.Metric{
+			Name:  name,
+			Value: value,
+		},
+		Step:   step,
+		Source: source,
+	}
+}
+
+func TestAggregateMetricLogs(t *testing.T) {
+	mlogs := []*v1beta1.MetricLog{
+		newMetricLog("2024-03-04T17:55:08Z", "loss", "0.4", ptr.To[int64](1), "metrics-0.log"),
+		newMetricLog("2024-03-04T17:55:10Z", "loss", "0.2", ptr.To[int64](2), "metrics-0.log"),
+		newMetricLog("2024-03-04T17:55:09Z", "loss", "0.6", ptr.To[int64](1), "metrics-1.log"),
+		newMetricLog("2024-03-04T17:55:11Z", "loss", "nan-value", ptr.To[int64](2), "metrics-1.log"),
+	}
+	mlogsWithoutStep := []*v1beta1.MetricLog{
+		newMetricLog("2024-03-04T17:55:08Z", "accuracy", "0.5", nil, "metrics-0.log"),
+		newMetricLog("2024-03-04T17:55:09Z", "accuracy", "0.7", nil, "metrics-0.log"),
+		newMetricLog("2024-03-04T17:55:08Z", "accuracy", "0.9", nil, "metrics-1.log"),
+	}
+
+	testCases := map[string]struct {
+		mlogs       []*v1beta1.MetricLog
+		aggregation commonv1beta1.MetricsAggregationType
+		wantMlogs   []*v1beta1.MetricLog
+		wantError   error
+	}{
+		"Metrics are not aggregated without aggregation": {
+			mlogs:     mlogs,
+			wantMlogs: mlogs,
+		},
+		"Metrics are not aggregated with None aggregation": {
+			mlogs:       mlogs,
+			aggregation: commonv1beta1.MetricsAggregationNone,
+			wantMlogs:   mlogs,
+		},
+		"Mean of the metrics at the same step": {
+			mlogs:       mlogs,
+			aggregation: commonv1beta1.MetricsAggregationMean,
+			wantMlogs: []*v1beta1.MetricLog{
+				newMetricLog("2024-03-04T17:55:09Z", "loss", "0.5", ptr.To[int64](1), ""),
+				newMetricLog("2024-03-04T17:55:10Z", "loss", "0.2", ptr.To[int64](2), ""),
+			},
+		},
+		"Min of the metrics at the same step": {
+			mlogs:       mlogs,
+			aggregation: commonv1beta1.MetricsAggregationMin,
+			wantMlogs: []*v1beta1.MetricLog{
+				newMetricLog("2024-03-04T17:55:09Z", "loss", "0.4", ptr.To[int64](1), ""),
+				newMetricLog("2024-03-04T17:55:10Z", "loss", "0.2", ptr.To[int64](2), ""),
+			},
+		},
+		"Max of the n-th metrics without step": {
+			mlogs:       mlogsWithoutStep,
+			aggregation: commonv1beta1.MetricsAggregationMax,
+			wantMlogs: []*v1beta1.MetricLog{
+				newMetricLog("2024-03-04T17:55:08Z", "accuracy", "0.9", nil, ""),
+				newMetricLog("2024-03-04T17:55:09Z", "accuracy", "0.7", nil, ""),
+			},
+		},
+		"Unknown aggregation": {
+			mlogs:       mlogs,
+			aggregation: "Median",
+			wantError:   errAggregation,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := AggregateMetricLogs(tc.mlogs, tc.aggregation)
+			if diff := cmp.Diff(tc.wantError, err, cmpopts.EquateErrors()); len(diff) != 0 {
+				t.Errorf("Unexpected error (-want,+got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantMlogs, got, cmpopts.IgnoreUnexported(v1beta1.MetricLog{}, v1beta1.Metric{})); len(diff) != 0 {
+				t.Errorf("Unexpected aggregated metrics (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
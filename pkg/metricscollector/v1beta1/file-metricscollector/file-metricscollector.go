@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
 	"regexp"
 	"strconv"
 	"strings"
@@ -40,9 +41,82 @@ var (
 	errOpenFile   = errors.New("failed to open the file")
 	errReadFile   = errors.New("failed to read the file")
 	errParseJson  = errors.New("failed to parse the json object")
+	errGlobFiles  = errors.New("failed to find the metrics files")
 )
 
-func CollectObservationLog(fileName string, metrics []string, filters []string, fileFormat commonv1beta1.FileFormat) (*v1beta1.ObservationLog, error) {
+// JsonFormatOptions is the options to parse the metrics in JSON format.
+type JsonFormatOptions struct {
+	// TimestampKey is the key of the metrics timestamp, nested keys are separated by dots.
+	// If it is empty, common.TimeStampJsonKey is used.
+	TimestampKey string
+	// TimestampFormat is the Go layout of the metrics timestamp string.
+	// If it is empty, the timestamp string must be in RFC3339 format.
+	TimestampFormat string
+}
+
+func CollectObservationLog(fileName string, metrics []string, filters []string, fileFormat commonv1beta1.FileFormat, jsonOpts JsonFormatOptions) (*v1beta1.ObservationLog, error) {
+	mlogs, err := collectMetricLogs(fileName, metrics, filters, fileFormat, jsonOpts)
+	if err != nil {
+		return nil, err
+	}
+	return newObservationLog(mlogs, metrics), nil
+}
+
+// CollectObservationLogFromDir collects the metrics from the files in the directory which match the pattern.
+// Metrics are tagged with the name of their file as the source and aggregated across the files.
+func CollectObservationLogFromDir(dirPath string, pattern string, metrics []string, filters []string, fileFormat commonv1beta1.FileFormat, jsonOpts JsonFormatOptions, aggregation commonv1beta1.MetricsAggregationType) (*v1beta1.ObservationLog, error) {
+	fileNames, err := GlobMetricsFiles(dirPath, pattern)
+	if err != nil {
+		return nil, err
+	}
+	var mlogs []*v1beta1.MetricLog
+	for _, fileName := range fileNames {
+		fileLogs, err := collectMetricLogs(fileName, metrics, filters, fileFormat, jsonOpts)
+		if err != nil {
+			return nil, err
+		}
+		source := MetricsSource(dirPath, fileName)
+		for _, mlog := range fileLogs {
+			mlog.Source = source
+		}
+		mlogs = append(mlogs, fileLogs...)
+	}
+	mlogs, err = AggregateMetricLogs(mlogs, aggregation)
+	if err != nil {
+		return nil, err
+	}
+	return newObservationLog(mlogs, metrics), nil
+}
+
+// GlobMetricsFiles returns the sorted names of the metrics files in the directory which match the pattern.
+// Directories and the process marker files are skipped.
+func GlobMetricsFiles(dirPath string, pattern string) ([]string, error) {
+	matches, err := filepath.Glob(filepath.Join(dirPath, pattern))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errGlobFiles, err.Error())
+	}
+	fileNames := make([]string, 0, len(matches))
+	for _, fileName := range matches {
+		if strings.HasSuffix(fileName, ".pid") {
+			continue
+		}
+		if info, err := os.Stat(fileName); err != nil || info.IsDir() {
+			continue
+		}
+		fileNames = append(fileNames, fileName)
+	}
+	return fileNames, nil
+}
+
+// MetricsSource returns the source of the metrics from the file, which is the file name relative to the directory.
+func MetricsSource(dirPath string, fileName string) string {
+	if source, err := filepath.Rel(dirPath, fileName); err == nil {
+		return source
+	}
+	return fileName
+}
+
+func collectMetricLogs(fileName string, metrics []string, filters []string, fileFormat commonv1beta1.FileFormat, jsonOpts JsonFormatOptions) ([]*v1beta1.MetricLog, error) {
 	// we should check fileFormat first in case of opening an invalid file
 	if fileFormat != commonv1beta1.JsonFormat && fileFormat != commonv1beta1.TextFormat {
 		return nil, errFileFormat
@@ -63,107 +137,220 @@ func CollectObservationLog(fileName string, metrics []string, filters []string,
 	case commonv1beta1.TextFormat:
 		return parseLogsInTextFormat(strings.Split(logs, "\n"), metrics, filters)
 	case commonv1beta1.JsonFormat:
-		return parseLogsInJsonFormat(strings.Split(logs, "\n"), metrics)
+		return parseLogsInJsonFormat(strings.Split(logs, "\n"), metrics, jsonOpts)
 	default:
 		return nil, nil
 	}
 }
 
-func parseLogsInTextFormat(logs []string, metrics []string, filters []string) (*v1beta1.ObservationLog, error) {
+// ParseLogLine returns the metric logs of the metrics from the single log line.
+func ParseLogLine(logline string, metrics []string, metricRegList []*regexp.Regexp, fileFormat commonv1beta1.FileFormat, jsonOpts JsonFormatOptions) ([]*v1beta1.MetricLog, error) {
+	switch fileFormat {
+	case commonv1beta1.TextFormat:
+		return parseLogLineInTextFormat(logline, metrics, metricRegList), nil
+	case commonv1beta1.JsonFormat:
+		return parseLogLineInJsonFormat(logline, metrics, jsonOpts)
+	default:
+		return nil, errFileFormat
+	}
+}
+
+func parseLogsInTextFormat(logs []string, metrics []string, filters []string) ([]*v1beta1.MetricLog, error) {
 	metricRegList := GetFilterRegexpList(filters)
 	mlogs := make([]*v1beta1.MetricLog, 0, len(logs))
 
 	for _, logline := range logs {
-		// skip line which doesn't contain any metrics keywords, avoiding unnecessary pattern match
-		isMetricLine := false
-		for _, m := range metrics {
-			if strings.Contains(logline, m) {
-				isMetricLine = true
-				break
-			}
-		}
-		if !isMetricLine {
-			continue
+		mlogs = append(mlogs, parseLogLineInTextFormat(logline, metrics, metricRegList)...)
+	}
+	return mlogs, nil
+}
+
+func parseLogLineInTextFormat(logline string, metrics []string, metricRegList []*regexp.Regexp) []*v1beta1.MetricLog {
+	// skip line which doesn't contain any metrics keywords, avoiding unnecessary pattern match
+	isMetricLine := false
+	for _, m := range metrics {
+		if strings.Contains(logline, m) {
+			isMetricLine = true
+			break
 		}
+	}
+	if !isMetricLine {
+		return nil
+	}
 
-		timestamp := time.Time{}.UTC().Format(time.RFC3339)
-		ls := strings.SplitN(logline, " ", 2)
-		if len(ls) != 2 {
-			klog.Warningf("Metrics will not have timestamp since %s doesn't begin with timestamp string", logline)
+	timestamp := time.Time{}.UTC().Format(time.RFC3339)
+	ls := strings.SplitN(logline, " ", 2)
+	if len(ls) != 2 {
+		klog.Warningf("Metrics will not have timestamp since %s doesn't begin with timestamp string", logline)
+	} else {
+		if _, err := time.Parse(time.RFC3339Nano, ls[0]); err != nil {
+			klog.Warningf("Metrics will not have timestamp since error parsing time %s: %v", ls[0], err)
 		} else {
-			if _, err := time.Parse(time.RFC3339Nano, ls[0]); err != nil {
-				klog.Warningf("Metrics will not have timestamp since error parsing time %s: %v", ls[0], err)
-			} else {
-				timestamp = ls[0]
-			}
+			timestamp = ls[0]
 		}
+	}
 
-		for _, metricReg := range metricRegList {
-			matchStrs := metricReg.FindAllStringSubmatch(logline, -1)
-			for _, kevList := range matchStrs {
-				if len(kevList) < 3 {
+	var mlogs []*v1beta1.MetricLog
+	var step *int64
+	for _, metricReg := range metricRegList {
+		// Named step group is skipped in the metric name and value groups.
+		nameIndex, valueIndex := 1, 2
+		stepIndex := metricReg.SubexpIndex(common.StepKey)
+		if stepIndex > 0 && stepIndex <= valueIndex {
+			if stepIndex == nameIndex {
+				nameIndex++
+			}
+			valueIndex++
+		}
+		matchStrs := metricReg.FindAllStringSubmatch(logline, -1)
+		for _, kevList := range matchStrs {
+			if stepIndex > 0 && kevList[stepIndex] != "" {
+				step = parseStep(kevList[stepIndex])
+			}
+			if len(kevList) <= valueIndex {
+				continue
+			}
+			name := strings.TrimSpace(kevList[nameIndex])
+			value := strings.TrimSpace(kevList[valueIndex])
+			if name == common.StepKey {
+				step = parseStep(value)
+			}
+			for _, m := range metrics {
+				if name != m {
 					continue
 				}
-				name := strings.TrimSpace(kevList[1])
-				value := strings.TrimSpace(kevList[2])
-				for _, m := range metrics {
-					if name != m {
-						continue
-					}
-					mlogs = append(mlogs, &v1beta1.MetricLog{
-						TimeStamp: timestamp,
-						Metric: &v1beta1.Metric{
-							Name:  name,
-							Value: value,
-						},
-					})
-					break
-				}
+				mlogs = append(mlogs, &v1beta1.MetricLog{
+					TimeStamp: timestamp,
+					Metric: &v1beta1.Metric{
+						Name:  name,
+						Value: value,
+					},
+				})
+				break
 			}
 		}
 	}
-	return newObservationLog(mlogs, metrics), nil
+	// Step can be logged after the metrics in the same line.
+	for _, mlog := range mlogs {
+		mlog.Step = step
+	}
+	return mlogs
 }
 
-func parseLogsInJsonFormat(logs []string, metrics []string) (*v1beta1.ObservationLog, error) {
+func parseLogsInJsonFormat(logs []string, metrics []string, jsonOpts JsonFormatOptions) ([]*v1beta1.MetricLog, error) {
 	mlogs := make([]*v1beta1.MetricLog, 0, len(logs))
 
 	for _, logline := range logs {
-		if len(logline) == 0 {
-			continue
+		lineLogs, err := parseLogLineInJsonFormat(logline, metrics, jsonOpts)
+		if err != nil {
+			return nil, err
 		}
-		var jsonObj map[string]interface{}
-		if err := json.Unmarshal([]byte(logline), &jsonObj); err != nil {
-			return nil, fmt.Errorf("%w: %s", errParseJson, err.Error())
+		mlogs = append(mlogs, lineLogs...)
+	}
+	return mlogs, nil
+}
+
+func parseLogLineInJsonFormat(logline string, metrics []string, jsonOpts JsonFormatOptions) ([]*v1beta1.MetricLog, error) {
+	if len(logline) == 0 {
+		return nil, nil
+	}
+	var jsonObj map[string]interface{}
+	if err := json.Unmarshal([]byte(logline), &jsonObj); err != nil {
+		return nil, fmt.Errorf("%w: %s", errParseJson, err.Error())
+	}
+
+	timestampKey := jsonOpts.TimestampKey
+	if timestampKey == "" {
+		timestampKey = common.TimeStampJsonKey
+	}
+	timestamp := time.Time{}.UTC().Format(time.RFC3339)
+	timestampJsonValue, exist := lookupJsonValue(jsonObj, timestampKey)
+	if !exist {
+		klog.Warningf("Metrics will not have timestamp since %s doesn't have the key %s", logline, timestampKey)
+	} else {
+		if parsedTimestamp := parseTimestamp(timestampJsonValue, jsonOpts.TimestampFormat); parsedTimestamp == "" {
+			klog.Warningf("Metrics will not have timestamp since error parsing time %v", timestampJsonValue)
+		} else {
+			timestamp = parsedTimestamp
 		}
+	}
+
+	var step *int64
+	switch stepJsonValue := jsonObj[common.StepKey].(type) {
+	case string:
+		step = parseStep(stepJsonValue)
+	case float64:
+		step = parseStep(strconv.FormatFloat(stepJsonValue, 'f', -1, 64))
+	}
 
-		timestamp := time.Time{}.UTC().Format(time.RFC3339)
-		timestampJsonValue, exist := jsonObj[common.TimeStampJsonKey]
+	var mlogs []*v1beta1.MetricLog
+	for _, m := range metrics {
+		jsonValue, exist := lookupJsonValue(jsonObj, m)
 		if !exist {
-			klog.Warningf("Metrics will not have timestamp since %s doesn't have the key timestamp", logline)
-		} else {
-			if parsedTimestamp := parseTimestamp(timestampJsonValue); parsedTimestamp == "" {
-				klog.Warningf("Metrics will not have timestamp since error parsing time %v", timestampJsonValue)
-			} else {
-				timestamp = parsedTimestamp
-			}
+			continue
 		}
+		value, ok := formatJsonValue(jsonValue)
+		if !ok {
+			klog.Warningf("Metric %s is skipped since the type of %v is neither string, number nor boolean", m, jsonValue)
+			continue
+		}
+		mlogs = append(mlogs, &v1beta1.MetricLog{
+			TimeStamp: timestamp,
+			Metric: &v1beta1.Metric{
+				Name:  m,
+				Value: value,
+			},
+			Step: step,
+		})
+	}
+	return mlogs, nil
+}
 
-		for _, m := range metrics {
-			value, exist := jsonObj[m].(string)
-			if !exist {
-				continue
-			}
-			mlogs = append(mlogs, &v1beta1.MetricLog{
-				TimeStamp: timestamp,
-				Metric: &v1beta1.Metric{
-					Name:  m,
-					Value: value,
-				},
-			})
+// lookupJsonValue returns the value of the key from the JSON object.
+// If the JSON object doesn't have the key, the key is split by dots to look up the value in the nested objects,
+// e.g. the key eval.acc returns 0.9 from {"eval": {"acc": 0.9}}.
+func lookupJsonValue(jsonObj map[string]interface{}, key string) (interface{}, bool) {
+	if value, exist := jsonObj[key]; exist {
+		return value, true
+	}
+	for i := range key {
+		if key[i] != '.' {
+			continue
+		}
+		nestedObj, ok := jsonObj[key[:i]].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if value, exist := lookupJsonValue(nestedObj, key[i+1:]); exist {
+			return value, true
 		}
 	}
-	return newObservationLog(mlogs, metrics), nil
+	return nil, false
+}
+
+// formatJsonValue returns the metric value from the JSON value.
+// Objects, arrays and null are not metric values.
+func formatJsonValue(value interface{}) (string, bool) {
+	switch v := value.(type) {
+	case string:
+		return v, true
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), true
+	case bool:
+		return strconv.FormatBool(v), true
+	default:
+		return "", false
+	}
+}
+
+// parseStep returns the step from its string value or nil if the value is not integer.
+func parseStep(value string) *int64 {
+	step, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+	if err != nil {
+		klog.Warningf("Metrics will not have step since %s is not integer", value)
+		return nil
+	}
+	return &step
 }
 
 func newObservationLog(mlogs []*v1beta1.MetricLog, metrics []string) *v1beta1.ObservationLog {
@@ -196,12 +383,19 @@ func newObservationLog(mlogs []*v1beta1.MetricLog, metrics []string) *v1beta1.Ob
 	}
 }
 
-func parseTimestamp(timestamp interface{}) string {
+func parseTimestamp(timestamp interface{}, layout string) string {
 	if stringTimestamp, ok := timestamp.(string); ok {
 
 		if stringTimestamp == "" {
 			klog.Warningln("Timestamp is empty")
 			return ""
+		} else if layout != "" {
+			t, err := time.Parse(layout, stringTimestamp)
+			if err != nil {
+				klog.Warningf("Failed to parse timestamp since %s is not %s format", stringTimestamp, layout)
+				return ""
+			}
+			return t.UTC().Format(time.RFC3339Nano)
 		} else if _, err := time.Parse(time.RFC3339Nano, stringTimestamp); err != nil {
 			klog.Warningf("Failed to parse timestamp since %s is not RFC3339Nano format", stringTimestamp)
 			return ""
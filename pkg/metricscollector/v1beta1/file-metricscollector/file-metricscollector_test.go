@@ -24,6 +24,8 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	"k8s.io/utils/ptr"
+
 	commonv1beta1 "github.com/kubeflow/katib/pkg/apis/controller/common/v1beta1"
 	v1beta1 "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
 	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
@@ -36,6 +38,7 @@ func TestCollectObservationLog(t *testing.T) {
 		metrics    []string
 		filters    []string
 		fileFormat commonv1beta1.FileFormat
+		jsonOpts   JsonFormatOptions
 		wantError  error
 		expected   *v1beta1.ObservationLog
 	}{
@@ -88,6 +91,90 @@ func TestCollectObservationLog(t *testing.T) {
 				},
 			},
 		},
+		"Numeric and nested metrics in JSON format": {
+			fileName: "nested.json",
+			testData: `{"loss": 0.3, "eval": {"acc": 0.9, "converged": true}, "timestamp": "2021-12-02T14:27:50Z"}
+{"loss": 1e-5, "eval.acc": 0.95, "eval": {"acc": 0.1}, "timestamp": "2021-12-02T14:27:51Z"}
+{"loss": {"train": 0.1}, "eval": {"acc": null}, "timestamp": "2021-12-02T14:27:52Z"}`,
+			metrics:    []string{"eval.acc", "loss", "eval.converged"},
+			fileFormat: commonv1beta1.JsonFormat,
+			expected: &v1beta1.ObservationLog{
+				MetricLogs: []*v1beta1.MetricLog{
+					{
+						TimeStamp: "2021-12-02T14:27:50Z",
+						Metric: &v1beta1.Metric{
+							Name:  "eval.acc",
+							Value: "0.9",
+						},
+					},
+					{
+						TimeStamp: "2021-12-02T14:27:50Z",
+						Metric: &v1beta1.Metric{
+							Name:  "loss",
+							Value: "0.3",
+						},
+					},
+					{
+						TimeStamp: "2021-12-02T14:27:50Z",
+						Metric: &v1beta1.Metric{
+							Name:  "eval.converged",
+							Value: "true",
+						},
+					},
+					{
+						TimeStamp: "2021-12-02T14:27:51Z",
+						Metric: &v1beta1.Metric{
+							Name:  "eval.acc",
+							Value: "0.95",
+						},
+					},
+					{
+						TimeStamp: "2021-12-02T14:27:51Z",
+						Metric: &v1beta1.Metric{
+							Name:  "loss",
+							Value: "0.00001",
+						},
+					},
+				},
+			},
+		},
+		"Custom timestamp key and format in JSON format": {
+			fileName: "custom-timestamp.json",
+			testData: `{"loss": "0.3", "meta": {"time": "2021-12-02 14:27:50"}}
+{"loss": "0.2", "meta": {"time": 1638422847}}
+{"loss": "0.1", "meta": {"time": "2021-12-02T14:27:52Z"}}`,
+			metrics:    []string{"loss"},
+			fileFormat: commonv1beta1.JsonFormat,
+			jsonOpts: JsonFormatOptions{
+				TimestampKey:    "meta.time",
+				TimestampFormat: "2006-01-02 15:04:05",
+			},
+			expected: &v1beta1.ObservationLog{
+				MetricLogs: []*v1beta1.MetricLog{
+					{
+						TimeStamp: "2021-12-02T14:27:50Z",
+						Metric: &v1beta1.Metric{
+							Name:  "loss",
+							Value: "0.3",
+						},
+					},
+					{
+						TimeStamp: "2021-12-02T05:27:27Z",
+						Metric: &v1beta1.Metric{
+							Name:  "loss",
+							Value: "0.2",
+						},
+					},
+					{
+						TimeStamp: time.Time{}.UTC().Format(time.RFC3339),
+						Metric: &v1beta1.Metric{
+							Name:  "loss",
+							Value: "0.1",
+						},
+					},
+				},
+			},
+		},
 		"Positive case for logs in TEXT format": {
 			fileName: "good.log",
 			testData: `2024-03-04T17:55:08Z INFO     {metricName: accuracy, metricValue: 0.8078};{metricName: loss, metricValue: 0.5183}
@@ -161,6 +248,95 @@ func TestCollectObservationLog(t *testing.T) {
 				},
 			},
 		},
+		"Logs with step in JSON format": {
+			fileName: "step.json",
+			testData: `{"step": 1, "loss": "0.5", "timestamp": "2021-12-02T14:27:50Z"}
+{"step": "2", "loss": "0.4", "timestamp": "2021-12-02T14:27:51Z"}
+{"step": "invalid", "loss": "0.3", "timestamp": "2021-12-02T14:27:52Z"}`,
+			metrics:    []string{"loss"},
+			fileFormat: commonv1beta1.JsonFormat,
+			expected: &v1beta1.ObservationLog{
+				MetricLogs: []*v1beta1.MetricLog{
+					{
+						TimeStamp: "2021-12-02T14:27:50Z",
+						Metric: &v1beta1.Metric{
+							Name:  "loss",
+							Value: "0.5",
+						},
+						Step: ptr.To[int64](1),
+					},
+					{
+						TimeStamp: "2021-12-02T14:27:51Z",
+						Metric: &v1beta1.Metric{
+							Name:  "loss",
+							Value: "0.4",
+						},
+						Step: ptr.To[int64](2),
+					},
+					{
+						TimeStamp: "2021-12-02T14:27:52Z",
+						Metric: &v1beta1.Metric{
+							Name:  "loss",
+							Value: "0.3",
+						},
+					},
+				},
+			},
+		},
+		"Logs with step in TEXT format": {
+			fileName: "step.log",
+			testData: `2024-03-04T17:55:08Z INFO     step=1 loss=0.5
+2024-03-04T17:55:09Z INFO     loss=0.4 step=2
+2024-03-04T17:55:10Z INFO     loss=0.3`,
+			metrics:    []string{"loss"},
+			fileFormat: commonv1beta1.TextFormat,
+			expected: &v1beta1.ObservationLog{
+				MetricLogs: []*v1beta1.MetricLog{
+					{
+						TimeStamp: "2024-03-04T17:55:08Z",
+						Metric: &v1beta1.Metric{
+							Name:  "loss",
+							Value: "0.5",
+						},
+						Step: ptr.To[int64](1),
+					},
+					{
+						TimeStamp: "2024-03-04T17:55:09Z",
+						Metric: &v1beta1.Metric{
+							Name:  "loss",
+							Value: "0.4",
+						},
+						Step: ptr.To[int64](2),
+					},
+					{
+						TimeStamp: "2024-03-04T17:55:10Z",
+						Metric: &v1beta1.Metric{
+							Name:  "loss",
+							Value: "0.3",
+						},
+					},
+				},
+			},
+		},
+		"Logs with step from the filter group in TEXT format": {
+			fileName:   "step-filter.log",
+			testData:   `2024-03-04T17:55:08Z INFO     epoch 3: {metricName: loss, metricValue: 0.5}`,
+			metrics:    []string{"loss"},
+			filters:    []string{"epoch (?P<step>\\d+): {metricName: ([\\w|-]+), metricValue: ((-?\\d+)(\\.\\d+)?)}"},
+			fileFormat: commonv1beta1.TextFormat,
+			expected: &v1beta1.ObservationLog{
+				MetricLogs: []*v1beta1.MetricLog{
+					{
+						TimeStamp: "2024-03-04T17:55:08Z",
+						Metric: &v1beta1.Metric{
+							Name:  "loss",
+							Value: "0.5",
+						},
+						Step: ptr.To[int64](3),
+					},
+				},
+			},
+		},
 		"Invalid case for logs in TEXT format": {
 			fileName: "invalid-value.log",
 			testData: `2024-03-04T17:55:08Z INFO     {metricName: accuracy, metricValue: .333}
@@ -316,7 +492,149 @@ invalid INFO     {metricName: loss, metricValue: 0.3634}`,
 					t.Fatalf("failed to write test data: %v", err)
 				}
 			}
-			actual, err := CollectObservationLog(filepath.Join(tmpDir, test.fileName), test.metrics, test.filters, test.fileFormat)
+			actual, err := CollectObservationLog(filepath.Join(tmpDir, test.fileName), test.metrics, test.filters, test.fileFormat, test.jsonOpts)
+			if diff := cmp.Diff(test.wantError, err, cmpopts.EquateErrors()); len(diff) != 0 {
+				t.Errorf("Unexpected error (-want,+got):\n%s", diff)
+			}
+			observationLogCmpOpts := cmpopts.IgnoreUnexported(v1beta1.ObservationLog{}, v1beta1.MetricLog{}, v1beta1.Metric{})
+			if diff := cmp.Diff(test.expected, actual, observationLogCmpOpts); len(diff) != 0 {
+				t.Errorf("Unexpected parsed result (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCollectObservationLogFromDir(t *testing.T) {
+	tmpDir := t.TempDir()
+	testData := map[string]string{
+		"metrics-0.log": `2024-03-04T17:55:08Z loss=0.4 step=1
+2024-03-04T17:55:10Z loss=0.2 step=2`,
+		"metrics-1.log": `2024-03-04T17:55:09Z loss=0.6 step=1`,
+		"other.log":     `2024-03-04T17:55:09Z loss=0.9 step=1`,
+		"7.pid":         `completed`,
+	}
+	for fileName, data := range testData {
+		if err := os.WriteFile(filepath.Join(tmpDir, fileName), []byte(data), 0600); err != nil {
+			t.Fatalf("failed to write test data: %v", err)
+		}
+	}
+
+	testCases := map[string]struct {
+		pattern     string
+		aggregation commonv1beta1.MetricsAggregationType
+		wantError   error
+		expected    *v1beta1.ObservationLog
+	}{
+		"Metrics of every file are tagged with the source": {
+			pattern:     "metrics-*.log",
+			aggregation: commonv1beta1.MetricsAggregationNone,
+			expected: &v1beta1.ObservationLog{
+				MetricLogs: []*v1beta1.MetricLog{
+					{
+						TimeStamp: "2024-03-04T17:55:08Z",
+						Metric: &v1beta1.Metric{
+							Name:  "loss",
+							Value: "0.4",
+						},
+						Step:   ptr.To[int64](1),
+						Source: "metrics-0.log",
+					},
+					{
+						TimeStamp: "2024-03-04T17:55:10Z",
+						Metric: &v1beta1.Metric{
+							Name:  "loss",
+							Value: "0.2",
+						},
+						Step:   ptr.To[int64](2),
+						Source: "metrics-0.log",
+					},
+					{
+						TimeStamp: "2024-03-04T17:55:09Z",
+						Metric: &v1beta1.Metric{
+							Name:  "loss",
+							Value: "0.6",
+						},
+						Step:   ptr.To[int64](1),
+						Source: "metrics-1.log",
+					},
+				},
+			},
+		},
+		"Metrics of the files are aggregated": {
+			pattern:     "metrics-*.log",
+			aggregation: commonv1beta1.MetricsAggregationMean,
+			expected: &v1beta1.ObservationLog{
+				MetricLogs: []*v1beta1.MetricLog{
+					{
+						TimeStamp: "2024-03-04T17:55:09Z",
+						Metric: &v1beta1.Metric{
+							Name:  "loss",
+							Value: "0.5",
+						},
+						Step: ptr.To[int64](1),
+					},
+					{
+						TimeStamp: "2024-03-04T17:55:10Z",
+						Metric: &v1beta1.Metric{
+							Name:  "loss",
+							Value: "0.2",
+						},
+						Step: ptr.To[int64](2),
+					},
+				},
+			},
+		},
+		"Process marker files are skipped": {
+			pattern: "*",
+			expected: &v1beta1.ObservationLog{
+				MetricLogs: []*v1beta1.MetricLog{
+					{
+						TimeStamp: "2024-03-04T17:55:08Z",
+						Metric: &v1beta1.Metric{
+							Name:  "loss",
+							Value: "0.4",
+						},
+						Step:   ptr.To[int64](1),
+						Source: "metrics-0.log",
+					},
+					{
+						TimeStamp: "2024-03-04T17:55:10Z",
+						Metric: &v1beta1.Metric{
+							Name:  "loss",
+							Value: "0.2",
+						},
+						Step:   ptr.To[int64](2),
+						Source: "metrics-0.log",
+					},
+					{
+						TimeStamp: "2024-03-04T17:55:09Z",
+						Metric: &v1beta1.Metric{
+							Name:  "loss",
+							Value: "0.6",
+						},
+						Step:   ptr.To[int64](1),
+						Source: "metrics-1.log",
+					},
+					{
+						TimeStamp: "2024-03-04T17:55:09Z",
+						Metric: &v1beta1.Metric{
+							Name:  "loss",
+							Value: "0.9",
+						},
+						Step:   ptr.To[int64](1),
+						Source: "other.log",
+					},
+				},
+			},
+		},
+		"Invalid pattern": {
+			pattern:   "[",
+			wantError: errGlobFiles,
+		},
+	}
+	for name, test := range testCases {
+		t.Run(name, func(t *testing.T) {
+			actual, err := CollectObservationLogFromDir(tmpDir, test.pattern, []string{"loss"}, nil, commonv1beta1.TextFormat, JsonFormatOptions{}, test.aggregation)
 			if diff := cmp.Diff(test.wantError, err, cmpopts.EquateErrors()); len(diff) != 0 {
 				t.Errorf("Unexpected error (-want,+got):\n%s", diff)
 			}
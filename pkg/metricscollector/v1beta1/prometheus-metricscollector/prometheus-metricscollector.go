@@ -0,0 +1,206 @@
+/*
+Copyright 2022 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package prometheusmetricscollector
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+	"k8s.io/klog/v2"
+
+	v1beta1 "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
+)
+
+var (
+	errScrape          = errors.New("failed to scrape the metrics endpoint")
+	errParseExposition = errors.New("failed to parse the prometheus text exposition format")
+)
+
+// Collector scrapes the Prometheus metrics endpoint of the training container and
+// keeps MetricLogs for the Experiment's objective and additional metrics.
+// Samples are recorded only when their value is changed since the previous scrape, or their timestamp
+// is changed if the endpoint exposes it, so that the same gauge value is not reported multiple times.
+type Collector struct {
+	url     string
+	metrics []string
+	client  *http.Client
+
+	mu          sync.Mutex
+	lastSamples map[string]string
+	metricLogs  []*v1beta1.MetricLog
+}
+
+// New creates Collector for the given metrics endpoint URL and metric names.
+// First metric in metrics must be the objective metric.
+func New(url string, metrics []string, timeout time.Duration) *Collector {
+	return &Collector{
+		url:         url,
+		metrics:     metrics,
+		client:      &http.Client{Timeout: timeout},
+		lastSamples: map[string]string{},
+	}
+}
+
+// Collect scrapes the metrics endpoint once and returns MetricLogs which are recorded in this scrape.
+func (c *Collector) Collect() ([]*v1beta1.MetricLog, error) {
+	scrapeTime := time.Now().UTC()
+	resp, err := c.client.Get(c.url)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errScrape, err.Error())
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%w: unexpected status code %d from %s", errScrape, resp.StatusCode, c.url)
+	}
+
+	var parser expfmt.TextParser
+	families, err := parser.TextToMetricFamilies(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errParseExposition, err.Error())
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var newLogs []*v1beta1.MetricLog
+	for _, sample := range parseMetricFamilies(families, c.metrics, scrapeTime) {
+		// Skip sample if it has not been changed since the previous scrape.
+		// Timestamp of the sample without the exposed timestamp is the scrape time, so only its value is compared.
+		sampleValue := sample.metricLog.Metric.Value
+		if sample.timestamped {
+			sampleValue = sample.metricLog.TimeStamp + "/" + sampleValue
+		}
+		if last, ok := c.lastSamples[sample.series]; ok && last == sampleValue {
+			continue
+		}
+		c.lastSamples[sample.series] = sampleValue
+		newLogs = append(newLogs, sample.metricLog)
+	}
+	c.metricLogs = append(c.metricLogs, newLogs...)
+	return newLogs, nil
+}
+
+// ObservationLog returns ObservationLog with all MetricLogs recorded by the Collector.
+func (c *Collector) ObservationLog() *v1beta1.ObservationLog {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return newObservationLog(c.metricLogs, c.metrics)
+}
+
+// sample is the scraped value of the metric with the unique series identifier.
+type sample struct {
+	series    string
+	metricLog *v1beta1.MetricLog
+	// timestamped is true if the timestamp of the sample is exposed by the endpoint.
+	timestamped bool
+}
+
+// parseMetricFamilies converts samples of the metric families to MetricLogs.
+// Metric family name must be equal to the metric name. Only gauge, counter and untyped
+// metrics are supported, since summaries and histograms don't have the single value.
+func parseMetricFamilies(families map[string]*dto.MetricFamily, metrics []string, scrapeTime time.Time) []sample {
+	var samples []sample
+	for _, m := range metrics {
+		family, ok := families[m]
+		if !ok {
+			continue
+		}
+		for _, metric := range family.GetMetric() {
+			var value float64
+			switch family.GetType() {
+			case dto.MetricType_GAUGE:
+				value = metric.GetGauge().GetValue()
+			case dto.MetricType_COUNTER:
+				value = metric.GetCounter().GetValue()
+			case dto.MetricType_UNTYPED:
+				value = metric.GetUntyped().GetValue()
+			default:
+				klog.Warningf("Metric %v has unsupported type %v and it is skipped", m, family.GetType())
+				continue
+			}
+
+			timestamp := scrapeTime
+			if metric.TimestampMs != nil {
+				timestamp = time.UnixMilli(metric.GetTimestampMs()).UTC()
+			}
+			samples = append(samples, sample{
+				series:      seriesID(m, metric.GetLabel()),
+				timestamped: metric.TimestampMs != nil,
+				metricLog: &v1beta1.MetricLog{
+					TimeStamp: timestamp.Format(time.RFC3339Nano),
+					Metric: &v1beta1.Metric{
+						Name:  m,
+						Value: strconv.FormatFloat(value, 'f', -1, 64),
+					},
+				},
+			})
+		}
+	}
+	return samples
+}
+
+// seriesID returns the unique identifier of the time series, e.g. accuracy{rank="0"}.
+func seriesID(name string, labels []*dto.LabelPair) string {
+	if len(labels) == 0 {
+		return name
+	}
+	labelStrings := make([]string, 0, len(labels))
+	for _, l := range labels {
+		labelStrings = append(labelStrings, fmt.Sprintf("%s=%q", l.GetName(), l.GetValue()))
+	}
+	sort.Strings(labelStrings)
+	return fmt.Sprintf("%s{%s}", name, strings.Join(labelStrings, ","))
+}
+
+func newObservationLog(mlogs []*v1beta1.MetricLog, metrics []string) *v1beta1.ObservationLog {
+	// Metrics logs must contain at least one objective metric value
+	// Objective metric is located at first index
+	isObjectiveMetricReported := false
+	for _, mLog := range mlogs {
+		if mLog.Metric.Name == metrics[0] {
+			isObjectiveMetricReported = true
+			break
+		}
+	}
+	// If objective metrics were not reported, insert unavailable value in the DB
+	if !isObjectiveMetricReported {
+		klog.Infof("Objective metric %v is not found in scraped metrics, %v value is reported", metrics[0], consts.UnavailableMetricValue)
+		return &v1beta1.ObservationLog{
+			MetricLogs: []*v1beta1.MetricLog{
+				{
+					TimeStamp: time.Time{}.UTC().Format(time.RFC3339),
+					Metric: &v1beta1.Metric{
+						Name:  metrics[0],
+						Value: consts.UnavailableMetricValue,
+					},
+				},
+			},
+		}
+	}
+	return &v1beta1.ObservationLog{
+		MetricLogs: append([]*v1beta1.MetricLog{}, mlogs...),
+	}
+}
@@ -0,0 +1,190 @@
+/*
+Copyright 2022 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package prometheusmetricscollector
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	v1beta1 "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
+)
+
+func TestCollect(t *testing.T) {
+	testCases := map[string]struct {
+		responses  []string
+		statusCode int
+		metrics    []string
+		wantError  error
+		wantLogs   [][]*v1beta1.MetricLog
+		expected   *v1beta1.ObservationLog
+	}{
+		"Gauge and counter metrics with timestamps": {
+			responses: []string{
+				`# TYPE accuracy gauge
+accuracy 0.8 1638422847000
+# TYPE loss counter
+loss 0.3 1638422847000
+# TYPE other gauge
+other 1
+`,
+				`# TYPE accuracy gauge
+accuracy 0.8 1638422847000
+# TYPE loss counter
+loss 0.2 1638422848000
+`,
+			},
+			statusCode: http.StatusOK,
+			metrics:    []string{"accuracy", "loss"},
+			wantLogs: [][]*v1beta1.MetricLog{
+				{
+					{TimeStamp: "2021-12-02T05:27:27Z", Metric: &v1beta1.Metric{Name: "accuracy", Value: "0.8"}},
+					{TimeStamp: "2021-12-02T05:27:27Z", Metric: &v1beta1.Metric{Name: "loss", Value: "0.3"}},
+				},
+				{
+					{TimeStamp: "2021-12-02T05:27:28Z", Metric: &v1beta1.Metric{Name: "loss", Value: "0.2"}},
+				},
+			},
+			expected: &v1beta1.ObservationLog{
+				MetricLogs: []*v1beta1.MetricLog{
+					{TimeStamp: "2021-12-02T05:27:27Z", Metric: &v1beta1.Metric{Name: "accuracy", Value: "0.8"}},
+					{TimeStamp: "2021-12-02T05:27:27Z", Metric: &v1beta1.Metric{Name: "loss", Value: "0.3"}},
+					{TimeStamp: "2021-12-02T05:27:28Z", Metric: &v1beta1.Metric{Name: "loss", Value: "0.2"}},
+				},
+			},
+		},
+		"Metrics with labels": {
+			responses: []string{
+				`# TYPE accuracy gauge
+accuracy{rank="0"} 0.7 1638422847000
+accuracy{rank="1"} 0.7 1638422847000
+`,
+			},
+			statusCode: http.StatusOK,
+			metrics:    []string{"accuracy"},
+			expected: &v1beta1.ObservationLog{
+				MetricLogs: []*v1beta1.MetricLog{
+					{TimeStamp: "2021-12-02T05:27:27Z", Metric: &v1beta1.Metric{Name: "accuracy", Value: "0.7"}},
+					{TimeStamp: "2021-12-02T05:27:27Z", Metric: &v1beta1.Metric{Name: "accuracy", Value: "0.7"}},
+				},
+			},
+		},
+		"Histogram metric is skipped": {
+			responses: []string{
+				`# TYPE accuracy histogram
+accuracy_bucket{le="1"} 1
+accuracy_bucket{le="+Inf"} 1
+accuracy_sum 0.5
+accuracy_count 1
+`,
+			},
+			statusCode: http.StatusOK,
+			metrics:    []string{"accuracy"},
+			expected: &v1beta1.ObservationLog{
+				MetricLogs: []*v1beta1.MetricLog{
+					{
+						TimeStamp: time.Time{}.UTC().Format(time.RFC3339),
+						Metric:    &v1beta1.Metric{Name: "accuracy", Value: consts.UnavailableMetricValue},
+					},
+				},
+			},
+		},
+		"Invalid exposition format": {
+			responses:  []string{"accuracy 0.8 0.7 0.6\n"},
+			statusCode: http.StatusOK,
+			metrics:    []string{"accuracy"},
+			wantError:  errParseExposition,
+		},
+		"Metrics endpoint returns error": {
+			responses:  []string{""},
+			statusCode: http.StatusInternalServerError,
+			metrics:    []string{"accuracy"},
+			wantError:  errScrape,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			scrape := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tc.statusCode)
+				fmt.Fprint(w, tc.responses[scrape])
+			}))
+			defer server.Close()
+
+			collector := New(server.URL, tc.metrics, time.Second)
+			for scrape = range tc.responses {
+				logs, err := collector.Collect()
+				if diff := cmp.Diff(tc.wantError, err, cmpopts.EquateErrors()); len(diff) != 0 {
+					t.Fatalf("Unexpected error (-want,+got):\n%s", diff)
+				}
+				if tc.wantLogs != nil {
+					if diff := cmp.Diff(tc.wantLogs[scrape], logs, cmpopts.IgnoreUnexported(v1beta1.MetricLog{}, v1beta1.Metric{})); len(diff) != 0 {
+						t.Errorf("Unexpected metric logs for scrape %d (-want,+got):\n%s", scrape, diff)
+					}
+				}
+			}
+			if tc.wantError != nil {
+				return
+			}
+			if diff := cmp.Diff(tc.expected, collector.ObservationLog(), cmpopts.IgnoreUnexported(v1beta1.ObservationLog{}, v1beta1.MetricLog{}, v1beta1.Metric{})); len(diff) != 0 {
+				t.Errorf("Unexpected observation log (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCollectWithoutTimestamps(t *testing.T) {
+	responses := []string{
+		"# TYPE accuracy gauge\naccuracy 0.5\n",
+		"# TYPE accuracy gauge\naccuracy 0.5\n",
+		"# TYPE accuracy gauge\naccuracy 0.6\n",
+	}
+	// Timestamps of the samples are the scrape times, so only the unchanged value is skipped.
+	wantLogs := [][]*v1beta1.MetricLog{
+		{{Metric: &v1beta1.Metric{Name: "accuracy", Value: "0.5"}}},
+		nil,
+		{{Metric: &v1beta1.Metric{Name: "accuracy", Value: "0.6"}}},
+	}
+
+	scrape := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, responses[scrape])
+	}))
+	defer server.Close()
+
+	collector := New(server.URL, []string{"accuracy"}, time.Second)
+	for scrape = range responses {
+		logs, err := collector.Collect()
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if diff := cmp.Diff(wantLogs[scrape], logs, cmpopts.IgnoreUnexported(v1beta1.MetricLog{}, v1beta1.Metric{}),
+			cmpopts.IgnoreFields(v1beta1.MetricLog{}, "TimeStamp")); len(diff) != 0 {
+			t.Errorf("Unexpected metric logs for scrape %d (-want,+got):\n%s", scrape, diff)
+		}
+	}
+	if got := len(collector.ObservationLog().GetMetricLogs()); got != 2 {
+		t.Errorf("Unexpected number of metric logs in observation log, want: 2, got: %d", got)
+	}
+}
@@ -0,0 +1,554 @@
+/*
+Copyright 2022 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package tfeventmetricscollector collects the metrics from the TensorFlow event files.
+// When the event file is under a directory, e.g. train, the metric name is "{{dirname}}/{{metric name}}",
+// e.g. train/accuracy. Otherwise, the metric is collected from the event files of all directories.
+package tfeventmetricscollector
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"io/fs"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	"k8s.io/klog/v2"
+
+	v1beta1 "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
+)
+
+// EventFileNameKeyword is the keyword of the event file names, e.g. events.out.tfevents.1700000000.hostname.
+const EventFileNameKeyword = "tfevents"
+
+var (
+	errOpenFile        = errors.New("failed to open the event file")
+	errReadFile        = errors.New("failed to read the event file")
+	errCorruptedRecord = errors.New("corrupted record in the event file")
+	errParseEvent      = errors.New("failed to parse the event")
+
+	crc32cTable = crc32.MakeTable(crc32.Castagnoli)
+)
+
+const (
+	// Record is framed as uint64 length, uint32 masked crc of length, data and uint32 masked crc of data.
+	recordHeaderSize = 12
+	recordFooterSize = 4
+	crcMaskDelta     = 0xa282ead8
+)
+
+// Field numbers of tensorflow.Event, tensorflow.Summary, tensorflow.TensorProto and tensorflow.TensorShapeProto.
+const (
+	eventWallTimeField = 1
+	eventStepField     = 2
+	eventSummaryField  = 5
+
+	summaryValueField = 1
+
+	valueTagField         = 1
+	valueSimpleValueField = 2
+	valueTensorField      = 8
+
+	tensorDtypeField         = 1
+	tensorShapeField         = 2
+	tensorContentField       = 4
+	tensorFloatValField      = 5
+	tensorDoubleValField     = 6
+	tensorIntValField        = 7
+	tensorInt64ValField      = 10
+	tensorShapeDimField      = 2
+	tensorShapeDimSizeField  = 1
+	tensorShapeUnknownRank   = 3
+	dataTypeFloat            = 1
+	dataTypeDouble           = 2
+	dataTypeInt32            = 3
+	dataTypeUint8            = 4
+	dataTypeInt16            = 5
+	dataTypeInt8             = 6
+	dataTypeInt64            = 9
+	scalarTensorElementCount = 1
+)
+
+// CollectObservationLog collects the metrics from the event files in the directory and its subdirectories.
+func CollectObservationLog(dirPath string, metrics []string) (*v1beta1.ObservationLog, error) {
+	mlogs, err := NewEventDirReader(dirPath, metrics).Read()
+	if err != nil {
+		return nil, err
+	}
+	return newObservationLog(mlogs, metrics), nil
+}
+
+// EventDirReader reads the metrics from the event files in the directory and its subdirectories.
+// Every read returns the metrics of the events which have been written since the last read,
+// including the events of the files which have been created since the last read.
+type EventDirReader struct {
+	dirPath string
+	metrics []string
+	readers map[string]*EventFileReader
+	// Event files with corrupted records are not read anymore.
+	corrupted map[string]bool
+}
+
+// NewEventDirReader creates a new EventDirReader for the metrics.
+func NewEventDirReader(dirPath string, metrics []string) *EventDirReader {
+	return &EventDirReader{
+		dirPath:   dirPath,
+		metrics:   metrics,
+		readers:   map[string]*EventFileReader{},
+		corrupted: map[string]bool{},
+	}
+}
+
+// Read returns the metric logs of the new events in the event files.
+// Event files with corrupted records are skipped, event files which fail to be read are read again by the next read.
+func (r *EventDirReader) Read() ([]*v1beta1.MetricLog, error) {
+	var fileNames []string
+	err := filepath.WalkDir(r.dirPath, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() && strings.Contains(d.Name(), EventFileNameKeyword) {
+			fileNames = append(fileNames, path)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errReadFile, err.Error())
+	}
+
+	var mlogs []*v1beta1.MetricLog
+	for _, fileName := range fileNames {
+		if r.corrupted[fileName] {
+			continue
+		}
+		reader, ok := r.readers[fileName]
+		if !ok {
+			klog.Infof("%s will be parsed", fileName)
+			reader = NewEventFileReader(fileName, r.metrics)
+			r.readers[fileName] = reader
+		}
+		fileLogs, err := reader.Read()
+		if errors.Is(err, errCorruptedRecord) {
+			klog.Warningf("Event file %s is skipped: %v", fileName, err)
+			r.corrupted[fileName] = true
+		} else if err != nil {
+			// Event file might be removed or not readable yet, it is read again by the next read.
+			klog.Warningf("Failed to read event file %s: %v", fileName, err)
+		}
+		// Metrics of the records before the failed record are collected.
+		mlogs = append(mlogs, fileLogs...)
+	}
+	return mlogs, nil
+}
+
+// EventFileReader reads the metrics from the TFRecord-framed event file.
+// The record which is not completely written yet is read by the next read.
+type EventFileReader struct {
+	fileName string
+	metrics  []string
+	offset   int64
+}
+
+// NewEventFileReader creates a new EventFileReader for the metrics.
+func NewEventFileReader(fileName string, metrics []string) *EventFileReader {
+	return &EventFileReader{
+		fileName: fileName,
+		metrics:  metrics,
+	}
+}
+
+// Read returns the metric logs of the events which have been written since the last read.
+func (r *EventFileReader) Read() ([]*v1beta1.MetricLog, error) {
+	file, err := os.Open(r.fileName)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errOpenFile, err.Error())
+	}
+	defer file.Close()
+	if _, err := file.Seek(r.offset, io.SeekStart); err != nil {
+		return nil, fmt.Errorf("%w: %s", errReadFile, err.Error())
+	}
+	content, err := io.ReadAll(file)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errReadFile, err.Error())
+	}
+
+	var mlogs []*v1beta1.MetricLog
+	for {
+		data, size, err := readRecord(content)
+		if err != nil {
+			return mlogs, fmt.Errorf("%w at offset %d", err, r.offset)
+		}
+		if size == 0 {
+			return mlogs, nil
+		}
+		eventLogs, err := r.parseEvent(data)
+		if err != nil {
+			return mlogs, fmt.Errorf("%w at offset %d", err, r.offset)
+		}
+		mlogs = append(mlogs, eventLogs...)
+		content = content[size:]
+		r.offset += int64(size)
+	}
+}
+
+// readRecord returns the data of the first record and the size of the record.
+// If the record is not completely written, the size is 0.
+func readRecord(content []byte) ([]byte, int, error) {
+	if len(content) < recordHeaderSize {
+		return nil, 0, nil
+	}
+	if maskedCRC(content[:8]) != binary.LittleEndian.Uint32(content[8:recordHeaderSize]) {
+		return nil, 0, fmt.Errorf("%w: length crc mismatch", errCorruptedRecord)
+	}
+	length := binary.LittleEndian.Uint64(content[:8])
+	if length > uint64(len(content)-recordHeaderSize-recordFooterSize) || len(content) < recordHeaderSize+recordFooterSize {
+		return nil, 0, nil
+	}
+	size := recordHeaderSize + int(length) + recordFooterSize
+	data := content[recordHeaderSize : recordHeaderSize+int(length)]
+	if maskedCRC(data) != binary.LittleEndian.Uint32(content[size-recordFooterSize:size]) {
+		return nil, 0, fmt.Errorf("%w: data crc mismatch", errCorruptedRecord)
+	}
+	return data, size, nil
+}
+
+func maskedCRC(data []byte) uint32 {
+	crc := crc32.Checksum(data, crc32cTable)
+	return ((crc >> 15) | (crc << 17)) + crcMaskDelta
+}
+
+// parseEvent returns the metric logs of the summary values of the event.
+func (r *EventFileReader) parseEvent(data []byte) ([]*v1beta1.MetricLog, error) {
+	var wallTime float64
+	var step int64
+	var summaries [][]byte
+	err := forEachField(data, func(num protowire.Number, typ protowire.Type, value []byte, v uint64) {
+		switch {
+		case num == eventWallTimeField && typ == protowire.Fixed64Type:
+			wallTime = math.Float64frombits(v)
+		case num == eventStepField && typ == protowire.VarintType:
+			step = int64(v)
+		case num == eventSummaryField && typ == protowire.BytesType:
+			summaries = append(summaries, value)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sec, frac := math.Modf(wallTime)
+	timestamp := time.Unix(int64(sec), int64(frac*1e9)).UTC().Format(time.RFC3339Nano)
+	var mlogs []*v1beta1.MetricLog
+	for _, summary := range summaries {
+		var values [][]byte
+		err := forEachField(summary, func(num protowire.Number, typ protowire.Type, value []byte, _ uint64) {
+			if num == summaryValueField && typ == protowire.BytesType {
+				values = append(values, value)
+			}
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, value := range values {
+			tag, metricValue, ok, err := parseSummaryValue(value)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				continue
+			}
+			for _, m := range r.metrics {
+				if !r.shouldConsider(tag, m) {
+					continue
+				}
+				mlogs = append(mlogs, &v1beta1.MetricLog{
+					TimeStamp: timestamp,
+					Metric: &v1beta1.Metric{
+						Name:  m,
+						Value: metricValue,
+					},
+					Step: &step,
+				})
+			}
+		}
+	}
+	return mlogs, nil
+}
+
+// shouldConsider returns true if the tag is the metric. If the metric name has a directory,
+// the event file must be in that directory, e.g. metric train/accuracy matches tag accuracy of train/events.out.tfevents.
+// Tag can have a suffix, e.g. metric accuracy matches tag accuracy_1.
+func (r *EventFileReader) shouldConsider(tag string, metricName string) bool {
+	fileDir := filepath.Dir(r.fileName)
+	if i := strings.LastIndex(metricName, "/"); i >= 0 {
+		if !strings.HasSuffix(fileDir, metricName[:i]) {
+			return false
+		}
+		metricName = metricName[i+1:]
+	}
+	return strings.HasPrefix(tag, metricName)
+}
+
+// parseSummaryValue returns the tag and the value of the scalar summary value.
+// Summary values which are not scalar, e.g. histograms, images or tensors with several elements, are skipped.
+func parseSummaryValue(data []byte) (string, string, bool, error) {
+	var tag, value string
+	var ok bool
+	var tensor []byte
+	err := forEachField(data, func(num protowire.Number, typ protowire.Type, b []byte, v uint64) {
+		switch {
+		case num == valueTagField && typ == protowire.BytesType:
+			tag = string(b)
+		case num == valueSimpleValueField && typ == protowire.Fixed32Type:
+			value = strconv.FormatFloat(float64(math.Float32frombits(uint32(v))), 'f', -1, 32)
+			ok = true
+		case num == valueTensorField && typ == protowire.BytesType:
+			tensor = b
+		}
+	})
+	if err != nil || ok || tensor == nil {
+		return tag, value, ok, err
+	}
+	value, ok, err = parseScalarTensor(tensor)
+	return tag, value, ok, err
+}
+
+// parseScalarTensor returns the value of the tensor with the single numeric element.
+func parseScalarTensor(data []byte) (string, bool, error) {
+	var dtype uint64
+	var shape, content []byte
+	// Typed values are encoded as packed or as single repeated fields.
+	var floatVals []float32
+	var doubleVals []float64
+	var intVals []int64
+	err := forEachField(data, func(num protowire.Number, typ protowire.Type, b []byte, v uint64) {
+		switch num {
+		case tensorDtypeField:
+			dtype = v
+		case tensorShapeField:
+			shape = b
+		case tensorContentField:
+			content = b
+		case tensorFloatValField:
+			for _, bits := range repeatedFixed32(typ, b, v) {
+				floatVals = append(floatVals, math.Float32frombits(bits))
+			}
+		case tensorDoubleValField:
+			for _, bits := range repeatedFixed64(typ, b, v) {
+				doubleVals = append(doubleVals, math.Float64frombits(bits))
+			}
+		case tensorIntValField, tensorInt64ValField:
+			for _, iv := range repeatedVarint(typ, b, v) {
+				intVals = append(intVals, int64(iv))
+			}
+		}
+	})
+	if err != nil {
+		return "", false, err
+	}
+	if elements, err := tensorElementCount(shape); err != nil || elements != scalarTensorElementCount {
+		return "", false, err
+	}
+
+	switch dtype {
+	case dataTypeFloat:
+		if len(content) == 4 {
+			floatVals = []float32{math.Float32frombits(binary.LittleEndian.Uint32(content))}
+		}
+		if len(floatVals) == 1 {
+			return strconv.FormatFloat(float64(floatVals[0]), 'f', -1, 32), true, nil
+		}
+	case dataTypeDouble:
+		if len(content) == 8 {
+			doubleVals = []float64{math.Float64frombits(binary.LittleEndian.Uint64(content))}
+		}
+		if len(doubleVals) == 1 {
+			return strconv.FormatFloat(doubleVals[0], 'f', -1, 64), true, nil
+		}
+	case dataTypeInt32, dataTypeInt16, dataTypeInt8, dataTypeUint8, dataTypeInt64:
+		if len(content) > 0 {
+			intVals = decodeIntContent(dtype, content)
+		}
+		if len(intVals) == 1 {
+			// Int32, int16 and int8 values are sign-extended when they are encoded as varint.
+			if dtype != dataTypeInt64 {
+				intVals[0] = int64(int32(intVals[0]))
+			}
+			return strconv.FormatInt(intVals[0], 10), true, nil
+		}
+	}
+	return "", false, nil
+}
+
+// tensorElementCount returns the number of the elements of the tensor shape.
+func tensorElementCount(shape []byte) (int64, error) {
+	count := int64(1)
+	err := forEachField(shape, func(num protowire.Number, typ protowire.Type, b []byte, v uint64) {
+		switch {
+		case num == tensorShapeUnknownRank && v != 0:
+			count = -1
+		case num == tensorShapeDimField && typ == protowire.BytesType && count >= 0:
+			size := int64(0)
+			_ = forEachField(b, func(num protowire.Number, typ protowire.Type, _ []byte, v uint64) {
+				if num == tensorShapeDimSizeField && typ == protowire.VarintType {
+					size = int64(v)
+				}
+			})
+			count *= size
+		}
+	})
+	return count, err
+}
+
+func decodeIntContent(dtype uint64, content []byte) []int64 {
+	var vals []int64
+	switch dtype {
+	case dataTypeInt64:
+		for i := 0; i+8 <= len(content); i += 8 {
+			vals = append(vals, int64(binary.LittleEndian.Uint64(content[i:])))
+		}
+	case dataTypeInt32:
+		for i := 0; i+4 <= len(content); i += 4 {
+			vals = append(vals, int64(int32(binary.LittleEndian.Uint32(content[i:]))))
+		}
+	case dataTypeInt16:
+		for i := 0; i+2 <= len(content); i += 2 {
+			vals = append(vals, int64(int16(binary.LittleEndian.Uint16(content[i:]))))
+		}
+	case dataTypeInt8:
+		for _, b := range content {
+			vals = append(vals, int64(int8(b)))
+		}
+	case dataTypeUint8:
+		for _, b := range content {
+			vals = append(vals, int64(b))
+		}
+	}
+	return vals
+}
+
+// forEachField calls fn for every field of the protobuf message.
+// For bytes fields the value is passed as b, for varint and fixed fields the value is passed as v.
+func forEachField(data []byte, fn func(num protowire.Number, typ protowire.Type, b []byte, v uint64)) error {
+	for len(data) > 0 {
+		num, typ, n := protowire.ConsumeTag(data)
+		if n < 0 {
+			return fmt.Errorf("%w: %v", errParseEvent, protowire.ParseError(n))
+		}
+		data = data[n:]
+		var b []byte
+		var v uint64
+		switch typ {
+		case protowire.VarintType:
+			v, n = protowire.ConsumeVarint(data)
+		case protowire.Fixed32Type:
+			var v32 uint32
+			v32, n = protowire.ConsumeFixed32(data)
+			v = uint64(v32)
+		case protowire.Fixed64Type:
+			v, n = protowire.ConsumeFixed64(data)
+		case protowire.BytesType:
+			b, n = protowire.ConsumeBytes(data)
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, data)
+		}
+		if n < 0 {
+			return fmt.Errorf("%w: %v", errParseEvent, protowire.ParseError(n))
+		}
+		data = data[n:]
+		fn(num, typ, b, v)
+	}
+	return nil
+}
+
+func repeatedFixed32(typ protowire.Type, b []byte, v uint64) []uint32 {
+	if typ == protowire.Fixed32Type {
+		return []uint32{uint32(v)}
+	}
+	var vals []uint32
+	for len(b) >= 4 {
+		vals = append(vals, binary.LittleEndian.Uint32(b))
+		b = b[4:]
+	}
+	return vals
+}
+
+func repeatedFixed64(typ protowire.Type, b []byte, v uint64) []uint64 {
+	if typ == protowire.Fixed64Type {
+		return []uint64{v}
+	}
+	var vals []uint64
+	for len(b) >= 8 {
+		vals = append(vals, binary.LittleEndian.Uint64(b))
+		b = b[8:]
+	}
+	return vals
+}
+
+func repeatedVarint(typ protowire.Type, b []byte, v uint64) []uint64 {
+	if typ == protowire.VarintType {
+		return []uint64{v}
+	}
+	var vals []uint64
+	for len(b) > 0 {
+		val, n := protowire.ConsumeVarint(b)
+		if n < 0 {
+			break
+		}
+		vals = append(vals, val)
+		b = b[n:]
+	}
+	return vals
+}
+
+func newObservationLog(mlogs []*v1beta1.MetricLog, metrics []string) *v1beta1.ObservationLog {
+	// Metrics logs must contain at least one objective metric value
+	// Objective metric is located at first index
+	isObjectiveMetricReported := false
+	for _, mLog := range mlogs {
+		if mLog.Metric.Name == metrics[0] {
+			isObjectiveMetricReported = true
+			break
+		}
+	}
+	// If objective metrics were not reported, insert unavailable value in the DB
+	if !isObjectiveMetricReported {
+		klog.Infof("Objective metric %v is not found in event files, %v value is reported", metrics[0], consts.UnavailableMetricValue)
+		return &v1beta1.ObservationLog{
+			MetricLogs: []*v1beta1.MetricLog{
+				{
+					TimeStamp: time.Time{}.UTC().Format(time.RFC3339),
+					Metric: &v1beta1.Metric{
+						Name:  metrics[0],
+						Value: consts.UnavailableMetricValue,
+					},
+				},
+			},
+		}
+	}
+	return &v1beta1.ObservationLog{
+		MetricLogs: mlogs,
+	}
+}
@@ -0,0 +1,247 @@
+/*
+Copyright 2022 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tfeventmetricscollector
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"k8s.io/utils/ptr"
+
+	v1beta1 "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+	"github.com/kubeflow/katib/pkg/controller.v1beta1/consts"
+)
+
+const (
+	testLogsDir          = "testdata/logs"
+	testTrainEventFile   = "testdata/logs/train/events.out.tfevents.1700000000.katib.1"
+	testCorruptedLogsDir = "testdata/corrupted"
+)
+
+func newMetricLog(timestamp, name, value string, step int64) *v1beta1.MetricLog {
+	return &v1beta1.MetricLog{
+		TimeStamp: timestamp,
+		Metric: &v1beta1.Metric{
+			Name:  name,
+			Value: value,
+		},
+		Step: ptr.To(step),
+	}
+}
+
+func TestCollectObservationLog(t *testing.T) {
+	testCases := map[string]struct {
+		dirPath   string
+		metrics   []string
+		wantOlog  *v1beta1.ObservationLog
+		wantError error
+	}{
+		"Scalar tensors of the directory": {
+			dirPath: testLogsDir,
+			metrics: []string{"train/accuracy", "train/loss"},
+			wantOlog: &v1beta1.ObservationLog{
+				MetricLogs: []*v1beta1.MetricLog{
+					newMetricLog("2023-11-14T22:13:20.5Z", "train/accuracy", "0.5", 0),
+					newMetricLog("2023-11-14T22:13:20.5Z", "train/loss", "0.9", 0),
+					newMetricLog("2023-11-14T22:13:21.5Z", "train/accuracy", "0.75", 1),
+					newMetricLog("2023-11-14T22:13:21.5Z", "train/loss", "0.5", 1),
+					newMetricLog("2023-11-14T22:13:22.5Z", "train/accuracy", "0.9", 2),
+					newMetricLog("2023-11-14T22:13:22.5Z", "train/loss", "0.25", 2),
+				},
+			},
+		},
+		"Simple values and typed tensors with tag suffix": {
+			dirPath: testLogsDir,
+			metrics: []string{"test/accuracy", "precision", "samples", "recall"},
+			wantOlog: &v1beta1.ObservationLog{
+				MetricLogs: []*v1beta1.MetricLog{
+					newMetricLog("2023-11-14T22:13:20.5Z", "test/accuracy", "0.6", 0),
+					newMetricLog("2023-11-14T22:13:21.5Z", "test/accuracy", "0.8", 1),
+					newMetricLog("2023-11-14T22:13:22.5Z", "precision", "0.125", 2),
+					newMetricLog("2023-11-14T22:13:22.5Z", "samples", "1000", 2),
+					newMetricLog("2023-11-14T22:13:22.5Z", "recall", "0.5", 2),
+				},
+			},
+		},
+		"Non-scalar tensors are skipped": {
+			dirPath: testLogsDir,
+			metrics: []string{"weights"},
+			wantOlog: &v1beta1.ObservationLog{
+				MetricLogs: []*v1beta1.MetricLog{
+					{
+						TimeStamp: time.Time{}.UTC().Format(time.RFC3339),
+						Metric: &v1beta1.Metric{
+							Name:  "weights",
+							Value: consts.UnavailableMetricValue,
+						},
+					},
+				},
+			},
+		},
+		"Corrupted record is skipped": {
+			dirPath: testCorruptedLogsDir,
+			metrics: []string{"loss"},
+			wantOlog: &v1beta1.ObservationLog{
+				MetricLogs: []*v1beta1.MetricLog{
+					{
+						TimeStamp: time.Time{}.UTC().Format(time.RFC3339),
+						Metric: &v1beta1.Metric{
+							Name:  "loss",
+							Value: consts.UnavailableMetricValue,
+						},
+					},
+				},
+			},
+		},
+		"Directory does not exist": {
+			dirPath:   "testdata/invalid",
+			metrics:   []string{"loss"},
+			wantError: errReadFile,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := CollectObservationLog(tc.dirPath, tc.metrics)
+			if diff := cmp.Diff(tc.wantError, err, cmpopts.EquateErrors()); len(diff) != 0 {
+				t.Errorf("Unexpected error (-want,+got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantOlog, got, cmpopts.IgnoreUnexported(v1beta1.ObservationLog{}, v1beta1.MetricLog{}, v1beta1.Metric{})); len(diff) != 0 {
+				t.Errorf("Unexpected observation log (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestEventFileReaderRead(t *testing.T) {
+	content, err := os.ReadFile(testTrainEventFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	corrupted, err := os.ReadFile(filepath.Join(testCorruptedLogsDir, "events.out.tfevents.1700000000.katib.3"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Half of the train event file ends in the middle of the record.
+	half := len(content) / 2
+
+	testCases := map[string]struct {
+		writes    [][]byte
+		wantLogs  [][]*v1beta1.MetricLog
+		wantError error
+	}{
+		"Incomplete record is read after it is written": {
+			writes: [][]byte{content[:half], content[half:]},
+			wantLogs: [][]*v1beta1.MetricLog{
+				{
+					newMetricLog("2023-11-14T22:13:20.5Z", "loss", "0.9", 0),
+				},
+				{
+					newMetricLog("2023-11-14T22:13:21.5Z", "loss", "0.5", 1),
+					newMetricLog("2023-11-14T22:13:22.5Z", "loss", "0.25", 2),
+				},
+			},
+		},
+		"Corrupted record": {
+			writes:    [][]byte{corrupted},
+			wantLogs:  [][]*v1beta1.MetricLog{nil},
+			wantError: errCorruptedRecord,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			fileName := filepath.Join(t.TempDir(), "events.out.tfevents.1700000000.katib")
+			reader := NewEventFileReader(fileName, []string{"loss"})
+			for i, data := range tc.writes {
+				file, err := os.OpenFile(fileName, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if _, err := file.Write(data); err != nil {
+					t.Fatal(err)
+				}
+				file.Close()
+
+				got, err := reader.Read()
+				if i == len(tc.writes)-1 {
+					if diff := cmp.Diff(tc.wantError, err, cmpopts.EquateErrors()); len(diff) != 0 {
+						t.Errorf("Unexpected error (-want,+got):\n%s", diff)
+					}
+				} else if err != nil {
+					t.Fatalf("Unexpected error: %v", err)
+				}
+				if diff := cmp.Diff(tc.wantLogs[i], got, cmpopts.IgnoreUnexported(v1beta1.MetricLog{}, v1beta1.Metric{})); len(diff) != 0 {
+					t.Errorf("Unexpected metric logs of read %d (-want,+got):\n%s", i, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestEventDirReaderRead(t *testing.T) {
+	content, err := os.ReadFile(testTrainEventFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	corrupted, err := os.ReadFile(filepath.Join(testCorruptedLogsDir, "events.out.tfevents.1700000000.katib.3"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dirPath := t.TempDir()
+	corruptedFile := filepath.Join(dirPath, "events.out.tfevents.1700000000.katib.3")
+	if err := os.WriteFile(corruptedFile, corrupted, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	// Event file is not readable until the target of the link is written.
+	targetFile := filepath.Join(t.TempDir(), "events.out.tfevents.1700000000.katib.1")
+	linkFile := filepath.Join(dirPath, "events.out.tfevents.1700000000.katib.1")
+	if err := os.Symlink(targetFile, linkFile); err != nil {
+		t.Fatal(err)
+	}
+	reader := NewEventDirReader(dirPath, []string{"loss"})
+
+	got, err := reader.Read()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(got) != 0 {
+		t.Errorf("Unexpected metric logs of the unreadable event files: %v", got)
+	}
+	if diff := cmp.Diff(map[string]bool{corruptedFile: true}, reader.corrupted); len(diff) != 0 {
+		t.Errorf("Only event file with corrupted record must be skipped (-want,+got):\n%s", diff)
+	}
+
+	if err := os.WriteFile(targetFile, content, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	got, err = reader.Read()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	wantLogs := []*v1beta1.MetricLog{
+		newMetricLog("2023-11-14T22:13:20.5Z", "loss", "0.9", 0),
+		newMetricLog("2023-11-14T22:13:21.5Z", "loss", "0.5", 1),
+		newMetricLog("2023-11-14T22:13:22.5Z", "loss", "0.25", 2),
+	}
+	if diff := cmp.Diff(wantLogs, got, cmpopts.IgnoreUnexported(v1beta1.MetricLog{}, v1beta1.Metric{})); len(diff) != 0 {
+		t.Errorf("Unexpected metric logs of the event file which is readable again (-want,+got):\n%s", diff)
+	}
+}
@@ -66,32 +66,32 @@ func (mr *MockKatibDBInterfaceMockRecorder) DeleteObservationLog(arg0 any) *gomo
 }
 
 // GetObservationLog mocks base method.
-func (m *MockKatibDBInterface) GetObservationLog(arg0, arg1, arg2, arg3 string) (*api_v1_beta1.ObservationLog, error) {
+func (m *MockKatibDBInterface) GetObservationLog(arg0, arg1, arg2, arg3 string, arg4, arg5 *int64) (*api_v1_beta1.ObservationLog, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetObservationLog", arg0, arg1, arg2, arg3)
+	ret := m.ctrl.Call(m, "GetObservationLog", arg0, arg1, arg2, arg3, arg4, arg5)
 	ret0, _ := ret[0].(*api_v1_beta1.ObservationLog)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetObservationLog indicates an expected call of GetObservationLog.
-func (mr *MockKatibDBInterfaceMockRecorder) GetObservationLog(arg0, arg1, arg2, arg3 any) *gomock.Call {
+func (mr *MockKatibDBInterfaceMockRecorder) GetObservationLog(arg0, arg1, arg2, arg3, arg4, arg5 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetObservationLog", reflect.TypeOf((*MockKatibDBInterface)(nil).GetObservationLog), arg0, arg1, arg2, arg3)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetObservationLog", reflect.TypeOf((*MockKatibDBInterface)(nil).GetObservationLog), arg0, arg1, arg2, arg3, arg4, arg5)
 }
 
 // RegisterObservationLog mocks base method.
-func (m *MockKatibDBInterface) RegisterObservationLog(arg0 string, arg1 *api_v1_beta1.ObservationLog) error {
+func (m *MockKatibDBInterface) RegisterObservationLog(arg0 string, arg1 *api_v1_beta1.ObservationLog, arg2 string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RegisterObservationLog", arg0, arg1)
+	ret := m.ctrl.Call(m, "RegisterObservationLog", arg0, arg1, arg2)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // RegisterObservationLog indicates an expected call of RegisterObservationLog.
-func (mr *MockKatibDBInterfaceMockRecorder) RegisterObservationLog(arg0, arg1 any) *gomock.Call {
+func (mr *MockKatibDBInterfaceMockRecorder) RegisterObservationLog(arg0, arg1, arg2 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterObservationLog", reflect.TypeOf((*MockKatibDBInterface)(nil).RegisterObservationLog), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterObservationLog", reflect.TypeOf((*MockKatibDBInterface)(nil).RegisterObservationLog), arg0, arg1, arg2)
 }
 
 // SelectOne mocks base method.
@@ -74,18 +74,18 @@ func (mr *MockGeneratorMockRecorder) GetMetricsCollectorConfigData(arg0 any) *go
 }
 
 // GetRunSpecWithHyperParameters mocks base method.
-func (m *MockGenerator) GetRunSpecWithHyperParameters(arg0 *v1beta11.Experiment, arg1, arg2 string, arg3 []v1beta10.ParameterAssignment) (*unstructured.Unstructured, error) {
+func (m *MockGenerator) GetRunSpecWithHyperParameters(arg0 *v1beta11.Experiment, arg1, arg2 string, arg3 []v1beta10.ParameterAssignment, arg4 string) (*unstructured.Unstructured, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetRunSpecWithHyperParameters", arg0, arg1, arg2, arg3)
+	ret := m.ctrl.Call(m, "GetRunSpecWithHyperParameters", arg0, arg1, arg2, arg3, arg4)
 	ret0, _ := ret[0].(*unstructured.Unstructured)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetRunSpecWithHyperParameters indicates an expected call of GetRunSpecWithHyperParameters.
-func (mr *MockGeneratorMockRecorder) GetRunSpecWithHyperParameters(arg0, arg1, arg2, arg3 any) *gomock.Call {
+func (mr *MockGeneratorMockRecorder) GetRunSpecWithHyperParameters(arg0, arg1, arg2, arg3, arg4 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRunSpecWithHyperParameters", reflect.TypeOf((*MockGenerator)(nil).GetRunSpecWithHyperParameters), arg0, arg1, arg2, arg3)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRunSpecWithHyperParameters", reflect.TypeOf((*MockGenerator)(nil).GetRunSpecWithHyperParameters), arg0, arg1, arg2, arg3, arg4)
 }
 
 // GetSuggestionConfigData mocks base method.
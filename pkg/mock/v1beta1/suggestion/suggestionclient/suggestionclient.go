@@ -42,17 +42,17 @@ func (m *MockSuggestionClient) EXPECT() *MockSuggestionClientMockRecorder {
 }
 
 // SyncAssignments mocks base method.
-func (m *MockSuggestionClient) SyncAssignments(arg0 *v1beta10.Suggestion, arg1 *v1beta1.Experiment, arg2 []v1beta11.Trial) error {
+func (m *MockSuggestionClient) SyncAssignments(arg0 *v1beta10.Suggestion, arg1 *v1beta1.Experiment, arg2, arg3 []v1beta11.Trial) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SyncAssignments", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "SyncAssignments", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // SyncAssignments indicates an expected call of SyncAssignments.
-func (mr *MockSuggestionClientMockRecorder) SyncAssignments(arg0, arg1, arg2 any) *gomock.Call {
+func (mr *MockSuggestionClientMockRecorder) SyncAssignments(arg0, arg1, arg2, arg3 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncAssignments", reflect.TypeOf((*MockSuggestionClient)(nil).SyncAssignments), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncAssignments", reflect.TypeOf((*MockSuggestionClient)(nil).SyncAssignments), arg0, arg1, arg2, arg3)
 }
 
 // ValidateAlgorithmSettings mocks base method.
@@ -0,0 +1,210 @@
+/*
+Copyright 2022 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package suggestion_goptuna_v1beta1
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/c-bata/goptuna"
+	"k8s.io/klog/v2"
+)
+
+const defaultASHAReductionFactor = 3
+
+// ashaSamplerOption is a type of the function to customize the ASHA sampler.
+type ashaSamplerOption func(sampler *ashaSampler)
+
+// ashaSamplerOptionReductionFactor sets the reduction factor, i.e. the top 1/eta trials of the rung are promoted
+// and the resource of the next rung is eta times larger.
+func ashaSamplerOptionReductionFactor(eta int) ashaSamplerOption {
+	return func(sampler *ashaSampler) {
+		sampler.eta = eta
+	}
+}
+
+// ashaSamplerOptionMinResource sets the resource of the first rung.
+// By default, it is the minimum value of the resource parameter.
+func ashaSamplerOptionMinResource(minResource float64) ashaSamplerOption {
+	return func(sampler *ashaSampler) {
+		sampler.minResource = &minResource
+	}
+}
+
+// ashaSampler is the relative sampler of the asynchronous successive halving algorithm (ASHA).
+// The resource parameter, e.g. the number of epochs, is the budget of the trial. Trials are grouped into rungs
+// by the resource, the resource of the rung k is minResource * eta^k up to the maximum value of the resource parameter.
+// When the trial is requested, the best trial in the top 1/eta completed trials of the rung which is not promoted yet
+// is promoted to the next rung, i.e. the same parameters are suggested with the larger resource.
+// Higher rungs are preferred. If there are no trials to promote, new parameters are sampled by the independent
+// random sampler with the resource of the first rung.
+type ashaSampler struct {
+	resourceName string
+	eta          int
+	minResource  *float64
+}
+
+func newASHASampler(resourceName string, opts ...ashaSamplerOption) *ashaSampler {
+	sampler := &ashaSampler{
+		resourceName: resourceName,
+		eta:          defaultASHAReductionFactor,
+	}
+	for _, opt := range opts {
+		opt(sampler)
+	}
+	return sampler
+}
+
+var _ goptuna.RelativeSampler = &ashaSampler{}
+
+// SampleRelative returns the parameters of the promoted trial or the resource of the first rung.
+func (s *ashaSampler) SampleRelative(
+	study *goptuna.Study,
+	trial goptuna.FrozenTrial,
+	searchSpace map[string]interface{},
+) (map[string]float64, error) {
+	distribution, ok := searchSpace[s.resourceName]
+	if !ok {
+		return nil, fmt.Errorf("resource parameter %s is not in the search space", s.resourceName)
+	}
+	resources, err := s.rungResources(distribution)
+	if err != nil {
+		return nil, err
+	}
+
+	trials, err := study.GetTrials()
+	if err != nil {
+		return nil, err
+	}
+	// Rung index -> trials in the rung.
+	rungs := make([][]goptuna.FrozenTrial, len(resources))
+	for _, t := range trials {
+		if t.ID == trial.ID {
+			continue
+		}
+		value, ok := t.InternalParams[s.resourceName]
+		if !ok {
+			continue
+		}
+		if k := rungIndex(resources, value); k >= 0 {
+			rungs[k] = append(rungs[k], t)
+		}
+	}
+
+	for k := len(resources) - 2; k >= 0; k-- {
+		promoted := make(map[string]bool, len(rungs[k+1]))
+		for _, t := range rungs[k+1] {
+			promoted[s.configKey(t)] = true
+		}
+		candidate, ok := s.findPromotableTrial(study.Direction(), rungs[k], promoted)
+		if !ok {
+			continue
+		}
+		params := make(map[string]float64, len(candidate.InternalParams))
+		for name, value := range candidate.InternalParams {
+			params[name] = value
+		}
+		params[s.resourceName] = resources[k+1]
+		klog.Infof("Promote trial to the next rung: trialID=%d, rung=%d, %s=%v", candidate.ID, k+1, s.resourceName, resources[k+1])
+		return params, nil
+	}
+	return map[string]float64{s.resourceName: resources[0]}, nil
+}
+
+// rungResources returns the resource of each rung in the internal representation.
+func (s *ashaSampler) rungResources(distribution interface{}) ([]float64, error) {
+	var low, high float64
+	isInt := false
+	switch d := distribution.(type) {
+	case goptuna.UniformDistribution:
+		low, high = d.Low, d.High
+	case goptuna.IntUniformDistribution:
+		low, high, isInt = float64(d.Low), float64(d.High), true
+	default:
+		return nil, fmt.Errorf("resource parameter %s must be int or double without step", s.resourceName)
+	}
+	minResource := low
+	if s.minResource != nil {
+		minResource = *s.minResource
+	}
+	if minResource <= 0 || minResource < low || minResource > high {
+		return nil, fmt.Errorf("min_resource must be positive and in the range of the resource parameter [%v, %v]: %v", low, high, minResource)
+	}
+	if isInt && minResource != math.Trunc(minResource) {
+		return nil, fmt.Errorf("min_resource must be integer for int resource parameter %s: %v", s.resourceName, minResource)
+	}
+
+	var resources []float64
+	for r := minResource; r <= high*(1+1e-9); r *= float64(s.eta) {
+		resources = append(resources, r)
+	}
+	return resources, nil
+}
+
+// findPromotableTrial returns the best trial in the top 1/eta completed trials of the rung which is not promoted.
+func (s *ashaSampler) findPromotableTrial(
+	direction goptuna.StudyDirection,
+	trials []goptuna.FrozenTrial,
+	promoted map[string]bool,
+) (goptuna.FrozenTrial, bool) {
+	completed := make([]goptuna.FrozenTrial, 0, len(trials))
+	for _, t := range trials {
+		if t.State == goptuna.TrialStateComplete {
+			completed = append(completed, t)
+		}
+	}
+	sort.SliceStable(completed, func(i, j int) bool {
+		if direction == goptuna.StudyDirectionMaximize {
+			return completed[i].Value > completed[j].Value
+		}
+		return completed[i].Value < completed[j].Value
+	})
+	for _, t := range completed[:len(completed)/s.eta] {
+		if !promoted[s.configKey(t)] {
+			return t, true
+		}
+	}
+	return goptuna.FrozenTrial{}, false
+}
+
+// configKey identifies the configuration of the trial, i.e. parameters except the resource.
+// Configurations are compared by the parameters instead of the trial ids, since ids are changed
+// when the study is restored.
+func (s *ashaSampler) configKey(trial goptuna.FrozenTrial) string {
+	params := make(map[string]float64, len(trial.InternalParams))
+	for name, value := range trial.InternalParams {
+		if name != s.resourceName {
+			params[name] = value
+		}
+	}
+	// Map keys are sorted by json.Marshal, so the key is deterministic.
+	key, _ := json.Marshal(params)
+	return string(key)
+}
+
+// rungIndex returns the index of the rung which has the resource. It returns -1 if there is no such rung,
+// e.g. the trial is imported from the prior Experiment with the other resource.
+func rungIndex(resources []float64, resource float64) int {
+	for k, r := range resources {
+		if math.Abs(r-resource) <= 1e-9*math.Max(1, math.Abs(r)) {
+			return k
+		}
+	}
+	return -1
+}
@@ -0,0 +1,227 @@
+/*
+Copyright 2022 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package suggestion_goptuna_v1beta1
+
+import (
+	"testing"
+
+	"github.com/c-bata/goptuna"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestASHASampler_SampleRelative(t *testing.T) {
+	searchSpace := map[string]interface{}{
+		"x":      goptuna.UniformDistribution{Low: 0, High: 1},
+		"epochs": goptuna.IntUniformDistribution{Low: 1, High: 30},
+	}
+	type trial struct {
+		x      float64
+		epochs float64
+		state  goptuna.TrialState
+		value  float64
+	}
+	completed := func(x, epochs, value float64) trial {
+		return trial{x: x, epochs: epochs, state: goptuna.TrialStateComplete, value: value}
+	}
+	running := func(x, epochs float64) trial {
+		return trial{x: x, epochs: epochs, state: goptuna.TrialStateRunning}
+	}
+
+	for name, tc := range map[string]struct {
+		direction  goptuna.StudyDirection
+		opts       []ashaSamplerOption
+		trials     []trial
+		wantParams map[string]float64
+	}{
+		"New configuration without trials": {
+			direction:  goptuna.StudyDirectionMinimize,
+			wantParams: map[string]float64{"epochs": 1},
+		},
+		"New configuration until 1/eta of the rung is completed": {
+			direction: goptuna.StudyDirectionMinimize,
+			trials: []trial{
+				completed(0.1, 1, 0.1),
+				completed(0.2, 1, 0.2),
+				running(0.3, 1),
+			},
+			wantParams: map[string]float64{"epochs": 1},
+		},
+		"Promote the best trial of the rung": {
+			direction: goptuna.StudyDirectionMinimize,
+			trials: []trial{
+				completed(0.1, 1, 0.3),
+				completed(0.2, 1, 0.1),
+				completed(0.3, 1, 0.2),
+			},
+			wantParams: map[string]float64{"x": 0.2, "epochs": 3},
+		},
+		"Promote the best trial of the rung to maximize": {
+			direction: goptuna.StudyDirectionMaximize,
+			trials: []trial{
+				completed(0.1, 1, 0.3),
+				completed(0.2, 1, 0.1),
+				completed(0.3, 1, 0.2),
+			},
+			wantParams: map[string]float64{"x": 0.1, "epochs": 3},
+		},
+		"Promoted trial is not promoted again": {
+			direction: goptuna.StudyDirectionMinimize,
+			trials: []trial{
+				completed(0.1, 1, 0.1),
+				completed(0.2, 1, 0.2),
+				completed(0.3, 1, 0.3),
+				completed(0.4, 1, 0.4),
+				completed(0.5, 1, 0.5),
+				completed(0.6, 1, 0.6),
+				running(0.1, 3),
+			},
+			wantParams: map[string]float64{"x": 0.2, "epochs": 3},
+		},
+		"New configuration if top trials are promoted": {
+			direction: goptuna.StudyDirectionMinimize,
+			trials: []trial{
+				completed(0.1, 1, 0.1),
+				completed(0.2, 1, 0.2),
+				completed(0.3, 1, 0.3),
+				running(0.1, 3),
+			},
+			wantParams: map[string]float64{"epochs": 1},
+		},
+		"Higher rung is preferred": {
+			direction: goptuna.StudyDirectionMinimize,
+			trials: []trial{
+				completed(0.1, 1, 0.1),
+				completed(0.2, 1, 0.2),
+				completed(0.3, 1, 0.3),
+				completed(0.4, 3, 0.4),
+				completed(0.5, 3, 0.2),
+				completed(0.6, 3, 0.6),
+			},
+			wantParams: map[string]float64{"x": 0.5, "epochs": 9},
+		},
+		"Trials in the last rung are not promoted": {
+			direction: goptuna.StudyDirectionMinimize,
+			trials: []trial{
+				completed(0.1, 27, 0.1),
+				completed(0.2, 27, 0.2),
+				completed(0.3, 27, 0.3),
+			},
+			wantParams: map[string]float64{"epochs": 1},
+		},
+		"Trials out of the rungs are ignored": {
+			direction: goptuna.StudyDirectionMinimize,
+			trials: []trial{
+				completed(0.1, 2, 0.1),
+				completed(0.2, 2, 0.2),
+				completed(0.3, 2, 0.3),
+			},
+			wantParams: map[string]float64{"epochs": 1},
+		},
+		"Rungs start from min resource": {
+			direction: goptuna.StudyDirectionMinimize,
+			opts:      []ashaSamplerOption{ashaSamplerOptionMinResource(2), ashaSamplerOptionReductionFactor(2)},
+			trials: []trial{
+				completed(0.1, 2, 0.1),
+				completed(0.2, 2, 0.2),
+			},
+			wantParams: map[string]float64{"x": 0.1, "epochs": 4},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			study, err := goptuna.CreateStudy(defaultStudyName, goptuna.StudyOptionDirection(tc.direction), goptuna.StudyOptionLogger(nil))
+			if err != nil {
+				t.Fatalf("Failed to create study: %v", err)
+			}
+			for _, tr := range tc.trials {
+				id, err := study.Storage.CreateNewTrial(study.ID)
+				if err != nil {
+					t.Fatalf("Failed to create trial: %v", err)
+				}
+				if err = study.Storage.SetTrialParam(id, "x", tr.x, searchSpace["x"]); err != nil {
+					t.Fatalf("Failed to set trial param: %v", err)
+				}
+				if err = study.Storage.SetTrialParam(id, "epochs", tr.epochs, searchSpace["epochs"]); err != nil {
+					t.Fatalf("Failed to set trial param: %v", err)
+				}
+				if tr.state == goptuna.TrialStateComplete {
+					if err = study.Storage.SetTrialValue(id, tr.value); err != nil {
+						t.Fatalf("Failed to set trial value: %v", err)
+					}
+				}
+				if err = study.Storage.SetTrialState(id, tr.state); err != nil {
+					t.Fatalf("Failed to set trial state: %v", err)
+				}
+			}
+
+			sampler := newASHASampler("epochs", tc.opts...)
+			gotParams, err := sampler.SampleRelative(study, goptuna.FrozenTrial{ID: -1}, searchSpace)
+			if err != nil {
+				t.Fatalf("Unexpected error from SampleRelative: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantParams, gotParams); len(diff) != 0 {
+				t.Errorf("Unexpected params from SampleRelative (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestASHASampler_rungResources(t *testing.T) {
+	for name, tc := range map[string]struct {
+		distribution  interface{}
+		opts          []ashaSamplerOption
+		wantResources []float64
+		wantErr       bool
+	}{
+		"Int resource": {
+			distribution:  goptuna.IntUniformDistribution{Low: 1, High: 27},
+			wantResources: []float64{1, 3, 9, 27},
+		},
+		"Double resource with min resource": {
+			distribution:  goptuna.UniformDistribution{Low: 0, High: 1},
+			opts:          []ashaSamplerOption{ashaSamplerOptionMinResource(0.1), ashaSamplerOptionReductionFactor(2)},
+			wantResources: []float64{0.1, 0.2, 0.4, 0.8},
+		},
+		"Min resource out of the range": {
+			distribution: goptuna.IntUniformDistribution{Low: 1, High: 27},
+			opts:         []ashaSamplerOption{ashaSamplerOptionMinResource(30)},
+			wantErr:      true,
+		},
+		"Non-positive min resource": {
+			distribution: goptuna.IntUniformDistribution{Low: 0, High: 27},
+			wantErr:      true,
+		},
+		"Non-integer min resource of int resource": {
+			distribution: goptuna.IntUniformDistribution{Low: 1, High: 27},
+			opts:         []ashaSamplerOption{ashaSamplerOptionMinResource(1.5)},
+			wantErr:      true,
+		},
+		"Resource with step": {
+			distribution: goptuna.StepIntUniformDistribution{Low: 1, High: 27, Step: 2},
+			wantErr:      true,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gotResources, err := newASHASampler("epochs", tc.opts...).rungResources(tc.distribution)
+			if tc.wantErr != (err != nil) {
+				t.Fatalf("Unexpected error from rungResources: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantResources, gotResources); len(diff) != 0 {
+				t.Errorf("Unexpected resources from rungResources (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
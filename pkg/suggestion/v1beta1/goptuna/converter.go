@@ -17,7 +17,10 @@ limitations under the License.
 package suggestion_goptuna_v1beta1
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
+	"math"
 	"strconv"
 	"time"
 
@@ -26,6 +29,7 @@ import (
 	"github.com/c-bata/goptuna/sobol"
 	"github.com/c-bata/goptuna/tpe"
 	api_v1_beta1 "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+	"github.com/kubeflow/katib/pkg/util/v1beta1/constraint"
 )
 
 func toGoptunaDirection(t api_v1_beta1.ObjectiveType) goptuna.StudyDirection {
@@ -35,11 +39,29 @@ func toGoptunaDirection(t api_v1_beta1.ObjectiveType) goptuna.StudyDirection {
 	return goptuna.StudyDirectionMaximize
 }
 
-func toGoptunaSampler(algorithm *api_v1_beta1.AlgorithmSpec) (goptuna.Sampler, goptuna.RelativeSampler, error) {
+// toGoptunaDirections returns the optimization directions of the objective metric and the additional objectives.
+func toGoptunaDirections(objective *api_v1_beta1.ObjectiveSpec) []goptuna.StudyDirection {
+	directions := []goptuna.StudyDirection{toGoptunaDirection(objective.GetType())}
+	for _, o := range objective.GetAdditionalObjectives() {
+		directions = append(directions, toGoptunaDirection(o.GetType()))
+	}
+	return directions
+}
+
+// toGoptunaSampler returns the samplers of the algorithm.
+// CMA-ES starts from cmaesInitialMean if it is set, otherwise from the center of the search space.
+func toGoptunaSampler(
+	algorithm *api_v1_beta1.AlgorithmSpec,
+	objective *api_v1_beta1.ObjectiveSpec,
+	cmaesInitialMean map[string]float64,
+) (goptuna.Sampler, goptuna.RelativeSampler, error) {
 	name := algorithm.GetAlgorithmName()
 	if name == AlgorithmCMAES {
-		opts := make([]cmaes.SamplerOption, 0, len(algorithm.GetAlgorithmSettings())+1)
+		opts := make([]cmaes.SamplerOption, 0, len(algorithm.GetAlgorithmSettings())+2)
 		opts = append(opts, cmaes.SamplerOptionNStartupTrials(0))
+		if cmaesInitialMean != nil {
+			opts = append(opts, cmaes.SamplerOptionInitialMean(cmaesInitialMean))
+		}
 		for _, s := range algorithm.GetAlgorithmSettings() {
 			if s.Name == "random_state" {
 				seed, err := strconv.Atoi(s.Value)
@@ -63,11 +85,28 @@ func toGoptunaSampler(algorithm *api_v1_beta1.AlgorithmSpec) (goptuna.Sampler, g
 				} else if s.Value != "none" {
 					return nil, nil, fmt.Errorf("invalid restart_strategy: '%s'", s.Value)
 				}
+			} else if s.Name == "population_size" {
+				// CMA-ES updates the distribution when the trials of the population are completed, and
+				// the values of the other trials in the generation are discarded. The population size which is
+				// not smaller than parallelTrialCount keeps the parallel trials in the same generation.
+				n, err := strconv.Atoi(s.Value)
+				if err != nil {
+					return nil, nil, err
+				}
+				if n < 2 {
+					return nil, nil, fmt.Errorf("population_size must be greater than 1: '%s'", s.Value)
+				}
+				opts = append(opts, cmaes.SamplerOptionOptimizerOptions(cmaes.OptimizerOptionPopulationSize(n)))
+			} else if s.Name == "pending_trial_strategy" {
+				// Pseudo values would be told to CMA-ES as the values of the generation.
+				return nil, nil, fmt.Errorf("pending_trial_strategy is not supported by %s, use population_size instead", AlgorithmCMAES)
 			}
 		}
 		return nil, cmaes.NewSampler(opts...), nil
 	} else if name == AlgorithmTPE {
 		opts := make([]tpe.SamplerOption, 0, len(algorithm.GetAlgorithmSettings()))
+		pendingTrialStrategy := pendingTrialStrategyNone
+		nStartupTrials := defaultTPENumberOfStartupTrials
 		for _, s := range algorithm.GetAlgorithmSettings() {
 			if s.Name == "random_state" {
 				seed, err := strconv.Atoi(s.Value)
@@ -81,17 +120,123 @@ func toGoptunaSampler(algorithm *api_v1_beta1.AlgorithmSpec) (goptuna.Sampler, g
 					return nil, nil, err
 				}
 				opts = append(opts, tpe.SamplerOptionNumberOfStartupTrials(n))
+				nStartupTrials = n
 			} else if s.Name == "n_ei_candidates" {
 				n, err := strconv.Atoi(s.Value)
 				if err != nil {
 					return nil, nil, err
 				}
 				opts = append(opts, tpe.SamplerOptionNumberOfEICandidates(n))
+			} else if s.Name == "pending_trial_strategy" {
+				if err := validatePendingTrialStrategy(s.Value); err != nil {
+					return nil, nil, err
+				}
+				pendingTrialStrategy = s.Value
 			}
 		}
+		if pendingTrialStrategy != pendingTrialStrategyNone {
+			// Pending trials are not counted as the startup trials, which are sampled randomly.
+			return &pendingTrialSampler{
+				sampler: tpe.NewSampler(opts...),
+				view:    newPendingTrialView(pendingTrialStrategy, nStartupTrials),
+			}, nil, nil
+		}
 		return tpe.NewSampler(opts...), nil, nil
 	} else if name == AlgorithmSobol {
 		return nil, sobol.NewSampler(), nil
+	} else if name == AlgorithmNSGA2 {
+		opts := make([]nsga2SamplerOption, 0, len(algorithm.GetAlgorithmSettings()))
+		randomOpts := make([]goptuna.RandomSamplerOption, 0, 1)
+		for _, s := range algorithm.GetAlgorithmSettings() {
+			if s.Name == "random_state" {
+				seed, err := strconv.Atoi(s.Value)
+				if err != nil {
+					return nil, nil, err
+				}
+				opts = append(opts, nsga2SamplerOptionSeed(int64(seed)))
+				randomOpts = append(randomOpts, goptuna.RandomSamplerOptionSeed(int64(seed)))
+			} else if s.Name == "population_size" {
+				n, err := strconv.Atoi(s.Value)
+				if err != nil {
+					return nil, nil, err
+				}
+				if n < 2 {
+					return nil, nil, fmt.Errorf("population_size must be greater than 1: '%s'", s.Value)
+				}
+				opts = append(opts, nsga2SamplerOptionPopulationSize(n))
+			} else if s.Name == "crossover_prob" {
+				prob, err := strconv.ParseFloat(s.Value, 64)
+				if err != nil {
+					return nil, nil, err
+				}
+				if prob < 0 || prob > 1 {
+					return nil, nil, fmt.Errorf("crossover_prob must be in [0, 1]: '%s'", s.Value)
+				}
+				opts = append(opts, nsga2SamplerOptionCrossoverProb(prob))
+			} else if s.Name == "mutation_prob" {
+				prob, err := strconv.ParseFloat(s.Value, 64)
+				if err != nil {
+					return nil, nil, err
+				}
+				if prob < 0 || prob > 1 {
+					return nil, nil, fmt.Errorf("mutation_prob must be in [0, 1]: '%s'", s.Value)
+				}
+				opts = append(opts, nsga2SamplerOptionMutationProb(prob))
+			}
+		}
+		// Initial population and mutated parameters are sampled randomly.
+		return goptuna.NewRandomSampler(randomOpts...), newNSGA2Sampler(toGoptunaDirections(objective), opts...), nil
+	} else if name == AlgorithmGrid {
+		opts := make([]gridSamplerOption, 0, len(algorithm.GetAlgorithmSettings()))
+		for _, s := range algorithm.GetAlgorithmSettings() {
+			if s.Name == "allow_partial_grid" {
+				allow, err := strconv.ParseBool(s.Value)
+				if err != nil {
+					return nil, nil, err
+				}
+				opts = append(opts, gridSamplerOptionAllowPartialGrid(allow))
+			}
+		}
+		// Single value parameters which are out of the relative search space are sampled by the random sampler.
+		return goptuna.NewRandomSampler(), newGridSampler(opts...), nil
+	} else if name == AlgorithmASHA {
+		opts := make([]ashaSamplerOption, 0, len(algorithm.GetAlgorithmSettings()))
+		randomOpts := make([]goptuna.RandomSamplerOption, 0, 1)
+		resourceName := ""
+		for _, s := range algorithm.GetAlgorithmSettings() {
+			if s.Name == "random_state" {
+				seed, err := strconv.Atoi(s.Value)
+				if err != nil {
+					return nil, nil, err
+				}
+				randomOpts = append(randomOpts, goptuna.RandomSamplerOptionSeed(int64(seed)))
+			} else if s.Name == "resource_name" {
+				resourceName = s.Value
+			} else if s.Name == "eta" {
+				eta, err := strconv.Atoi(s.Value)
+				if err != nil {
+					return nil, nil, err
+				}
+				if eta < 2 {
+					return nil, nil, fmt.Errorf("eta must be greater than 1: '%s'", s.Value)
+				}
+				opts = append(opts, ashaSamplerOptionReductionFactor(eta))
+			} else if s.Name == "min_resource" {
+				minResource, err := strconv.ParseFloat(s.Value, 64)
+				if err != nil {
+					return nil, nil, err
+				}
+				if minResource <= 0 {
+					return nil, nil, fmt.Errorf("min_resource must be positive: '%s'", s.Value)
+				}
+				opts = append(opts, ashaSamplerOptionMinResource(minResource))
+			}
+		}
+		if resourceName == "" {
+			return nil, nil, errors.New("resource_name must be set")
+		}
+		// New configurations in the first rung are sampled randomly.
+		return goptuna.NewRandomSampler(randomOpts...), newASHASampler(resourceName, opts...), nil
 	} else {
 		opts := make([]goptuna.RandomSamplerOption, 0, len(algorithm.GetAlgorithmSettings()))
 		for _, s := range algorithm.GetAlgorithmSettings() {
@@ -107,9 +252,23 @@ func toGoptunaSampler(algorithm *api_v1_beta1.AlgorithmSpec) (goptuna.Sampler, g
 	}
 }
 
+// toGoptunaSearchSpace returns the goptuna distributions of the parameters.
+// Double parameters with log-uniform distribution are sampled from goptuna.LogUniformDistribution.
+// Other distributions which goptuna doesn't provide are sampled with the paramTransform of the parameter.
 func toGoptunaSearchSpace(parameters []*api_v1_beta1.ParameterSpec) (map[string]interface{}, error) {
 	searchSpace := make(map[string]interface{}, len(parameters))
 	for _, p := range parameters {
+		if p.ParameterType == api_v1_beta1.ParameterType_DOUBLE || p.ParameterType == api_v1_beta1.ParameterType_INT {
+			distribution := p.GetFeasibleSpace().GetDistribution()
+			if distribution != api_v1_beta1.Distribution_DISTRIBUTION_UNSPECIFIED && distribution != api_v1_beta1.Distribution_UNIFORM {
+				d, err := toGoptunaNonUniformDistribution(p)
+				if err != nil {
+					return nil, err
+				}
+				searchSpace[p.Name] = d
+				continue
+			}
+		}
 		if p.ParameterType == api_v1_beta1.ParameterType_DOUBLE {
 			high, err := strconv.ParseFloat(p.GetFeasibleSpace().GetMax(), 64)
 			if err != nil {
@@ -183,6 +342,85 @@ func toGoptunaSearchSpace(parameters []*api_v1_beta1.ParameterSpec) (map[string]
 	return searchSpace, nil
 }
 
+// toGoptunaNonUniformDistribution returns the goptuna distribution of the double or int parameter with
+// log-uniform, normal or log-normal distribution.
+func toGoptunaNonUniformDistribution(p *api_v1_beta1.ParameterSpec) (interface{}, error) {
+	distribution := p.GetFeasibleSpace().GetDistribution()
+	if p.GetFeasibleSpace().GetStep() != "" {
+		return nil, fmt.Errorf("Step of parameter %s is not supported with %v distribution", p.Name, distribution)
+	}
+	high, err := strconv.ParseFloat(p.GetFeasibleSpace().GetMax(), 64)
+	if err != nil {
+		return nil, err
+	}
+	low, err := strconv.ParseFloat(p.GetFeasibleSpace().GetMin(), 64)
+	if err != nil {
+		return nil, err
+	}
+	switch distribution {
+	case api_v1_beta1.Distribution_LOG_UNIFORM:
+		if low <= 0 {
+			return nil, fmt.Errorf("Min of parameter %s with %v distribution must be positive: %v", p.Name, distribution, low)
+		}
+		if p.ParameterType == api_v1_beta1.ParameterType_INT && (low != math.Trunc(low) || high != math.Trunc(high)) {
+			return nil, fmt.Errorf("Min and max of int parameter %s must be integer", p.Name)
+		}
+		return goptuna.LogUniformDistribution{
+			High: high,
+			Low:  low,
+		}, nil
+	case api_v1_beta1.Distribution_NORMAL, api_v1_beta1.Distribution_LOG_NORMAL:
+		if distribution == api_v1_beta1.Distribution_LOG_NORMAL && low <= 0 {
+			return nil, fmt.Errorf("Min of parameter %s with %v distribution must be positive: %v", p.Name, distribution, low)
+		}
+		// Single value parameters are not transformed.
+		if low == high {
+			return goptuna.UniformDistribution{
+				High: high,
+				Low:  low,
+			}, nil
+		}
+		return normalQuantileDistribution, nil
+	}
+	return nil, fmt.Errorf("Unsupported distribution of parameter %s: %v", p.Name, distribution)
+}
+
+// toParamTransforms returns transforms of the parameters by the parameter name.
+// Parameters which are sampled from the goptuna distribution as is don't have transforms.
+func toParamTransforms(parameters []*api_v1_beta1.ParameterSpec) (map[string]*paramTransform, error) {
+	transforms := make(map[string]*paramTransform)
+	for _, p := range parameters {
+		t, err := newParamTransform(p)
+		if err != nil {
+			return nil, err
+		}
+		if t != nil {
+			transforms[p.GetName()] = t
+		}
+	}
+	return transforms, nil
+}
+
+// toParameterConditions returns conditions of the conditional parameters by the parameter name.
+func toParameterConditions(parameters []*api_v1_beta1.ParameterSpec) (map[string]*api_v1_beta1.ParameterCondition, error) {
+	parameterTypes := make(map[string]api_v1_beta1.ParameterType, len(parameters))
+	for _, p := range parameters {
+		parameterTypes[p.GetName()] = p.GetParameterType()
+	}
+	conditions := make(map[string]*api_v1_beta1.ParameterCondition)
+	for _, p := range parameters {
+		if p.GetCondition() == nil {
+			continue
+		}
+		parent := p.GetCondition().GetParent()
+		if parentType, ok := parameterTypes[parent]; !ok || parentType != api_v1_beta1.ParameterType_CATEGORICAL {
+			return nil, fmt.Errorf("Parent of the conditional parameter %s must be categorical parameter: %s", p.GetName(), parent)
+		}
+		conditions[p.GetName()] = p.GetCondition()
+	}
+	return conditions, nil
+}
+
 func toGoptunaState(condition api_v1_beta1.TrialStatus_TrialConditionType) (goptuna.TrialState, error) {
 	if condition == api_v1_beta1.TrialStatus_CREATED {
 		return goptuna.TrialStateRunning, nil
@@ -198,6 +436,23 @@ func toGoptunaState(condition api_v1_beta1.TrialStatus_TrialConditionType) (gopt
 	return goptuna.TrialStateFail, fmt.Errorf("Unexpected Trial condition: %v", condition)
 }
 
+// getObjectiveValues returns final values of the objective metric and the additional objectives.
+func getObjectiveValues(objective *api_v1_beta1.ObjectiveSpec, trial *api_v1_beta1.Trial) ([]float64, error) {
+	metricNames := []string{objective.GetObjectiveMetricName()}
+	for _, o := range objective.GetAdditionalObjectives() {
+		metricNames = append(metricNames, o.GetObjectiveMetricName())
+	}
+	values := make([]float64, 0, len(metricNames))
+	for _, metricName := range metricNames {
+		v, err := getFinalMetric(metricName, trial)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, v)
+	}
+	return values, nil
+}
+
 func getFinalMetric(objectMetricName string, trial *api_v1_beta1.Trial) (float64, error) {
 	metrics := trial.GetStatus().GetObservation().GetMetrics()
 	for i := len(metrics) - 1; i >= 0; i-- {
@@ -215,9 +470,10 @@ func getFinalMetric(objectMetricName string, trial *api_v1_beta1.Trial) (float64
 
 func toGoptunaTrials(
 	ktrials []*api_v1_beta1.Trial,
-	objectMetricName string,
+	objective *api_v1_beta1.ObjectiveSpec,
 	study *goptuna.Study,
 	searchSpace map[string]interface{},
+	transforms map[string]*paramTransform,
 ) (map[string]goptuna.FrozenTrial, error) {
 	gtrials := make(map[string]goptuna.FrozenTrial, len(ktrials))
 	for i, kt := range ktrials {
@@ -241,15 +497,25 @@ func toGoptunaTrials(
 		}
 
 		var finalValue float64
+		var systemAttrs map[string]string
 		if state == goptuna.TrialStateComplete {
-			finalValue, err = getFinalMetric(objectMetricName, kt)
+			values, err := getObjectiveValues(objective, kt)
 			if err != nil {
 				return nil, err
 			}
+			finalValue = values[0]
+			// Values of all objectives are required for the multi-objective optimization.
+			if len(values) > 1 {
+				encoded, err := json.Marshal(values)
+				if err != nil {
+					return nil, err
+				}
+				systemAttrs = map[string]string{objectiveValuesAttrKey: string(encoded)}
+			}
 		}
 
 		assignments := kt.GetSpec().GetParameterAssignments().GetAssignments()
-		internalParams, externalParams, err := toGoptunaParams(assignments, searchSpace)
+		internalParams, externalParams, err := toGoptunaParams(assignments, searchSpace, transforms)
 		if err != nil {
 			return nil, err
 		}
@@ -267,7 +533,7 @@ func toGoptunaTrials(
 			Params:             externalParams,
 			Distributions:      searchSpace,
 			UserAttrs:          nil,
-			SystemAttrs:        nil,
+			SystemAttrs:        systemAttrs,
 		}
 		gtrials[kt.GetName()] = gt
 	}
@@ -277,6 +543,7 @@ func toGoptunaTrials(
 func toGoptunaParams(
 	assignments []*api_v1_beta1.ParameterAssignment,
 	searchSpace map[string]interface{},
+	transforms map[string]*paramTransform,
 ) (
 	internalParams map[string]float64,
 	externalParams map[string]interface{},
@@ -289,6 +556,19 @@ func toGoptunaParams(
 		name := assignments[i].GetName()
 		valueStr := assignments[i].GetValue()
 
+		if t, ok := transforms[name]; ok {
+			p, err := t.toInternal(valueStr)
+			if err != nil {
+				return nil, nil, err
+			}
+			internalParams[name] = p
+			externalParams[name], err = goptuna.ToExternalRepresentation(searchSpace[name], p)
+			if err != nil {
+				return nil, nil, err
+			}
+			continue
+		}
+
 		switch d := searchSpace[name].(type) {
 		case goptuna.UniformDistribution:
 			p, err := strconv.ParseFloat(valueStr, 64)
@@ -297,6 +577,13 @@ func toGoptunaParams(
 			}
 			internalParams[name] = p
 			externalParams[name] = d.ToExternalRepr(p)
+		case goptuna.LogUniformDistribution:
+			p, err := strconv.ParseFloat(valueStr, 64)
+			if err != nil {
+				return nil, nil, err
+			}
+			internalParams[name] = p
+			externalParams[name] = d.ToExternalRepr(p)
 		case goptuna.DiscreteUniformDistribution:
 			p, err := strconv.ParseFloat(valueStr, 64)
 			if err != nil {
@@ -342,19 +629,46 @@ func toGoptunaParams(
 	return internalParams, externalParams, nil
 }
 
+// createStudyAndSearchSpace creates the study of the Experiment.
+// Trials are the history at the study creation, e.g. prior trials to warm-start the Experiment.
 func createStudyAndSearchSpace(
 	experiment *api_v1_beta1.Experiment,
+	trials []*api_v1_beta1.Trial,
 ) (*goptuna.Study, map[string]interface{}, error) {
 	direction := toGoptunaDirection(experiment.GetSpec().GetObjective().GetType())
-	independentSampler, relativeSampler, err := toGoptunaSampler(experiment.GetSpec().GetAlgorithm())
+	searchSpace, err := toGoptunaSearchSpace(experiment.GetSpec().GetParameterSpecs().GetParameters())
 	if err != nil {
 		return nil, nil, err
 	}
-	searchSpace, err := toGoptunaSearchSpace(experiment.GetSpec().GetParameterSpecs().GetParameters())
+	// CMA-ES updates the distribution only with trials sampled by itself, so the history is used as the initial mean.
+	var cmaesInitialMean map[string]float64
+	if experiment.GetSpec().GetAlgorithm().GetAlgorithmName() == AlgorithmCMAES {
+		transforms, err := toParamTransforms(experiment.GetSpec().GetParameterSpecs().GetParameters())
+		if err != nil {
+			return nil, nil, err
+		}
+		cmaesInitialMean = toCMAESInitialMean(trials, experiment.GetSpec().GetObjective(), searchSpace, transforms)
+	}
+	independentSampler, relativeSampler, err := toGoptunaSampler(experiment.GetSpec().GetAlgorithm(), experiment.GetSpec().GetObjective(), cmaesInitialMean)
 	if err != nil {
 		return nil, nil, err
 	}
 
+	// Grid enumerates only active parameters which satisfy the constraints.
+	if grid, ok := relativeSampler.(*gridSampler); ok {
+		conditions, err := toParameterConditions(experiment.GetSpec().GetParameterSpecs().GetParameters())
+		if err != nil {
+			return nil, nil, err
+		}
+		constraints, err := constraint.CompileAll(experiment.GetSpec().GetParameterConstraints())
+		if err != nil {
+			return nil, nil, err
+		}
+		if err = grid.setSearchSpace(searchSpace, conditions, constraints); err != nil {
+			return nil, nil, err
+		}
+	}
+
 	studyOpts := make([]goptuna.StudyOption, 0, 5)
 	studyOpts = append(studyOpts, goptuna.StudyOptionDirection(direction))
 	studyOpts = append(studyOpts, goptuna.StudyOptionDefineSearchSpace(searchSpace))
@@ -373,3 +687,61 @@ func createStudyAndSearchSpace(
 
 	return study, searchSpace, nil
 }
+
+// toCMAESInitialMean returns parameters of the best succeeded trial in the CMA-ES representation.
+// Trials without values of all continuous parameters are skipped. It returns nil if there are no such trials.
+func toCMAESInitialMean(
+	ktrials []*api_v1_beta1.Trial,
+	objective *api_v1_beta1.ObjectiveSpec,
+	searchSpace map[string]interface{},
+	transforms map[string]*paramTransform,
+) map[string]float64 {
+	var mean map[string]float64
+	var bestValue float64
+	for _, kt := range ktrials {
+		if kt.GetStatus().GetCondition() != api_v1_beta1.TrialStatus_SUCCEEDED {
+			continue
+		}
+		value, err := getFinalMetric(objective.GetObjectiveMetricName(), kt)
+		if err != nil {
+			continue
+		}
+		isBetter := value > bestValue
+		if objective.GetType() == api_v1_beta1.ObjectiveType_MINIMIZE {
+			isBetter = value < bestValue
+		}
+		if mean != nil && !isBetter {
+			continue
+		}
+		internalParams, _, err := toGoptunaParams(kt.GetSpec().GetParameterAssignments().GetAssignments(), searchSpace, transforms)
+		if err != nil {
+			continue
+		}
+
+		trialMean := make(map[string]float64, len(searchSpace))
+		for name, distribution := range searchSpace {
+			switch distribution.(type) {
+			case goptuna.CategoricalDistribution:
+				// Categorical parameters are not sampled by CMA-ES.
+				continue
+			case goptuna.LogUniformDistribution:
+				if p, ok := internalParams[name]; ok {
+					trialMean[name] = math.Log(p)
+				}
+			default:
+				if p, ok := internalParams[name]; ok {
+					trialMean[name] = p
+				}
+			}
+			if _, ok := trialMean[name]; !ok {
+				trialMean = nil
+				break
+			}
+		}
+		if trialMean != nil {
+			mean = trialMean
+			bestValue = value
+		}
+	}
+	return mean
+}
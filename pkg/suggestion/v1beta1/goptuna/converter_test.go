@@ -21,7 +21,6 @@ import (
 
 	"github.com/c-bata/goptuna"
 	"github.com/google/go-cmp/cmp"
-	"github.com/google/go-cmp/cmp/cmpopts"
 	api_v1_beta1 "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
 )
 
@@ -52,7 +51,7 @@ func Test_toGoptunaSearchSpace(t *testing.T) {
 	cases := map[string]struct {
 		parameters      []*api_v1_beta1.ParameterSpec
 		wantSearchSpace map[string]interface{}
-		wantError       error
+		wantErr         bool
 	}{
 		"Double parameter type": {
 			parameters: []*api_v1_beta1.ParameterSpec{
@@ -92,6 +91,84 @@ func Test_toGoptunaSearchSpace(t *testing.T) {
 				},
 			},
 		},
+		"Double parameter type with log-uniform distribution": {
+			parameters: []*api_v1_beta1.ParameterSpec{
+				{
+					Name:          "param-double",
+					ParameterType: api_v1_beta1.ParameterType_DOUBLE,
+					FeasibleSpace: &api_v1_beta1.FeasibleSpace{
+						Max:          "0.1",
+						Min:          "0.0001",
+						Distribution: api_v1_beta1.Distribution_LOG_UNIFORM,
+					},
+				},
+			},
+			wantSearchSpace: map[string]interface{}{
+				"param-double": goptuna.LogUniformDistribution{
+					High: 0.1,
+					Low:  0.0001,
+				},
+			},
+		},
+		"Double parameter type with log-uniform distribution and step": {
+			parameters: []*api_v1_beta1.ParameterSpec{
+				{
+					Name:          "param-double",
+					ParameterType: api_v1_beta1.ParameterType_DOUBLE,
+					FeasibleSpace: &api_v1_beta1.FeasibleSpace{
+						Max:          "0.1",
+						Min:          "0.0001",
+						Step:         "0.001",
+						Distribution: api_v1_beta1.Distribution_LOG_UNIFORM,
+					},
+				},
+			},
+			wantErr: true,
+		},
+		"Double parameter type with log-uniform distribution and non-positive min": {
+			parameters: []*api_v1_beta1.ParameterSpec{
+				{
+					Name:          "param-double",
+					ParameterType: api_v1_beta1.ParameterType_DOUBLE,
+					FeasibleSpace: &api_v1_beta1.FeasibleSpace{
+						Max:          "0.1",
+						Min:          "0",
+						Distribution: api_v1_beta1.Distribution_LOG_UNIFORM,
+					},
+				},
+			},
+			wantErr: true,
+		},
+		"Double parameter type with normal distribution": {
+			parameters: []*api_v1_beta1.ParameterSpec{
+				{
+					Name:          "param-double",
+					ParameterType: api_v1_beta1.ParameterType_DOUBLE,
+					FeasibleSpace: &api_v1_beta1.FeasibleSpace{
+						Max:          "5.5",
+						Min:          "1.5",
+						Distribution: api_v1_beta1.Distribution_NORMAL,
+					},
+				},
+			},
+			wantSearchSpace: map[string]interface{}{
+				"param-double": normalQuantileDistribution,
+			},
+		},
+		"Double parameter type with log-normal distribution and non-positive min": {
+			parameters: []*api_v1_beta1.ParameterSpec{
+				{
+					Name:          "param-double",
+					ParameterType: api_v1_beta1.ParameterType_DOUBLE,
+					FeasibleSpace: &api_v1_beta1.FeasibleSpace{
+						Max:          "5.5",
+						Min:          "-1.5",
+						Distribution: api_v1_beta1.Distribution_LOG_NORMAL,
+					},
+				},
+			},
+			wantErr: true,
+		},
 		"Int parameter type": {
 			parameters: []*api_v1_beta1.ParameterSpec{
 				{
@@ -130,6 +207,41 @@ func Test_toGoptunaSearchSpace(t *testing.T) {
 				},
 			},
 		},
+		"Int parameter type with log-uniform distribution": {
+			parameters: []*api_v1_beta1.ParameterSpec{
+				{
+					Name:          "param-int",
+					ParameterType: api_v1_beta1.ParameterType_INT,
+					FeasibleSpace: &api_v1_beta1.FeasibleSpace{
+						Max:          "1024",
+						Min:          "16",
+						Distribution: api_v1_beta1.Distribution_LOG_UNIFORM,
+					},
+				},
+			},
+			wantSearchSpace: map[string]interface{}{
+				"param-int": goptuna.LogUniformDistribution{
+					High: 1024,
+					Low:  16,
+				},
+			},
+		},
+		"Int parameter type with log-normal distribution": {
+			parameters: []*api_v1_beta1.ParameterSpec{
+				{
+					Name:          "param-int",
+					ParameterType: api_v1_beta1.ParameterType_INT,
+					FeasibleSpace: &api_v1_beta1.FeasibleSpace{
+						Max:          "1024",
+						Min:          "16",
+						Distribution: api_v1_beta1.Distribution_LOG_NORMAL,
+					},
+				},
+			},
+			wantSearchSpace: map[string]interface{}{
+				"param-int": normalQuantileDistribution,
+			},
+		},
 		"Discrete parameter type": {
 			parameters: []*api_v1_beta1.ParameterSpec{
 				{
@@ -166,8 +278,8 @@ func Test_toGoptunaSearchSpace(t *testing.T) {
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
 			got, err := toGoptunaSearchSpace(tc.parameters)
-			if diff := cmp.Diff(tc.wantError, err, cmpopts.EquateErrors()); len(diff) != 0 {
-				t.Errorf("Unexpected error from toGoptunaSearchSpace (-want,+got):\n%s", diff)
+			if tc.wantErr != (err != nil) {
+				t.Errorf("Unexpected error from toGoptunaSearchSpace: %v", err)
 			}
 			if diff := cmp.Diff(tc.wantSearchSpace, got); len(diff) != 0 {
 				t.Errorf("Unexpected search space from toGoptunaSearchSpace (-want,+got):\n%s", diff)
@@ -175,3 +287,68 @@ func Test_toGoptunaSearchSpace(t *testing.T) {
 		})
 	}
 }
+
+func Test_toCMAESInitialMean(t *testing.T) {
+	searchSpace := map[string]interface{}{
+		"x":         goptuna.UniformDistribution{Low: -10, High: 10},
+		"y":         goptuna.IntUniformDistribution{Low: 0, High: 10},
+		"optimizer": goptuna.CategoricalDistribution{Choices: []string{"sgd", "adam"}},
+	}
+	newTrial := func(condition api_v1_beta1.TrialStatus_TrialConditionType, value string, assignments ...string) *api_v1_beta1.Trial {
+		trial := &api_v1_beta1.Trial{
+			Spec: &api_v1_beta1.TrialSpec{
+				ParameterAssignments: &api_v1_beta1.TrialSpec_ParameterAssignments{},
+			},
+			Status: &api_v1_beta1.TrialStatus{
+				Condition: condition,
+				Observation: &api_v1_beta1.Observation{
+					Metrics: []*api_v1_beta1.Metric{{Name: "metric-1", Value: value}},
+				},
+			},
+		}
+		for i := 0; i < len(assignments); i += 2 {
+			trial.Spec.ParameterAssignments.Assignments = append(trial.Spec.ParameterAssignments.Assignments,
+				&api_v1_beta1.ParameterAssignment{Name: assignments[i], Value: assignments[i+1]})
+		}
+		return trial
+	}
+	trials := []*api_v1_beta1.Trial{
+		newTrial(api_v1_beta1.TrialStatus_SUCCEEDED, "0.5", "x", "1.5", "y", "2", "optimizer", "sgd"),
+		newTrial(api_v1_beta1.TrialStatus_SUCCEEDED, "0.1", "x", "-3", "y", "7", "optimizer", "adam"),
+		// Trials without the continuous parameters or failed trials are not used.
+		newTrial(api_v1_beta1.TrialStatus_SUCCEEDED, "0.9", "x", "5"),
+		newTrial(api_v1_beta1.TrialStatus_FAILED, "0.9", "x", "5", "y", "5"),
+	}
+
+	for name, tc := range map[string]struct {
+		objectiveType api_v1_beta1.ObjectiveType
+		trials        []*api_v1_beta1.Trial
+		wantMean      map[string]float64
+	}{
+		"maximize": {
+			objectiveType: api_v1_beta1.ObjectiveType_MAXIMIZE,
+			trials:        trials,
+			wantMean:      map[string]float64{"x": 1.5, "y": 2},
+		},
+		"minimize": {
+			objectiveType: api_v1_beta1.ObjectiveType_MINIMIZE,
+			trials:        trials,
+			wantMean:      map[string]float64{"x": -3, "y": 7},
+		},
+		"no trials": {
+			objectiveType: api_v1_beta1.ObjectiveType_MAXIMIZE,
+			wantMean:      nil,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			objective := &api_v1_beta1.ObjectiveSpec{
+				Type:                tc.objectiveType,
+				ObjectiveMetricName: "metric-1",
+			}
+			got := toCMAESInitialMean(tc.trials, objective, searchSpace, nil)
+			if diff := cmp.Diff(tc.wantMean, got); len(diff) != 0 {
+				t.Errorf("Unexpected mean from toCMAESInitialMean (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
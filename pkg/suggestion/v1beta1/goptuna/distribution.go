@@ -0,0 +1,152 @@
+/*
+Copyright 2022 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package suggestion_goptuna_v1beta1
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/c-bata/goptuna"
+	api_v1_beta1 "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+)
+
+// normalTruncation is the number of standard deviations between the mean and the min/max of the parameter
+// with normal or log-normal distribution, i.e. 99.7% of the values of the distribution are in [min, max].
+const normalTruncation = 3
+
+// normalQuantileDistribution is the goptuna distribution of the parameters with normal or log-normal distribution.
+// Goptuna doesn't provide normal distributions, so the quantile of the value is sampled uniformly instead.
+var normalQuantileDistribution = goptuna.UniformDistribution{
+	Low:  normalCDF(-normalTruncation),
+	High: normalCDF(normalTruncation),
+}
+
+// paramTransform converts the internal value sampled from the goptuna distribution to the parameter value
+// and vice versa. It is used for the distributions which goptuna doesn't provide:
+// int parameters with log-uniform distribution are sampled from the log-uniform distribution and rounded,
+// parameters with normal and log-normal distributions are sampled by the quantile of the value.
+type paramTransform struct {
+	distribution api_v1_beta1.Distribution
+	isInt        bool
+	low          float64
+	high         float64
+	// Mean and standard deviation of the normal distribution. They are in the log space for log-normal distribution.
+	mu    float64
+	sigma float64
+}
+
+// newParamTransform returns the transform of the parameter. It returns nil if the parameter is sampled from
+// the goptuna distribution as is.
+func newParamTransform(p *api_v1_beta1.ParameterSpec) (*paramTransform, error) {
+	if p.GetParameterType() != api_v1_beta1.ParameterType_DOUBLE && p.GetParameterType() != api_v1_beta1.ParameterType_INT {
+		return nil, nil
+	}
+	isInt := p.GetParameterType() == api_v1_beta1.ParameterType_INT
+	distribution := p.GetFeasibleSpace().GetDistribution()
+	if distribution != api_v1_beta1.Distribution_NORMAL && distribution != api_v1_beta1.Distribution_LOG_NORMAL &&
+		!(distribution == api_v1_beta1.Distribution_LOG_UNIFORM && isInt) {
+		return nil, nil
+	}
+	low, err := strconv.ParseFloat(p.GetFeasibleSpace().GetMin(), 64)
+	if err != nil {
+		return nil, err
+	}
+	high, err := strconv.ParseFloat(p.GetFeasibleSpace().GetMax(), 64)
+	if err != nil {
+		return nil, err
+	}
+	// Single value parameters are sampled from the uniform distribution.
+	if low == high {
+		return nil, nil
+	}
+	t := &paramTransform{
+		distribution: distribution,
+		isInt:        isInt,
+		low:          low,
+		high:         high,
+	}
+	switch distribution {
+	case api_v1_beta1.Distribution_NORMAL:
+		t.mu = (low + high) / 2
+		t.sigma = (high - low) / (2 * normalTruncation)
+	case api_v1_beta1.Distribution_LOG_NORMAL:
+		if low <= 0 {
+			return nil, fmt.Errorf("min of parameter %s with %v distribution must be positive: %v", p.GetName(), distribution, low)
+		}
+		t.mu = (math.Log(low) + math.Log(high)) / 2
+		t.sigma = (math.Log(high) - math.Log(low)) / (2 * normalTruncation)
+	}
+	return t, nil
+}
+
+// toValue returns the parameter value of the internal value.
+func (t *paramTransform) toValue(internal float64) string {
+	var v float64
+	switch t.distribution {
+	case api_v1_beta1.Distribution_NORMAL:
+		v = t.mu + t.sigma*quantileToZ(internal)
+	case api_v1_beta1.Distribution_LOG_NORMAL:
+		v = math.Exp(t.mu + t.sigma*quantileToZ(internal))
+	default:
+		v = internal
+	}
+	v = math.Min(math.Max(v, t.low), t.high)
+	if t.isInt {
+		return strconv.Itoa(int(math.Round(v)))
+	}
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+// toInternal returns the internal value of the parameter value.
+func (t *paramTransform) toInternal(value string) (float64, error) {
+	v, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, err
+	}
+	v = math.Min(math.Max(v, t.low), t.high)
+	switch t.distribution {
+	case api_v1_beta1.Distribution_NORMAL:
+		z := (v - t.mu) / t.sigma
+		return normalCDF(math.Min(math.Max(z, -normalTruncation), normalTruncation)), nil
+	case api_v1_beta1.Distribution_LOG_NORMAL:
+		z := (math.Log(v) - t.mu) / t.sigma
+		return normalCDF(math.Min(math.Max(z, -normalTruncation), normalTruncation)), nil
+	}
+	return v, nil
+}
+
+// quantileToZ returns the standard score of the quantile. Quantiles at the bounds of normalQuantileDistribution
+// are converted to the truncation exactly, so the min and max of the parameter are not affected by the rounding error.
+func quantileToZ(q float64) float64 {
+	if q <= normalQuantileDistribution.Low {
+		return -normalTruncation
+	} else if q >= normalQuantileDistribution.High {
+		return normalTruncation
+	}
+	return normalPPF(q)
+}
+
+// normalCDF is the cumulative distribution function of the standard normal distribution.
+func normalCDF(z float64) float64 {
+	return 0.5 * (1 + math.Erf(z/math.Sqrt2))
+}
+
+// normalPPF is the inverse of normalCDF.
+func normalPPF(q float64) float64 {
+	return math.Sqrt2 * math.Erfinv(2*q-1)
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2022 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package suggestion_goptuna_v1beta1
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	api_v1_beta1 "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+)
+
+func TestParamTransform(t *testing.T) {
+	newParameter := func(parameterType api_v1_beta1.ParameterType, distribution api_v1_beta1.Distribution, min, max string) *api_v1_beta1.ParameterSpec {
+		return &api_v1_beta1.ParameterSpec{
+			Name:          "param",
+			ParameterType: parameterType,
+			FeasibleSpace: &api_v1_beta1.FeasibleSpace{
+				Max:          max,
+				Min:          min,
+				Distribution: distribution,
+			},
+		}
+	}
+	for name, tc := range map[string]struct {
+		parameter     *api_v1_beta1.ParameterSpec
+		internals     []float64
+		wantValues    []string
+		wantTransform bool
+	}{
+		"Double parameter with log-uniform distribution is not transformed": {
+			parameter: newParameter(api_v1_beta1.ParameterType_DOUBLE, api_v1_beta1.Distribution_LOG_UNIFORM, "0.001", "0.1"),
+		},
+		"Int parameter with uniform distribution is not transformed": {
+			parameter: newParameter(api_v1_beta1.ParameterType_INT, api_v1_beta1.Distribution_UNIFORM, "1", "10"),
+		},
+		"Single value parameter is not transformed": {
+			parameter: newParameter(api_v1_beta1.ParameterType_DOUBLE, api_v1_beta1.Distribution_NORMAL, "1", "1"),
+		},
+		"Int parameter with log-uniform distribution is rounded": {
+			parameter:     newParameter(api_v1_beta1.ParameterType_INT, api_v1_beta1.Distribution_LOG_UNIFORM, "16", "1024"),
+			internals:     []float64{16, 100.4, 100.6, 1024},
+			wantValues:    []string{"16", "100", "101", "1024"},
+			wantTransform: true,
+		},
+		"Double parameter with normal distribution": {
+			parameter:     newParameter(api_v1_beta1.ParameterType_DOUBLE, api_v1_beta1.Distribution_NORMAL, "-1", "5"),
+			internals:     []float64{normalQuantileDistribution.Low, normalCDF(-1), 0.5, normalCDF(1), normalQuantileDistribution.High},
+			wantValues:    []string{"-1", "1", "2", "3", "5"},
+			wantTransform: true,
+		},
+		"Int parameter with log-normal distribution": {
+			parameter:     newParameter(api_v1_beta1.ParameterType_INT, api_v1_beta1.Distribution_LOG_NORMAL, "1", "1000000"),
+			internals:     []float64{normalQuantileDistribution.Low, normalCDF(-1), 0.5, normalCDF(1), normalQuantileDistribution.High},
+			wantValues:    []string{"1", "100", "1000", "10000", "1000000"},
+			wantTransform: true,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			transform, err := newParamTransform(tc.parameter)
+			if err != nil {
+				t.Fatalf("Unexpected error from newParamTransform: %v", err)
+			}
+			if tc.wantTransform != (transform != nil) {
+				t.Fatalf("Unexpected transform from newParamTransform: %v", transform)
+			}
+			if transform == nil {
+				return
+			}
+			gotValues := make([]string, 0, len(tc.internals))
+			for _, internal := range tc.internals {
+				value := transform.toValue(internal)
+				gotValues = append(gotValues, value)
+
+				// The internal value of the value is converted to the same value.
+				gotInternal, err := transform.toInternal(value)
+				if err != nil {
+					t.Fatalf("Unexpected error from toInternal: %v", err)
+				}
+				if got := transform.toValue(gotInternal); got != value {
+					t.Errorf("Internal value of %s is converted to %s", value, got)
+				}
+			}
+			if diff := cmp.Diff(tc.wantValues, gotValues); len(diff) != 0 {
+				t.Errorf("Unexpected values from toValue (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
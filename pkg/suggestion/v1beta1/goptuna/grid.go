@@ -0,0 +1,285 @@
+/*
+Copyright 2022 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package suggestion_goptuna_v1beta1
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+	"strconv"
+
+	"github.com/c-bata/goptuna"
+	api_v1_beta1 "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+	"github.com/kubeflow/katib/pkg/util/v1beta1/constraint"
+)
+
+// errSearchSpaceExhausted is returned if all parameters of the search space have been suggested.
+var errSearchSpaceExhausted = errors.New("Search space is exhausted")
+
+// gridSamplerOption is a type of the function to customize the grid sampler.
+type gridSamplerOption func(sampler *gridSampler)
+
+// gridSamplerOptionAllowPartialGrid allows the grid which is larger than the max trial count of the Experiment,
+// i.e. only the part of the grid is evaluated.
+func gridSamplerOptionAllowPartialGrid(allow bool) gridSamplerOption {
+	return func(sampler *gridSampler) {
+		sampler.allowPartialGrid = allow
+	}
+}
+
+// gridSampler is the relative sampler which enumerates the Cartesian product of the parameter values.
+// Double parameters must have the step. Values of the inactive conditional parameters are not enumerated and
+// parameters which violate the constraints are skipped. Parameters of the trials in the study, e.g. prior trials
+// to warm-start the Experiment, are not suggested again.
+// It returns errSearchSpaceExhausted after all parameters of the grid have been suggested.
+type gridSampler struct {
+	allowPartialGrid bool
+
+	names       []string // Sorted parameter names, the values of the last parameter are changed first.
+	searchSpace map[string]interface{}
+	conditions  map[string]*api_v1_beta1.ParameterCondition
+	constraints []*constraint.Constraint
+	next        int // Index of the next grid point to check
+}
+
+func newGridSampler(opts ...gridSamplerOption) *gridSampler {
+	sampler := &gridSampler{}
+	for _, opt := range opts {
+		opt(sampler)
+	}
+	return sampler
+}
+
+var _ goptuna.RelativeSampler = &gridSampler{}
+
+// setSearchSpace sets the grid of the search space.
+func (s *gridSampler) setSearchSpace(
+	searchSpace map[string]interface{},
+	conditions map[string]*api_v1_beta1.ParameterCondition,
+	constraints []*constraint.Constraint,
+) error {
+	names := make([]string, 0, len(searchSpace))
+	for name, distribution := range searchSpace {
+		if _, err := gridSize(distribution); err != nil {
+			return fmt.Errorf("Parameter %s is not supported by grid: %w", name, err)
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	s.names = names
+	s.searchSpace = searchSpace
+	s.conditions = conditions
+	s.constraints = constraints
+	s.next = 0
+	return nil
+}
+
+// size returns the number of parameters in the grid. Inactive conditional parameters are not counted,
+// but the constraints are not taken into account.
+func (s *gridSampler) size() int {
+	// Parent name -> conditional parameters.
+	children := make(map[string][]string, len(s.conditions))
+	for _, name := range s.names {
+		if condition, ok := s.conditions[name]; ok {
+			children[condition.GetParent()] = append(children[condition.GetParent()], name)
+		}
+	}
+	var size func(name string) int
+	size = func(name string) int {
+		n, _ := gridSize(s.searchSpace[name])
+		if len(children[name]) == 0 {
+			return n
+		}
+		total := 0
+		for i := 0; i < n; i++ {
+			value := formatGridValue(s.searchSpace[name], gridValue(s.searchSpace[name], i))
+			combinations := 1
+			for _, child := range children[name] {
+				if slices.Contains(s.conditions[child].GetValues(), value) {
+					combinations = saturatingMul(combinations, size(child))
+				}
+			}
+			total = saturatingAdd(total, combinations)
+		}
+		return total
+	}
+	total := 1
+	for _, name := range s.names {
+		if _, ok := s.conditions[name]; !ok {
+			total = saturatingMul(total, size(name))
+		}
+	}
+	return total
+}
+
+// SampleRelative returns the next grid point which is neither in the study nor violates the constraints.
+func (s *gridSampler) SampleRelative(
+	study *goptuna.Study,
+	trial goptuna.FrozenTrial,
+	searchSpace map[string]interface{},
+) (map[string]float64, error) {
+	if s.searchSpace == nil {
+		return nil, errors.New("search space of the grid is not set")
+	}
+	trials, err := study.GetTrials()
+	if err != nil {
+		return nil, err
+	}
+	used := make(map[string]bool, len(trials))
+	for _, t := range trials {
+		if t.ID != trial.ID && len(t.InternalParams) != 0 {
+			used[gridKey(t.InternalParams)] = true
+		}
+	}
+
+	// Number of the grid points without inactive parameters.
+	total := 1
+	for _, name := range s.names {
+		n, _ := gridSize(s.searchSpace[name])
+		total = saturatingMul(total, n)
+	}
+	for ; s.next < total; s.next++ {
+		params, values, ok := s.gridPoint(s.next)
+		if !ok || used[gridKey(params)] {
+			continue
+		}
+		ok, _, err := constraint.IsSatisfiedAll(s.constraints, values)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			continue
+		}
+		s.next++
+		// Parameters out of the relative search space, e.g. single value parameters, are sampled independently.
+		relativeParams := make(map[string]float64, len(params))
+		for name, value := range params {
+			if _, ok := searchSpace[name]; ok {
+				relativeParams[name] = value
+			}
+		}
+		return relativeParams, nil
+	}
+	return nil, errSearchSpaceExhausted
+}
+
+// gridPoint returns the internal and external representation of the active parameters of the grid point.
+// Grid points which differ only in the inactive parameters are the same, so only the one with the first value
+// of the inactive parameters is valid. It returns false for the other grid points.
+func (s *gridSampler) gridPoint(index int) (map[string]float64, map[string]string, bool) {
+	indexes := make(map[string]int, len(s.names))
+	for i := len(s.names) - 1; i >= 0; i-- {
+		n, _ := gridSize(s.searchSpace[s.names[i]])
+		indexes[s.names[i]] = index % n
+		index /= n
+	}
+
+	params := make(map[string]float64, len(s.names))
+	values := make(map[string]string, len(s.names))
+	visited := make(map[string]bool, len(s.names))
+	var visit func(name string)
+	visit = func(name string) {
+		if visited[name] {
+			return
+		}
+		visited[name] = true
+		if condition, ok := s.conditions[name]; ok {
+			visit(condition.GetParent())
+			parentValue, ok := values[condition.GetParent()]
+			if !ok || !slices.Contains(condition.GetValues(), parentValue) {
+				return
+			}
+		}
+		params[name] = gridValue(s.searchSpace[name], indexes[name])
+		values[name] = formatGridValue(s.searchSpace[name], params[name])
+	}
+	for _, name := range s.names {
+		visit(name)
+		if _, ok := params[name]; !ok && indexes[name] != 0 {
+			return nil, nil, false
+		}
+	}
+	return params, values, true
+}
+
+// gridSize returns the number of values of the parameter in the grid.
+func gridSize(distribution interface{}) (int, error) {
+	switch d := distribution.(type) {
+	case goptuna.DiscreteUniformDistribution:
+		return int(math.Floor((d.High-d.Low)/d.Q+1e-9)) + 1, nil
+	case goptuna.IntUniformDistribution:
+		return d.High - d.Low + 1, nil
+	case goptuna.StepIntUniformDistribution:
+		return (d.High-d.Low)/d.Step + 1, nil
+	case goptuna.CategoricalDistribution:
+		return len(d.Choices), nil
+	case goptuna.UniformDistribution:
+		return 0, errors.New("double parameter must have step")
+	}
+	return 0, fmt.Errorf("unsupported distribution: %v", distribution)
+}
+
+// gridValue returns the internal representation of the i-th value of the parameter.
+func gridValue(distribution interface{}, i int) float64 {
+	switch d := distribution.(type) {
+	case goptuna.DiscreteUniformDistribution:
+		// Values are rounded not to suggest values like 0.30000000000000004.
+		v, _ := strconv.ParseFloat(strconv.FormatFloat(d.Low+float64(i)*d.Q, 'g', 12, 64), 64)
+		return math.Min(v, d.High)
+	case goptuna.IntUniformDistribution:
+		return float64(d.Low + i)
+	case goptuna.StepIntUniformDistribution:
+		return float64(d.Low + i*d.Step)
+	}
+	// Categorical parameters are represented by the index of the choice.
+	return float64(i)
+}
+
+// formatGridValue returns the value of the parameter in the same format as the suggested parameters.
+func formatGridValue(distribution interface{}, value float64) string {
+	switch d := distribution.(type) {
+	case goptuna.IntUniformDistribution, goptuna.StepIntUniformDistribution:
+		return strconv.Itoa(int(value))
+	case goptuna.CategoricalDistribution:
+		return d.Choices[int(value)]
+	}
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
+
+// gridKey identifies the parameters of the grid point.
+func gridKey(params map[string]float64) string {
+	// Map keys are sorted by json.Marshal, so the key is deterministic.
+	key, _ := json.Marshal(params)
+	return string(key)
+}
+
+func saturatingMul(a, b int) int {
+	if a != 0 && b > math.MaxInt/a {
+		return math.MaxInt
+	}
+	return a * b
+}
+
+func saturatingAdd(a, b int) int {
+	if b > math.MaxInt-a {
+		return math.MaxInt
+	}
+	return a + b
+}
@@ -0,0 +1,135 @@
+/*
+Copyright 2022 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package suggestion_goptuna_v1beta1
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/c-bata/goptuna"
+	"github.com/google/go-cmp/cmp"
+
+	api_v1_beta1 "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+	"github.com/kubeflow/katib/pkg/util/v1beta1/constraint"
+)
+
+func TestGridSampler_size(t *testing.T) {
+	for name, tc := range map[string]struct {
+		searchSpace map[string]interface{}
+		conditions  map[string]*api_v1_beta1.ParameterCondition
+		wantSize    int
+		wantErr     bool
+	}{
+		"Cartesian product": {
+			searchSpace: map[string]interface{}{
+				"lr":         goptuna.DiscreteUniformDistribution{Low: 0.1, High: 0.3, Q: 0.1},
+				"batch-size": goptuna.StepIntUniformDistribution{Low: 32, High: 128, Step: 32},
+				"layers":     goptuna.IntUniformDistribution{Low: 1, High: 3},
+				"optimizer":  goptuna.CategoricalDistribution{Choices: []string{"sgd", "adam"}},
+			},
+			wantSize: 3 * 4 * 3 * 2,
+		},
+		"Inactive conditional parameters are not counted": {
+			searchSpace: map[string]interface{}{
+				"optimizer": goptuna.CategoricalDistribution{Choices: []string{"sgd", "adam", "rmsprop"}},
+				"momentum":  goptuna.CategoricalDistribution{Choices: []string{"0.5", "0.9"}},
+				"beta":      goptuna.IntUniformDistribution{Low: 1, High: 3},
+			},
+			conditions: map[string]*api_v1_beta1.ParameterCondition{
+				"momentum": {Parent: "optimizer", Values: []string{"sgd", "rmsprop"}},
+				"beta":     {Parent: "optimizer", Values: []string{"adam"}},
+			},
+			wantSize: 2 + 3 + 2,
+		},
+		"Double parameter without step": {
+			searchSpace: map[string]interface{}{
+				"lr": goptuna.UniformDistribution{Low: 0.1, High: 0.3},
+			},
+			wantErr: true,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			sampler := newGridSampler()
+			err := sampler.setSearchSpace(tc.searchSpace, tc.conditions, nil)
+			if tc.wantErr != (err != nil) {
+				t.Fatalf("Unexpected error from setSearchSpace: %v", err)
+			}
+			if err != nil {
+				return
+			}
+			if got := sampler.size(); got != tc.wantSize {
+				t.Errorf("Unexpected size of the grid, want %d, got %d", tc.wantSize, got)
+			}
+		})
+	}
+}
+
+func TestGridSampler_SampleRelative(t *testing.T) {
+	searchSpace := map[string]interface{}{
+		"lr":        goptuna.DiscreteUniformDistribution{Low: 0.1, High: 0.3, Q: 0.1},
+		"optimizer": goptuna.CategoricalDistribution{Choices: []string{"sgd", "adam"}},
+		"momentum":  goptuna.CategoricalDistribution{Choices: []string{"0.5", "0.9"}},
+	}
+	conditions := map[string]*api_v1_beta1.ParameterCondition{
+		"momentum": {Parent: "optimizer", Values: []string{"sgd"}},
+	}
+	constraints, err := constraint.CompileAll([]string{`params["lr"] < 0.25`})
+	if err != nil {
+		t.Fatalf("Failed to compile constraints: %v", err)
+	}
+	study, err := goptuna.CreateStudy(defaultStudyName, goptuna.StudyOptionLogger(nil))
+	if err != nil {
+		t.Fatalf("Failed to create study: %v", err)
+	}
+	// The grid point of the trial in the study is not suggested again.
+	id, err := study.Storage.CreateNewTrial(study.ID)
+	if err != nil {
+		t.Fatalf("Failed to create trial: %v", err)
+	}
+	for name, value := range map[string]float64{"lr": 0.1, "optimizer": 0, "momentum": 1} {
+		if err = study.Storage.SetTrialParam(id, name, value, searchSpace[name]); err != nil {
+			t.Fatalf("Failed to set trial param: %v", err)
+		}
+	}
+
+	sampler := newGridSampler()
+	if err = sampler.setSearchSpace(searchSpace, conditions, constraints); err != nil {
+		t.Fatalf("Failed to set search space: %v", err)
+	}
+	// The values of the last parameter in the sorted names are changed first.
+	wantParams := []map[string]float64{
+		{"lr": 0.1, "momentum": 0, "optimizer": 0},
+		{"lr": 0.1, "optimizer": 1},
+		{"lr": 0.2, "momentum": 0, "optimizer": 0},
+		{"lr": 0.2, "optimizer": 1},
+		{"lr": 0.2, "momentum": 1, "optimizer": 0},
+	}
+	var gotParams []map[string]float64
+	for {
+		params, err := sampler.SampleRelative(study, goptuna.FrozenTrial{ID: -1}, searchSpace)
+		if errors.Is(err, errSearchSpaceExhausted) {
+			break
+		}
+		if err != nil {
+			t.Fatalf("Unexpected error from SampleRelative: %v", err)
+		}
+		gotParams = append(gotParams, params)
+	}
+	if diff := cmp.Diff(wantParams, gotParams); len(diff) != 0 {
+		t.Errorf("Unexpected params from SampleRelative (-want,+got):\n%s", diff)
+	}
+}
@@ -0,0 +1,293 @@
+/*
+Copyright 2022 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package suggestion_goptuna_v1beta1
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+
+	"github.com/c-bata/goptuna"
+)
+
+const (
+	// objectiveValuesAttrKey is the Goptuna trial system attribute which holds
+	// JSON encoded values of all objectives for the multi-objective optimization.
+	objectiveValuesAttrKey = "katib:objective_values"
+
+	defaultNSGA2PopulationSize = 50
+	defaultNSGA2CrossoverProb  = 0.9
+)
+
+// nsga2SamplerOption is a type of the function to customize the NSGA-II sampler.
+type nsga2SamplerOption func(sampler *nsga2Sampler)
+
+// nsga2SamplerOptionSeed sets seed number.
+func nsga2SamplerOptionSeed(seed int64) nsga2SamplerOption {
+	return func(sampler *nsga2Sampler) {
+		sampler.rng = rand.New(rand.NewSource(seed))
+	}
+}
+
+// nsga2SamplerOptionPopulationSize sets the number of individuals in the population.
+func nsga2SamplerOptionPopulationSize(size int) nsga2SamplerOption {
+	return func(sampler *nsga2Sampler) {
+		sampler.populationSize = size
+	}
+}
+
+// nsga2SamplerOptionCrossoverProb sets the probability that the crossover is applied to parents.
+func nsga2SamplerOptionCrossoverProb(prob float64) nsga2SamplerOption {
+	return func(sampler *nsga2Sampler) {
+		sampler.crossoverProb = prob
+	}
+}
+
+// nsga2SamplerOptionMutationProb sets the probability that each parameter is mutated.
+func nsga2SamplerOptionMutationProb(prob float64) nsga2SamplerOption {
+	return func(sampler *nsga2Sampler) {
+		sampler.mutationProb = &prob
+	}
+}
+
+// nsga2Sampler is the relative sampler based on the NSGA-II genetic algorithm
+// for the multi-objective optimization.
+// Until the population is filled with completed trials, it doesn't return any parameters,
+// so they are sampled by the independent random sampler.
+// After that, parents are selected by the binary tournament from the elite population which is
+// ranked by the non-dominated sorting and the crowding distance. Child parameters are created by the uniform
+// crossover of the parents, and the mutated parameters are sampled by the independent random sampler.
+type nsga2Sampler struct {
+	directions     []goptuna.StudyDirection
+	rng            *rand.Rand
+	populationSize int
+	crossoverProb  float64
+	// mutationProb is equal to 1/len(searchSpace) if it is not set.
+	mutationProb *float64
+}
+
+var _ goptuna.RelativeSampler = &nsga2Sampler{}
+
+func newNSGA2Sampler(directions []goptuna.StudyDirection, opts ...nsga2SamplerOption) *nsga2Sampler {
+	sampler := &nsga2Sampler{
+		directions:     directions,
+		rng:            rand.New(rand.NewSource(0)),
+		populationSize: defaultNSGA2PopulationSize,
+		crossoverProb:  defaultNSGA2CrossoverProb,
+	}
+	for _, opt := range opts {
+		opt(sampler)
+	}
+	return sampler
+}
+
+// individual is the completed trial with values of all objectives, which are converted to minimize.
+type individual struct {
+	trial    goptuna.FrozenTrial
+	values   []float64
+	rank     int
+	crowding float64
+}
+
+// SampleRelative samples the child parameters from the elite population.
+func (s *nsga2Sampler) SampleRelative(
+	study *goptuna.Study,
+	trial goptuna.FrozenTrial,
+	searchSpace map[string]interface{},
+) (map[string]float64, error) {
+	trials, err := study.GetTrials()
+	if err != nil {
+		return nil, err
+	}
+	var individuals []*individual
+	for _, t := range trials {
+		if t.State != goptuna.TrialStateComplete {
+			continue
+		}
+		values, err := s.objectiveValues(t)
+		if err != nil {
+			return nil, err
+		}
+		individuals = append(individuals, &individual{trial: t, values: values})
+	}
+	if len(individuals) < s.populationSize {
+		return nil, nil
+	}
+	population := s.selectElite(individuals)
+
+	// Iterate over the sorted names to make sampling reproducible with the same seed.
+	names := make([]string, 0, len(searchSpace))
+	for name := range searchSpace {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	mutationProb := 1.0 / float64(len(names))
+	if s.mutationProb != nil {
+		mutationProb = *s.mutationProb
+	}
+
+	parent1 := s.tournament(population)
+	parent2 := s.tournament(population)
+	isCrossover := s.rng.Float64() < s.crossoverProb
+	params := make(map[string]float64, len(names))
+	for _, name := range names {
+		value, ok := parent1.trial.InternalParams[name]
+		if isCrossover && s.rng.Float64() < 0.5 {
+			value, ok = parent2.trial.InternalParams[name]
+		}
+		// Mutated parameters are sampled by the independent sampler.
+		if !ok || s.rng.Float64() < mutationProb {
+			continue
+		}
+		params[name] = value
+	}
+	return params, nil
+}
+
+// objectiveValues returns values of all objectives for the trial which are converted to minimize.
+func (s *nsga2Sampler) objectiveValues(trial goptuna.FrozenTrial) ([]float64, error) {
+	values := []float64{trial.Value}
+	if encoded, ok := trial.SystemAttrs[objectiveValuesAttrKey]; ok {
+		if err := json.Unmarshal([]byte(encoded), &values); err != nil {
+			return nil, err
+		}
+	}
+	if len(values) != len(s.directions) {
+		return nil, fmt.Errorf("trial %d has %d objective values, but %d objectives are defined", trial.ID, len(values), len(s.directions))
+	}
+	for i := range values {
+		if s.directions[i] == goptuna.StudyDirectionMaximize {
+			values[i] = -values[i]
+		}
+	}
+	return values, nil
+}
+
+// selectElite sorts individuals by non-dominated fronts and returns the best populationSize of them.
+// Individuals from the last front which doesn't fit entirely are selected by the crowding distance.
+func (s *nsga2Sampler) selectElite(individuals []*individual) []*individual {
+	elite := make([]*individual, 0, s.populationSize)
+	for rank, front := range nonDominatedSort(individuals) {
+		setCrowdingDistance(front)
+		for _, ind := range front {
+			ind.rank = rank
+		}
+		if len(elite)+len(front) > s.populationSize {
+			sort.SliceStable(front, func(i, j int) bool {
+				return front[i].crowding > front[j].crowding
+			})
+			front = front[:s.populationSize-len(elite)]
+		}
+		elite = append(elite, front...)
+		if len(elite) == s.populationSize {
+			break
+		}
+	}
+	return elite
+}
+
+// tournament selects the better of two random individuals by the rank and the crowding distance.
+func (s *nsga2Sampler) tournament(population []*individual) *individual {
+	a := population[s.rng.Intn(len(population))]
+	b := population[s.rng.Intn(len(population))]
+	if a.rank < b.rank || (a.rank == b.rank && a.crowding > b.crowding) {
+		return a
+	}
+	return b
+}
+
+// nonDominatedSort splits individuals into fronts. Individuals from the first front
+// are not dominated by any other individual, individuals from the second front are dominated
+// only by individuals from the first front, etc.
+func nonDominatedSort(individuals []*individual) [][]*individual {
+	dominatedBy := make([]int, len(individuals))
+	dominates := make([][]int, len(individuals))
+	for i := range individuals {
+		for j := range individuals {
+			if i != j && isDominated(individuals[j].values, individuals[i].values) {
+				dominatedBy[i]++
+				dominates[j] = append(dominates[j], i)
+			}
+		}
+	}
+
+	var fronts [][]*individual
+	var current []int
+	for i := range individuals {
+		if dominatedBy[i] == 0 {
+			current = append(current, i)
+		}
+	}
+	for len(current) != 0 {
+		front := make([]*individual, 0, len(current))
+		var next []int
+		for _, i := range current {
+			front = append(front, individuals[i])
+			for _, j := range dominates[i] {
+				dominatedBy[j]--
+				if dominatedBy[j] == 0 {
+					next = append(next, j)
+				}
+			}
+		}
+		fronts = append(fronts, front)
+		current = next
+	}
+	return fronts
+}
+
+// isDominated returns true if values b are dominated by values a for minimization.
+func isDominated(a, b []float64) bool {
+	isBetter := false
+	for i := range a {
+		if a[i] > b[i] {
+			return false
+		}
+		if a[i] < b[i] {
+			isBetter = true
+		}
+	}
+	return isBetter
+}
+
+// setCrowdingDistance sets the distance to the neighbours in the objective space for each individual of the front.
+// Boundary individuals have infinite distance, so they are always preferred.
+func setCrowdingDistance(front []*individual) {
+	for _, ind := range front {
+		ind.crowding = 0
+	}
+	if len(front) == 0 {
+		return
+	}
+	for m := range front[0].values {
+		sort.SliceStable(front, func(i, j int) bool {
+			return front[i].values[m] < front[j].values[m]
+		})
+		front[0].crowding = math.Inf(1)
+		front[len(front)-1].crowding = math.Inf(1)
+		valueRange := front[len(front)-1].values[m] - front[0].values[m]
+		if valueRange == 0 {
+			continue
+		}
+		for i := 1; i < len(front)-1; i++ {
+			front[i].crowding += (front[i+1].values[m] - front[i-1].values[m]) / valueRange
+		}
+	}
+}
@@ -0,0 +1,175 @@
+/*
+Copyright 2022 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package suggestion_goptuna_v1beta1
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/c-bata/goptuna"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func Test_nonDominatedSort(t *testing.T) {
+	for name, tc := range map[string]struct {
+		values     [][]float64
+		wantFronts [][]int
+	}{
+		"single objective": {
+			values:     [][]float64{{3}, {1}, {2}, {1}},
+			wantFronts: [][]int{{1, 3}, {2}, {0}},
+		},
+		"two objectives": {
+			values: [][]float64{
+				{1, 5},
+				{2, 2},
+				{5, 1},
+				{3, 3},
+				{4, 4},
+				{6, 6},
+			},
+			wantFronts: [][]int{{0, 1, 2}, {3}, {4}, {5}},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			individuals := make([]*individual, 0, len(tc.values))
+			indexes := make(map[*individual]int, len(tc.values))
+			for i, v := range tc.values {
+				ind := &individual{values: v}
+				indexes[ind] = i
+				individuals = append(individuals, ind)
+			}
+			var gotFronts [][]int
+			for _, front := range nonDominatedSort(individuals) {
+				var got []int
+				for _, ind := range front {
+					got = append(got, indexes[ind])
+				}
+				gotFronts = append(gotFronts, got)
+			}
+			if diff := cmp.Diff(tc.wantFronts, gotFronts); len(diff) != 0 {
+				t.Errorf("Unexpected fronts from nonDominatedSort (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_setCrowdingDistance(t *testing.T) {
+	front := []*individual{
+		{values: []float64{1, 4}},
+		{values: []float64{2, 2}},
+		{values: []float64{3, 1}},
+		{values: []float64{0, 5}},
+	}
+	setCrowdingDistance(front)
+
+	got := make(map[float64]float64, len(front))
+	for _, ind := range front {
+		got[ind.values[0]] = ind.crowding
+	}
+	want := map[float64]float64{
+		0: math.Inf(1),
+		1: 2.0/3 + 3.0/4,
+		2: 2.0/3 + 3.0/4,
+		3: math.Inf(1),
+	}
+	if diff := cmp.Diff(want, got, cmpopts.EquateApprox(0, 1e-9)); len(diff) != 0 {
+		t.Errorf("Unexpected crowding distance (-want,+got):\n%s", diff)
+	}
+}
+
+func TestNSGA2Sampler_SampleRelative(t *testing.T) {
+	searchSpace := map[string]interface{}{
+		"x": goptuna.UniformDistribution{Low: 0, High: 10},
+		"y": goptuna.IntUniformDistribution{Low: 0, High: 10},
+	}
+	directions := []goptuna.StudyDirection{goptuna.StudyDirectionMaximize, goptuna.StudyDirectionMinimize}
+
+	newStudy := func(t *testing.T, completed int) *goptuna.Study {
+		t.Helper()
+		study, err := goptuna.CreateStudy(defaultStudyName, goptuna.StudyOptionLogger(nil))
+		if err != nil {
+			t.Fatalf("Failed to create study: %v", err)
+		}
+		for i := 0; i < completed; i++ {
+			id, err := study.Storage.CreateNewTrial(study.ID)
+			if err != nil {
+				t.Fatalf("Failed to create trial: %v", err)
+			}
+			for name, distribution := range searchSpace {
+				if err = study.Storage.SetTrialParam(id, name, float64(i), distribution); err != nil {
+					t.Fatalf("Failed to set trial param: %v", err)
+				}
+			}
+			values, _ := json.Marshal([]float64{float64(i), float64(i)})
+			if err = study.Storage.SetTrialSystemAttr(id, objectiveValuesAttrKey, string(values)); err != nil {
+				t.Fatalf("Failed to set trial system attr: %v", err)
+			}
+			if err = study.Storage.SetTrialValue(id, float64(i)); err != nil {
+				t.Fatalf("Failed to set trial value: %v", err)
+			}
+			if err = study.Storage.SetTrialState(id, goptuna.TrialStateComplete); err != nil {
+				t.Fatalf("Failed to set trial state: %v", err)
+			}
+		}
+		return study
+	}
+
+	t.Run("Random sampling until population is filled", func(t *testing.T) {
+		sampler := newNSGA2Sampler(directions, nsga2SamplerOptionPopulationSize(4))
+		params, err := sampler.SampleRelative(newStudy(t, 3), goptuna.FrozenTrial{}, searchSpace)
+		if err != nil {
+			t.Fatalf("Unexpected error from SampleRelative: %v", err)
+		}
+		if len(params) != 0 {
+			t.Errorf("Parameters must be sampled randomly, but got %v", params)
+		}
+	})
+
+	t.Run("Child parameters are inherited from the population", func(t *testing.T) {
+		sampler := newNSGA2Sampler(directions,
+			nsga2SamplerOptionPopulationSize(4),
+			nsga2SamplerOptionMutationProb(0),
+			nsga2SamplerOptionSeed(1),
+		)
+		study := newStudy(t, 4)
+		for i := 0; i < 10; i++ {
+			params, err := sampler.SampleRelative(study, goptuna.FrozenTrial{}, searchSpace)
+			if err != nil {
+				t.Fatalf("Unexpected error from SampleRelative: %v", err)
+			}
+			if len(params) != len(searchSpace) {
+				t.Fatalf("All parameters must be inherited without mutation, but got %v", params)
+			}
+			for name, v := range params {
+				if v < 0 || v > 3 || v != math.Trunc(v) {
+					t.Errorf("Parameter %s=%v is not inherited from the parents", name, v)
+				}
+			}
+		}
+	})
+
+	t.Run("Trial without values of all objectives", func(t *testing.T) {
+		sampler := newNSGA2Sampler([]goptuna.StudyDirection{goptuna.StudyDirectionMaximize, goptuna.StudyDirectionMinimize, goptuna.StudyDirectionMinimize},
+			nsga2SamplerOptionPopulationSize(2))
+		if _, err := sampler.SampleRelative(newStudy(t, 2), goptuna.FrozenTrial{}, searchSpace); err == nil {
+			t.Errorf("Expected error from SampleRelative, but got nil")
+		}
+	})
+}
@@ -0,0 +1,367 @@
+/*
+Copyright 2022 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package suggestion_goptuna_v1beta1
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/c-bata/goptuna"
+)
+
+// Strategies to decide the pseudo objective values of the pending trials.
+const (
+	pendingTrialStrategyNone = "none"
+	// Constant liar strategies use the mean, best or worst value of the completed trials.
+	pendingTrialStrategyConstantLiarMean  = "constant_liar_mean"
+	pendingTrialStrategyConstantLiarBest  = "constant_liar_best"
+	pendingTrialStrategyConstantLiarWorst = "constant_liar_worst"
+	// Kriging believer uses the value predicted by the Gaussian process fitted to the completed trials.
+	pendingTrialStrategyKrigingBeliever = "kriging_believer"
+)
+
+const (
+	// krigingLengthScale is the length scale of the RBF kernel in the normalized search space,
+	// i.e. each parameter is in [0, 1].
+	krigingLengthScale = 0.25
+	// krigingNoise is the variance of the observation noise of the standardized objective values.
+	krigingNoise = 1e-3
+	// krigingMaxTrials is the maximum number of the latest completed trials to fit the Gaussian process.
+	krigingMaxTrials = 200
+)
+
+// validatePendingTrialStrategy returns an error if the strategy is not supported.
+func validatePendingTrialStrategy(strategy string) error {
+	switch strategy {
+	case pendingTrialStrategyNone, pendingTrialStrategyConstantLiarMean, pendingTrialStrategyConstantLiarBest,
+		pendingTrialStrategyConstantLiarWorst, pendingTrialStrategyKrigingBeliever:
+		return nil
+	}
+	return fmt.Errorf("invalid pending_trial_strategy: '%s'", strategy)
+}
+
+// pendingTrialView is the view of the study where the pending trials, i.e. running trials which have parameters,
+// are completed with the pseudo objective values. Samplers which sample parameters with the view treat
+// the pending trials as observations, so the parameters of the parallel trials are not sampled close together.
+// The trial which is being sampled is not a pending trial.
+type pendingTrialView struct {
+	goptuna.Storage
+
+	strategy string
+	// minCompletedTrials is the number of completed trials required to set the pseudo values,
+	// e.g. TPE samples randomly until the startup trials are completed.
+	minCompletedTrials int
+
+	mu             sync.Mutex
+	study          *goptuna.Study // Study with the view as the storage
+	currentTrialID int
+	cacheKey       string
+	pseudoValues   map[int]float64 // Trial id -> pseudo objective value
+}
+
+func newPendingTrialView(strategy string, minCompletedTrials int) *pendingTrialView {
+	return &pendingTrialView{
+		strategy:           strategy,
+		minCompletedTrials: minCompletedTrials,
+		currentTrialID:     -1,
+	}
+}
+
+// viewStudy returns the study with the view of the storage of the study.
+func (v *pendingTrialView) viewStudy(study *goptuna.Study, currentTrialID int) (*goptuna.Study, error) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.currentTrialID = currentTrialID
+	if v.study != nil && v.Storage == study.Storage && v.study.ID == study.ID {
+		return v.study, nil
+	}
+	name, err := study.Storage.GetStudyNameFromID(study.ID)
+	if err != nil {
+		return nil, err
+	}
+	v.Storage = study.Storage
+	viewStudy, err := goptuna.LoadStudy(name, goptuna.StudyOptionStorage(v), goptuna.StudyOptionLogger(nil))
+	if err != nil {
+		return nil, err
+	}
+	v.study = viewStudy
+	v.cacheKey = ""
+	return viewStudy, nil
+}
+
+// GetAllTrials returns the trials where the pending trials are completed with the pseudo objective values.
+func (v *pendingTrialView) GetAllTrials(studyID int) ([]goptuna.FrozenTrial, error) {
+	trials, err := v.Storage.GetAllTrials(studyID)
+	if err != nil {
+		return nil, err
+	}
+	direction, err := v.GetStudyDirection(studyID)
+	if err != nil {
+		return nil, err
+	}
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	var completed []goptuna.FrozenTrial
+	var pendingIDs []string
+	for _, t := range trials {
+		if t.State == goptuna.TrialStateComplete {
+			completed = append(completed, t)
+		} else if v.isPending(t) {
+			pendingIDs = append(pendingIDs, fmt.Sprint(t.ID))
+		}
+	}
+	if len(completed) < v.minCompletedTrials || len(completed) == 0 || len(pendingIDs) == 0 {
+		return trials, nil
+	}
+	// Trials are never back to running, so the pseudo values are same until the pending or completed trials are changed.
+	key := fmt.Sprintf("%d:%s", len(completed), strings.Join(pendingIDs, ","))
+	if key != v.cacheKey {
+		v.pseudoValues, err = pendingTrialValues(v.strategy, direction, completed, trials, v.isPending)
+		if err != nil {
+			return nil, err
+		}
+		v.cacheKey = key
+	}
+	for i := range trials {
+		if value, ok := v.pseudoValues[trials[i].ID]; ok && v.isPending(trials[i]) {
+			trials[i].State = goptuna.TrialStateComplete
+			trials[i].Value = value
+		}
+	}
+	return trials, nil
+}
+
+func (v *pendingTrialView) isPending(trial goptuna.FrozenTrial) bool {
+	return trial.State == goptuna.TrialStateRunning && len(trial.InternalParams) != 0 && trial.ID != v.currentTrialID
+}
+
+// pendingTrialValues returns the pseudo objective values of the pending trials by the trial id.
+func pendingTrialValues(
+	strategy string,
+	direction goptuna.StudyDirection,
+	completed []goptuna.FrozenTrial,
+	trials []goptuna.FrozenTrial,
+	isPending func(goptuna.FrozenTrial) bool,
+) (map[int]float64, error) {
+	values := make(map[int]float64)
+	if strategy == pendingTrialStrategyKrigingBeliever {
+		gp, err := newKrigingModel(completed)
+		if err != nil {
+			return nil, err
+		}
+		for _, t := range trials {
+			if isPending(t) {
+				values[t.ID] = gp.predict(t)
+			}
+		}
+		return values, nil
+	}
+
+	var value float64
+	switch strategy {
+	case pendingTrialStrategyConstantLiarMean:
+		for _, t := range completed {
+			value += t.Value
+		}
+		value /= float64(len(completed))
+	case pendingTrialStrategyConstantLiarBest, pendingTrialStrategyConstantLiarWorst:
+		minValue, maxValue := math.Inf(1), math.Inf(-1)
+		for _, t := range completed {
+			minValue = math.Min(minValue, t.Value)
+			maxValue = math.Max(maxValue, t.Value)
+		}
+		isBest := strategy == pendingTrialStrategyConstantLiarBest
+		if isBest == (direction == goptuna.StudyDirectionMinimize) {
+			value = minValue
+		} else {
+			value = maxValue
+		}
+	default:
+		return nil, fmt.Errorf("invalid pending_trial_strategy: '%s'", strategy)
+	}
+	for _, t := range trials {
+		if isPending(t) {
+			values[t.ID] = value
+		}
+	}
+	return values, nil
+}
+
+// krigingModel is the Gaussian process with the RBF kernel. Objective values are standardized and
+// the prior mean is the mean of the objective values.
+type krigingModel struct {
+	trials []goptuna.FrozenTrial
+	alpha  []float64 // (K + noise * I)^-1 (y - mean)
+	mean   float64
+	std    float64
+}
+
+func newKrigingModel(completed []goptuna.FrozenTrial) (*krigingModel, error) {
+	trials := completed
+	if len(trials) > krigingMaxTrials {
+		trials = append([]goptuna.FrozenTrial(nil), completed...)
+		sort.Slice(trials, func(i, j int) bool { return trials[i].ID < trials[j].ID })
+		trials = trials[len(trials)-krigingMaxTrials:]
+	}
+	n := len(trials)
+	m := &krigingModel{trials: trials}
+	for _, t := range trials {
+		m.mean += t.Value
+	}
+	m.mean /= float64(n)
+	for _, t := range trials {
+		m.std += (t.Value - m.mean) * (t.Value - m.mean)
+	}
+	m.std = math.Sqrt(m.std / float64(n))
+	if m.std == 0 {
+		return m, nil
+	}
+
+	// Lower triangular matrix of the Cholesky decomposition of K + noise * I.
+	l := make([][]float64, n)
+	for i := range l {
+		l[i] = make([]float64, i+1)
+		for j := 0; j <= i; j++ {
+			sum := krigingKernel(trials[i], trials[j])
+			if i == j {
+				sum += krigingNoise
+			}
+			for k := 0; k < j; k++ {
+				sum -= l[i][k] * l[j][k]
+			}
+			if i == j {
+				if sum <= 0 {
+					return nil, errors.New("kernel matrix of kriging is not positive definite")
+				}
+				l[i][i] = math.Sqrt(sum)
+			} else {
+				l[i][j] = sum / l[j][j]
+			}
+		}
+	}
+	// Solve L L^T alpha = y by the forward and backward substitution.
+	m.alpha = make([]float64, n)
+	for i := 0; i < n; i++ {
+		sum := (trials[i].Value - m.mean) / m.std
+		for k := 0; k < i; k++ {
+			sum -= l[i][k] * m.alpha[k]
+		}
+		m.alpha[i] = sum / l[i][i]
+	}
+	for i := n - 1; i >= 0; i-- {
+		sum := m.alpha[i]
+		for k := i + 1; k < n; k++ {
+			sum -= l[k][i] * m.alpha[k]
+		}
+		m.alpha[i] = sum / l[i][i]
+	}
+	return m, nil
+}
+
+// predict returns the posterior mean of the objective value of the trial.
+func (m *krigingModel) predict(trial goptuna.FrozenTrial) float64 {
+	if m.alpha == nil {
+		return m.mean
+	}
+	var value float64
+	for i, t := range m.trials {
+		value += krigingKernel(t, trial) * m.alpha[i]
+	}
+	return m.mean + m.std*value
+}
+
+// krigingKernel is the RBF kernel of the parameters normalized to [0, 1]. Categorical parameters and
+// inactive parameters contribute to the squared distance by 1 if the values are different.
+func krigingKernel(a, b goptuna.FrozenTrial) float64 {
+	var d2 float64
+	for name, distribution := range a.Distributions {
+		va, okA := a.InternalParams[name]
+		vb, okB := b.InternalParams[name]
+		if !okA || !okB {
+			if okA != okB {
+				d2++
+			}
+			continue
+		}
+		if _, ok := distribution.(goptuna.CategoricalDistribution); ok {
+			if va != vb {
+				d2++
+			}
+			continue
+		}
+		d := normalizeParam(distribution, va) - normalizeParam(distribution, vb)
+		d2 += d * d
+	}
+	// Parameters which only the other trial has.
+	for name := range b.Distributions {
+		if _, ok := a.Distributions[name]; !ok {
+			if _, ok := b.InternalParams[name]; ok {
+				d2++
+			}
+		}
+	}
+	return math.Exp(-d2 / (2 * krigingLengthScale * krigingLengthScale))
+}
+
+// normalizeParam returns the internal value of the parameter scaled to [0, 1].
+func normalizeParam(distribution interface{}, value float64) float64 {
+	var low, high float64
+	switch d := distribution.(type) {
+	case goptuna.UniformDistribution:
+		low, high = d.Low, d.High
+	case goptuna.LogUniformDistribution:
+		low, high, value = math.Log(d.Low), math.Log(d.High), math.Log(value)
+	case goptuna.DiscreteUniformDistribution:
+		low, high = d.Low, d.High
+	case goptuna.IntUniformDistribution:
+		low, high = float64(d.Low), float64(d.High)
+	case goptuna.StepIntUniformDistribution:
+		low, high = float64(d.Low), float64(d.High)
+	default:
+		return value
+	}
+	if high == low {
+		return 0
+	}
+	return (value - low) / (high - low)
+}
+
+// pendingTrialSampler is the independent sampler which samples parameters with the pendingTrialView.
+type pendingTrialSampler struct {
+	sampler goptuna.Sampler
+	view    *pendingTrialView
+}
+
+var _ goptuna.Sampler = &pendingTrialSampler{}
+
+func (s *pendingTrialSampler) Sample(
+	study *goptuna.Study,
+	trial goptuna.FrozenTrial,
+	paramName string,
+	paramDistribution interface{},
+) (float64, error) {
+	viewStudy, err := s.view.viewStudy(study, trial.ID)
+	if err != nil {
+		return 0, err
+	}
+	return s.sampler.Sample(viewStudy, trial, paramName, paramDistribution)
+}
@@ -0,0 +1,176 @@
+/*
+Copyright 2022 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package suggestion_goptuna_v1beta1
+
+import (
+	"math"
+	"testing"
+
+	"github.com/c-bata/goptuna"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestPendingTrialView_GetAllTrials(t *testing.T) {
+	distribution := goptuna.UniformDistribution{Low: 0, High: 1}
+	type trial struct {
+		x     float64
+		state goptuna.TrialState
+		value float64
+	}
+	trials := []trial{
+		{x: 0.1, state: goptuna.TrialStateComplete, value: 1},
+		{x: 0.5, state: goptuna.TrialStateComplete, value: 2},
+		{x: 0.9, state: goptuna.TrialStateComplete, value: 6},
+		{x: 0.1, state: goptuna.TrialStateRunning},
+		{x: 0.3, state: goptuna.TrialStateFail},
+		{x: 0.7, state: goptuna.TrialStateRunning},
+	}
+
+	for name, tc := range map[string]struct {
+		strategy           string
+		direction          goptuna.StudyDirection
+		minCompletedTrials int
+		wantValues         map[int]float64 // Trial index -> pseudo value of the pending trial
+	}{
+		"Constant liar with mean": {
+			strategy:   pendingTrialStrategyConstantLiarMean,
+			direction:  goptuna.StudyDirectionMinimize,
+			wantValues: map[int]float64{3: 3, 5: 3},
+		},
+		"Constant liar with best to minimize": {
+			strategy:   pendingTrialStrategyConstantLiarBest,
+			direction:  goptuna.StudyDirectionMinimize,
+			wantValues: map[int]float64{3: 1, 5: 1},
+		},
+		"Constant liar with best to maximize": {
+			strategy:   pendingTrialStrategyConstantLiarBest,
+			direction:  goptuna.StudyDirectionMaximize,
+			wantValues: map[int]float64{3: 6, 5: 6},
+		},
+		"Constant liar with worst to minimize": {
+			strategy:   pendingTrialStrategyConstantLiarWorst,
+			direction:  goptuna.StudyDirectionMinimize,
+			wantValues: map[int]float64{3: 6, 5: 6},
+		},
+		"Kriging believer": {
+			strategy:  pendingTrialStrategyKrigingBeliever,
+			direction: goptuna.StudyDirectionMinimize,
+			// The value at the completed point is almost same as the observation, the other is between the neighbors.
+			wantValues: map[int]float64{3: 1, 5: 4.30},
+		},
+		"Pending trials are not completed until min completed trials": {
+			strategy:           pendingTrialStrategyConstantLiarMean,
+			direction:          goptuna.StudyDirectionMinimize,
+			minCompletedTrials: 4,
+			wantValues:         map[int]float64{},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			study, err := goptuna.CreateStudy(defaultStudyName, goptuna.StudyOptionDirection(tc.direction), goptuna.StudyOptionLogger(nil))
+			if err != nil {
+				t.Fatalf("Failed to create study: %v", err)
+			}
+			ids := make([]int, 0, len(trials)+1)
+			for _, tr := range trials {
+				id, err := study.Storage.CreateNewTrial(study.ID)
+				if err != nil {
+					t.Fatalf("Failed to create trial: %v", err)
+				}
+				if err = study.Storage.SetTrialParam(id, "x", tr.x, distribution); err != nil {
+					t.Fatalf("Failed to set trial param: %v", err)
+				}
+				if tr.state == goptuna.TrialStateComplete {
+					if err = study.Storage.SetTrialValue(id, tr.value); err != nil {
+						t.Fatalf("Failed to set trial value: %v", err)
+					}
+				}
+				if err = study.Storage.SetTrialState(id, tr.state); err != nil {
+					t.Fatalf("Failed to set trial state: %v", err)
+				}
+				ids = append(ids, id)
+			}
+			// The trial which is being sampled is not pending.
+			currentID, err := study.Storage.CreateNewTrial(study.ID)
+			if err != nil {
+				t.Fatalf("Failed to create trial: %v", err)
+			}
+			if err = study.Storage.SetTrialParam(currentID, "x", 0.2, distribution); err != nil {
+				t.Fatalf("Failed to set trial param: %v", err)
+			}
+
+			view := newPendingTrialView(tc.strategy, tc.minCompletedTrials)
+			viewStudy, err := view.viewStudy(study, currentID)
+			if err != nil {
+				t.Fatalf("Failed to create view: %v", err)
+			}
+			gotTrials, err := viewStudy.GetTrials()
+			if err != nil {
+				t.Fatalf("Failed to get trials: %v", err)
+			}
+			gotValues := make(map[int]float64)
+			for i, id := range ids {
+				if trials[i].state == goptuna.TrialStateRunning && gotTrials[id].State == goptuna.TrialStateComplete {
+					gotValues[i] = gotTrials[id].Value
+				}
+			}
+			if diff := cmp.Diff(tc.wantValues, gotValues, cmpopts.EquateApprox(0, 0.01)); len(diff) != 0 {
+				t.Errorf("Unexpected pseudo values of pending trials (-want,+got):\n%s", diff)
+			}
+			if gotTrials[currentID].State != goptuna.TrialStateRunning {
+				t.Errorf("Trial which is being sampled must be running: %v", gotTrials[currentID].State)
+			}
+
+			// Trials in the storage are not changed.
+			storedTrials, err := study.GetTrials()
+			if err != nil {
+				t.Fatalf("Failed to get trials: %v", err)
+			}
+			for i, id := range ids {
+				if storedTrials[id].State != trials[i].state {
+					t.Errorf("State of trial %d is changed to %v in the storage", i, storedTrials[id].State)
+				}
+			}
+		})
+	}
+}
+
+func TestKrigingModel_predict(t *testing.T) {
+	distribution := goptuna.UniformDistribution{Low: -1, High: 1}
+	newTrial := func(x, value float64) goptuna.FrozenTrial {
+		return goptuna.FrozenTrial{
+			State:          goptuna.TrialStateComplete,
+			Value:          value,
+			InternalParams: map[string]float64{"x": x},
+			Distributions:  map[string]interface{}{"x": distribution},
+		}
+	}
+	var completed []goptuna.FrozenTrial
+	for x := -1.0; x <= 1; x += 0.25 {
+		completed = append(completed, newTrial(x, x*x))
+	}
+	model, err := newKrigingModel(completed)
+	if err != nil {
+		t.Fatalf("Failed to fit kriging model: %v", err)
+	}
+	// Values between the completed points are interpolated.
+	for _, x := range []float64{-0.6, 0.1, 0.4} {
+		if got := model.predict(newTrial(x, 0)); math.Abs(got-x*x) > 0.05 {
+			t.Errorf("Unexpected prediction at %v: want %v, got %v", x, x*x, got)
+		}
+	}
+}
@@ -17,15 +17,24 @@ limitations under the License.
 package suggestion_goptuna_v1beta1
 
 import (
+	"errors"
 	"fmt"
 	"reflect"
+	"slices"
 	"strconv"
 
 	"github.com/c-bata/goptuna"
 	api_v1_beta1 "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+	"github.com/kubeflow/katib/pkg/util/v1beta1/constraint"
+	"k8s.io/klog/v2"
 )
 
-func sampleNextParam(study *goptuna.Study, searchSpace map[string]interface{}) (int, []*api_v1_beta1.ParameterAssignment, error) {
+func sampleNextParam(
+	study *goptuna.Study,
+	searchSpace map[string]interface{},
+	conditions map[string]*api_v1_beta1.ParameterCondition,
+	transforms map[string]*paramTransform,
+) (int, []*api_v1_beta1.ParameterAssignment, error) {
 	nextTrialID, err := study.Storage.CreateNewTrial(study.ID)
 	if err != nil {
 		return -1, nil, err
@@ -42,58 +51,156 @@ func sampleNextParam(study *goptuna.Study, searchSpace map[string]interface{}) (
 	}
 
 	assignments := make([]*api_v1_beta1.ParameterAssignment, 0, len(searchSpace))
-	for name := range searchSpace {
-		switch distribution := searchSpace[name].(type) {
-		case goptuna.UniformDistribution:
-			p, err := trial.SuggestFloat(name, distribution.Low, distribution.High)
-			if err != nil {
-				return nextTrialID, nil, err
-			}
-			assignments = append(assignments, &api_v1_beta1.ParameterAssignment{
-				Name:  name,
-				Value: strconv.FormatFloat(p, 'f', -1, 64),
-			})
-		case goptuna.DiscreteUniformDistribution:
-			p, err := trial.SuggestDiscreteFloat(name, distribution.Low, distribution.High, distribution.Q)
-			if err != nil {
-				return nextTrialID, nil, err
-			}
-			assignments = append(assignments, &api_v1_beta1.ParameterAssignment{
-				Name:  name,
-				Value: strconv.FormatFloat(p, 'f', -1, 64),
-			})
-		case goptuna.IntUniformDistribution:
-			p, err := trial.SuggestInt(name, distribution.Low, distribution.High)
-			if err != nil {
-				return nextTrialID, nil, err
-			}
-			assignments = append(assignments, &api_v1_beta1.ParameterAssignment{
-				Name:  name,
-				Value: strconv.Itoa(p),
-			})
-		case goptuna.StepIntUniformDistribution:
-			p, err := trial.SuggestStepInt(name, distribution.Low, distribution.High, distribution.Step)
-			if err != nil {
-				return nextTrialID, nil, err
+	// Values of the sampled parameters. Inactive parameters don't have values.
+	values := make(map[string]string, len(searchSpace))
+	visited := make(map[string]bool, len(searchSpace))
+	var sample func(name string) error
+	sample = func(name string) error {
+		if visited[name] {
+			return nil
+		}
+		visited[name] = true
+
+		// Parent must be sampled before the conditional parameter to check whether it is active.
+		// Inactive parameters are neither sampled nor stored in Goptuna storage.
+		if condition, ok := conditions[name]; ok {
+			if err := sample(condition.GetParent()); err != nil {
+				return err
 			}
-			assignments = append(assignments, &api_v1_beta1.ParameterAssignment{
-				Name:  name,
-				Value: strconv.Itoa(p),
-			})
-		case goptuna.CategoricalDistribution:
-			p, err := trial.SuggestCategorical(name, distribution.Choices)
-			if err != nil {
-				return nextTrialID, nil, err
+			parentValue, ok := values[condition.GetParent()]
+			if !ok || !slices.Contains(condition.GetValues(), parentValue) {
+				return nil
 			}
-			assignments = append(assignments, &api_v1_beta1.ParameterAssignment{
-				Name:  name,
-				Value: p,
-			})
+		}
+
+		value, err := suggestParam(&trial, name, searchSpace[name], transforms[name])
+		if err != nil {
+			return err
+		}
+		values[name] = value
+		assignments = append(assignments, &api_v1_beta1.ParameterAssignment{
+			Name:  name,
+			Value: value,
+		})
+		return nil
+	}
+	for name := range searchSpace {
+		if err = sample(name); err != nil {
+			return nextTrialID, nil, err
 		}
 	}
 	return nextTrialID, assignments, nil
 }
 
+// sampleNextParamWithConstraints samples parameters until they satisfy all constraints.
+// Goptuna trials with parameters which violate constraints are marked as failed, so samplers don't use them.
+func sampleNextParamWithConstraints(
+	study *goptuna.Study,
+	searchSpace map[string]interface{},
+	conditions map[string]*api_v1_beta1.ParameterCondition,
+	transforms map[string]*paramTransform,
+	constraints []*constraint.Constraint,
+) (int, []*api_v1_beta1.ParameterAssignment, error) {
+	for attempt := 0; attempt < maxConstraintResampleAttempts; attempt++ {
+		trialID, assignments, err := sampleNextParam(study, searchSpace, conditions, transforms)
+		if err != nil || len(constraints) == 0 {
+			return trialID, assignments, err
+		}
+
+		values := make(map[string]string, len(assignments))
+		for _, a := range assignments {
+			values[a.GetName()] = a.GetValue()
+		}
+		ok, violated, err := constraint.IsSatisfiedAll(constraints, values)
+		if err != nil {
+			return trialID, nil, err
+		}
+		if ok {
+			return trialID, assignments, nil
+		}
+		klog.Infof("Sampled parameters violate constraint %q: trialID=%d, assignments=%v", violated, trialID, assignments)
+		if err = study.Storage.SetTrialState(trialID, goptuna.TrialStateFail); err != nil {
+			return trialID, nil, err
+		}
+	}
+	return -1, nil, fmt.Errorf("Failed to sample parameters which satisfy constraints in %d attempts", maxConstraintResampleAttempts)
+}
+
+func suggestParam(trial *goptuna.Trial, name string, distribution interface{}, transform *paramTransform) (string, error) {
+	if transform != nil {
+		return suggestTransformedParam(trial, name, distribution, transform)
+	}
+	switch distribution := distribution.(type) {
+	case goptuna.UniformDistribution:
+		p, err := trial.SuggestFloat(name, distribution.Low, distribution.High)
+		if err != nil {
+			return "", err
+		}
+		return strconv.FormatFloat(p, 'f', -1, 64), nil
+	case goptuna.LogUniformDistribution:
+		p, err := trial.SuggestLogFloat(name, distribution.Low, distribution.High)
+		if err != nil {
+			return "", err
+		}
+		return strconv.FormatFloat(p, 'f', -1, 64), nil
+	case goptuna.DiscreteUniformDistribution:
+		p, err := trial.SuggestDiscreteFloat(name, distribution.Low, distribution.High, distribution.Q)
+		if err != nil {
+			return "", err
+		}
+		return strconv.FormatFloat(p, 'f', -1, 64), nil
+	case goptuna.IntUniformDistribution:
+		p, err := trial.SuggestInt(name, distribution.Low, distribution.High)
+		if err != nil {
+			return "", err
+		}
+		return strconv.Itoa(p), nil
+	case goptuna.StepIntUniformDistribution:
+		p, err := trial.SuggestStepInt(name, distribution.Low, distribution.High, distribution.Step)
+		if err != nil {
+			return "", err
+		}
+		return strconv.Itoa(p), nil
+	case goptuna.CategoricalDistribution:
+		return trial.SuggestCategorical(name, distribution.Choices)
+	}
+	return "", fmt.Errorf("Unsupported distribution of parameter %s: %v", name, distribution)
+}
+
+// suggestTransformedParam samples the internal value of the parameter and returns the transformed value.
+func suggestTransformedParam(trial *goptuna.Trial, name string, distribution interface{}, transform *paramTransform) (string, error) {
+	var internal float64
+	var err error
+	switch distribution := distribution.(type) {
+	case goptuna.UniformDistribution:
+		internal, err = trial.SuggestFloat(name, distribution.Low, distribution.High)
+	case goptuna.LogUniformDistribution:
+		internal, err = trial.SuggestLogFloat(name, distribution.Low, distribution.High)
+	default:
+		return "", fmt.Errorf("Unsupported distribution of parameter %s: %v", name, distribution)
+	}
+	if err != nil {
+		return "", err
+	}
+	value := transform.toValue(internal)
+	// The transformed value is rounded, e.g. to the integer, so the internal value is updated with the value.
+	// Otherwise, the Katib trial with the value is not matched with the Goptuna trial.
+	internal, err = transform.toInternal(value)
+	if err != nil {
+		return "", err
+	}
+	if err = trial.Study.Storage.SetTrialParam(trial.ID, name, internal, distribution); err != nil {
+		return "", err
+	}
+	return value, nil
+}
+
+// errTrialNotFound is returned if Goptuna trial with the same parameters is not found.
+var errTrialNotFound = errors.New("Same parameter is not found")
+
+// importedTrialSystemAttrKey is the system attribute of the trials which are imported without sampling.
+const importedTrialSystemAttrKey = "katib:imported"
+
 func findGoptunaTrialIDByParam(study *goptuna.Study, trialMapping map[string]int, ktrial goptuna.FrozenTrial) (int, error) {
 	trials, err := study.GetTrials()
 	if err != nil {
@@ -123,5 +230,26 @@ func findGoptunaTrialIDByParam(study *goptuna.Study, trialMapping map[string]int
 			return trials[i].ID, nil
 		}
 	}
-	return -1, fmt.Errorf("Same parameter is not found for Trial: %v", ktrial)
+	return -1, fmt.Errorf("%w for Trial: %v", errTrialNotFound, ktrial)
+}
+
+// importGoptunaTrial creates Goptuna trial with parameters of the Katib trial which is not sampled by Goptuna.
+// The trial is created as running, its state and value are synced as for the sampled trials.
+func importGoptunaTrial(study *goptuna.Study, ktrial goptuna.FrozenTrial) (int, error) {
+	trialID, err := study.Storage.CreateNewTrial(study.ID)
+	if err != nil {
+		return -1, err
+	}
+	// Imported trials are marked not to be counted as the trials drawn by the sampler.
+	err = study.Storage.SetTrialSystemAttr(trialID, importedTrialSystemAttrKey, "true")
+	if err != nil {
+		return trialID, err
+	}
+	for name, value := range ktrial.InternalParams {
+		err = study.Storage.SetTrialParam(trialID, name, value, ktrial.Distributions[name])
+		if err != nil {
+			return trialID, err
+		}
+	}
+	return trialID, nil
 }
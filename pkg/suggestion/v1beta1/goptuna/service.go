@@ -18,10 +18,13 @@ package suggestion_goptuna_v1beta1
 
 import (
 	"context"
+	"errors"
+	"slices"
 	"sync"
 
 	"github.com/c-bata/goptuna"
 	api_v1_beta1 "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+	"github.com/kubeflow/katib/pkg/util/v1beta1/constraint"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"k8s.io/klog/v2"
@@ -32,8 +35,18 @@ const (
 	AlgorithmTPE    = "tpe"
 	AlgorithmRandom = "random"
 	AlgorithmSobol  = "sobol"
+	AlgorithmNSGA2  = "nsga2"
+	AlgorithmASHA   = "asha"
+	AlgorithmGrid   = "grid"
 
 	defaultStudyName = "Katib"
+
+	// defaultTPENumberOfStartupTrials is the default number of the trials which TPE samples randomly.
+	defaultTPENumberOfStartupTrials = 10
+
+	// maxConstraintResampleAttempts is the maximum number of samples for one suggestion
+	// to find parameters which satisfy the parameter constraints.
+	maxConstraintResampleAttempts = 100
 )
 
 func NewSuggestionService() *SuggestionService {
@@ -44,24 +57,45 @@ func NewSuggestionService() *SuggestionService {
 	}
 }
 
+// NewSuggestionServiceWithStudyDir creates the suggestion service which persists the study to the directory.
+// The study persisted by the previous service, e.g. before the restart of the suggestion pod,
+// is restored when the study is created at the first GetSuggestions call.
+func NewSuggestionServiceWithStudyDir(studyDir string) (*SuggestionService, error) {
+	snapshot, err := loadStudySnapshot(studyDir)
+	if err != nil {
+		return nil, err
+	}
+	s := NewSuggestionService()
+	s.studyDir = studyDir
+	s.snapshot = snapshot
+	return s, nil
+}
+
 type SuggestionService struct {
 	mu           sync.RWMutex
 	searchSpace  map[string]interface{}
+	conditions   map[string]*api_v1_beta1.ParameterCondition // Parameter name -> condition of the conditional parameter
+	transforms   map[string]*paramTransform                  // Parameter name -> transform of the parameter which goptuna can't sample as is
+	constraints  []*constraint.Constraint
 	study        *goptuna.Study
 	trialMapping map[string]int // Katib trial name -> Goptuna trial id
+
+	studyDir          string         // Directory to persist the study, the study is not persisted if it is empty
+	snapshot          *studySnapshot // Persisted study which is restored at the first run
+	initialTrialNames []string       // Katib trials at the study creation, they are persisted with the study
 }
 
 func (s *SuggestionService) GetSuggestions(
 	ctx context.Context,
 	req *api_v1_beta1.GetSuggestionsRequest,
 ) (*api_v1_beta1.GetSuggestionsReply, error) {
-	err := s.initStudyAndSearchSpaceAtFirstRun(req.GetExperiment())
+	err := s.initStudyAndSearchSpaceAtFirstRun(req.GetExperiment(), req.GetTrials())
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Failed to create goptuna study and search space: %s", err.Error())
 	}
 
-	objectMetricName := req.GetExperiment().GetSpec().GetObjective().GetObjectiveMetricName()
-	trials, err := toGoptunaTrials(req.GetTrials(), objectMetricName, s.study, s.searchSpace)
+	objective := req.GetExperiment().GetSpec().GetObjective()
+	trials, err := toGoptunaTrials(req.GetTrials(), objective, s.study, s.searchSpace, s.transforms)
 	if err != nil {
 		klog.Errorf("Failed to convert to Goptuna trials: %s", err)
 		return nil, status.Error(codes.Internal, err.Error())
@@ -73,22 +107,53 @@ func (s *SuggestionService) GetSuggestions(
 	}
 
 	currentRequestNumber := int(req.GetCurrentRequestNumber())
-	parameterAssignments := make([]*api_v1_beta1.GetSuggestionsReply_ParameterAssignments, currentRequestNumber)
+	parameterAssignments := make([]*api_v1_beta1.GetSuggestionsReply_ParameterAssignments, 0, currentRequestNumber)
+	exhausted := false
 	for i := 0; i < currentRequestNumber; i++ {
-		trialID, assignments, err := sampleNextParam(s.study, s.searchSpace)
-		if err != nil {
+		trialID, assignments, err := sampleNextParamWithConstraints(s.study, s.searchSpace, s.conditions, s.transforms, s.constraints)
+		if errors.Is(err, errSearchSpaceExhausted) {
+			// The trial which is created to sample the parameters is not suggested.
+			if err = s.study.Storage.SetTrialState(trialID, goptuna.TrialStateFail); err != nil {
+				klog.Errorf("Failed to update state: %s", err)
+				return nil, status.Error(codes.Internal, err.Error())
+			}
+			klog.Infof("Search space is exhausted: %d of %d trials are suggested", i, currentRequestNumber)
+			exhausted = true
+			break
+		} else if err != nil {
 			klog.Errorf("Failed to sample next param: trialID=%d, err=%s", trialID, err)
 			return nil, status.Error(codes.Internal, err.Error())
 		}
 
 		klog.Infof("Success to sample new trial: trialID=%d, assignments=%v", trialID, assignments)
-		parameterAssignments[i] = &api_v1_beta1.GetSuggestionsReply_ParameterAssignments{
+		budget := ""
+		if sampler, ok := s.study.RelativeSampler.(*ashaSampler); ok {
+			// The resource of the rung is the budget of the Trial.
+			for _, a := range assignments {
+				if a.Name == sampler.resourceName {
+					budget = a.Value
+				}
+			}
+		}
+		parameterAssignments = append(parameterAssignments, &api_v1_beta1.GetSuggestionsReply_ParameterAssignments{
 			Assignments: assignments,
+			Budget:      budget,
+		})
+	}
+
+	if s.studyDir != "" {
+		s.mu.Lock()
+		err = saveStudySnapshot(s.studyDir, req.GetExperiment().GetName(), s.study, s.trialMapping, s.initialTrialNames)
+		s.mu.Unlock()
+		if err != nil {
+			klog.Errorf("Failed to persist Goptuna study: %s", err)
+			return nil, status.Error(codes.Internal, err.Error())
 		}
 	}
 
 	return &api_v1_beta1.GetSuggestionsReply{
 		ParameterAssignments: parameterAssignments,
+		SearchSpaceExhausted: exhausted,
 	}, nil
 }
 
@@ -109,6 +174,12 @@ func (s *SuggestionService) syncTrials(ktrials map[string]goptuna.FrozenTrial) (
 			// Because Katib's trial name is determined by Katib controller after finished this gRPC call.
 			// So `findGoptunaTrialIDByParam()` returns the goptuna trial ID from the parameter values.
 			gtrialID, err = findGoptunaTrialIDByParam(s.study, s.trialMapping, ktrial)
+			if errors.Is(err, errTrialNotFound) && ktrial.State.IsFinished() {
+				// Finished trials which are not sampled by Goptuna, e.g. prior trials to warm-start
+				// the Experiment, are imported to the study as the history.
+				gtrialID, err = importGoptunaTrial(s.study, ktrial)
+				klog.Infof("Import finished trial : trialName=%s", katibTrialName)
+			}
 			if err != nil {
 				klog.Errorf("Failed to find Goptuna Trial ID: trialName=%s, err=%s", katibTrialName, err)
 				return err
@@ -136,6 +207,12 @@ func (s *SuggestionService) syncTrials(ktrials map[string]goptuna.FrozenTrial) (
 			if err != nil {
 				return err
 			}
+			if values, ok := ktrial.SystemAttrs[objectiveValuesAttrKey]; ok {
+				err = s.study.Storage.SetTrialSystemAttr(gtrialID, objectiveValuesAttrKey, values)
+				if err != nil {
+					return err
+				}
+			}
 		}
 
 		err = s.study.Storage.SetTrialState(gtrialID, ktrial.State)
@@ -152,6 +229,7 @@ func (s *SuggestionService) syncTrials(ktrials map[string]goptuna.FrozenTrial) (
 
 func (s *SuggestionService) initStudyAndSearchSpaceAtFirstRun(
 	experiment *api_v1_beta1.Experiment,
+	trials []*api_v1_beta1.Trial,
 ) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -160,13 +238,58 @@ func (s *SuggestionService) initStudyAndSearchSpaceAtFirstRun(
 		return nil
 	}
 
-	study, searchSpace, err := createStudyAndSearchSpace(experiment)
+	snapshot := s.snapshot
+	if snapshot != nil && snapshot.ExperimentName != experiment.GetName() {
+		klog.Infof("Skip restoring Goptuna study of another Experiment: %s", snapshot.ExperimentName)
+		snapshot = nil
+	}
+	// Samplers are created with the same settings and history, so the restored trials are sampled by the same sampler.
+	// For example, CMA-ES starts from the same mean and continues the generations of the restored trials.
+	initialTrials := trials
+	if snapshot != nil {
+		initialTrials = make([]*api_v1_beta1.Trial, 0, len(snapshot.InitialTrialNames))
+		for _, trial := range trials {
+			if slices.Contains(snapshot.InitialTrialNames, trial.GetName()) {
+				initialTrials = append(initialTrials, trial)
+			}
+		}
+	}
+	study, searchSpace, err := createStudyAndSearchSpace(experiment, initialTrials)
+	if err != nil {
+		return err
+	}
+	conditions, err := toParameterConditions(experiment.GetSpec().GetParameterSpecs().GetParameters())
+	if err != nil {
+		return err
+	}
+	transforms, err := toParamTransforms(experiment.GetSpec().GetParameterSpecs().GetParameters())
+	if err != nil {
+		return err
+	}
+	constraints, err := constraint.CompileAll(experiment.GetSpec().GetParameterConstraints())
 	if err != nil {
 		return err
 	}
 
+	if snapshot != nil {
+		trialMapping, err := restoreStudySnapshot(study, searchSpace, snapshot)
+		if err != nil {
+			return err
+		}
+		s.trialMapping = trialMapping
+		klog.Infof("Restored Goptuna study: %d trials", len(snapshot.Trials))
+	}
+	s.snapshot = nil
+	s.initialTrialNames = make([]string, 0, len(initialTrials))
+	for _, trial := range initialTrials {
+		s.initialTrialNames = append(s.initialTrialNames, trial.GetName())
+	}
+
 	s.study = study
 	s.searchSpace = searchSpace
+	s.conditions = conditions
+	s.transforms = transforms
+	s.constraints = constraints
 	return nil
 }
 
@@ -179,10 +302,15 @@ func (s *SuggestionService) ValidateAlgorithmSettings(
 	}
 
 	algorithmName := req.GetExperiment().GetSpec().GetAlgorithm().GetAlgorithmName()
-	if algorithmName != AlgorithmRandom && algorithmName != AlgorithmCMAES && algorithmName != AlgorithmTPE && algorithmName != AlgorithmSobol {
+	if algorithmName != AlgorithmRandom && algorithmName != AlgorithmCMAES && algorithmName != AlgorithmTPE && algorithmName != AlgorithmSobol && algorithmName != AlgorithmNSGA2 && algorithmName != AlgorithmASHA && algorithmName != AlgorithmGrid {
 		return nil, status.Error(codes.InvalidArgument, "unsupported algorithm")
 	}
 
+	// Multi-objective optimization is supported only by NSGA-II, other samplers optimize the single value.
+	if len(req.GetExperiment().GetSpec().GetObjective().GetAdditionalObjectives()) != 0 && algorithmName != AlgorithmNSGA2 {
+		return nil, status.Errorf(codes.InvalidArgument, "multi-objective optimization is supported only by %s algorithm", AlgorithmNSGA2)
+	}
+
 	params := req.GetExperiment().GetSpec().GetParameterSpecs().GetParameters()
 	if algorithmName == AlgorithmCMAES {
 		cnt := 0
@@ -194,6 +322,35 @@ func (s *SuggestionService) ValidateAlgorithmSettings(
 		if cnt < 2 {
 			return nil, status.Error(codes.InvalidArgument, "CMA-ES only supports two or more dimensional continuous search space.")
 		}
+		// CMA-ES updates the distribution with values of all parameters, so inactive parameters can't be skipped.
+		for _, p := range params {
+			if p.GetCondition() != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "CMA-ES doesn't support conditional parameter: %s", p.Name)
+			}
+		}
+	}
+
+	// Normal and log-normal priors are honoured by the independent samplers, other samplers would ignore them.
+	// Grid enumerates all values of the parameter, so it can't honour any distribution other than uniform.
+	for _, p := range params {
+		switch distribution := p.GetFeasibleSpace().GetDistribution(); distribution {
+		case api_v1_beta1.Distribution_LOG_UNIFORM:
+			if algorithmName == AlgorithmGrid {
+				return nil, status.Errorf(codes.InvalidArgument, "%s doesn't support %v distribution: %s", algorithmName, distribution, p.Name)
+			}
+		case api_v1_beta1.Distribution_NORMAL, api_v1_beta1.Distribution_LOG_NORMAL:
+			if algorithmName != AlgorithmRandom && algorithmName != AlgorithmTPE {
+				return nil, status.Errorf(codes.InvalidArgument, "%s doesn't support %v distribution: %s", algorithmName, distribution, p.Name)
+			}
+		}
+	}
+
+	if algorithmName == AlgorithmGrid {
+		for _, p := range params {
+			if p.ParameterType == api_v1_beta1.ParameterType_DOUBLE && p.GetFeasibleSpace().GetStep() == "" {
+				return nil, status.Errorf(codes.InvalidArgument, "Grid requires step for double parameter: %s", p.Name)
+			}
+		}
 	}
 
 	paramSet := make(map[string]interface{}, len(params))
@@ -203,10 +360,42 @@ func (s *SuggestionService) ValidateAlgorithmSettings(
 		}
 		paramSet[p.Name] = nil
 	}
-	_, _, err := createStudyAndSearchSpace(req.GetExperiment())
+	if _, err := toGoptunaSearchSpace(params); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if _, err := toParameterConditions(params); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if _, err := constraint.CompileAll(req.GetExperiment().GetSpec().GetParameterConstraints()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	study, searchSpace, err := createStudyAndSearchSpace(req.GetExperiment(), nil)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Failed to create goptuna study and search space: %s", err.Error())
 	}
+	if grid, ok := study.RelativeSampler.(*gridSampler); ok {
+		// The grid larger than maxTrialCount is evaluated partially, so it must be allowed explicitly.
+		maxTrialCount := int(req.GetExperiment().GetSpec().GetMaxTrialCount())
+		if size := grid.size(); maxTrialCount > 0 && size > maxTrialCount && !grid.allowPartialGrid {
+			return nil, status.Errorf(codes.InvalidArgument,
+				"Grid size %d is larger than maxTrialCount %d, set allow_partial_grid to evaluate the part of the grid", size, maxTrialCount)
+		}
+	}
+	if sampler, ok := study.RelativeSampler.(*ashaSampler); ok {
+		distribution, ok := searchSpace[sampler.resourceName]
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "resource_name must be one of the parameters: %s", sampler.resourceName)
+		}
+		// Promoted trials must have the resource parameter to be trained with the larger resource.
+		for _, p := range params {
+			if p.Name == sampler.resourceName && p.GetCondition() != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "resource parameter must not be conditional: %s", p.Name)
+			}
+		}
+		if _, err = sampler.rungResources(distribution); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
 	return &api_v1_beta1.ValidateAlgorithmSettingsReply{}, nil
 }
 
@@ -18,8 +18,13 @@ package suggestion_goptuna_v1beta1_test
 
 import (
 	"context"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
 	api_v1_beta1 "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
 	suggestion_goptuna_v1beta1 "github.com/kubeflow/katib/pkg/suggestion/v1beta1/goptuna"
 	"google.golang.org/grpc/codes"
@@ -152,6 +157,42 @@ func TestSuggestionService_GetSuggestions(t *testing.T) {
 				CurrentRequestNumber: 2,
 			},
 		},
+		{
+			name: "NSGA-II multi-objective request",
+			req: &api_v1_beta1.GetSuggestionsRequest{
+				Experiment: &api_v1_beta1.Experiment{
+					Name: "test",
+					Spec: &api_v1_beta1.ExperimentSpec{
+						Algorithm: &api_v1_beta1.AlgorithmSpec{
+							AlgorithmName: "nsga2",
+							AlgorithmSettings: []*api_v1_beta1.AlgorithmSetting{
+								{
+									Name:  "random_state",
+									Value: "10",
+								},
+								{
+									Name:  "population_size",
+									Value: "4",
+								},
+							},
+						},
+						Objective: &api_v1_beta1.ObjectiveSpec{
+							Type:                  api_v1_beta1.ObjectiveType_MAXIMIZE,
+							ObjectiveMetricName:   "metric-1",
+							AdditionalMetricNames: []string{"metric-2"},
+							AdditionalObjectives: []*api_v1_beta1.AdditionalObjective{
+								{
+									Type:                api_v1_beta1.ObjectiveType_MINIMIZE,
+									ObjectiveMetricName: "metric-2",
+								},
+							},
+						},
+						ParameterSpecs: parameterSpecs,
+					},
+				},
+				CurrentRequestNumber: 2,
+			},
+		},
 	} {
 		t.Run(tt.name, func(t *testing.T) {
 			s := &suggestion_goptuna_v1beta1.SuggestionService{}
@@ -185,3 +226,1318 @@ func TestSuggestionService_GetSuggestions(t *testing.T) {
 		})
 	}
 }
+
+func TestSuggestionService_GetSuggestionsMultiObjective(t *testing.T) {
+	ctx := context.TODO()
+	experiment := &api_v1_beta1.Experiment{
+		Name: "test",
+		Spec: &api_v1_beta1.ExperimentSpec{
+			Algorithm: &api_v1_beta1.AlgorithmSpec{
+				AlgorithmName: "nsga2",
+				AlgorithmSettings: []*api_v1_beta1.AlgorithmSetting{
+					{
+						Name:  "random_state",
+						Value: "10",
+					},
+					{
+						Name:  "population_size",
+						Value: "4",
+					},
+				},
+			},
+			Objective: &api_v1_beta1.ObjectiveSpec{
+				Type:                  api_v1_beta1.ObjectiveType_MAXIMIZE,
+				ObjectiveMetricName:   "metric-1",
+				AdditionalMetricNames: []string{"metric-2"},
+				AdditionalObjectives: []*api_v1_beta1.AdditionalObjective{
+					{
+						Type:                api_v1_beta1.ObjectiveType_MINIMIZE,
+						ObjectiveMetricName: "metric-2",
+					},
+				},
+			},
+			ParameterSpecs: &api_v1_beta1.ExperimentSpec_ParameterSpecs{
+				Parameters: []*api_v1_beta1.ParameterSpec{
+					{
+						Name:          "param-1",
+						ParameterType: api_v1_beta1.ParameterType_DOUBLE,
+						FeasibleSpace: &api_v1_beta1.FeasibleSpace{
+							Max: "1",
+							Min: "0",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	s := suggestion_goptuna_v1beta1.NewSuggestionService()
+	reply, err := s.GetSuggestions(ctx, &api_v1_beta1.GetSuggestionsRequest{
+		Experiment:           experiment,
+		CurrentRequestNumber: 4,
+	})
+	if err != nil {
+		t.Fatalf("GetSuggestions() returns error for the initial population: %v", err)
+	}
+
+	// Complete the initial population, so the next suggestions are sampled by NSGA-II.
+	var trials []*api_v1_beta1.Trial
+	for i, pa := range reply.ParameterAssignments {
+		trials = append(trials, &api_v1_beta1.Trial{
+			Name: fmt.Sprintf("trial-%d", i),
+			Spec: &api_v1_beta1.TrialSpec{
+				Objective: experiment.Spec.Objective,
+				ParameterAssignments: &api_v1_beta1.TrialSpec_ParameterAssignments{
+					Assignments: pa.Assignments,
+				},
+			},
+			Status: &api_v1_beta1.TrialStatus{
+				Condition: api_v1_beta1.TrialStatus_SUCCEEDED,
+				Observation: &api_v1_beta1.Observation{
+					Metrics: []*api_v1_beta1.Metric{
+						{Name: "metric-1", Value: pa.Assignments[0].Value},
+						{Name: "metric-2", Value: pa.Assignments[0].Value},
+					},
+				},
+			},
+		})
+	}
+	reply, err = s.GetSuggestions(ctx, &api_v1_beta1.GetSuggestionsRequest{
+		Experiment:           experiment,
+		Trials:               trials,
+		CurrentRequestNumber: 2,
+	})
+	if err != nil {
+		t.Fatalf("GetSuggestions() returns error for the next generation: %v", err)
+	}
+	if len(reply.ParameterAssignments) != 2 {
+		t.Errorf("GetSuggestions() should return 2 suggestions, but got %#v", reply.ParameterAssignments)
+	}
+
+	// Trial without value of the additional objective can't be used for the multi-objective optimization.
+	trials[0].Status.Observation.Metrics = trials[0].Status.Observation.Metrics[:1]
+	trials[0].Name = "trial-without-metric"
+	_, err = s.GetSuggestions(ctx, &api_v1_beta1.GetSuggestionsRequest{
+		Experiment:           experiment,
+		Trials:               trials,
+		CurrentRequestNumber: 1,
+	})
+	if status.Code(err) != codes.Internal {
+		t.Errorf("GetSuggestions() should return %v, but got %v", codes.Internal, err)
+	}
+}
+
+func TestSuggestionService_GetSuggestionsConditional(t *testing.T) {
+	ctx := context.TODO()
+	parameters := []*api_v1_beta1.ParameterSpec{
+		{
+			Name:          "optimizer",
+			ParameterType: api_v1_beta1.ParameterType_CATEGORICAL,
+			FeasibleSpace: &api_v1_beta1.FeasibleSpace{
+				List: []string{"sgd", "adam"},
+			},
+		},
+		{
+			Name:          "momentum",
+			ParameterType: api_v1_beta1.ParameterType_DOUBLE,
+			FeasibleSpace: &api_v1_beta1.FeasibleSpace{
+				Max: "0.9",
+				Min: "0.5",
+			},
+			Condition: &api_v1_beta1.ParameterCondition{
+				Parent: "optimizer",
+				Values: []string{"sgd"},
+			},
+		},
+		{
+			Name:          "nesterov",
+			ParameterType: api_v1_beta1.ParameterType_CATEGORICAL,
+			FeasibleSpace: &api_v1_beta1.FeasibleSpace{
+				List: []string{"true", "false"},
+			},
+			Condition: &api_v1_beta1.ParameterCondition{
+				Parent: "optimizer",
+				Values: []string{"sgd"},
+			},
+		},
+		{
+			Name:          "dampening",
+			ParameterType: api_v1_beta1.ParameterType_DOUBLE,
+			FeasibleSpace: &api_v1_beta1.FeasibleSpace{
+				Max: "0.5",
+				Min: "0",
+			},
+			Condition: &api_v1_beta1.ParameterCondition{
+				Parent: "nesterov",
+				Values: []string{"false"},
+			},
+		},
+	}
+	// Parameter is active only if its parent is active and assigned to one of the condition values.
+	checkAssignments := func(t *testing.T, assignments []*api_v1_beta1.ParameterAssignment) {
+		t.Helper()
+		values := make(map[string]string, len(assignments))
+		for _, a := range assignments {
+			values[a.Name] = a.Value
+		}
+		for _, p := range parameters {
+			_, isAssigned := values[p.Name]
+			isActive := true
+			if p.Condition != nil {
+				parentValue, ok := values[p.Condition.Parent]
+				isActive = ok && parentValue == p.Condition.Values[0]
+			}
+			if isAssigned != isActive {
+				t.Errorf("Parameter %s is assigned: %v, but it is active: %v, assignments: %v", p.Name, isAssigned, isActive, values)
+			}
+		}
+	}
+
+	for _, algorithmName := range []string{"random", "tpe", "sobol", "nsga2"} {
+		t.Run(algorithmName, func(t *testing.T) {
+			experiment := &api_v1_beta1.Experiment{
+				Name: "test",
+				Spec: &api_v1_beta1.ExperimentSpec{
+					Algorithm: &api_v1_beta1.AlgorithmSpec{
+						AlgorithmName: algorithmName,
+						AlgorithmSettings: []*api_v1_beta1.AlgorithmSetting{
+							{
+								Name:  "random_state",
+								Value: "10",
+							},
+						},
+					},
+					Objective: &api_v1_beta1.ObjectiveSpec{
+						Type:                api_v1_beta1.ObjectiveType_MINIMIZE,
+						ObjectiveMetricName: "metric-1",
+					},
+					ParameterSpecs: &api_v1_beta1.ExperimentSpec_ParameterSpecs{
+						Parameters: parameters,
+					},
+				},
+			}
+
+			s := suggestion_goptuna_v1beta1.NewSuggestionService()
+			reply, err := s.GetSuggestions(ctx, &api_v1_beta1.GetSuggestionsRequest{
+				Experiment:           experiment,
+				CurrentRequestNumber: 20,
+			})
+			if err != nil {
+				t.Fatalf("GetSuggestions() returns error: %v", err)
+			}
+
+			// Inactive parameters are not assigned to the completed Trials.
+			var trials []*api_v1_beta1.Trial
+			for i, pa := range reply.ParameterAssignments {
+				checkAssignments(t, pa.Assignments)
+				trials = append(trials, &api_v1_beta1.Trial{
+					Name: fmt.Sprintf("trial-%d", i),
+					Spec: &api_v1_beta1.TrialSpec{
+						Objective: experiment.Spec.Objective,
+						ParameterAssignments: &api_v1_beta1.TrialSpec_ParameterAssignments{
+							Assignments: pa.Assignments,
+						},
+					},
+					Status: &api_v1_beta1.TrialStatus{
+						Condition: api_v1_beta1.TrialStatus_SUCCEEDED,
+						Observation: &api_v1_beta1.Observation{
+							Metrics: []*api_v1_beta1.Metric{
+								{Name: "metric-1", Value: fmt.Sprintf("%d", i)},
+							},
+						},
+					},
+				})
+			}
+			reply, err = s.GetSuggestions(ctx, &api_v1_beta1.GetSuggestionsRequest{
+				Experiment:           experiment,
+				Trials:               trials,
+				CurrentRequestNumber: 5,
+			})
+			if err != nil {
+				t.Fatalf("GetSuggestions() returns error for the completed Trials: %v", err)
+			}
+			for _, pa := range reply.ParameterAssignments {
+				checkAssignments(t, pa.Assignments)
+			}
+		})
+	}
+}
+
+func TestSuggestionService_GetSuggestionsWithConstraints(t *testing.T) {
+	ctx := context.TODO()
+	for _, algorithmName := range []string{"random", "tpe", "cmaes", "sobol"} {
+		t.Run(algorithmName, func(t *testing.T) {
+			experiment := &api_v1_beta1.Experiment{
+				Name: "test",
+				Spec: &api_v1_beta1.ExperimentSpec{
+					Algorithm: &api_v1_beta1.AlgorithmSpec{
+						AlgorithmName: algorithmName,
+						AlgorithmSettings: []*api_v1_beta1.AlgorithmSetting{
+							{
+								Name:  "random_state",
+								Value: "10",
+							},
+						},
+					},
+					Objective: &api_v1_beta1.ObjectiveSpec{
+						Type:                api_v1_beta1.ObjectiveType_MINIMIZE,
+						ObjectiveMetricName: "metric-1",
+					},
+					ParameterSpecs: &api_v1_beta1.ExperimentSpec_ParameterSpecs{
+						Parameters: []*api_v1_beta1.ParameterSpec{
+							{
+								Name:          "batch_size",
+								ParameterType: api_v1_beta1.ParameterType_INT,
+								FeasibleSpace: &api_v1_beta1.FeasibleSpace{
+									Max: "64",
+									Min: "1",
+								},
+							},
+							{
+								Name:          "grad_accum",
+								ParameterType: api_v1_beta1.ParameterType_INT,
+								FeasibleSpace: &api_v1_beta1.FeasibleSpace{
+									Max: "16",
+									Min: "1",
+								},
+							},
+						},
+					},
+					ParameterConstraints: []string{"batch_size * grad_accum <= 128"},
+				},
+			}
+
+			s := suggestion_goptuna_v1beta1.NewSuggestionService()
+			reply, err := s.GetSuggestions(ctx, &api_v1_beta1.GetSuggestionsRequest{
+				Experiment:           experiment,
+				CurrentRequestNumber: 10,
+			})
+			if err != nil {
+				t.Fatalf("GetSuggestions() returns error: %v", err)
+			}
+			if len(reply.ParameterAssignments) != 10 {
+				t.Fatalf("GetSuggestions() should return 10 assignments, but got %d", len(reply.ParameterAssignments))
+			}
+			for _, pa := range reply.ParameterAssignments {
+				values := make(map[string]int, len(pa.Assignments))
+				for _, a := range pa.Assignments {
+					v, err := strconv.Atoi(a.Value)
+					if err != nil {
+						t.Fatalf("Failed to parse assignment %s=%s: %v", a.Name, a.Value, err)
+					}
+					values[a.Name] = v
+				}
+				if values["batch_size"]*values["grad_accum"] > 128 {
+					t.Errorf("Assignments violate the constraint: %v", values)
+				}
+			}
+		})
+	}
+}
+
+func TestSuggestionService_GetSuggestionsWarmStart(t *testing.T) {
+	ctx := context.TODO()
+	objective := &api_v1_beta1.ObjectiveSpec{
+		Type:                api_v1_beta1.ObjectiveType_MAXIMIZE,
+		ObjectiveMetricName: "metric-1",
+	}
+	newTrial := func(name string, condition api_v1_beta1.TrialStatus_TrialConditionType, assignments []*api_v1_beta1.ParameterAssignment, value string) *api_v1_beta1.Trial {
+		trial := &api_v1_beta1.Trial{
+			Name: name,
+			Spec: &api_v1_beta1.TrialSpec{
+				Objective: objective,
+				ParameterAssignments: &api_v1_beta1.TrialSpec_ParameterAssignments{
+					Assignments: assignments,
+				},
+			},
+			Status: &api_v1_beta1.TrialStatus{
+				Condition:   condition,
+				Observation: &api_v1_beta1.Observation{},
+			},
+		}
+		if value != "" {
+			trial.Status.Observation.Metrics = []*api_v1_beta1.Metric{{Name: "metric-1", Value: value}}
+		}
+		return trial
+	}
+	// The best prior trial is in the corner of the search space.
+	var priorTrials []*api_v1_beta1.Trial
+	for i := 0; i < 5; i++ {
+		priorTrials = append(priorTrials, newTrial(fmt.Sprintf("prior-trial-%d", i), api_v1_beta1.TrialStatus_SUCCEEDED,
+			[]*api_v1_beta1.ParameterAssignment{
+				{Name: "param-1", Value: fmt.Sprintf("%d", 2*i)},
+				{Name: "param-2", Value: fmt.Sprintf("%d", 2*i)},
+			}, fmt.Sprintf("%d", i)))
+	}
+
+	for _, algorithmName := range []string{"random", "tpe", "cmaes"} {
+		t.Run(algorithmName, func(t *testing.T) {
+			experiment := &api_v1_beta1.Experiment{
+				Name: "test",
+				Spec: &api_v1_beta1.ExperimentSpec{
+					Algorithm: &api_v1_beta1.AlgorithmSpec{
+						AlgorithmName: algorithmName,
+						AlgorithmSettings: []*api_v1_beta1.AlgorithmSetting{
+							{
+								Name:  "random_state",
+								Value: "10",
+							},
+						},
+					},
+					Objective: objective,
+					ParameterSpecs: &api_v1_beta1.ExperimentSpec_ParameterSpecs{
+						Parameters: []*api_v1_beta1.ParameterSpec{
+							{
+								Name:          "param-1",
+								ParameterType: api_v1_beta1.ParameterType_INT,
+								FeasibleSpace: &api_v1_beta1.FeasibleSpace{
+									Max: "10",
+									Min: "0",
+								},
+							},
+							{
+								Name:          "param-2",
+								ParameterType: api_v1_beta1.ParameterType_INT,
+								FeasibleSpace: &api_v1_beta1.FeasibleSpace{
+									Max: "10",
+									Min: "0",
+								},
+							},
+						},
+					},
+				},
+			}
+
+			s := suggestion_goptuna_v1beta1.NewSuggestionService()
+			reply, err := s.GetSuggestions(ctx, &api_v1_beta1.GetSuggestionsRequest{
+				Experiment:           experiment,
+				Trials:               priorTrials,
+				CurrentRequestNumber: 10,
+			})
+			if err != nil {
+				t.Fatalf("GetSuggestions() returns error for the prior Trials: %v", err)
+			}
+			sum := 0
+			for _, pa := range reply.ParameterAssignments {
+				for _, a := range pa.Assignments {
+					v, err := strconv.Atoi(a.Value)
+					if err != nil {
+						t.Fatalf("Failed to parse assignment %s=%s: %v", a.Name, a.Value, err)
+					}
+					sum += v
+				}
+			}
+			// CMA-ES starts from the best prior trial instead of the center of the search space.
+			if algorithmName == "cmaes" && sum <= 5*2*len(reply.ParameterAssignments) {
+				t.Errorf("CMA-ES suggestions must be around the best prior trial, but got %v", reply.ParameterAssignments)
+			}
+
+			// Prior Trials are sent with the Experiment Trials in the next requests.
+			trials := append([]*api_v1_beta1.Trial{}, priorTrials...)
+			for i, pa := range reply.ParameterAssignments {
+				trials = append(trials, newTrial(fmt.Sprintf("trial-%d", i), api_v1_beta1.TrialStatus_RUNNING, pa.Assignments, ""))
+			}
+			if _, err = s.GetSuggestions(ctx, &api_v1_beta1.GetSuggestionsRequest{
+				Experiment:           experiment,
+				Trials:               trials,
+				CurrentRequestNumber: 2,
+			}); err != nil {
+				t.Fatalf("GetSuggestions() returns error for the Experiment Trials: %v", err)
+			}
+		})
+	}
+}
+
+func TestSuggestionService_GetSuggestionsASHA(t *testing.T) {
+	ctx := context.TODO()
+	experiment := &api_v1_beta1.Experiment{
+		Name: "test",
+		Spec: &api_v1_beta1.ExperimentSpec{
+			Algorithm: &api_v1_beta1.AlgorithmSpec{
+				AlgorithmName: "asha",
+				AlgorithmSettings: []*api_v1_beta1.AlgorithmSetting{
+					{
+						Name:  "random_state",
+						Value: "10",
+					},
+					{
+						Name:  "resource_name",
+						Value: "epochs",
+					},
+				},
+			},
+			Objective: &api_v1_beta1.ObjectiveSpec{
+				Type:                api_v1_beta1.ObjectiveType_MINIMIZE,
+				ObjectiveMetricName: "loss",
+			},
+			ParameterSpecs: &api_v1_beta1.ExperimentSpec_ParameterSpecs{
+				Parameters: []*api_v1_beta1.ParameterSpec{
+					{
+						Name:          "lr",
+						ParameterType: api_v1_beta1.ParameterType_DOUBLE,
+						FeasibleSpace: &api_v1_beta1.FeasibleSpace{
+							Max: "0.1",
+							Min: "0.01",
+						},
+					},
+					{
+						Name:          "epochs",
+						ParameterType: api_v1_beta1.ParameterType_INT,
+						FeasibleSpace: &api_v1_beta1.FeasibleSpace{
+							Max: "9",
+							Min: "1",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	s := suggestion_goptuna_v1beta1.NewSuggestionService()
+	var trials []*api_v1_beta1.Trial
+	// Learning rate -> epochs of the suggested Trials.
+	epochs := make(map[string][]string)
+	for i := 0; i < 6; i++ {
+		reply, err := s.GetSuggestions(ctx, &api_v1_beta1.GetSuggestionsRequest{
+			Experiment:           experiment,
+			Trials:               trials,
+			CurrentRequestNumber: 3,
+		})
+		if err != nil {
+			t.Fatalf("GetSuggestions() returns error: %v", err)
+		}
+		// Trials are completed before the next request, the loss is smaller with the larger resource.
+		for _, pa := range reply.ParameterAssignments {
+			values := make(map[string]string, len(pa.Assignments))
+			for _, a := range pa.Assignments {
+				values[a.Name] = a.Value
+			}
+			lr, err := strconv.ParseFloat(values["lr"], 64)
+			if err != nil {
+				t.Fatalf("Failed to parse lr: %v", err)
+			}
+			e, err := strconv.Atoi(values["epochs"])
+			if err != nil {
+				t.Fatalf("Failed to parse epochs: %v", err)
+			}
+			if pa.Budget != values["epochs"] {
+				t.Errorf("Budget must be the resource of the rung: budget=%s, epochs=%s", pa.Budget, values["epochs"])
+			}
+			epochs[values["lr"]] = append(epochs[values["lr"]], values["epochs"])
+			trials = append(trials, &api_v1_beta1.Trial{
+				Name: fmt.Sprintf("trial-%d", len(trials)),
+				Spec: &api_v1_beta1.TrialSpec{
+					Objective: experiment.Spec.Objective,
+					ParameterAssignments: &api_v1_beta1.TrialSpec_ParameterAssignments{
+						Assignments: pa.Assignments,
+					},
+				},
+				Status: &api_v1_beta1.TrialStatus{
+					Condition: api_v1_beta1.TrialStatus_SUCCEEDED,
+					Observation: &api_v1_beta1.Observation{
+						Metrics: []*api_v1_beta1.Metric{
+							{Name: "loss", Value: strconv.FormatFloat(lr/float64(e), 'f', -1, 64)},
+						},
+					},
+				},
+			})
+		}
+	}
+
+	// Each configuration is trained with the resources of the rungs in order.
+	rungs := []string{"1", "3", "9"}
+	promoted := 0
+	for lr, got := range epochs {
+		if diff := cmp.Diff(rungs[:len(got)], got); diff != "" {
+			t.Errorf("Unexpected epochs of lr=%s (-want,+got):\n%s", lr, diff)
+		}
+		if len(got) > 1 {
+			promoted++
+		}
+	}
+	if promoted == 0 || len(epochs) == len(trials) {
+		t.Errorf("Configurations must be promoted to the next rungs: %v", epochs)
+	}
+}
+
+func TestSuggestionService_GetSuggestionsGrid(t *testing.T) {
+	ctx := context.TODO()
+	experiment := &api_v1_beta1.Experiment{
+		Name: "test",
+		Spec: &api_v1_beta1.ExperimentSpec{
+			Algorithm: &api_v1_beta1.AlgorithmSpec{
+				AlgorithmName: "grid",
+			},
+			Objective: &api_v1_beta1.ObjectiveSpec{
+				Type:                api_v1_beta1.ObjectiveType_MINIMIZE,
+				ObjectiveMetricName: "loss",
+			},
+			ParameterSpecs: &api_v1_beta1.ExperimentSpec_ParameterSpecs{
+				Parameters: []*api_v1_beta1.ParameterSpec{
+					{
+						Name:          "lr",
+						ParameterType: api_v1_beta1.ParameterType_DOUBLE,
+						FeasibleSpace: &api_v1_beta1.FeasibleSpace{
+							Max:  "0.3",
+							Min:  "0.1",
+							Step: "0.1",
+						},
+					},
+					{
+						Name:          "optimizer",
+						ParameterType: api_v1_beta1.ParameterType_CATEGORICAL,
+						FeasibleSpace: &api_v1_beta1.FeasibleSpace{
+							List: []string{"sgd", "adam"},
+						},
+					},
+					{
+						Name:          "momentum",
+						ParameterType: api_v1_beta1.ParameterType_DISCRETE,
+						FeasibleSpace: &api_v1_beta1.FeasibleSpace{
+							List: []string{"0.5", "0.9"},
+						},
+						Condition: &api_v1_beta1.ParameterCondition{
+							Parent: "optimizer",
+							Values: []string{"sgd"},
+						},
+					},
+					{
+						Name:          "batch-size",
+						ParameterType: api_v1_beta1.ParameterType_INT,
+						FeasibleSpace: &api_v1_beta1.FeasibleSpace{
+							Max:  "64",
+							Min:  "32",
+							Step: "32",
+						},
+					},
+				},
+			},
+			// Grid points with lr=0.3 and batch-size=64 are skipped.
+			ParameterConstraints: []string{`!(params["lr"] > 0.25 && params["batch-size"] == 64)`},
+		},
+	}
+	// lr x (sgd x momentum + adam) x batch-size = 3 x 3 x 2 = 18, the constraint removes 3 of them.
+	gridSize := 15
+	toKey := func(assignments []*api_v1_beta1.ParameterAssignment) string {
+		values := make(map[string]string, len(assignments))
+		for _, a := range assignments {
+			values[a.Name] = a.Value
+		}
+		return fmt.Sprint(values)
+	}
+	// Prior Trial is not suggested again.
+	trials := []*api_v1_beta1.Trial{
+		{
+			Name: "prior-trial",
+			Spec: &api_v1_beta1.TrialSpec{
+				Objective: experiment.Spec.Objective,
+				ParameterAssignments: &api_v1_beta1.TrialSpec_ParameterAssignments{
+					Assignments: []*api_v1_beta1.ParameterAssignment{
+						{Name: "lr", Value: "0.1"},
+						{Name: "optimizer", Value: "adam"},
+						{Name: "batch-size", Value: "32"},
+					},
+				},
+			},
+			Status: &api_v1_beta1.TrialStatus{
+				Condition: api_v1_beta1.TrialStatus_SUCCEEDED,
+				Observation: &api_v1_beta1.Observation{
+					Metrics: []*api_v1_beta1.Metric{{Name: "loss", Value: "0.5"}},
+				},
+			},
+		},
+	}
+	suggested := map[string]bool{toKey(trials[0].Spec.ParameterAssignments.Assignments): true}
+
+	s := suggestion_goptuna_v1beta1.NewSuggestionService()
+	for _, tc := range []struct {
+		requestNumber   int
+		wantSuggestions int
+		wantExhausted   bool
+	}{
+		{requestNumber: 10, wantSuggestions: 10},
+		{requestNumber: 10, wantSuggestions: gridSize - 11, wantExhausted: true},
+		{requestNumber: 1, wantSuggestions: 0, wantExhausted: true},
+	} {
+		reply, err := s.GetSuggestions(ctx, &api_v1_beta1.GetSuggestionsRequest{
+			Experiment:           experiment,
+			Trials:               trials,
+			CurrentRequestNumber: int32(tc.requestNumber),
+		})
+		if err != nil {
+			t.Fatalf("GetSuggestions() returns error: %v", err)
+		}
+		if len(reply.ParameterAssignments) != tc.wantSuggestions || reply.SearchSpaceExhausted != tc.wantExhausted {
+			t.Errorf("GetSuggestions() returns %d suggestions and exhausted %v, want %d and %v",
+				len(reply.ParameterAssignments), reply.SearchSpaceExhausted, tc.wantSuggestions, tc.wantExhausted)
+		}
+		for _, pa := range reply.ParameterAssignments {
+			key := toKey(pa.Assignments)
+			if suggested[key] {
+				t.Errorf("Grid point is suggested twice: %s", key)
+			}
+			suggested[key] = true
+			trials = append(trials, &api_v1_beta1.Trial{
+				Name: fmt.Sprintf("trial-%d", len(trials)),
+				Spec: &api_v1_beta1.TrialSpec{
+					Objective: experiment.Spec.Objective,
+					ParameterAssignments: &api_v1_beta1.TrialSpec_ParameterAssignments{
+						Assignments: pa.Assignments,
+					},
+				},
+				Status: &api_v1_beta1.TrialStatus{
+					Condition:   api_v1_beta1.TrialStatus_RUNNING,
+					Observation: &api_v1_beta1.Observation{},
+				},
+			})
+		}
+	}
+	if len(suggested) != gridSize {
+		t.Errorf("Grid has %d points, but %d points are suggested: %v", gridSize, len(suggested), suggested)
+	}
+}
+
+func TestSuggestionService_GetSuggestionsDistribution(t *testing.T) {
+	ctx := context.TODO()
+	newParameter := func(name string, parameterType api_v1_beta1.ParameterType, distribution api_v1_beta1.Distribution, min, max string) *api_v1_beta1.ParameterSpec {
+		return &api_v1_beta1.ParameterSpec{
+			Name:          name,
+			ParameterType: parameterType,
+			FeasibleSpace: &api_v1_beta1.FeasibleSpace{
+				Max:          max,
+				Min:          min,
+				Distribution: distribution,
+			},
+		}
+	}
+	for _, algorithmName := range []string{"random", "tpe"} {
+		t.Run(algorithmName, func(t *testing.T) {
+			experiment := &api_v1_beta1.Experiment{
+				Name: "test",
+				Spec: &api_v1_beta1.ExperimentSpec{
+					Algorithm: &api_v1_beta1.AlgorithmSpec{
+						AlgorithmName: algorithmName,
+					},
+					Objective: &api_v1_beta1.ObjectiveSpec{
+						Type:                api_v1_beta1.ObjectiveType_MINIMIZE,
+						ObjectiveMetricName: "loss",
+					},
+					ParameterSpecs: &api_v1_beta1.ExperimentSpec_ParameterSpecs{
+						Parameters: []*api_v1_beta1.ParameterSpec{
+							newParameter("lr", api_v1_beta1.ParameterType_DOUBLE, api_v1_beta1.Distribution_LOG_UNIFORM, "0.00001", "0.1"),
+							newParameter("batch-size", api_v1_beta1.ParameterType_INT, api_v1_beta1.Distribution_LOG_UNIFORM, "16", "1024"),
+							newParameter("dropout", api_v1_beta1.ParameterType_DOUBLE, api_v1_beta1.Distribution_NORMAL, "0", "0.6"),
+							newParameter("units", api_v1_beta1.ParameterType_INT, api_v1_beta1.Distribution_LOG_NORMAL, "1", "1000000"),
+						},
+					},
+				},
+			}
+			s := suggestion_goptuna_v1beta1.NewSuggestionService()
+			reply, err := s.GetSuggestions(ctx, &api_v1_beta1.GetSuggestionsRequest{
+				Experiment:           experiment,
+				CurrentRequestNumber: 200,
+			})
+			if err != nil {
+				t.Fatalf("GetSuggestions() returns error: %v", err)
+			}
+
+			// Number of the values in the middle of the range in the log or linear space.
+			// It is about 50% for log-uniform distributions and 68% for normal distributions.
+			inMiddle := make(map[string]int)
+			trials := make([]*api_v1_beta1.Trial, 0, len(reply.ParameterAssignments))
+			for i, pa := range reply.ParameterAssignments {
+				for _, a := range pa.Assignments {
+					switch a.Name {
+					case "lr", "dropout":
+						v, err := strconv.ParseFloat(a.Value, 64)
+						if err != nil {
+							t.Fatalf("Failed to parse %s: %v", a.Name, err)
+						}
+						if (a.Name == "lr" && v >= 0.00001 && v < 0.001) || (a.Name == "dropout" && v >= 0.2 && v <= 0.4) {
+							inMiddle[a.Name]++
+						}
+					case "batch-size", "units":
+						v, err := strconv.Atoi(a.Value)
+						if err != nil {
+							t.Fatalf("%s must be integer: %v", a.Name, err)
+						}
+						if (a.Name == "batch-size" && v >= 16 && v < 128) || (a.Name == "units" && v >= 100 && v <= 10000) {
+							inMiddle[a.Name]++
+						}
+					}
+				}
+				trials = append(trials, &api_v1_beta1.Trial{
+					Name: fmt.Sprintf("trial-%d", i),
+					Spec: &api_v1_beta1.TrialSpec{
+						Objective: experiment.Spec.Objective,
+						ParameterAssignments: &api_v1_beta1.TrialSpec_ParameterAssignments{
+							Assignments: pa.Assignments,
+						},
+					},
+					Status: &api_v1_beta1.TrialStatus{
+						Condition:   api_v1_beta1.TrialStatus_RUNNING,
+						Observation: &api_v1_beta1.Observation{},
+					},
+				})
+			}
+			// Uniform distributions would have 1% of lr, 11% of batch-size, 33% of dropout and 1% of units in the middle.
+			for name, want := range map[string]int{"lr": 60, "batch-size": 60, "dropout": 100, "units": 100} {
+				if inMiddle[name] < want {
+					t.Errorf("%d of 200 values of %s are in the middle of the range, want at least %d", inMiddle[name], name, want)
+				}
+			}
+
+			// Running Trials with the transformed values are matched with the Goptuna trials.
+			if _, err = s.GetSuggestions(ctx, &api_v1_beta1.GetSuggestionsRequest{
+				Experiment:           experiment,
+				Trials:               trials,
+				CurrentRequestNumber: 1,
+			}); err != nil {
+				t.Errorf("GetSuggestions() returns error with the suggested Trials: %v", err)
+			}
+		})
+	}
+}
+
+func TestSuggestionService_GetSuggestionsPendingTrialStrategy(t *testing.T) {
+	ctx := context.TODO()
+	newExperiment := func(strategy string, seed int) *api_v1_beta1.Experiment {
+		return &api_v1_beta1.Experiment{
+			Name: "test",
+			Spec: &api_v1_beta1.ExperimentSpec{
+				Algorithm: &api_v1_beta1.AlgorithmSpec{
+					AlgorithmName: "tpe",
+					AlgorithmSettings: []*api_v1_beta1.AlgorithmSetting{
+						{Name: "random_state", Value: strconv.Itoa(seed)},
+						{Name: "pending_trial_strategy", Value: strategy},
+					},
+				},
+				Objective: &api_v1_beta1.ObjectiveSpec{
+					Type:                api_v1_beta1.ObjectiveType_MINIMIZE,
+					ObjectiveMetricName: "loss",
+				},
+				ParameterSpecs: &api_v1_beta1.ExperimentSpec_ParameterSpecs{
+					Parameters: []*api_v1_beta1.ParameterSpec{
+						{
+							Name:          "x",
+							ParameterType: api_v1_beta1.ParameterType_DOUBLE,
+							FeasibleSpace: &api_v1_beta1.FeasibleSpace{Max: "10", Min: "-10"},
+						},
+						{
+							Name:          "y",
+							ParameterType: api_v1_beta1.ParameterType_DOUBLE,
+							FeasibleSpace: &api_v1_beta1.FeasibleSpace{Max: "10", Min: "-10"},
+						},
+					},
+				},
+			},
+		}
+	}
+	toPoint := func(t *testing.T, assignments []*api_v1_beta1.ParameterAssignment) [2]float64 {
+		t.Helper()
+		var p [2]float64
+		for _, a := range assignments {
+			v, err := strconv.ParseFloat(a.Value, 64)
+			if err != nil || v < -10 || v > 10 {
+				t.Fatalf("GetSuggestions() returns %s out of the feasible space: %s", a.Name, a.Value)
+			}
+			if a.Name == "x" {
+				p[0] = v
+			} else {
+				p[1] = v
+			}
+		}
+		return p
+	}
+	// Completed Trials on the coarse grid and around the optimum (2, -1).
+	var points [][2]float64
+	for x := -9.0; x <= 9; x += 3 {
+		for y := -9.0; y <= 9; y += 3 {
+			points = append(points, [2]float64{x, y})
+		}
+	}
+	for x := 1.0; x <= 3; x += 0.5 {
+		for y := -2.0; y <= 0; y += 0.5 {
+			points = append(points, [2]float64{x, y})
+		}
+	}
+	completed := make([]*api_v1_beta1.Trial, 0, len(points))
+	for _, p := range points {
+		completed = append(completed, &api_v1_beta1.Trial{
+			Name: fmt.Sprintf("trial-%d", len(completed)),
+			Spec: &api_v1_beta1.TrialSpec{
+				ParameterAssignments: &api_v1_beta1.TrialSpec_ParameterAssignments{
+					Assignments: []*api_v1_beta1.ParameterAssignment{
+						{Name: "x", Value: strconv.FormatFloat(p[0], 'f', -1, 64)},
+						{Name: "y", Value: strconv.FormatFloat(p[1], 'f', -1, 64)},
+					},
+				},
+			},
+			Status: &api_v1_beta1.TrialStatus{
+				Condition: api_v1_beta1.TrialStatus_SUCCEEDED,
+				Observation: &api_v1_beta1.Observation{
+					Metrics: []*api_v1_beta1.Metric{
+						{Name: "loss", Value: strconv.FormatFloat((p[0]-2)*(p[0]-2)+(p[1]+1)*(p[1]+1), 'f', -1, 64)},
+					},
+				},
+			},
+		})
+	}
+
+	strategies := []string{"none", "constant_liar_mean", "constant_liar_best", "constant_liar_worst", "kriging_believer"}
+	for _, strategy := range strategies {
+		t.Run(strategy, func(t *testing.T) {
+			s := suggestion_goptuna_v1beta1.NewSuggestionService()
+			trials := slices.Clone(completed)
+			suggested := make(map[[2]float64]bool)
+			// Suggestions of the first request are pending while the second request is sampled.
+			for _, requestNumber := range []int{3, 5} {
+				reply, err := s.GetSuggestions(ctx, &api_v1_beta1.GetSuggestionsRequest{
+					Experiment:           newExperiment(strategy, 1),
+					Trials:               trials,
+					CurrentRequestNumber: int32(requestNumber),
+				})
+				if err != nil {
+					t.Fatalf("GetSuggestions() returns error: %v", err)
+				}
+				if len(reply.ParameterAssignments) != requestNumber {
+					t.Fatalf("GetSuggestions() should return %d suggestions, but got %d", requestNumber, len(reply.ParameterAssignments))
+				}
+				for _, pa := range reply.ParameterAssignments {
+					p := toPoint(t, pa.Assignments)
+					if suggested[p] {
+						t.Errorf("GetSuggestions() returns duplicated suggestion: %v", p)
+					}
+					suggested[p] = true
+					trials = append(trials, &api_v1_beta1.Trial{
+						Name: fmt.Sprintf("trial-%d", len(trials)),
+						Spec: &api_v1_beta1.TrialSpec{
+							ParameterAssignments: &api_v1_beta1.TrialSpec_ParameterAssignments{
+								Assignments: pa.Assignments,
+							},
+						},
+						Status: &api_v1_beta1.TrialStatus{
+							Condition:   api_v1_beta1.TrialStatus_RUNNING,
+							Observation: &api_v1_beta1.Observation{},
+						},
+					})
+				}
+			}
+		})
+	}
+
+	// batchDiversity returns the distance to the nearest suggestion in the batch, averaged over the seeds
+	// since a single batch of TPE is noisy.
+	batchDiversity := func(t *testing.T, strategy string) float64 {
+		t.Helper()
+		seeds := 100
+		var total float64
+		for seed := 0; seed < seeds; seed++ {
+			s := suggestion_goptuna_v1beta1.NewSuggestionService()
+			reply, err := s.GetSuggestions(ctx, &api_v1_beta1.GetSuggestionsRequest{
+				Experiment:           newExperiment(strategy, seed),
+				Trials:               completed,
+				CurrentRequestNumber: 10,
+			})
+			if err != nil {
+				t.Fatalf("GetSuggestions() returns error: %v", err)
+			}
+			batch := make([][2]float64, 0, len(reply.ParameterAssignments))
+			for _, pa := range reply.ParameterAssignments {
+				batch = append(batch, toPoint(t, pa.Assignments))
+			}
+			for i := range batch {
+				nearest := math.Inf(1)
+				for j := range batch {
+					if i != j {
+						nearest = math.Min(nearest, math.Hypot(batch[i][0]-batch[j][0], batch[i][1]-batch[j][1]))
+					}
+				}
+				total += nearest / float64(len(batch)*seeds)
+			}
+		}
+		return total
+	}
+	// Pseudo observations with the best value widen the promising region of TPE, so the batch is spread.
+	baseline := batchDiversity(t, "none")
+	if got := batchDiversity(t, "constant_liar_best"); got <= baseline {
+		t.Errorf("Batch with constant_liar_best should be more diverse than without pending trial strategy, but got %v <= %v", got, baseline)
+	}
+}
+
+func TestSuggestionService_ValidateAlgorithmSettings(t *testing.T) {
+	ctx := context.TODO()
+	newExperiment := func(algorithm *api_v1_beta1.AlgorithmSpec, additionalObjectives []*api_v1_beta1.AdditionalObjective) *api_v1_beta1.Experiment {
+		return &api_v1_beta1.Experiment{
+			Name: "test",
+			Spec: &api_v1_beta1.ExperimentSpec{
+				Algorithm: algorithm,
+				Objective: &api_v1_beta1.ObjectiveSpec{
+					Type:                 api_v1_beta1.ObjectiveType_MAXIMIZE,
+					ObjectiveMetricName:  "metric-1",
+					AdditionalObjectives: additionalObjectives,
+				},
+				ParameterSpecs: &api_v1_beta1.ExperimentSpec_ParameterSpecs{
+					Parameters: []*api_v1_beta1.ParameterSpec{
+						{
+							Name:          "param-1",
+							ParameterType: api_v1_beta1.ParameterType_DOUBLE,
+							FeasibleSpace: &api_v1_beta1.FeasibleSpace{
+								Max: "1",
+								Min: "0",
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+	additionalObjectives := []*api_v1_beta1.AdditionalObjective{
+		{
+			Type:                api_v1_beta1.ObjectiveType_MINIMIZE,
+			ObjectiveMetricName: "metric-2",
+		},
+	}
+	conditionalParameters := []*api_v1_beta1.ParameterSpec{
+		{
+			Name:          "optimizer",
+			ParameterType: api_v1_beta1.ParameterType_CATEGORICAL,
+			FeasibleSpace: &api_v1_beta1.FeasibleSpace{
+				List: []string{"sgd", "adam"},
+			},
+		},
+		{
+			Name:          "momentum",
+			ParameterType: api_v1_beta1.ParameterType_DOUBLE,
+			FeasibleSpace: &api_v1_beta1.FeasibleSpace{
+				Max: "0.9",
+				Min: "0.5",
+			},
+			Condition: &api_v1_beta1.ParameterCondition{
+				Parent: "optimizer",
+				Values: []string{"sgd"},
+			},
+		},
+	}
+
+	newDistributionExperiment := func(algorithmName string, distribution api_v1_beta1.Distribution, step string) *api_v1_beta1.Experiment {
+		e := newExperiment(&api_v1_beta1.AlgorithmSpec{AlgorithmName: algorithmName}, nil)
+		e.Spec.ParameterSpecs.Parameters[0].FeasibleSpace.Min = "0.01"
+		e.Spec.ParameterSpecs.Parameters[0].FeasibleSpace.Step = step
+		e.Spec.ParameterSpecs.Parameters[0].FeasibleSpace.Distribution = distribution
+		return e
+	}
+
+	newASHAExperiment := func(settings map[string]string) *api_v1_beta1.Experiment {
+		algorithm := &api_v1_beta1.AlgorithmSpec{AlgorithmName: "asha"}
+		for name, value := range settings {
+			algorithm.AlgorithmSettings = append(algorithm.AlgorithmSettings, &api_v1_beta1.AlgorithmSetting{
+				Name:  name,
+				Value: value,
+			})
+		}
+		e := newExperiment(algorithm, nil)
+		e.Spec.ParameterSpecs.Parameters = append(e.Spec.ParameterSpecs.Parameters, &api_v1_beta1.ParameterSpec{
+			Name:          "epochs",
+			ParameterType: api_v1_beta1.ParameterType_INT,
+			FeasibleSpace: &api_v1_beta1.FeasibleSpace{
+				Max: "27",
+				Min: "1",
+			},
+		})
+		return e
+	}
+
+	for _, tt := range []struct {
+		name         string
+		experiment   *api_v1_beta1.Experiment
+		expectedCode codes.Code
+	}{
+		{
+			name:         "NSGA-II multi-objective experiment",
+			experiment:   newExperiment(&api_v1_beta1.AlgorithmSpec{AlgorithmName: "nsga2"}, additionalObjectives),
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "NSGA-II single objective experiment",
+			experiment:   newExperiment(&api_v1_beta1.AlgorithmSpec{AlgorithmName: "nsga2"}, nil),
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "TPE multi-objective experiment",
+			experiment:   newExperiment(&api_v1_beta1.AlgorithmSpec{AlgorithmName: "tpe"}, additionalObjectives),
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name: "NSGA-II invalid population size",
+			experiment: newExperiment(&api_v1_beta1.AlgorithmSpec{
+				AlgorithmName: "nsga2",
+				AlgorithmSettings: []*api_v1_beta1.AlgorithmSetting{
+					{
+						Name:  "population_size",
+						Value: "1",
+					},
+				},
+			}, additionalObjectives),
+			expectedCode: codes.Internal,
+		},
+		{
+			name: "NSGA-II invalid mutation probability",
+			experiment: newExperiment(&api_v1_beta1.AlgorithmSpec{
+				AlgorithmName: "nsga2",
+				AlgorithmSettings: []*api_v1_beta1.AlgorithmSetting{
+					{
+						Name:  "mutation_prob",
+						Value: "1.5",
+					},
+				},
+			}, additionalObjectives),
+			expectedCode: codes.Internal,
+		},
+		{
+			name: "TPE with pending trial strategy",
+			experiment: newExperiment(&api_v1_beta1.AlgorithmSpec{
+				AlgorithmName: "tpe",
+				AlgorithmSettings: []*api_v1_beta1.AlgorithmSetting{
+					{
+						Name:  "pending_trial_strategy",
+						Value: "kriging_believer",
+					},
+				},
+			}, nil),
+			expectedCode: codes.OK,
+		},
+		{
+			name: "TPE invalid pending trial strategy",
+			experiment: newExperiment(&api_v1_beta1.AlgorithmSpec{
+				AlgorithmName: "tpe",
+				AlgorithmSettings: []*api_v1_beta1.AlgorithmSetting{
+					{
+						Name:  "pending_trial_strategy",
+						Value: "unknown",
+					},
+				},
+			}, nil),
+			expectedCode: codes.Internal,
+		},
+		{
+			name: "CMA-ES with pending trial strategy",
+			experiment: func() *api_v1_beta1.Experiment {
+				e := newExperiment(&api_v1_beta1.AlgorithmSpec{
+					AlgorithmName: "cmaes",
+					AlgorithmSettings: []*api_v1_beta1.AlgorithmSetting{
+						{
+							Name:  "pending_trial_strategy",
+							Value: "constant_liar_mean",
+						},
+					},
+				}, nil)
+				e.Spec.ParameterSpecs.Parameters = append(e.Spec.ParameterSpecs.Parameters, &api_v1_beta1.ParameterSpec{
+					Name:          "param-2",
+					ParameterType: api_v1_beta1.ParameterType_DOUBLE,
+					FeasibleSpace: &api_v1_beta1.FeasibleSpace{Max: "5", Min: "1"},
+				})
+				return e
+			}(),
+			expectedCode: codes.Internal,
+		},
+		{
+			name: "CMA-ES invalid population size",
+			experiment: func() *api_v1_beta1.Experiment {
+				e := newExperiment(&api_v1_beta1.AlgorithmSpec{
+					AlgorithmName: "cmaes",
+					AlgorithmSettings: []*api_v1_beta1.AlgorithmSetting{
+						{
+							Name:  "population_size",
+							Value: "1",
+						},
+					},
+				}, nil)
+				e.Spec.ParameterSpecs.Parameters = append(e.Spec.ParameterSpecs.Parameters, &api_v1_beta1.ParameterSpec{
+					Name:          "param-2",
+					ParameterType: api_v1_beta1.ParameterType_DOUBLE,
+					FeasibleSpace: &api_v1_beta1.FeasibleSpace{Max: "5", Min: "1"},
+				})
+				return e
+			}(),
+			expectedCode: codes.Internal,
+		},
+		{
+			name: "CMA-ES with conditional parameter",
+			experiment: func() *api_v1_beta1.Experiment {
+				e := newExperiment(&api_v1_beta1.AlgorithmSpec{AlgorithmName: "cmaes"}, nil)
+				e.Spec.ParameterSpecs.Parameters = append(e.Spec.ParameterSpecs.Parameters, conditionalParameters...)
+				return e
+			}(),
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name: "TPE with conditional parameter",
+			experiment: func() *api_v1_beta1.Experiment {
+				e := newExperiment(&api_v1_beta1.AlgorithmSpec{AlgorithmName: "tpe"}, nil)
+				e.Spec.ParameterSpecs.Parameters = append(e.Spec.ParameterSpecs.Parameters, conditionalParameters...)
+				return e
+			}(),
+			expectedCode: codes.OK,
+		},
+		{
+			name: "Parent of conditional parameter is not categorical",
+			experiment: func() *api_v1_beta1.Experiment {
+				e := newExperiment(&api_v1_beta1.AlgorithmSpec{AlgorithmName: "tpe"}, nil)
+				e.Spec.ParameterSpecs.Parameters = append(e.Spec.ParameterSpecs.Parameters, &api_v1_beta1.ParameterSpec{
+					Name:          "param-2",
+					ParameterType: api_v1_beta1.ParameterType_DOUBLE,
+					FeasibleSpace: &api_v1_beta1.FeasibleSpace{
+						Max: "1",
+						Min: "0",
+					},
+					Condition: &api_v1_beta1.ParameterCondition{
+						Parent: "param-1",
+						Values: []string{"0.5"},
+					},
+				})
+				return e
+			}(),
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name: "Invalid parameter constraint",
+			experiment: func() *api_v1_beta1.Experiment {
+				e := newExperiment(&api_v1_beta1.AlgorithmSpec{AlgorithmName: "tpe"}, nil)
+				e.Spec.ParameterConstraints = []string{"param-1 <<"}
+				return e
+			}(),
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name: "Grid experiment",
+			experiment: func() *api_v1_beta1.Experiment {
+				e := newExperiment(&api_v1_beta1.AlgorithmSpec{AlgorithmName: "grid"}, nil)
+				e.Spec.ParameterSpecs.Parameters[0].FeasibleSpace.Step = "0.1"
+				e.Spec.MaxTrialCount = 11
+				return e
+			}(),
+			expectedCode: codes.OK,
+		},
+		{
+			name: "Grid without step of double parameter",
+			experiment: func() *api_v1_beta1.Experiment {
+				e := newExperiment(&api_v1_beta1.AlgorithmSpec{AlgorithmName: "grid"}, nil)
+				e.Spec.MaxTrialCount = 11
+				return e
+			}(),
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name: "Grid larger than maxTrialCount",
+			experiment: func() *api_v1_beta1.Experiment {
+				e := newExperiment(&api_v1_beta1.AlgorithmSpec{AlgorithmName: "grid"}, nil)
+				e.Spec.ParameterSpecs.Parameters[0].FeasibleSpace.Step = "0.1"
+				e.Spec.MaxTrialCount = 10
+				return e
+			}(),
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name: "Partial grid larger than maxTrialCount",
+			experiment: func() *api_v1_beta1.Experiment {
+				e := newExperiment(&api_v1_beta1.AlgorithmSpec{
+					AlgorithmName: "grid",
+					AlgorithmSettings: []*api_v1_beta1.AlgorithmSetting{
+						{
+							Name:  "allow_partial_grid",
+							Value: "true",
+						},
+					},
+				}, nil)
+				e.Spec.ParameterSpecs.Parameters[0].FeasibleSpace.Step = "0.1"
+				e.Spec.MaxTrialCount = 10
+				return e
+			}(),
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "Log-uniform distribution with TPE",
+			experiment:   newDistributionExperiment("tpe", api_v1_beta1.Distribution_LOG_UNIFORM, ""),
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "Log-uniform distribution with step",
+			experiment:   newDistributionExperiment("random", api_v1_beta1.Distribution_LOG_UNIFORM, "0.01"),
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "Log-uniform distribution with grid",
+			experiment:   newDistributionExperiment("grid", api_v1_beta1.Distribution_LOG_UNIFORM, "0.01"),
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "Normal distribution with random",
+			experiment:   newDistributionExperiment("random", api_v1_beta1.Distribution_NORMAL, ""),
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "Log-normal distribution with TPE",
+			experiment:   newDistributionExperiment("tpe", api_v1_beta1.Distribution_LOG_NORMAL, ""),
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "Normal distribution with Sobol",
+			experiment:   newDistributionExperiment("sobol", api_v1_beta1.Distribution_NORMAL, ""),
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "ASHA experiment",
+			experiment:   newASHAExperiment(map[string]string{"resource_name": "epochs", "eta": "3", "min_resource": "3"}),
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "ASHA without resource_name",
+			experiment:   newASHAExperiment(map[string]string{"eta": "3"}),
+			expectedCode: codes.Internal,
+		},
+		{
+			name:         "ASHA invalid eta",
+			experiment:   newASHAExperiment(map[string]string{"resource_name": "epochs", "eta": "1"}),
+			expectedCode: codes.Internal,
+		},
+		{
+			name:         "ASHA resource_name is not in parameters",
+			experiment:   newASHAExperiment(map[string]string{"resource_name": "steps"}),
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "ASHA min_resource is out of the resource range",
+			experiment:   newASHAExperiment(map[string]string{"resource_name": "epochs", "min_resource": "30"}),
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name: "ASHA categorical resource parameter",
+			experiment: func() *api_v1_beta1.Experiment {
+				e := newASHAExperiment(map[string]string{"resource_name": "optimizer"})
+				e.Spec.ParameterSpecs.Parameters = append(e.Spec.ParameterSpecs.Parameters, conditionalParameters...)
+				return e
+			}(),
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name: "ASHA conditional resource parameter",
+			experiment: func() *api_v1_beta1.Experiment {
+				e := newASHAExperiment(map[string]string{"resource_name": "momentum"})
+				e.Spec.ParameterSpecs.Parameters = append(e.Spec.ParameterSpecs.Parameters, conditionalParameters...)
+				return e
+			}(),
+			expectedCode: codes.InvalidArgument,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			s := suggestion_goptuna_v1beta1.NewSuggestionService()
+			_, err := s.ValidateAlgorithmSettings(ctx, &api_v1_beta1.ValidateAlgorithmSettingsRequest{
+				Experiment: tt.experiment,
+			})
+			if tt.expectedCode != status.Code(err) {
+				t.Errorf("ValidateAlgorithmSettings() should return = %v, but got %v", tt.expectedCode, err)
+			}
+		})
+	}
+}
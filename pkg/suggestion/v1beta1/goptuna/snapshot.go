@@ -0,0 +1,223 @@
+/*
+Copyright 2022 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package suggestion_goptuna_v1beta1
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/c-bata/goptuna"
+	"github.com/c-bata/goptuna/cmaes"
+	"github.com/c-bata/goptuna/sobol"
+)
+
+const (
+	studySnapshotFileName = "goptuna-study.json"
+
+	// cmaesGenerationSystemAttrKey is the system attribute of the trials which CMA-ES samples in the generation.
+	cmaesGenerationSystemAttrKey = "goptuna:cmaes:generationId"
+)
+
+// studySnapshot is the state of the suggestion service which is persisted to restore the study after restart.
+type studySnapshot struct {
+	ExperimentName string `json:"experimentName"`
+	// Trials are stored without parameter distributions, they are restored from the Experiment search space.
+	Trials       []goptuna.FrozenTrial `json:"trials"`
+	TrialMapping map[string]int        `json:"trialMapping"`
+	// InitialTrialNames are the Katib trials at the study creation, e.g. prior trials to warm-start the Experiment.
+	// The restored study is created with them, so the samplers start from the same state, e.g. the CMA-ES mean.
+	InitialTrialNames []string `json:"initialTrialNames,omitempty"`
+}
+
+// loadStudySnapshot reads the snapshot from the directory. It returns nil if the snapshot doesn't exist.
+func loadStudySnapshot(dir string) (*studySnapshot, error) {
+	data, err := os.ReadFile(filepath.Join(dir, studySnapshotFileName))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	snapshot := &studySnapshot{}
+	if err = json.Unmarshal(data, snapshot); err != nil {
+		return nil, fmt.Errorf("Failed to parse study snapshot: %w", err)
+	}
+	return snapshot, nil
+}
+
+// saveStudySnapshot writes the snapshot of the study to the directory.
+// The file is replaced atomically, so the previous snapshot is kept if the service is stopped while writing.
+func saveStudySnapshot(dir, experimentName string, study *goptuna.Study, trialMapping map[string]int, initialTrialNames []string) error {
+	trials, err := study.GetTrials()
+	if err != nil {
+		return err
+	}
+	for i := range trials {
+		trials[i].Params = nil
+		trials[i].Distributions = nil
+	}
+	data, err := json.Marshal(&studySnapshot{
+		ExperimentName:    experimentName,
+		Trials:            trials,
+		TrialMapping:      trialMapping,
+		InitialTrialNames: initialTrialNames,
+	})
+	if err != nil {
+		return err
+	}
+
+	f, err := os.CreateTemp(dir, studySnapshotFileName+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	if _, err = f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+	if err = f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), filepath.Join(dir, studySnapshotFileName))
+}
+
+// restoreStudySnapshot clones the persisted trials to the new study and returns the Katib trial mapping.
+func restoreStudySnapshot(study *goptuna.Study, searchSpace map[string]interface{}, snapshot *studySnapshot) (map[string]int, error) {
+	trials := snapshot.Trials
+	sort.Slice(trials, func(i, j int) bool { return trials[i].ID < trials[j].ID })
+
+	sampledTrials := 0
+	for i := range trials {
+		trial := &trials[i]
+		if _, ok := trial.SystemAttrs[importedTrialSystemAttrKey]; !ok {
+			sampledTrials++
+		}
+		trial.Params = make(map[string]interface{}, len(trial.InternalParams))
+		trial.Distributions = make(map[string]interface{}, len(trial.InternalParams))
+		for name, value := range trial.InternalParams {
+			distribution, ok := searchSpace[name]
+			if !ok {
+				return nil, fmt.Errorf("Parameter %s of the persisted trial is not in the search space", name)
+			}
+			externalValue, err := goptuna.ToExternalRepresentation(distribution, value)
+			if err != nil {
+				return nil, err
+			}
+			trial.Params[name] = externalValue
+			trial.Distributions[name] = distribution
+		}
+	}
+
+	// CMA-ES optimizer is kept in memory, so it is replayed to continue the same generation and mean.
+	if sampler, ok := study.RelativeSampler.(*cmaes.Sampler); ok {
+		if err := replayCMAESSampler(study.Direction(), sampler, searchSpace, trials); err != nil {
+			return nil, err
+		}
+	}
+
+	trialIDs := make(map[int]int, len(trials)) // Persisted trial id -> restored trial id
+	for _, trial := range trials {
+		trialID, err := study.Storage.CloneTrial(study.ID, trial)
+		if err != nil {
+			return nil, err
+		}
+		trialIDs[trial.ID] = trialID
+	}
+
+	// Sobol sequence is kept in memory, so the points drawn for the persisted trials are skipped
+	// not to suggest the same parameters again.
+	if sampler, ok := study.RelativeSampler.(*sobol.Sampler); ok {
+		relativeSearchSpace := make(map[string]interface{}, len(searchSpace))
+		for name, distribution := range searchSpace {
+			if single, _ := goptuna.DistributionIsSingle(distribution); !single {
+				relativeSearchSpace[name] = distribution
+			}
+		}
+		for i := 0; i < sampledTrials; i++ {
+			if _, err := sampler.SampleRelative(study, goptuna.FrozenTrial{}, relativeSearchSpace); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	trialMapping := make(map[string]int, len(snapshot.TrialMapping))
+	for katibTrialName, id := range snapshot.TrialMapping {
+		trialID, ok := trialIDs[id]
+		if !ok {
+			return nil, fmt.Errorf("Persisted trial %d of Trial %s is not found", id, katibTrialName)
+		}
+		trialMapping[katibTrialName] = trialID
+	}
+	return trialMapping, nil
+}
+
+// replayCMAESSampler samples the persisted trials again in the order of the trial ids.
+// The trials which were completed before each trial started are completed in the replayed study,
+// so the optimizer is told the same solutions in the same generations as before the restart.
+// The generations of the trials are updated, since the optimizer id is random if the seed isn't set.
+func replayCMAESSampler(direction goptuna.StudyDirection, sampler *cmaes.Sampler, searchSpace map[string]interface{}, trials []goptuna.FrozenTrial) error {
+	replay, err := goptuna.CreateStudy("replay", goptuna.StudyOptionDirection(direction), goptuna.StudyOptionLogger(nil))
+	if err != nil {
+		return err
+	}
+	replayIDs := make([]int, len(trials))
+	finished := make([]bool, len(trials))
+	for i := range trials {
+		trial := &trials[i]
+		for j := range trials[:i] {
+			if finished[j] || !trials[j].State.IsFinished() || trials[j].DatetimeComplete.After(trial.DatetimeStart) {
+				continue
+			}
+			if err = replay.Storage.SetTrialValue(replayIDs[j], trials[j].Value); err != nil {
+				return err
+			}
+			if err = replay.Storage.SetTrialState(replayIDs[j], trials[j].State); err != nil {
+				return err
+			}
+			finished[j] = true
+		}
+
+		running := *trial
+		running.State = goptuna.TrialStateRunning
+		running.Value = 0
+		running.DatetimeComplete = time.Time{}
+		if replayIDs[i], err = replay.Storage.CloneTrial(replay.ID, running); err != nil {
+			return err
+		}
+		if _, ok := trial.SystemAttrs[importedTrialSystemAttrKey]; ok {
+			continue
+		}
+		frozen, err := replay.Storage.GetTrial(replayIDs[i])
+		if err != nil {
+			return err
+		}
+		if _, err = sampler.SampleRelative(replay, frozen, searchSpace); errors.Is(err, goptuna.ErrUnsupportedSearchSpace) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		if trial.SystemAttrs, err = replay.Storage.GetTrialSystemAttrs(replayIDs[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
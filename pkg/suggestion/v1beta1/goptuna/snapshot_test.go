@@ -0,0 +1,227 @@
+/*
+Copyright 2022 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package suggestion_goptuna_v1beta1
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/c-bata/goptuna"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	api_v1_beta1 "github.com/kubeflow/katib/pkg/apis/manager/v1beta1"
+)
+
+func TestSuggestionService_RestoreStudy(t *testing.T) {
+	ctx := context.TODO()
+	newExperiment := func(name, algorithmName string) *api_v1_beta1.Experiment {
+		return &api_v1_beta1.Experiment{
+			Name: name,
+			Spec: &api_v1_beta1.ExperimentSpec{
+				Algorithm: &api_v1_beta1.AlgorithmSpec{
+					AlgorithmName: algorithmName,
+					AlgorithmSettings: []*api_v1_beta1.AlgorithmSetting{
+						{
+							Name:  "random_state",
+							Value: "10",
+						},
+					},
+				},
+				Objective: &api_v1_beta1.ObjectiveSpec{
+					Type:                api_v1_beta1.ObjectiveType_MINIMIZE,
+					ObjectiveMetricName: "metric-1",
+				},
+				ParameterSpecs: &api_v1_beta1.ExperimentSpec_ParameterSpecs{
+					Parameters: []*api_v1_beta1.ParameterSpec{
+						{
+							Name:          "param-1",
+							ParameterType: api_v1_beta1.ParameterType_INT,
+							FeasibleSpace: &api_v1_beta1.FeasibleSpace{
+								Max: "10",
+								Min: "-10",
+							},
+						},
+						{
+							Name:          "param-2",
+							ParameterType: api_v1_beta1.ParameterType_DOUBLE,
+							FeasibleSpace: &api_v1_beta1.FeasibleSpace{
+								Max: "5.5",
+								Min: "-1.5",
+							},
+						},
+						{
+							Name:          "param-3",
+							ParameterType: api_v1_beta1.ParameterType_CATEGORICAL,
+							FeasibleSpace: &api_v1_beta1.FeasibleSpace{
+								List: []string{"cat1", "cat2", "cat3"},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+	newTrial := func(experiment *api_v1_beta1.Experiment, name string, assignments []*api_v1_beta1.ParameterAssignment) *api_v1_beta1.Trial {
+		return &api_v1_beta1.Trial{
+			Name: name,
+			Spec: &api_v1_beta1.TrialSpec{
+				Objective: experiment.Spec.Objective,
+				ParameterAssignments: &api_v1_beta1.TrialSpec_ParameterAssignments{
+					Assignments: assignments,
+				},
+			},
+			Status: &api_v1_beta1.TrialStatus{
+				Condition:   api_v1_beta1.TrialStatus_RUNNING,
+				Observation: &api_v1_beta1.Observation{},
+			},
+		}
+	}
+	toMaps := func(assignments []*api_v1_beta1.GetSuggestionsReply_ParameterAssignments) []map[string]string {
+		maps := make([]map[string]string, 0, len(assignments))
+		for _, pa := range assignments {
+			m := make(map[string]string, len(pa.Assignments))
+			for _, a := range pa.Assignments {
+				m[a.Name] = a.Value
+			}
+			maps = append(maps, m)
+		}
+		return maps
+	}
+	// getSuggestions requests the suggestions and appends the running Trials of the assignments.
+	getSuggestions := func(t *testing.T, s *SuggestionService, experiment *api_v1_beta1.Experiment, trials []*api_v1_beta1.Trial, requestNumber int) ([]*api_v1_beta1.Trial, []*api_v1_beta1.GetSuggestionsReply_ParameterAssignments) {
+		t.Helper()
+		reply, err := s.GetSuggestions(ctx, &api_v1_beta1.GetSuggestionsRequest{
+			Experiment:           experiment,
+			Trials:               trials,
+			CurrentRequestNumber: int32(requestNumber),
+		})
+		if err != nil {
+			t.Fatalf("GetSuggestions() returns error: %v", err)
+		}
+		for _, pa := range reply.ParameterAssignments {
+			trials = append(trials, newTrial(experiment, fmt.Sprintf("trial-%d", len(trials)), pa.Assignments))
+		}
+		return trials, reply.ParameterAssignments
+	}
+	// runBeforeRestart suggests 4 Trials, completes 2 of them and suggests 2 Trials again.
+	runBeforeRestart := func(t *testing.T, s *SuggestionService, experiment *api_v1_beta1.Experiment) []*api_v1_beta1.Trial {
+		t.Helper()
+		trials, _ := getSuggestions(t, s, experiment, nil, 4)
+		for i, trial := range trials[:2] {
+			trial.Status.Condition = api_v1_beta1.TrialStatus_SUCCEEDED
+			trial.Status.Observation.Metrics = []*api_v1_beta1.Metric{
+				{Name: "metric-1", Value: fmt.Sprintf("%d", i)},
+			}
+		}
+		trials, _ = getSuggestions(t, s, experiment, trials, 2)
+		return trials
+	}
+
+	for _, algorithmName := range []string{"random", "cmaes", "sobol"} {
+		t.Run(algorithmName, func(t *testing.T) {
+			dir := t.TempDir()
+			experiment := newExperiment("test", algorithmName)
+			if algorithmName == AlgorithmCMAES {
+				// Completed Trials of the population update the distribution before the restart.
+				experiment.Spec.Algorithm.AlgorithmSettings = append(experiment.Spec.Algorithm.AlgorithmSettings,
+					&api_v1_beta1.AlgorithmSetting{Name: "population_size", Value: "2"})
+				// Categorical parameter isn't sampled by CMA-ES, but by the independent sampler without the seed.
+				experiment.Spec.ParameterSpecs.Parameters = experiment.Spec.ParameterSpecs.Parameters[:2]
+			}
+
+			s, err := NewSuggestionServiceWithStudyDir(dir)
+			if err != nil {
+				t.Fatalf("NewSuggestionServiceWithStudyDir() returns error: %v", err)
+			}
+			trials := runBeforeRestart(t, s, experiment)
+			if _, err = os.Stat(filepath.Join(dir, studySnapshotFileName)); err != nil {
+				t.Fatalf("Study is not persisted: %v", err)
+			}
+			wantTrialMapping := s.trialMapping
+			wantTrials, err := s.study.GetTrials()
+			if err != nil {
+				t.Fatalf("Failed to get Goptuna trials: %v", err)
+			}
+
+			// The restarted service restores the study and accepts the Trials suggested before the restart.
+			restarted, err := NewSuggestionServiceWithStudyDir(dir)
+			if err != nil {
+				t.Fatalf("NewSuggestionServiceWithStudyDir() returns error after restart: %v", err)
+			}
+			_, assignments := getSuggestions(t, restarted, experiment, trials, 2)
+			for name, id := range wantTrialMapping {
+				if restarted.trialMapping[name] != id {
+					t.Errorf("Trial %s is mapped to %d after restart, want %d", name, restarted.trialMapping[name], id)
+				}
+			}
+			gotTrials, err := restarted.study.GetTrials()
+			if err != nil {
+				t.Fatalf("Failed to get Goptuna trials after restart: %v", err)
+			}
+			if diff := cmp.Diff(wantTrials, gotTrials[:len(wantTrials)], cmpopts.IgnoreFields(goptuna.FrozenTrial{}, "StudyID")); diff != "" {
+				t.Errorf("Unexpected restored trials (-want,+got):\n%s", diff)
+			}
+			if algorithmName == AlgorithmRandom {
+				return
+			}
+
+			// CMA-ES continues the same generation and mean, and Sobol sequence continues from the point before the restart.
+			// So the restarted service suggests the same parameters as the service which isn't restarted.
+			reference := NewSuggestionService()
+			_, wantAssignments := getSuggestions(t, reference, experiment, runBeforeRestart(t, reference, experiment), 2)
+			if diff := cmp.Diff(toMaps(wantAssignments), toMaps(assignments)); diff != "" {
+				t.Errorf("Unexpected suggestions after restart (-want,+got):\n%s", diff)
+			}
+			wantTrials, err = reference.study.GetTrials()
+			if err != nil {
+				t.Fatalf("Failed to get Goptuna trials of the reference: %v", err)
+			}
+			if diff := cmp.Diff(wantTrials, gotTrials, cmpopts.IgnoreFields(goptuna.FrozenTrial{}, "StudyID", "DatetimeStart", "DatetimeComplete")); diff != "" {
+				t.Errorf("Unexpected trials after restart (-want,+got):\n%s", diff)
+			}
+		})
+	}
+
+	t.Run("Study of another Experiment is not restored", func(t *testing.T) {
+		dir := t.TempDir()
+		s, err := NewSuggestionServiceWithStudyDir(dir)
+		if err != nil {
+			t.Fatalf("NewSuggestionServiceWithStudyDir() returns error: %v", err)
+		}
+		runBeforeRestart(t, s, newExperiment("test", "random"))
+
+		restarted, err := NewSuggestionServiceWithStudyDir(dir)
+		if err != nil {
+			t.Fatalf("NewSuggestionServiceWithStudyDir() returns error after restart: %v", err)
+		}
+		getSuggestions(t, restarted, newExperiment("another", "random"), nil, 1)
+		trials, err := restarted.study.GetTrials()
+		if err != nil {
+			t.Fatalf("Failed to get Goptuna trials: %v", err)
+		}
+		if len(trials) != 1 || len(restarted.trialMapping) != 0 {
+			t.Errorf("Study of another Experiment must not be restored, got %d trials and mapping %v", len(trials), restarted.trialMapping)
+		}
+		if trials[0].State != goptuna.TrialStateRunning {
+			t.Errorf("Unexpected state of the new trial: %v", trials[0].State)
+		}
+	})
+}
@@ -0,0 +1,503 @@
+/*
+Copyright 2022 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package constraint implements parameter constraints of the Experiment.
+//
+// Constraint is the boolean expression in the Go syntax over the parameter names,
+// for example "batch_size * grad_accum <= 512" or "hidden_dim % num_heads == 0".
+// Parameters which names are not valid identifiers can be referenced as params["num-layers"].
+// Supported operators are arithmetic (+, -, *, /, %), comparison (==, !=, <, <=, >, >=)
+// and logical (&&, ||, !), supported functions are abs, min, max, floor, ceil, sqrt, log and pow.
+package constraint
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/parser"
+	"go/token"
+	"math"
+	"strconv"
+)
+
+// paramsIdent is the name of the map to reference parameters which names are not valid identifiers.
+const paramsIdent = "params"
+
+var (
+	errTypeMismatch    = errors.New("type mismatch")
+	errUnsupportedExpr = errors.New("unsupported expression")
+)
+
+// Constraint is the compiled parameter constraint.
+type Constraint struct {
+	expression string
+	expr       ast.Expr
+	parameters []string
+}
+
+// Compile parses the constraint expression.
+func Compile(expression string) (*Constraint, error) {
+	expr, err := parser.ParseExpr(expression)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse constraint %q: %w", expression, err)
+	}
+	c := &Constraint{
+		expression: expression,
+		expr:       expr,
+	}
+	seen := map[string]bool{}
+	if err = c.collectParameters(expr, seen); err != nil {
+		return nil, fmt.Errorf("invalid constraint %q: %w", expression, err)
+	}
+	k, err := checkKind(expr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid constraint %q: %w", expression, err)
+	}
+	if k != kindBool {
+		return nil, fmt.Errorf("constraint %q must be boolean expression", expression)
+	}
+	return c, nil
+}
+
+// CompileAll parses all constraint expressions.
+func CompileAll(expressions []string) ([]*Constraint, error) {
+	constraints := make([]*Constraint, 0, len(expressions))
+	for _, expression := range expressions {
+		c, err := Compile(expression)
+		if err != nil {
+			return nil, err
+		}
+		constraints = append(constraints, c)
+	}
+	return constraints, nil
+}
+
+// String returns the original expression of the constraint.
+func (c *Constraint) String() string {
+	return c.expression
+}
+
+// Parameters returns names of parameters referenced by the constraint.
+func (c *Constraint) Parameters() []string {
+	return c.parameters
+}
+
+// IsSatisfied evaluates the constraint for the parameter assignments, where key is the parameter name.
+// Constraint is always satisfied if any referenced parameter is not assigned, e.g. inactive conditional parameter.
+func (c *Constraint) IsSatisfied(assignments map[string]string) (bool, error) {
+	for _, name := range c.parameters {
+		if _, ok := assignments[name]; !ok {
+			return true, nil
+		}
+	}
+	v, err := eval(c.expr, assignments)
+	if err != nil {
+		return false, fmt.Errorf("failed to evaluate constraint %q: %w", c.expression, err)
+	}
+	if v.kind != kindBool {
+		return false, fmt.Errorf("constraint %q must be boolean expression", c.expression)
+	}
+	return v.b, nil
+}
+
+// IsSatisfiedAll returns true if all constraints are satisfied for the parameter assignments.
+// Returned string is the expression of the first violated constraint.
+func IsSatisfiedAll(constraints []*Constraint, assignments map[string]string) (bool, string, error) {
+	for _, c := range constraints {
+		ok, err := c.IsSatisfied(assignments)
+		if err != nil {
+			return false, c.String(), err
+		}
+		if !ok {
+			return false, c.String(), nil
+		}
+	}
+	return true, "", nil
+}
+
+func (c *Constraint) collectParameters(expr ast.Expr, seen map[string]bool) error {
+	addParameter := func(name string) {
+		if !seen[name] {
+			seen[name] = true
+			c.parameters = append(c.parameters, name)
+		}
+	}
+	switch e := expr.(type) {
+	case *ast.BasicLit:
+		if e.Kind != token.INT && e.Kind != token.FLOAT && e.Kind != token.STRING {
+			return fmt.Errorf("%w: literal %s", errUnsupportedExpr, e.Value)
+		}
+	case *ast.Ident:
+		if e.Name != "true" && e.Name != "false" {
+			addParameter(e.Name)
+		}
+	case *ast.IndexExpr:
+		name, err := paramName(e)
+		if err != nil {
+			return err
+		}
+		addParameter(name)
+	case *ast.ParenExpr:
+		return c.collectParameters(e.X, seen)
+	case *ast.UnaryExpr:
+		if e.Op != token.SUB && e.Op != token.ADD && e.Op != token.NOT {
+			return fmt.Errorf("%w: operator %s", errUnsupportedExpr, e.Op)
+		}
+		return c.collectParameters(e.X, seen)
+	case *ast.BinaryExpr:
+		switch e.Op {
+		case token.ADD, token.SUB, token.MUL, token.QUO, token.REM,
+			token.EQL, token.NEQ, token.LSS, token.LEQ, token.GTR, token.GEQ,
+			token.LAND, token.LOR:
+		default:
+			return fmt.Errorf("%w: operator %s", errUnsupportedExpr, e.Op)
+		}
+		if err := c.collectParameters(e.X, seen); err != nil {
+			return err
+		}
+		return c.collectParameters(e.Y, seen)
+	case *ast.CallExpr:
+		fn, ok := e.Fun.(*ast.Ident)
+		if !ok {
+			return fmt.Errorf("%w: function call", errUnsupportedExpr)
+		}
+		arity, ok := functionArity[fn.Name]
+		if !ok {
+			return fmt.Errorf("%w: function %s", errUnsupportedExpr, fn.Name)
+		}
+		if arity >= 0 && len(e.Args) != arity || arity < 0 && len(e.Args) == 0 {
+			return fmt.Errorf("invalid number of arguments for function %s: %d", fn.Name, len(e.Args))
+		}
+		for _, arg := range e.Args {
+			if err := c.collectParameters(arg, seen); err != nil {
+				return err
+			}
+		}
+	default:
+		return fmt.Errorf("%w: %T", errUnsupportedExpr, expr)
+	}
+	return nil
+}
+
+// checkKind returns the kind of the expression without the parameter assignments.
+// Parameter values are numbers or strings, so they are checked as numbers
+// and only string literals are rejected as operands of arithmetic operators and functions.
+func checkKind(expr ast.Expr) (kind, error) {
+	switch e := expr.(type) {
+	case *ast.BasicLit:
+		if e.Kind == token.STRING {
+			return kindString, nil
+		}
+		return kindNumber, nil
+	case *ast.Ident:
+		if e.Name == "true" || e.Name == "false" {
+			return kindBool, nil
+		}
+		return kindNumber, nil
+	case *ast.IndexExpr:
+		return kindNumber, nil
+	case *ast.ParenExpr:
+		return checkKind(e.X)
+	case *ast.UnaryExpr:
+		x, err := checkKind(e.X)
+		if err != nil {
+			return 0, err
+		}
+		if e.Op == token.NOT && x == kindBool || e.Op != token.NOT && x == kindNumber {
+			return x, nil
+		}
+		return 0, fmt.Errorf("%w: operator %s", errTypeMismatch, e.Op)
+	case *ast.BinaryExpr:
+		x, err := checkKind(e.X)
+		if err != nil {
+			return 0, err
+		}
+		y, err := checkKind(e.Y)
+		if err != nil {
+			return 0, err
+		}
+		switch e.Op {
+		case token.LAND, token.LOR:
+			if x == kindBool && y == kindBool {
+				return kindBool, nil
+			}
+		case token.EQL, token.NEQ:
+			if (x == kindBool) == (y == kindBool) {
+				return kindBool, nil
+			}
+		case token.LSS, token.LEQ, token.GTR, token.GEQ:
+			if x != kindBool && y != kindBool {
+				return kindBool, nil
+			}
+		default:
+			if x == kindNumber && y == kindNumber {
+				return kindNumber, nil
+			}
+		}
+		return 0, fmt.Errorf("%w: operator %s", errTypeMismatch, e.Op)
+	case *ast.CallExpr:
+		for _, arg := range e.Args {
+			k, err := checkKind(arg)
+			if err != nil {
+				return 0, err
+			}
+			if k != kindNumber {
+				return 0, fmt.Errorf("%w: arguments of function %s must be numbers", errTypeMismatch, e.Fun.(*ast.Ident).Name)
+			}
+		}
+		return kindNumber, nil
+	}
+	return 0, fmt.Errorf("%w: %T", errUnsupportedExpr, expr)
+}
+
+// paramName returns the parameter name from the params["name"] expression.
+func paramName(e *ast.IndexExpr) (string, error) {
+	ident, ok := e.X.(*ast.Ident)
+	lit, isLit := e.Index.(*ast.BasicLit)
+	if !ok || ident.Name != paramsIdent || !isLit || lit.Kind != token.STRING {
+		return "", fmt.Errorf("%w: index expression must be %s[\"<parameter name>\"]", errUnsupportedExpr, paramsIdent)
+	}
+	return strconv.Unquote(lit.Value)
+}
+
+type kind int
+
+const (
+	kindNumber kind = iota
+	kindString
+	kindBool
+)
+
+// value is the result of the expression. Parameter values which can be parsed as number
+// keep the original string, so they can be compared with string literals.
+type value struct {
+	kind kind
+	num  float64
+	str  string
+	b    bool
+}
+
+func numberValue(num float64) value {
+	return value{kind: kindNumber, num: num}
+}
+
+func boolValue(b bool) value {
+	return value{kind: kindBool, b: b}
+}
+
+// parameterValue converts the assigned value to number if it is possible.
+func parameterValue(s string) value {
+	if num, err := strconv.ParseFloat(s, 64); err == nil {
+		return value{kind: kindNumber, num: num, str: s}
+	}
+	return value{kind: kindString, str: s}
+}
+
+// functionArity is the number of arguments for the supported functions, -1 means any number.
+var functionArity = map[string]int{
+	"abs":   1,
+	"floor": 1,
+	"ceil":  1,
+	"sqrt":  1,
+	"log":   1,
+	"pow":   2,
+	"min":   -1,
+	"max":   -1,
+}
+
+func eval(expr ast.Expr, assignments map[string]string) (value, error) {
+	switch e := expr.(type) {
+	case *ast.BasicLit:
+		if e.Kind == token.STRING {
+			s, err := strconv.Unquote(e.Value)
+			if err != nil {
+				return value{}, err
+			}
+			return value{kind: kindString, str: s}, nil
+		}
+		num, _ := constant.Float64Val(constant.MakeFromLiteral(e.Value, e.Kind, 0))
+		return value{kind: kindNumber, num: num, str: e.Value}, nil
+	case *ast.Ident:
+		if e.Name == "true" || e.Name == "false" {
+			return boolValue(e.Name == "true"), nil
+		}
+		return parameterValue(assignments[e.Name]), nil
+	case *ast.IndexExpr:
+		name, err := paramName(e)
+		if err != nil {
+			return value{}, err
+		}
+		return parameterValue(assignments[name]), nil
+	case *ast.ParenExpr:
+		return eval(e.X, assignments)
+	case *ast.UnaryExpr:
+		x, err := eval(e.X, assignments)
+		if err != nil {
+			return value{}, err
+		}
+		switch {
+		case e.Op == token.NOT && x.kind == kindBool:
+			return boolValue(!x.b), nil
+		case e.Op == token.SUB && x.kind == kindNumber:
+			return numberValue(-x.num), nil
+		case e.Op == token.ADD && x.kind == kindNumber:
+			return numberValue(x.num), nil
+		}
+		return value{}, fmt.Errorf("%w: operator %s", errTypeMismatch, e.Op)
+	case *ast.BinaryExpr:
+		return evalBinary(e, assignments)
+	case *ast.CallExpr:
+		return evalCall(e, assignments)
+	}
+	return value{}, fmt.Errorf("%w: %T", errUnsupportedExpr, expr)
+}
+
+func evalBinary(e *ast.BinaryExpr, assignments map[string]string) (value, error) {
+	x, err := eval(e.X, assignments)
+	if err != nil {
+		return value{}, err
+	}
+	// Logical operators are short-circuited.
+	if e.Op == token.LAND || e.Op == token.LOR {
+		if x.kind != kindBool {
+			return value{}, fmt.Errorf("%w: operator %s", errTypeMismatch, e.Op)
+		}
+		if e.Op == token.LAND && !x.b || e.Op == token.LOR && x.b {
+			return x, nil
+		}
+		y, err := eval(e.Y, assignments)
+		if err != nil {
+			return value{}, err
+		}
+		if y.kind != kindBool {
+			return value{}, fmt.Errorf("%w: operator %s", errTypeMismatch, e.Op)
+		}
+		return y, nil
+	}
+
+	y, err := eval(e.Y, assignments)
+	if err != nil {
+		return value{}, err
+	}
+	switch e.Op {
+	case token.EQL, token.NEQ:
+		var equal bool
+		switch {
+		case x.kind == kindNumber && y.kind == kindNumber:
+			equal = x.num == y.num
+		case x.kind == kindBool && y.kind == kindBool:
+			equal = x.b == y.b
+		case x.kind != kindBool && y.kind != kindBool && (x.kind == kindString || x.str != "") && (y.kind == kindString || y.str != ""):
+			// Categorical values are compared as strings, e.g. batch_size == "32".
+			equal = x.str == y.str
+		default:
+			return value{}, fmt.Errorf("%w: operator %s", errTypeMismatch, e.Op)
+		}
+		return boolValue(equal == (e.Op == token.EQL)), nil
+	}
+
+	if x.kind == kindString && y.kind == kindString {
+		switch e.Op {
+		case token.LSS:
+			return boolValue(x.str < y.str), nil
+		case token.LEQ:
+			return boolValue(x.str <= y.str), nil
+		case token.GTR:
+			return boolValue(x.str > y.str), nil
+		case token.GEQ:
+			return boolValue(x.str >= y.str), nil
+		}
+	}
+	if x.kind != kindNumber || y.kind != kindNumber {
+		return value{}, fmt.Errorf("%w: operator %s", errTypeMismatch, e.Op)
+	}
+	switch e.Op {
+	case token.ADD:
+		return numberValue(x.num + y.num), nil
+	case token.SUB:
+		return numberValue(x.num - y.num), nil
+	case token.MUL:
+		return numberValue(x.num * y.num), nil
+	case token.QUO:
+		if y.num == 0 {
+			return value{}, errors.New("division by zero")
+		}
+		return numberValue(x.num / y.num), nil
+	case token.REM:
+		if y.num == 0 {
+			return value{}, errors.New("division by zero")
+		}
+		return numberValue(math.Mod(x.num, y.num)), nil
+	case token.LSS:
+		return boolValue(x.num < y.num), nil
+	case token.LEQ:
+		return boolValue(x.num <= y.num), nil
+	case token.GTR:
+		return boolValue(x.num > y.num), nil
+	case token.GEQ:
+		return boolValue(x.num >= y.num), nil
+	}
+	return value{}, fmt.Errorf("%w: operator %s", errUnsupportedExpr, e.Op)
+}
+
+func evalCall(e *ast.CallExpr, assignments map[string]string) (value, error) {
+	fn, ok := e.Fun.(*ast.Ident)
+	if !ok {
+		return value{}, fmt.Errorf("%w: function call", errUnsupportedExpr)
+	}
+	args := make([]float64, 0, len(e.Args))
+	for _, arg := range e.Args {
+		v, err := eval(arg, assignments)
+		if err != nil {
+			return value{}, err
+		}
+		if v.kind != kindNumber {
+			return value{}, fmt.Errorf("%w: arguments of function %s must be numbers", errTypeMismatch, fn.Name)
+		}
+		args = append(args, v.num)
+	}
+	if arity, ok := functionArity[fn.Name]; !ok || arity >= 0 && len(args) != arity || len(args) == 0 {
+		return value{}, fmt.Errorf("%w: function %s with %d arguments", errUnsupportedExpr, fn.Name, len(args))
+	}
+	switch fn.Name {
+	case "abs":
+		return numberValue(math.Abs(args[0])), nil
+	case "floor":
+		return numberValue(math.Floor(args[0])), nil
+	case "ceil":
+		return numberValue(math.Ceil(args[0])), nil
+	case "sqrt":
+		return numberValue(math.Sqrt(args[0])), nil
+	case "log":
+		return numberValue(math.Log(args[0])), nil
+	case "pow":
+		return numberValue(math.Pow(args[0], args[1])), nil
+	case "min":
+		result := args[0]
+		for _, arg := range args[1:] {
+			result = math.Min(result, arg)
+		}
+		return numberValue(result), nil
+	case "max":
+		result := args[0]
+		for _, arg := range args[1:] {
+			result = math.Max(result, arg)
+		}
+		return numberValue(result), nil
+	}
+	return value{}, fmt.Errorf("%w: function %s", errUnsupportedExpr, fn.Name)
+}
@@ -0,0 +1,169 @@
+/*
+Copyright 2022 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package constraint
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestCompile(t *testing.T) {
+	cases := map[string]struct {
+		expression     string
+		wantParameters []string
+		wantErr        bool
+	}{
+		"Arithmetic and comparison": {
+			expression:     "batch_size * grad_accum <= 512",
+			wantParameters: []string{"batch_size", "grad_accum"},
+		},
+		"Parameters with invalid identifier names": {
+			expression:     `params["num-layers"] * 2 < max(params["hidden-dim"], 8)`,
+			wantParameters: []string{"num-layers", "hidden-dim"},
+		},
+		"Logical operators and duplicate parameters": {
+			expression:     `optimizer == "sgd" && lr < 0.1 || optimizer != "sgd" && !(lr > 0.01)`,
+			wantParameters: []string{"optimizer", "lr"},
+		},
+		"Syntax error": {
+			expression: "batch_size * <= 512",
+			wantErr:    true,
+		},
+		"Unsupported operator": {
+			expression: "batch_size << 2 == 512",
+			wantErr:    true,
+		},
+		"Unsupported function": {
+			expression: "exp(lr) < 2",
+			wantErr:    true,
+		},
+		"Invalid number of function arguments": {
+			expression: "pow(lr) < 2",
+			wantErr:    true,
+		},
+		"Unsupported index expression": {
+			expression: `lr["value"] < 2`,
+			wantErr:    true,
+		},
+		"Expression is not boolean": {
+			expression: "lr * 2",
+			wantErr:    true,
+		},
+		"Logical operator with number": {
+			expression: "lr && momentum > 0.5",
+			wantErr:    true,
+		},
+		"Arithmetic with string literal": {
+			expression: `lr * "2" < 1`,
+			wantErr:    true,
+		},
+		"Comparison of boolean with number": {
+			expression: "(lr < 0.1) == 1",
+			wantErr:    true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c, err := Compile(tc.expression)
+			if tc.wantErr != (err != nil) {
+				t.Fatalf("Unexpected error from Compile, want error: %v, got: %v", tc.wantErr, err)
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(tc.wantParameters, c.Parameters()); len(diff) != 0 {
+				t.Errorf("Unexpected parameters (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsSatisfied(t *testing.T) {
+	cases := map[string]struct {
+		expression  string
+		assignments map[string]string
+		want        bool
+		wantErr     bool
+	}{
+		"Product is less than limit": {
+			expression:  "batch_size * grad_accum <= 512",
+			assignments: map[string]string{"batch_size": "64", "grad_accum": "8"},
+			want:        true,
+		},
+		"Product is greater than limit": {
+			expression:  "batch_size * grad_accum <= 512",
+			assignments: map[string]string{"batch_size": "128", "grad_accum": "8"},
+			want:        false,
+		},
+		"Divisor": {
+			expression:  "hidden_dim % num_heads == 0",
+			assignments: map[string]string{"hidden_dim": "768", "num_heads": "12"},
+			want:        true,
+		},
+		"Not divisor": {
+			expression:  "hidden_dim % num_heads == 0",
+			assignments: map[string]string{"hidden_dim": "768", "num_heads": "10"},
+			want:        false,
+		},
+		"Categorical value": {
+			expression:  `optimizer != "adam" || lr <= 0.01`,
+			assignments: map[string]string{"optimizer": "adam", "lr": "0.05"},
+			want:        false,
+		},
+		"Numeric categorical value is compared as string": {
+			expression:  `batch_size == "32"`,
+			assignments: map[string]string{"batch_size": "32"},
+			want:        true,
+		},
+		"Functions": {
+			expression:  `pow(2, params["num-layers"]) <= max(min(units, 64), 16)`,
+			assignments: map[string]string{"num-layers": "4", "units": "128"},
+			want:        true,
+		},
+		"Parameter is not assigned": {
+			expression:  "momentum < lr",
+			assignments: map[string]string{"lr": "0.01"},
+			want:        true,
+		},
+		"Arithmetic with string": {
+			expression:  "optimizer * 2 > 1",
+			assignments: map[string]string{"optimizer": "sgd"},
+			wantErr:     true,
+		},
+		"Division by zero": {
+			expression:  "hidden_dim % num_heads == 0",
+			assignments: map[string]string{"hidden_dim": "768", "num_heads": "0"},
+			wantErr:     true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c, err := Compile(tc.expression)
+			if err != nil {
+				t.Fatalf("Failed to compile constraint: %v", err)
+			}
+			got, err := c.IsSatisfied(tc.assignments)
+			if tc.wantErr != (err != nil) {
+				t.Fatalf("Unexpected error from IsSatisfied, want error: %v, got: %v", tc.wantErr, err)
+			}
+			if got != tc.want {
+				t.Errorf("Unexpected result from IsSatisfied, want: %v, got: %v", tc.want, got)
+			}
+		})
+	}
+}
@@ -23,6 +23,7 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
 	jsonPatch "github.com/mattbaird/jsonpatch"
 	batchv1 "k8s.io/api/batch/v1"
@@ -40,6 +41,7 @@ import (
 	experimentutil "github.com/kubeflow/katib/pkg/controller.v1beta1/experiment/util"
 	util "github.com/kubeflow/katib/pkg/controller.v1beta1/util"
 	mccommon "github.com/kubeflow/katib/pkg/metricscollector/v1beta1/common"
+	"github.com/kubeflow/katib/pkg/util/v1beta1/constraint"
 )
 
 var (
@@ -51,8 +53,13 @@ var (
 	earlyStoppingPath    = specPath.Child("earlyStopping")
 	resumePolicyPath     = specPath.Child("resumePolicy")
 	parametersPath       = specPath.Child("parameters")
+	constraintsPath      = specPath.Child("parameterConstraints")
+	warmStartPath        = specPath.Child("warmStart")
+	duplicatePath        = specPath.Child("duplicateSuggestion")
+	trialCachePath       = specPath.Child("trialCache")
 	trialTemplatePath    = specPath.Child("trialTemplate")
 	trialParametersPath  = trialTemplatePath.Child("trialParameters")
+	retryPolicyPath      = trialTemplatePath.Child("retryPolicy")
 	metricsCollectorPath = specPath.Child("metricsCollectorSpec")
 	metricsSourcePath    = metricsCollectorPath.Child("source")
 )
@@ -101,6 +108,49 @@ func (g *DefaultValidator) ValidateExperiment(instance, oldInst *experimentsv1be
 		allErrs = append(allErrs, field.Invalid(specPath.Child("parallelTrialCount"), *instance.Spec.ParallelTrialCount, "must be greater than 0"))
 	}
 
+	if adaptive := instance.Spec.AdaptiveParallelism; adaptive != nil {
+		adaptivePath := specPath.Child("adaptiveParallelism")
+		if adaptive.MinParallelTrialCount <= 0 {
+			allErrs = append(allErrs, field.Invalid(adaptivePath.Child("minParallelTrialCount"), adaptive.MinParallelTrialCount, "must be greater than 0"))
+		}
+		if adaptive.MaxParallelTrialCount < adaptive.MinParallelTrialCount {
+			allErrs = append(allErrs, field.Invalid(adaptivePath.Child("maxParallelTrialCount"), adaptive.MaxParallelTrialCount,
+				"should be greater than or equal to spec.adaptiveParallelism.minParallelTrialCount"))
+		}
+		if adaptive.PendingTimeout != nil && adaptive.PendingTimeout.Duration <= 0 {
+			allErrs = append(allErrs, field.Invalid(adaptivePath.Child("pendingTimeout"), adaptive.PendingTimeout.Duration.String(), "must be greater than 0"))
+		}
+	}
+
+	if instance.Spec.MaxDuration != nil && instance.Spec.MaxDuration.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("maxDuration"), instance.Spec.MaxDuration.Duration.String(), "must be greater than 0"))
+	}
+	if instance.Spec.MaxResourceUsage != nil {
+		if instance.Spec.MaxResourceUsage.ResourceName == "" {
+			allErrs = append(allErrs, field.Required(specPath.Child("maxResourceUsage").Child("resourceName"), "must be specified"))
+		}
+		if instance.Spec.MaxResourceUsage.Hours <= 0 {
+			allErrs = append(allErrs, field.Invalid(specPath.Child("maxResourceUsage").Child("hours"), instance.Spec.MaxResourceUsage.Hours, "must be greater than 0"))
+		}
+	}
+	if err := g.validateActiveTrialsPolicy(instance.Spec.BudgetPolicy, specPath.Child("budgetPolicy")); err != nil {
+		allErrs = append(allErrs, err...)
+	}
+	if err := g.validateActiveTrialsPolicy(instance.Spec.SuspendPolicy, specPath.Child("suspendPolicy")); err != nil {
+		allErrs = append(allErrs, err...)
+	}
+	if instance.Spec.Queueing != nil && instance.Spec.Queueing.Weight != nil && *instance.Spec.Queueing.Weight <= 0 {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("queueing").Child("weight"), *instance.Spec.Queueing.Weight, "must be greater than 0"))
+	}
+	if instance.Spec.Convergence != nil {
+		if instance.Spec.Convergence.Patience <= 0 {
+			allErrs = append(allErrs, field.Invalid(specPath.Child("convergence").Child("patience"), instance.Spec.Convergence.Patience, "must be greater than 0"))
+		}
+		if instance.Spec.Convergence.MinDelta != nil && *instance.Spec.Convergence.MinDelta < 0 {
+			allErrs = append(allErrs, field.Invalid(specPath.Child("convergence").Child("minDelta"), *instance.Spec.Convergence.MinDelta, "should not be less than 0"))
+		}
+	}
+
 	if instance.Spec.MaxFailedTrialCount != nil && instance.Spec.MaxTrialCount != nil {
 		if *instance.Spec.MaxFailedTrialCount > *instance.Spec.MaxTrialCount {
 			allErrs = append(allErrs, field.Invalid(specPath.Child("maxFailedTrialCount"), *instance.Spec.MaxFailedTrialCount,
@@ -117,8 +167,12 @@ func (g *DefaultValidator) ValidateExperiment(instance, oldInst *experimentsv1be
 	if oldInst != nil {
 		// We should validate restart only if appropriate fields are changed.
 		// Otherwise check below is triggered when experiment is deleted.
+		// Suspending or resuming the experiment is not restarting.
 		isRestarting := false
-		if !equality.Semantic.DeepEqual(instance.Spec, oldInst.Spec) {
+		newSpec := instance.Spec.DeepCopy()
+		newSpec.Suspend = oldInst.Spec.Suspend
+		newSpec.SuspendPolicy = oldInst.Spec.SuspendPolicy
+		if !equality.Semantic.DeepEqual(*newSpec, oldInst.Spec) {
 			isRestarting = true
 		}
 
@@ -137,8 +191,11 @@ func (g *DefaultValidator) ValidateExperiment(instance, oldInst *experimentsv1be
 		oldInst.Spec.MaxFailedTrialCount = instance.Spec.MaxFailedTrialCount
 		oldInst.Spec.MaxTrialCount = instance.Spec.MaxTrialCount
 		oldInst.Spec.ParallelTrialCount = instance.Spec.ParallelTrialCount
+		oldInst.Spec.Suspend = instance.Spec.Suspend
+		oldInst.Spec.SuspendPolicy = instance.Spec.SuspendPolicy
 		if !equality.Semantic.DeepEqual(instance.Spec, oldInst.Spec) {
-			allErrs = append(allErrs, field.Forbidden(specPath, "only spec.parallelTrialCount, spec.maxTrialCount and spec.maxFailedTrialCount are editable"))
+			allErrs = append(allErrs, field.Forbidden(specPath, "only spec.parallelTrialCount, spec.maxTrialCount, spec.maxFailedTrialCount, "+
+				"spec.suspend and spec.suspendPolicy are editable"))
 		}
 	}
 	if err := g.validateObjective(instance.Spec.Objective); err != nil {
@@ -174,6 +231,42 @@ func (g *DefaultValidator) ValidateExperiment(instance, oldInst *experimentsv1be
 		}
 	}
 
+	if len(instance.Spec.ParameterConstraints) > 0 {
+		if err := g.validateParameterConstraints(instance); err != nil {
+			allErrs = append(allErrs, err...)
+		}
+	}
+
+	if instance.Spec.WarmStart != nil {
+		if err := g.validateWarmStart(instance); err != nil {
+			allErrs = append(allErrs, err...)
+		}
+	}
+
+	if instance.Spec.DuplicateSuggestion != nil {
+		if err := g.validateDuplicateSuggestion(instance.Spec.DuplicateSuggestion); err != nil {
+			allErrs = append(allErrs, err...)
+		}
+	}
+
+	if instance.Spec.TrialCache != nil {
+		if err := g.validateTrialCache(instance.Spec.TrialCache); err != nil {
+			allErrs = append(allErrs, err...)
+		}
+	}
+
+	if instance.Spec.TrialTemplate != nil && instance.Spec.TrialTemplate.RetryPolicy != nil {
+		if err := g.validateRetryPolicy(instance.Spec.TrialTemplate.RetryPolicy); err != nil {
+			allErrs = append(allErrs, err...)
+		}
+	}
+
+	if instance.Spec.TrialTemplate != nil {
+		if err := g.validateTrialDeadlines(instance.Spec.TrialTemplate); err != nil {
+			allErrs = append(allErrs, err...)
+		}
+	}
+
 	if err := g.validateMetricsCollector(instance); err != nil {
 		allErrs = append(allErrs, err...)
 	}
@@ -198,6 +291,36 @@ func (g *DefaultValidator) validateObjective(obj *commonapiv1beta1.ObjectiveSpec
 		allErrs = append(allErrs, field.Invalid(objectivePath.Child("additionalMetricNames"),
 			obj.AdditionalMetricNames, "should not contain spec.objective.objectiveMetricName"))
 	}
+	if len(obj.AdditionalObjectives) != 0 {
+		allErrs = append(allErrs, validateAdditionalObjectives(obj)...)
+	}
+	return allErrs
+}
+
+func validateAdditionalObjectives(obj *commonapiv1beta1.ObjectiveSpec) field.ErrorList {
+	var allErrs field.ErrorList
+
+	// Pareto front of the multi-objective Experiment can't be compared with the single goal.
+	if obj.Goal != nil {
+		allErrs = append(allErrs, field.Invalid(objectivePath.Child("goal"), *obj.Goal,
+			"must not be specified with spec.objective.additionalObjectives"))
+	}
+	metricNames := map[string]bool{obj.ObjectiveMetricName: true}
+	for i, additionalObjective := range obj.AdditionalObjectives {
+		additionalObjectivePath := objectivePath.Child("additionalObjectives").Index(i)
+		if additionalObjective.Type != commonapiv1beta1.ObjectiveTypeMinimize && additionalObjective.Type != commonapiv1beta1.ObjectiveTypeMaximize {
+			allErrs = append(allErrs, field.Invalid(additionalObjectivePath.Child("type"), additionalObjective.Type,
+				fmt.Sprintf("must be %s or %s", commonapiv1beta1.ObjectiveTypeMinimize, commonapiv1beta1.ObjectiveTypeMaximize)))
+		}
+		if additionalObjective.ObjectiveMetricName == "" {
+			allErrs = append(allErrs, field.Required(additionalObjectivePath.Child("objectiveMetricName"), "must be specified"))
+			continue
+		}
+		if metricNames[additionalObjective.ObjectiveMetricName] {
+			allErrs = append(allErrs, field.Duplicate(additionalObjectivePath.Child("objectiveMetricName"), additionalObjective.ObjectiveMetricName))
+		}
+		metricNames[additionalObjective.ObjectiveMetricName] = true
+	}
 	return allErrs
 }
 
@@ -252,9 +375,28 @@ func (g *DefaultValidator) validateResumePolicy(resume experimentsv1beta1.Resume
 	return allErrs
 }
 
+func (g *DefaultValidator) validateActiveTrialsPolicy(policy experimentsv1beta1.ActiveTrialsPolicyType, policyPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	validTypes := map[experimentsv1beta1.ActiveTrialsPolicyType]string{
+		"":                                    "",
+		experimentsv1beta1.FinishActiveTrials: "",
+		experimentsv1beta1.KillActiveTrials:   "",
+	}
+	if _, ok := validTypes[policy]; !ok {
+		allErrs = append(allErrs, field.Invalid(policyPath, policy, "invalid ActiveTrialsPolicyType"))
+	}
+	return allErrs
+}
+
 func (g *DefaultValidator) validateParameters(parameters []experimentsv1beta1.ParameterSpec) field.ErrorList {
 	var allErrs field.ErrorList
+	// Parameters which are defined before the current one, so they can be used as the parent of the condition.
+	definedParameters := make(map[string]experimentsv1beta1.ParameterSpec, len(parameters))
 	for i, param := range parameters {
+		if param.Condition != nil {
+			allErrs = append(allErrs, validateParameterCondition(param.Condition, definedParameters, parametersPath.Index(i).Child("condition"))...)
+		}
+		definedParameters[param.Name] = param
 
 		if param.ParameterType != experimentsv1beta1.ParameterTypeInt &&
 			param.ParameterType != experimentsv1beta1.ParameterTypeDouble &&
@@ -302,6 +444,144 @@ func (g *DefaultValidator) validateParameters(parameters []experimentsv1beta1.Pa
 	return allErrs
 }
 
+func validateParameterCondition(condition *experimentsv1beta1.ParameterCondition, definedParameters map[string]experimentsv1beta1.ParameterSpec, conditionPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if condition.Parent == "" {
+		allErrs = append(allErrs, field.Required(conditionPath.Child("parent"), "must be specified"))
+		return allErrs
+	}
+	// Parent must be defined before the conditional parameter, that also prevents cycles of conditions.
+	parent, ok := definedParameters[condition.Parent]
+	if !ok {
+		allErrs = append(allErrs, field.Invalid(conditionPath.Child("parent"), condition.Parent,
+			"parent parameter must be defined before the conditional parameter"))
+		return allErrs
+	}
+	if parent.ParameterType != experimentsv1beta1.ParameterTypeCategorical {
+		allErrs = append(allErrs, field.Invalid(conditionPath.Child("parent"), condition.Parent,
+			fmt.Sprintf("parent parameter must have parameterType: %v", experimentsv1beta1.ParameterTypeCategorical)))
+		return allErrs
+	}
+	if len(condition.Values) == 0 {
+		allErrs = append(allErrs, field.Required(conditionPath.Child("values"), "must be specified"))
+	}
+	for i, value := range condition.Values {
+		if !contains(parent.FeasibleSpace.List, value) {
+			allErrs = append(allErrs, field.NotSupported(conditionPath.Child("values").Index(i), value, parent.FeasibleSpace.List))
+		}
+	}
+	return allErrs
+}
+
+func (g *DefaultValidator) validateParameterConstraints(instance *experimentsv1beta1.Experiment) field.ErrorList {
+	var allErrs field.ErrorList
+	parameterNames := make(map[string]bool, len(instance.Spec.Parameters))
+	for _, param := range instance.Spec.Parameters {
+		parameterNames[param.Name] = true
+	}
+	for i, expression := range instance.Spec.ParameterConstraints {
+		c, err := constraint.Compile(expression)
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(constraintsPath.Index(i), expression, err.Error()))
+			continue
+		}
+		for _, name := range c.Parameters() {
+			if !parameterNames[name] {
+				allErrs = append(allErrs, field.Invalid(constraintsPath.Index(i), expression,
+					fmt.Sprintf("parameter %v is not defined in spec.parameters", name)))
+			}
+		}
+	}
+	return allErrs
+}
+
+func (g *DefaultValidator) validateWarmStart(instance *experimentsv1beta1.Experiment) field.ErrorList {
+	var allErrs field.ErrorList
+	warmStart := instance.Spec.WarmStart
+	if instance.Spec.NasConfig != nil {
+		allErrs = append(allErrs, field.Invalid(warmStartPath, warmStart, "warm-start is supported only with spec.parameters"))
+	}
+	if len(warmStart.SourceExperiments) == 0 && warmStart.TrialsConfigMap == nil {
+		allErrs = append(allErrs, field.Required(warmStartPath, "sourceExperiments or trialsConfigMap must be specified"))
+	}
+	for i, name := range warmStart.SourceExperiments {
+		if name == "" || name == instance.Name {
+			allErrs = append(allErrs, field.Invalid(warmStartPath.Child("sourceExperiments").Index(i), name,
+				"must be the name of another Experiment"))
+		}
+	}
+	if source := warmStart.TrialsConfigMap; source != nil {
+		if source.ConfigMapName == "" {
+			allErrs = append(allErrs, field.Required(warmStartPath.Child("trialsConfigMap").Child("configMapName"), ""))
+		}
+		if source.TrialsPath == "" {
+			allErrs = append(allErrs, field.Required(warmStartPath.Child("trialsConfigMap").Child("trialsPath"), ""))
+		}
+	}
+	return allErrs
+}
+
+func (g *DefaultValidator) validateDuplicateSuggestion(duplicate *experimentsv1beta1.DuplicateSuggestionSpec) field.ErrorList {
+	var allErrs field.ErrorList
+	if duplicate.Policy != experimentsv1beta1.ResampleDuplicate && duplicate.Policy != experimentsv1beta1.ReuseDuplicate {
+		allErrs = append(allErrs, field.Invalid(duplicatePath.Child("policy"), duplicate.Policy,
+			fmt.Sprintf("must be %s or %s", experimentsv1beta1.ResampleDuplicate, experimentsv1beta1.ReuseDuplicate)))
+	}
+	if duplicate.Tolerance != nil && (*duplicate.Tolerance < 0 || *duplicate.Tolerance >= 1) {
+		allErrs = append(allErrs, field.Invalid(duplicatePath.Child("tolerance"), *duplicate.Tolerance,
+			"must be greater than or equal to 0 and less than 1"))
+	}
+	return allErrs
+}
+
+func (g *DefaultValidator) validateTrialCache(cache *commonapiv1beta1.TrialCacheSpec) field.ErrorList {
+	var allErrs field.ErrorList
+	if cache.Scope != commonapiv1beta1.TrialCacheScopeNamespace {
+		allErrs = append(allErrs, field.Invalid(trialCachePath.Child("scope"), cache.Scope,
+			fmt.Sprintf("must be %s", commonapiv1beta1.TrialCacheScopeNamespace)))
+	}
+	return allErrs
+}
+
+func (g *DefaultValidator) validateRetryPolicy(retry *commonapiv1beta1.RetryPolicy) field.ErrorList {
+	var allErrs field.ErrorList
+	if retry.MaxRetries < 0 {
+		allErrs = append(allErrs, field.Invalid(retryPolicyPath.Child("maxRetries"), retry.MaxRetries,
+			"must be greater than or equal to 0"))
+	}
+	if retry.Backoff != nil && retry.Backoff.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(retryPolicyPath.Child("backoff"), retry.Backoff.Duration.String(),
+			"must be greater than 0"))
+	}
+	for i, reason := range retry.OnReasons {
+		if _, err := regexp.Compile(reason); err != nil {
+			allErrs = append(allErrs, field.Invalid(retryPolicyPath.Child("onReasons").Index(i), reason,
+				fmt.Sprintf("must be a valid regular expression: %v", err)))
+		}
+	}
+	for i, message := range retry.OnMessages {
+		if _, err := regexp.Compile(message); err != nil {
+			allErrs = append(allErrs, field.Invalid(retryPolicyPath.Child("onMessages").Index(i), message,
+				fmt.Sprintf("must be a valid regular expression: %v", err)))
+		}
+	}
+	return allErrs
+}
+
+func (g *DefaultValidator) validateTrialDeadlines(trialTemplate *experimentsv1beta1.TrialTemplate) field.ErrorList {
+	var allErrs field.ErrorList
+	if trialTemplate.ActiveDeadlineSeconds != nil && *trialTemplate.ActiveDeadlineSeconds <= 0 {
+		allErrs = append(allErrs, field.Invalid(trialTemplatePath.Child("activeDeadlineSeconds"),
+			*trialTemplate.ActiveDeadlineSeconds, "must be greater than 0"))
+	}
+	if trialTemplate.PendingDeadlineSeconds != nil && *trialTemplate.PendingDeadlineSeconds <= 0 {
+		allErrs = append(allErrs, field.Invalid(trialTemplatePath.Child("pendingDeadlineSeconds"),
+			*trialTemplate.PendingDeadlineSeconds, "must be greater than 0"))
+	}
+	return allErrs
+}
+
 func (g *DefaultValidator) validateTrialTemplate(instance *experimentsv1beta1.Experiment) field.ErrorList {
 	var allErrs field.ErrorList
 	trialTemplate := instance.Spec.TrialTemplate
@@ -503,11 +783,42 @@ func (g *DefaultValidator) validateMetricsCollector(inst *experimentsv1beta1.Exp
 	case commonapiv1beta1.PushCollector, commonapiv1beta1.StdOutCollector:
 		return allErrs
 	case commonapiv1beta1.FileCollector:
-		if mcSpec.Source == nil || mcSpec.Source.FileSystemPath == nil ||
-			mcSpec.Source.FileSystemPath.Kind != commonapiv1beta1.FileKind || !filepath.IsAbs(mcSpec.Source.FileSystemPath.Path) {
+		if mcSpec.Source == nil || mcSpec.Source.FileSystemPath == nil || !filepath.IsAbs(mcSpec.Source.FileSystemPath.Path) ||
+			(mcSpec.Source.FileSystemPath.Kind != commonapiv1beta1.FileKind && mcSpec.Source.FileSystemPath.Kind != commonapiv1beta1.DirectoryKind) {
 			allErrs = append(allErrs, field.Required(metricsSourcePath.Child("fileSystemPath").Child("path"),
 				"file path where metrics file exists is required"))
 		}
+		fileSystemPath := mcSpec.Source.FileSystemPath
+		// Pattern and aggregation
+		if fileSystemPath.Kind == commonapiv1beta1.DirectoryKind {
+			if _, err := filepath.Match(fileSystemPath.Pattern, ""); fileSystemPath.Pattern == "" || err != nil {
+				allErrs = append(allErrs, field.Invalid(metricsSourcePath.Child("fileSystemPath").Child("pattern"),
+					fileSystemPath.Pattern, "valid glob pattern of metrics files is required when kind is Directory"))
+			}
+			switch fileSystemPath.Aggregation {
+			case "", commonapiv1beta1.MetricsAggregationNone:
+			case commonapiv1beta1.MetricsAggregationMean, commonapiv1beta1.MetricsAggregationMin, commonapiv1beta1.MetricsAggregationMax:
+				// Stop rules are evaluated on the metrics of every file, since the metrics are aggregated
+				// only when the training is completed.
+				if inst.Spec.EarlyStopping != nil {
+					allErrs = append(allErrs, field.Invalid(metricsSourcePath.Child("fileSystemPath").Child("aggregation"),
+						fileSystemPath.Aggregation, fmt.Sprintf("aggregation must be %v when early stopping is set", commonapiv1beta1.MetricsAggregationNone)))
+				}
+			default:
+				allErrs = append(allErrs, field.Invalid(metricsSourcePath.Child("fileSystemPath").Child("aggregation"),
+					fileSystemPath.Aggregation, fmt.Sprintf("aggregation must be one of %v, %v, %v or %v", commonapiv1beta1.MetricsAggregationNone,
+						commonapiv1beta1.MetricsAggregationMean, commonapiv1beta1.MetricsAggregationMin, commonapiv1beta1.MetricsAggregationMax)))
+			}
+		} else {
+			if fileSystemPath.Pattern != "" {
+				allErrs = append(allErrs, field.Invalid(metricsSourcePath.Child("fileSystemPath").Child("pattern"),
+					fileSystemPath.Pattern, "pattern must be empty when kind is File"))
+			}
+			if fileSystemPath.Aggregation != "" {
+				allErrs = append(allErrs, field.Invalid(metricsSourcePath.Child("fileSystemPath").Child("aggregation"),
+					fileSystemPath.Aggregation, "aggregation must be empty when kind is File"))
+			}
+		}
 		// Format
 		fileFormat := mcSpec.Source.FileSystemPath.Format
 		if fileFormat != commonapiv1beta1.TextFormat && fileFormat != commonapiv1beta1.JsonFormat {
@@ -518,6 +829,26 @@ func (g *DefaultValidator) validateMetricsCollector(inst *experimentsv1beta1.Exp
 			allErrs = append(allErrs, field.Invalid(metricsSourcePath.Child("filter"),
 				"", "filter must be nil when format of metrics file is json"))
 		}
+		// Timestamp
+		if fileFormat != commonapiv1beta1.JsonFormat {
+			if fileSystemPath.TimestampKey != "" {
+				allErrs = append(allErrs, field.Invalid(metricsSourcePath.Child("fileSystemPath").Child("timestampKey"),
+					fileSystemPath.TimestampKey, "timestampKey must be empty when format of metrics file is not json"))
+			}
+			if fileSystemPath.TimestampFormat != "" {
+				allErrs = append(allErrs, field.Invalid(metricsSourcePath.Child("fileSystemPath").Child("timestampFormat"),
+					fileSystemPath.TimestampFormat, "timestampFormat must be empty when format of metrics file is not json"))
+			}
+		} else {
+			if strings.HasPrefix(fileSystemPath.TimestampKey, ".") || strings.HasSuffix(fileSystemPath.TimestampKey, ".") {
+				allErrs = append(allErrs, field.Invalid(metricsSourcePath.Child("fileSystemPath").Child("timestampKey"),
+					fileSystemPath.TimestampKey, "timestampKey must not begin or end with a dot"))
+			}
+			if fileSystemPath.TimestampFormat != "" && !isValidTimestampLayout(fileSystemPath.TimestampFormat) {
+				allErrs = append(allErrs, field.Invalid(metricsSourcePath.Child("fileSystemPath").Child("timestampFormat"),
+					fileSystemPath.TimestampFormat, "timestampFormat must be a Go time layout, e.g. 2006-01-02 15:04:05"))
+			}
+		}
 	case commonapiv1beta1.TfEventCollector:
 		if mcSpec.Source == nil || mcSpec.Source.FileSystemPath == nil ||
 			mcSpec.Source.FileSystemPath.Kind != commonapiv1beta1.DirectoryKind || !filepath.IsAbs(mcSpec.Source.FileSystemPath.Path) {
@@ -573,6 +904,17 @@ func (g *DefaultValidator) validateMetricsCollector(inst *experimentsv1beta1.Exp
 	return allErrs
 }
 
+// isValidTimestampLayout checks that the layout contains time elements and can parse the time which it formats.
+func isValidTimestampLayout(layout string) bool {
+	t := time.Date(2001, time.February, 3, 16, 5, 6, 0, time.UTC)
+	formatted := t.Format(layout)
+	if formatted == layout {
+		return false
+	}
+	_, err := time.Parse(layout, formatted)
+	return err == nil
+}
+
 func isMetaKey(parameter string) bool {
 	// Check if parameter is trial metadata reference as ${trailSpec.Name}, ${trialSpec.Labels[label]}, etc. used for substitution
 	match := regexp.MustCompile(consts.TrialTemplateMetaReplaceFormatRegex).FindStringSubmatch(parameter)
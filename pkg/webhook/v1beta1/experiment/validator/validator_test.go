@@ -19,6 +19,7 @@ package validator
 import (
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
@@ -31,6 +32,7 @@ import (
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/utils/ptr"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 
@@ -143,6 +145,64 @@ func TestValidateExperiment(t *testing.T) {
 			},
 			testDescription: "additionalMetricNames should not contain objective metric name",
 		},
+		{
+			instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.Objective.Goal = nil
+				i.Spec.Objective.AdditionalObjectives = []commonv1beta1.AdditionalObjective{
+					{Type: commonv1beta1.ObjectiveTypeMinimize, ObjectiveMetricName: "latency"},
+				}
+				i.Spec.Objective.AdditionalMetricNames = []string{"latency"}
+				return i
+			}(),
+			testDescription: "Valid additional objectives",
+		},
+		{
+			instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.Objective.AdditionalObjectives = []commonv1beta1.AdditionalObjective{
+					{Type: commonv1beta1.ObjectiveTypeMinimize, ObjectiveMetricName: "latency"},
+				}
+				return i
+			}(),
+			wantErr: field.ErrorList{
+				field.Invalid(field.NewPath("spec").Child("objective").Child("goal"), "", ""),
+			},
+			testDescription: "Goal is set with additional objectives",
+		},
+		{
+			instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.Objective.Goal = nil
+				i.Spec.Objective.AdditionalObjectives = []commonv1beta1.AdditionalObjective{
+					{Type: commonv1beta1.ObjectiveTypeUnknown, ObjectiveMetricName: "latency"},
+					{Type: commonv1beta1.ObjectiveTypeMaximize},
+				}
+				return i
+			}(),
+			wantErr: field.ErrorList{
+				field.Invalid(field.NewPath("spec").Child("objective").Child("additionalObjectives").Index(0).Child("type"), "", ""),
+				field.Required(field.NewPath("spec").Child("objective").Child("additionalObjectives").Index(1).Child("objectiveMetricName"), ""),
+			},
+			testDescription: "Additional objective type is unknown and metric name is empty",
+		},
+		{
+			instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.Objective.Goal = nil
+				i.Spec.Objective.AdditionalObjectives = []commonv1beta1.AdditionalObjective{
+					{Type: commonv1beta1.ObjectiveTypeMinimize, ObjectiveMetricName: "latency"},
+					{Type: commonv1beta1.ObjectiveTypeMinimize, ObjectiveMetricName: "testme"},
+					{Type: commonv1beta1.ObjectiveTypeMaximize, ObjectiveMetricName: "latency"},
+				}
+				return i
+			}(),
+			wantErr: field.ErrorList{
+				field.Duplicate(field.NewPath("spec").Child("objective").Child("additionalObjectives").Index(1).Child("objectiveMetricName"), ""),
+				field.Duplicate(field.NewPath("spec").Child("objective").Child("additionalObjectives").Index(2).Child("objectiveMetricName"), ""),
+			},
+			testDescription: "Additional objectives contain duplicate metric names",
+		},
 		// Algorithm
 		{
 			instance: func() *experimentsv1beta1.Experiment {
@@ -272,6 +332,34 @@ func TestValidateExperiment(t *testing.T) {
 			}(),
 			testDescription: "Change algorithm name when resuming experiment",
 		},
+		{
+			instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.Suspend = ptr.To(true)
+				i.Spec.SuspendPolicy = experimentsv1beta1.KillActiveTrials
+				return i
+			}(),
+			oldInstance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Status = experimentsv1beta1.ExperimentStatus{
+					Trials: *i.Spec.MaxTrialCount,
+				}
+				return i
+			}(),
+			testDescription: "Suspend experiment which has created all trials",
+		},
+		{
+			instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.Suspend = ptr.To(true)
+				i.Spec.SuspendPolicy = "Pause"
+				return i
+			}(),
+			wantErr: field.ErrorList{
+				field.Invalid(field.NewPath("spec").Child("suspendPolicy"), "", ""),
+			},
+			testDescription: "Invalid suspend policy",
+		},
 		{
 			instance: func() *experimentsv1beta1.Experiment {
 				i := newFakeInstance()
@@ -335,6 +423,186 @@ func TestValidateExperiment(t *testing.T) {
 			},
 			testDescription: "Invalid feasible space in parameters",
 		},
+		// Parameter constraints
+		{
+			instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.ParameterConstraints = []string{"lr * 2 <= 8", `momentum != "0.95" || lr < 3`}
+				return i
+			}(),
+			wantErr:         nil,
+			testDescription: "Valid parameter constraints",
+		},
+		{
+			instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.ParameterConstraints = []string{"lr * <= 8", "lr < 3", "lr * batch_size <= 512"}
+				return i
+			}(),
+			wantErr: field.ErrorList{
+				field.Invalid(field.NewPath("spec").Child("parameterConstraints").Index(0), "", ""),
+				field.Invalid(field.NewPath("spec").Child("parameterConstraints").Index(2), "", ""),
+			},
+			testDescription: "Invalid syntax and unknown parameter in parameter constraints",
+		},
+		{
+			instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.ParameterConstraints = []string{"lr * 2", "lr < 3"}
+				return i
+			}(),
+			wantErr: field.ErrorList{
+				field.Invalid(field.NewPath("spec").Child("parameterConstraints").Index(0), "", ""),
+			},
+			testDescription: "Non-boolean parameter constraint",
+		},
+		{
+			instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.WarmStart = &experimentsv1beta1.WarmStartSpec{
+					SourceExperiments: []string{"source-experiment"},
+					TrialsConfigMap: &experimentsv1beta1.TrialsConfigMapSource{
+						ConfigMapName: "prior-trials",
+						TrialsPath:    "trials.json",
+					},
+				}
+				return i
+			}(),
+			wantErr:         nil,
+			testDescription: "Valid warm-start sources",
+		},
+		{
+			instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.WarmStart = &experimentsv1beta1.WarmStartSpec{
+					SourceExperiments: []string{i.Name},
+					TrialsConfigMap: &experimentsv1beta1.TrialsConfigMapSource{
+						ConfigMapName: "prior-trials",
+					},
+				}
+				return i
+			}(),
+			wantErr: field.ErrorList{
+				field.Invalid(field.NewPath("spec").Child("warmStart").Child("sourceExperiments").Index(0), "", ""),
+				field.Required(field.NewPath("spec").Child("warmStart").Child("trialsConfigMap").Child("trialsPath"), ""),
+			},
+			testDescription: "Experiment itself as warm-start source and empty trials path",
+		},
+		{
+			instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.WarmStart = &experimentsv1beta1.WarmStartSpec{}
+				return i
+			}(),
+			wantErr: field.ErrorList{
+				field.Required(field.NewPath("spec").Child("warmStart"), ""),
+			},
+			testDescription: "Empty warm-start sources",
+		},
+		{
+			instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.DuplicateSuggestion = &experimentsv1beta1.DuplicateSuggestionSpec{
+					Policy:    experimentsv1beta1.ReuseDuplicate,
+					Tolerance: ptr.To(0.01),
+				}
+				return i
+			}(),
+			wantErr:         nil,
+			testDescription: "Valid duplicate suggestion policy",
+		},
+		{
+			instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.DuplicateSuggestion = &experimentsv1beta1.DuplicateSuggestionSpec{
+					Policy:    "Ignore",
+					Tolerance: ptr.To(1.5),
+				}
+				return i
+			}(),
+			wantErr: field.ErrorList{
+				field.Invalid(field.NewPath("spec").Child("duplicateSuggestion").Child("policy"), "", ""),
+				field.Invalid(field.NewPath("spec").Child("duplicateSuggestion").Child("tolerance"), "", ""),
+			},
+			testDescription: "Invalid duplicate suggestion policy and tolerance",
+		},
+		{
+			instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.TrialCache = &commonv1beta1.TrialCacheSpec{
+					Scope: commonv1beta1.TrialCacheScopeNamespace,
+				}
+				return i
+			}(),
+			testDescription: "Valid trial cache scope",
+		},
+		{
+			instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.TrialCache = &commonv1beta1.TrialCacheSpec{
+					Scope: "Experiment",
+				}
+				return i
+			}(),
+			wantErr: field.ErrorList{
+				field.Invalid(field.NewPath("spec").Child("trialCache").Child("scope"), "", ""),
+			},
+			testDescription: "Invalid trial cache scope",
+		},
+		{
+			instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.TrialTemplate.RetryPolicy = &commonv1beta1.RetryPolicy{
+					MaxRetries: 3,
+					Backoff:    &metav1.Duration{Duration: 10 * time.Second},
+					OnReasons:  []string{"^Evicted$"},
+					OnMessages: []string{"(?i)out of memory"},
+				}
+				return i
+			}(),
+			testDescription: "Valid retry policy",
+		},
+		{
+			instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.TrialTemplate.RetryPolicy = &commonv1beta1.RetryPolicy{
+					MaxRetries: -1,
+					Backoff:    &metav1.Duration{Duration: 0},
+					OnReasons:  []string{"("},
+					OnMessages: []string{"[a-"},
+				}
+				return i
+			}(),
+			wantErr: field.ErrorList{
+				field.Invalid(field.NewPath("spec").Child("trialTemplate").Child("retryPolicy").Child("maxRetries"), "", ""),
+				field.Invalid(field.NewPath("spec").Child("trialTemplate").Child("retryPolicy").Child("backoff"), "", ""),
+				field.Invalid(field.NewPath("spec").Child("trialTemplate").Child("retryPolicy").Child("onReasons").Index(0), "", ""),
+				field.Invalid(field.NewPath("spec").Child("trialTemplate").Child("retryPolicy").Child("onMessages").Index(0), "", ""),
+			},
+			testDescription: "Invalid retry policy",
+		},
+		{
+			instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.TrialTemplate.ActiveDeadlineSeconds = ptr.To[int64](3600)
+				i.Spec.TrialTemplate.PendingDeadlineSeconds = ptr.To[int64](600)
+				return i
+			}(),
+			testDescription: "Valid trial deadlines",
+		},
+		{
+			instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.TrialTemplate.ActiveDeadlineSeconds = ptr.To[int64](0)
+				i.Spec.TrialTemplate.PendingDeadlineSeconds = ptr.To[int64](-1)
+				return i
+			}(),
+			wantErr: field.ErrorList{
+				field.Invalid(field.NewPath("spec").Child("trialTemplate").Child("activeDeadlineSeconds"), "", ""),
+				field.Invalid(field.NewPath("spec").Child("trialTemplate").Child("pendingDeadlineSeconds"), "", ""),
+			},
+			testDescription: "Invalid trial deadlines",
+		},
 		{
 			instance: func() *experimentsv1beta1.Experiment {
 				maxTrialCount := int32(5)
@@ -360,6 +628,112 @@ func TestValidateExperiment(t *testing.T) {
 			}(),
 			testDescription: "maxFailedTrialCount equal to maxTrialCount",
 		},
+		{
+			instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.AdaptiveParallelism = &experimentsv1beta1.AdaptiveParallelismSpec{
+					MinParallelTrialCount: 1,
+					MaxParallelTrialCount: 5,
+					PendingTimeout:        &metav1.Duration{Duration: time.Minute},
+				}
+				return i
+			}(),
+			testDescription: "Valid adaptive parallelism",
+		},
+		{
+			instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.AdaptiveParallelism = &experimentsv1beta1.AdaptiveParallelismSpec{
+					MaxParallelTrialCount: -1,
+					PendingTimeout:        &metav1.Duration{},
+				}
+				return i
+			}(),
+			wantErr: field.ErrorList{
+				field.Invalid(field.NewPath("spec").Child("adaptiveParallelism").Child("minParallelTrialCount"), "", ""),
+				field.Invalid(field.NewPath("spec").Child("adaptiveParallelism").Child("maxParallelTrialCount"), "", ""),
+				field.Invalid(field.NewPath("spec").Child("adaptiveParallelism").Child("pendingTimeout"), "", ""),
+			},
+			testDescription: "Invalid adaptive parallelism",
+		},
+		{
+			instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.MaxDuration = &metav1.Duration{Duration: time.Hour}
+				i.Spec.MaxResourceUsage = &experimentsv1beta1.ResourceUsageSpec{
+					ResourceName: "nvidia.com/gpu",
+					Hours:        10,
+				}
+				i.Spec.BudgetPolicy = experimentsv1beta1.KillActiveTrials
+				return i
+			}(),
+			testDescription: "Valid max duration, max resource usage and budget policy",
+		},
+		{
+			instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.MaxDuration = &metav1.Duration{}
+				i.Spec.MaxResourceUsage = &experimentsv1beta1.ResourceUsageSpec{}
+				i.Spec.BudgetPolicy = "Pause"
+				return i
+			}(),
+			wantErr: field.ErrorList{
+				field.Invalid(field.NewPath("spec").Child("maxDuration"), "", ""),
+				field.Required(field.NewPath("spec").Child("maxResourceUsage").Child("resourceName"), ""),
+				field.Invalid(field.NewPath("spec").Child("maxResourceUsage").Child("hours"), "", ""),
+				field.Invalid(field.NewPath("spec").Child("budgetPolicy"), "", ""),
+			},
+			testDescription: "Invalid max duration, max resource usage and budget policy",
+		},
+		{
+			instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.Queueing = &experimentsv1beta1.QueueingSpec{
+					Priority: 10,
+					Weight:   ptr.To[int32](2),
+				}
+				return i
+			}(),
+			testDescription: "Valid queueing",
+		},
+		{
+			instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.Queueing = &experimentsv1beta1.QueueingSpec{
+					Weight: ptr.To[int32](0),
+				}
+				return i
+			}(),
+			wantErr: field.ErrorList{
+				field.Invalid(field.NewPath("spec").Child("queueing").Child("weight"), "", ""),
+			},
+			testDescription: "Invalid queueing weight",
+		},
+		{
+			instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.Convergence = &experimentsv1beta1.ConvergenceSpec{
+					Patience: 5,
+					MinDelta: ptr.To(0.01),
+				}
+				return i
+			}(),
+			testDescription: "Valid convergence",
+		},
+		{
+			instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.Convergence = &experimentsv1beta1.ConvergenceSpec{
+					MinDelta: ptr.To(-0.01),
+				}
+				return i
+			}(),
+			wantErr: field.ErrorList{
+				field.Invalid(field.NewPath("spec").Child("convergence").Child("patience"), "", ""),
+				field.Invalid(field.NewPath("spec").Child("convergence").Child("minDelta"), "", ""),
+			},
+			testDescription: "Invalid convergence patience and min delta",
+		},
 		{
 			instance: func() *experimentsv1beta1.Experiment {
 				maxTrialCount := int32(5)
@@ -477,6 +851,74 @@ func TestValidateParameters(t *testing.T) {
 			},
 			testDescription: "Invalid distribution type",
 		},
+		{
+			parameters: append(newFakeInstance().Spec.Parameters, experimentsv1beta1.ParameterSpec{
+				Name:          "dampening",
+				ParameterType: experimentsv1beta1.ParameterTypeDouble,
+				FeasibleSpace: experimentsv1beta1.FeasibleSpace{Max: "0.5", Min: "0.1"},
+				Condition: &experimentsv1beta1.ParameterCondition{
+					Parent: "momentum",
+					Values: []string{"0.95", "0.85"},
+				},
+			}),
+			wantErr:         nil,
+			testDescription: "Valid conditional parameter",
+		},
+		{
+			parameters: func() []experimentsv1beta1.ParameterSpec {
+				ps := newFakeInstance().Spec.Parameters
+				ps[0].Condition = &experimentsv1beta1.ParameterCondition{
+					Parent: "momentum",
+					Values: []string{"0.95"},
+				}
+				return ps
+			}(),
+			wantErr: field.ErrorList{
+				field.Invalid(field.NewPath("spec").Child("parameters").Index(0).Child("condition").Child("parent"), "", ""),
+			},
+			testDescription: "Parent parameter is defined after the conditional parameter",
+		},
+		{
+			parameters: func() []experimentsv1beta1.ParameterSpec {
+				ps := newFakeInstance().Spec.Parameters
+				ps[1].Condition = &experimentsv1beta1.ParameterCondition{
+					Parent: "lr",
+					Values: []string{"1"},
+				}
+				return ps
+			}(),
+			wantErr: field.ErrorList{
+				field.Invalid(field.NewPath("spec").Child("parameters").Index(1).Child("condition").Child("parent"), "", ""),
+			},
+			testDescription: "Parent parameter is not categorical",
+		},
+		{
+			parameters: append(newFakeInstance().Spec.Parameters, experimentsv1beta1.ParameterSpec{
+				Name:          "dampening",
+				ParameterType: experimentsv1beta1.ParameterTypeDouble,
+				FeasibleSpace: experimentsv1beta1.FeasibleSpace{Max: "0.5", Min: "0.1"},
+				Condition: &experimentsv1beta1.ParameterCondition{
+					Parent: "momentum",
+					Values: []string{"0.95", "invalid-value"},
+				},
+			}),
+			wantErr: field.ErrorList{
+				field.NotSupported(field.NewPath("spec").Child("parameters").Index(2).Child("condition").Child("values").Index(1), "", []string{}),
+			},
+			testDescription: "Condition value is not in the feasible space of the parent parameter",
+		},
+		{
+			parameters: append(newFakeInstance().Spec.Parameters, experimentsv1beta1.ParameterSpec{
+				Name:          "dampening",
+				ParameterType: experimentsv1beta1.ParameterTypeDouble,
+				FeasibleSpace: experimentsv1beta1.FeasibleSpace{Max: "0.5", Min: "0.1"},
+				Condition:     &experimentsv1beta1.ParameterCondition{},
+			}),
+			wantErr: field.ErrorList{
+				field.Required(field.NewPath("spec").Child("parameters").Index(2).Child("condition").Child("parent"), ""),
+			},
+			testDescription: "Empty parent of the condition",
+		},
 		{
 			parameters:      newFakeInstance().Spec.Parameters,
 			wantErr:         nil,
@@ -553,6 +995,7 @@ spec:
 	validTemplate9 := p.EXPECT().GetTrialTemplate(gomock.Any()).Return(validJobStr, nil)
 	validTemplate10 := p.EXPECT().GetTrialTemplate(gomock.Any()).Return(validJobStr, nil)
 	validTemplate11 := p.EXPECT().GetTrialTemplate(gomock.Any()).Return(validJobStr, nil)
+	validTemplate12 := p.EXPECT().GetTrialTemplate(gomock.Any()).Return(validJobStr, nil)
 
 	missedParameterTemplate := p.EXPECT().GetTrialTemplate(gomock.Any()).Return(missedParameterJobStr, nil)
 	oddParameterTemplate := p.EXPECT().GetTrialTemplate(gomock.Any()).Return(oddParameterJobStr, nil)
@@ -572,6 +1015,7 @@ spec:
 		validTemplate7,
 		validTemplate8,
 		validTemplate9,
+		validTemplate12,
 		missedParameterTemplate,
 		oddParameterTemplate,
 		invalidParameterTemplate,
@@ -763,6 +1207,15 @@ spec:
 			}(),
 			testDescription: "Trial template contains Trial's label reference as parameter",
 		},
+		{
+			instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.Algorithm.AlgorithmName = "asha"
+				i.Spec.TrialTemplate.TrialParameters[1].Reference = "${trialSpec.Budget}"
+				return i
+			}(),
+			testDescription: "Trial template contains Trial's budget reference as parameter",
+		},
 		// Trial Template doesn't contain parameter from trialParameters
 		// missedParameterTemplate case
 		{
@@ -1270,6 +1723,175 @@ func TestValidateMetricsCollector(t *testing.T) {
 			},
 			testDescription: "Invalid metrics filer for File metrics collector when file format is `JSON`",
 		},
+		// FileMetricCollector timestamp options
+		{
+			instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.MetricsCollectorSpec = &commonv1beta1.MetricsCollectorSpec{
+					Collector: &commonv1beta1.CollectorSpec{
+						Kind: commonv1beta1.FileCollector,
+					},
+					Source: &commonv1beta1.SourceSpec{
+						FileSystemPath: &commonv1beta1.FileSystemPath{
+							Path:            "/absolute/path",
+							Kind:            commonv1beta1.FileKind,
+							Format:          commonv1beta1.TextFormat,
+							TimestampKey:    "time",
+							TimestampFormat: "2006-01-02 15:04:05",
+						},
+					},
+				}
+				return i
+			}(),
+			wantErr: field.ErrorList{
+				field.Invalid(field.NewPath("spec").Child("metricsCollectorSpec").Child("source").Child("fileSystemPath").Child("timestampKey"), "", ""),
+				field.Invalid(field.NewPath("spec").Child("metricsCollectorSpec").Child("source").Child("fileSystemPath").Child("timestampFormat"), "", ""),
+			},
+			testDescription: "Invalid timestamp options for File metrics collector when file format is `TEXT`",
+		},
+		{
+			instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.MetricsCollectorSpec = &commonv1beta1.MetricsCollectorSpec{
+					Collector: &commonv1beta1.CollectorSpec{
+						Kind: commonv1beta1.FileCollector,
+					},
+					Source: &commonv1beta1.SourceSpec{
+						FileSystemPath: &commonv1beta1.FileSystemPath{
+							Path:            "/absolute/path",
+							Kind:            commonv1beta1.FileKind,
+							Format:          commonv1beta1.JsonFormat,
+							TimestampKey:    "meta.",
+							TimestampFormat: "invalid",
+						},
+					},
+				}
+				return i
+			}(),
+			wantErr: field.ErrorList{
+				field.Invalid(field.NewPath("spec").Child("metricsCollectorSpec").Child("source").Child("fileSystemPath").Child("timestampKey"), "", ""),
+				field.Invalid(field.NewPath("spec").Child("metricsCollectorSpec").Child("source").Child("fileSystemPath").Child("timestampFormat"), "", ""),
+			},
+			testDescription: "Invalid timestamp key and format for File metrics collector",
+		},
+		{
+			instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.MetricsCollectorSpec = &commonv1beta1.MetricsCollectorSpec{
+					Collector: &commonv1beta1.CollectorSpec{
+						Kind: commonv1beta1.FileCollector,
+					},
+					Source: &commonv1beta1.SourceSpec{
+						FileSystemPath: &commonv1beta1.FileSystemPath{
+							Path:            "/absolute/path",
+							Kind:            commonv1beta1.FileKind,
+							Format:          commonv1beta1.JsonFormat,
+							TimestampKey:    "meta.time",
+							TimestampFormat: "2006-01-02 15:04:05",
+						},
+					},
+				}
+				return i
+			}(),
+			testDescription: "Run validator for correct File metrics collector with timestamp options",
+		},
+		// FileMetricCollector metrics files in the directory
+		{
+			instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.MetricsCollectorSpec = &commonv1beta1.MetricsCollectorSpec{
+					Collector: &commonv1beta1.CollectorSpec{
+						Kind: commonv1beta1.FileCollector,
+					},
+					Source: &commonv1beta1.SourceSpec{
+						FileSystemPath: &commonv1beta1.FileSystemPath{
+							Path:        "/absolute/path",
+							Kind:        commonv1beta1.DirectoryKind,
+							Format:      commonv1beta1.TextFormat,
+							Pattern:     "[",
+							Aggregation: "Median",
+						},
+					},
+				}
+				return i
+			}(),
+			wantErr: field.ErrorList{
+				field.Invalid(field.NewPath("spec").Child("metricsCollectorSpec").Child("source").Child("fileSystemPath").Child("pattern"), "", ""),
+				field.Invalid(field.NewPath("spec").Child("metricsCollectorSpec").Child("source").Child("fileSystemPath").Child("aggregation"), "", ""),
+			},
+			testDescription: "Invalid pattern and aggregation for File metrics collector when kind is Directory",
+		},
+		{
+			instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.MetricsCollectorSpec = &commonv1beta1.MetricsCollectorSpec{
+					Collector: &commonv1beta1.CollectorSpec{
+						Kind: commonv1beta1.FileCollector,
+					},
+					Source: &commonv1beta1.SourceSpec{
+						FileSystemPath: &commonv1beta1.FileSystemPath{
+							Path:        "/absolute/path",
+							Kind:        commonv1beta1.FileKind,
+							Format:      commonv1beta1.TextFormat,
+							Pattern:     "metrics-*.log",
+							Aggregation: commonv1beta1.MetricsAggregationMean,
+						},
+					},
+				}
+				return i
+			}(),
+			wantErr: field.ErrorList{
+				field.Invalid(field.NewPath("spec").Child("metricsCollectorSpec").Child("source").Child("fileSystemPath").Child("pattern"), "", ""),
+				field.Invalid(field.NewPath("spec").Child("metricsCollectorSpec").Child("source").Child("fileSystemPath").Child("aggregation"), "", ""),
+			},
+			testDescription: "Invalid pattern and aggregation for File metrics collector when kind is File",
+		},
+		{
+			instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.MetricsCollectorSpec = &commonv1beta1.MetricsCollectorSpec{
+					Collector: &commonv1beta1.CollectorSpec{
+						Kind: commonv1beta1.FileCollector,
+					},
+					Source: &commonv1beta1.SourceSpec{
+						FileSystemPath: &commonv1beta1.FileSystemPath{
+							Path:        "/absolute/path",
+							Kind:        commonv1beta1.DirectoryKind,
+							Format:      commonv1beta1.TextFormat,
+							Pattern:     "metrics-*.log",
+							Aggregation: commonv1beta1.MetricsAggregationMean,
+						},
+					},
+				}
+				return i
+			}(),
+			wantErr: field.ErrorList{
+				field.Invalid(field.NewPath("spec").Child("metricsCollectorSpec").Child("source").Child("fileSystemPath").Child("aggregation"), "", ""),
+			},
+			testDescription: "Invalid aggregation for File metrics collector when early stopping is set",
+		},
+		{
+			instance: func() *experimentsv1beta1.Experiment {
+				i := newFakeInstance()
+				i.Spec.EarlyStopping = nil
+				i.Spec.MetricsCollectorSpec = &commonv1beta1.MetricsCollectorSpec{
+					Collector: &commonv1beta1.CollectorSpec{
+						Kind: commonv1beta1.FileCollector,
+					},
+					Source: &commonv1beta1.SourceSpec{
+						FileSystemPath: &commonv1beta1.FileSystemPath{
+							Path:        "/absolute/path",
+							Kind:        commonv1beta1.DirectoryKind,
+							Format:      commonv1beta1.TextFormat,
+							Pattern:     "metrics-*.log",
+							Aggregation: commonv1beta1.MetricsAggregationMean,
+						},
+					},
+				}
+				return i
+			}(),
+			testDescription: "Run validator for correct File metrics collector with metrics files in the directory",
+		},
 		// Valid FileMetricCollector
 		{
 			instance: func() *experimentsv1beta1.Experiment {
@@ -317,11 +317,26 @@ func (s *SidecarInjector) getMetricsCollectorArgs(trial *trialsv1beta1.Trial, me
 	if mc.Collector.Kind == common.FileCollector && mc.Source != nil {
 		if mc.Source.FileSystemPath != nil {
 			args = append(args, "-format", string(mc.Source.FileSystemPath.Format))
+			if mc.Source.FileSystemPath.TimestampKey != "" {
+				args = append(args, "-timestamp-key", mc.Source.FileSystemPath.TimestampKey)
+			}
+			if mc.Source.FileSystemPath.TimestampFormat != "" {
+				args = append(args, "-timestamp-format", mc.Source.FileSystemPath.TimestampFormat)
+			}
+			if mc.Source.FileSystemPath.Kind == common.DirectoryKind {
+				args = append(args, "-pattern", mc.Source.FileSystemPath.Pattern)
+				if mc.Source.FileSystemPath.Aggregation != "" {
+					args = append(args, "-aggregation", string(mc.Source.FileSystemPath.Aggregation))
+				}
+			}
 		}
 	}
 	if mc.Collector.Kind == common.StdOutCollector {
 		args = append(args, "-format", string(common.TextFormat))
 	}
+	if mc.Collector.Kind == common.PrometheusMetricCollector && mc.Source != nil && mc.Source.HttpGet != nil {
+		args = append(args, "-url", getPrometheusMetricsURL(mc.Source.HttpGet))
+	}
 	if metricsCollectorConfigData.WaitAllProcesses != nil {
 		args = append(args, "-w", strconv.FormatBool(*metricsCollectorConfigData.WaitAllProcesses))
 	}
@@ -34,6 +34,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/client-go/kubernetes/scheme"
 	"sigs.k8s.io/controller-runtime/pkg/envtest"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
@@ -402,6 +403,63 @@ func TestGetMetricsCollectorArgs(t *testing.T) {
 				"-format", string(common.JsonFormat),
 			},
 		},
+		"File MC with Json Format and custom timestamp": {
+			trial:       testTrial,
+			metricNames: testMetricName,
+			mCSpec: common.MetricsCollectorSpec{
+				Collector: &common.CollectorSpec{
+					Kind: common.FileCollector,
+				},
+				Source: &common.SourceSpec{
+					FileSystemPath: &common.FileSystemPath{
+						Path:            testPath,
+						Format:          common.JsonFormat,
+						TimestampKey:    "meta.time",
+						TimestampFormat: "2006-01-02 15:04:05",
+					},
+				},
+			},
+			katibConfig: configv1beta1.MetricsCollectorConfig{},
+			wantArgs: []string{
+				"-t", testTrialName,
+				"-m", testMetricName,
+				"-o-type", string(testObjective),
+				"-s-db", katibDBAddress,
+				"-path", testPath,
+				"-format", string(common.JsonFormat),
+				"-timestamp-key", "meta.time",
+				"-timestamp-format", "2006-01-02 15:04:05",
+			},
+		},
+		"File MC with metrics files in the directory": {
+			trial:       testTrial,
+			metricNames: testMetricName,
+			mCSpec: common.MetricsCollectorSpec{
+				Collector: &common.CollectorSpec{
+					Kind: common.FileCollector,
+				},
+				Source: &common.SourceSpec{
+					FileSystemPath: &common.FileSystemPath{
+						Path:        testPath,
+						Kind:        common.DirectoryKind,
+						Format:      common.TextFormat,
+						Pattern:     "metrics-*.log",
+						Aggregation: common.MetricsAggregationMean,
+					},
+				},
+			},
+			katibConfig: configv1beta1.MetricsCollectorConfig{},
+			wantArgs: []string{
+				"-t", testTrialName,
+				"-m", testMetricName,
+				"-o-type", string(testObjective),
+				"-s-db", katibDBAddress,
+				"-path", testPath,
+				"-format", string(common.TextFormat),
+				"-pattern", "metrics-*.log",
+				"-aggregation", string(common.MetricsAggregationMean),
+			},
+		},
 		"Tf Event MC": {
 			trial:       testTrial,
 			metricNames: testMetricName,
@@ -462,7 +520,7 @@ func TestGetMetricsCollectorArgs(t *testing.T) {
 				"-path", testPath,
 			},
 		},
-		"Prometheus MC without Path": {
+		"Prometheus MC without HttpGet": {
 			trial:       testTrial,
 			metricNames: testMetricName,
 			mCSpec: common.MetricsCollectorSpec{
@@ -476,6 +534,31 @@ func TestGetMetricsCollectorArgs(t *testing.T) {
 				"-m", testMetricName,
 				"-o-type", string(testObjective),
 				"-s-db", katibDBAddress,
+				"-path", common.DefaultPrometheusDirPath,
+			},
+		},
+		"Prometheus MC with HttpGet": {
+			trial:       testTrial,
+			metricNames: testMetricName,
+			mCSpec: common.MetricsCollectorSpec{
+				Collector: &common.CollectorSpec{
+					Kind: common.PrometheusMetricCollector,
+				},
+				Source: &common.SourceSpec{
+					HttpGet: &v1.HTTPGetAction{
+						Path: common.DefaultPrometheusPath,
+						Port: intstr.FromInt(common.DefaultPrometheusPort),
+					},
+				},
+			},
+			katibConfig: configv1beta1.MetricsCollectorConfig{},
+			wantArgs: []string{
+				"-t", testTrialName,
+				"-m", testMetricName,
+				"-o-type", string(testObjective),
+				"-s-db", katibDBAddress,
+				"-path", common.DefaultPrometheusDirPath,
+				"-url", "http://localhost:8080/metrics",
 			},
 		},
 		"Trial with EarlyStopping rules": {
@@ -550,6 +633,14 @@ func TestNeedWrapWorkerContainer(t *testing.T) {
 			},
 			needWrap: true,
 		},
+		"Valid case with Prometheus metrics collector": {
+			mCSpec: common.MetricsCollectorSpec{
+				Collector: &common.CollectorSpec{
+					Kind: common.PrometheusMetricCollector,
+				},
+			},
+			needWrap: true,
+		},
 		"Valid case with needWrap false": {
 			mCSpec: common.MetricsCollectorSpec{
 				Collector: &common.CollectorSpec{
@@ -20,6 +20,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net"
+	"net/url"
 	"path/filepath"
 	"strings"
 
@@ -129,9 +131,16 @@ func getMountPath(mc common.MetricsCollectorSpec) (string, common.FileSystemKind
 	if mc.Collector.Kind == common.StdOutCollector {
 		return common.DefaultFilePath, common.FileKind
 	} else if mc.Collector.Kind == common.FileCollector {
+		// Metrics files which match the pattern are collected from the directory.
+		if mc.Source.FileSystemPath.Kind == common.DirectoryKind {
+			return mc.Source.FileSystemPath.Path, common.DirectoryKind
+		}
 		return mc.Source.FileSystemPath.Path, common.FileKind
 	} else if mc.Collector.Kind == common.TfEventCollector {
 		return mc.Source.FileSystemPath.Path, common.DirectoryKind
+	} else if mc.Collector.Kind == common.PrometheusMetricCollector {
+		// Metrics are scraped from the endpoint, directory is used only for the process markers.
+		return common.DefaultPrometheusDirPath, common.DirectoryKind
 	} else if mc.Collector.Kind == common.CustomCollector {
 		if mc.Source == nil || mc.Source.FileSystemPath == nil {
 			return "", common.InvalidKind
@@ -142,6 +151,25 @@ func getMountPath(mc common.MetricsCollectorSpec) (string, common.FileSystemKind
 	}
 }
 
+// getPrometheusMetricsURL returns URL of the metrics endpoint for the Prometheus metrics collector.
+// Sidecar container shares network namespace with the training container, so localhost is used by default.
+func getPrometheusMetricsURL(httpGet *v1.HTTPGetAction) string {
+	scheme := strings.ToLower(string(httpGet.Scheme))
+	if scheme == "" {
+		scheme = strings.ToLower(string(v1.URISchemeHTTP))
+	}
+	host := httpGet.Host
+	if host == "" {
+		host = "localhost"
+	}
+	u := url.URL{
+		Scheme: scheme,
+		Host:   net.JoinHostPort(host, httpGet.Port.String()),
+		Path:   httpGet.Path,
+	}
+	return u.String()
+}
+
 func needWrapWorkerContainer(mc common.MetricsCollectorSpec) bool {
 	mcKind := mc.Collector.Kind
 	for _, kind := range NeedWrapWorkerMetricsCollectorList {
@@ -79,6 +79,12 @@ else
   docker buildx build --platform "${ARCH}" -t "${REGISTRY}/tfevent-metrics-collector:${TAG}" -f ${CMD_PREFIX}/metricscollector/${VERSION}/tfevent-metricscollector/Dockerfile .
 fi
 
+echo -e "\nBuilding Go TF Event metrics collector image...\n"
+docker buildx build --platform "${ARCH}" -t "${REGISTRY}/tfevent-metrics-collector-go:${TAG}" -f ${CMD_PREFIX}/metricscollector/${VERSION}/tfevent-metricscollector-go/Dockerfile .
+
+echo -e "\nBuilding Prometheus metrics collector image...\n"
+docker buildx build --platform "${ARCH}" -t "${REGISTRY}/prometheus-metrics-collector:${TAG}" -f ${CMD_PREFIX}/metricscollector/${VERSION}/prometheus-metricscollector/Dockerfile .
+
 # Suggestion images
 echo -e "\nBuilding suggestion images..."
 
@@ -50,6 +50,12 @@ docker push "${REGISTRY}/file-metrics-collector:${TAG}"
 echo -e "\nPushing TF Event metrics collector image...\n"
 docker push "${REGISTRY}/tfevent-metrics-collector:${TAG}"
 
+echo -e "\nPushing Go TF Event metrics collector image...\n"
+docker push "${REGISTRY}/tfevent-metrics-collector-go:${TAG}"
+
+echo -e "\nPushing Prometheus metrics collector image...\n"
+docker push "${REGISTRY}/prometheus-metrics-collector:${TAG}"
+
 # Suggestion images
 echo -e "\nPushing suggestion images..."
 
@@ -27,16 +27,24 @@ from kubeflow.katib.exceptions import ApiValueError
 from kubeflow.katib.exceptions import ApiKeyError
 from kubeflow.katib.exceptions import ApiException
 # import models into sdk package
+from kubeflow.katib.models.v1beta1_adaptive_parallelism_spec import V1beta1AdaptiveParallelismSpec
+from kubeflow.katib.models.v1beta1_additional_objective import V1beta1AdditionalObjective
 from kubeflow.katib.models.v1beta1_algorithm_setting import V1beta1AlgorithmSetting
 from kubeflow.katib.models.v1beta1_algorithm_spec import V1beta1AlgorithmSpec
 from kubeflow.katib.models.v1beta1_collector_spec import V1beta1CollectorSpec
 from kubeflow.katib.models.v1beta1_config_map_source import V1beta1ConfigMapSource
+from kubeflow.katib.models.v1beta1_convergence_spec import V1beta1ConvergenceSpec
+from kubeflow.katib.models.v1beta1_convergence_status import V1beta1ConvergenceStatus
+from kubeflow.katib.models.v1beta1_duplicate_suggestion_spec import V1beta1DuplicateSuggestionSpec
 from kubeflow.katib.models.v1beta1_early_stopping_rule import V1beta1EarlyStoppingRule
 from kubeflow.katib.models.v1beta1_early_stopping_setting import V1beta1EarlyStoppingSetting
 from kubeflow.katib.models.v1beta1_early_stopping_spec import V1beta1EarlyStoppingSpec
 from kubeflow.katib.models.v1beta1_experiment import V1beta1Experiment
 from kubeflow.katib.models.v1beta1_experiment_condition import V1beta1ExperimentCondition
 from kubeflow.katib.models.v1beta1_experiment_list import V1beta1ExperimentList
+from kubeflow.katib.models.v1beta1_experiment_queue import V1beta1ExperimentQueue
+from kubeflow.katib.models.v1beta1_experiment_queue_list import V1beta1ExperimentQueueList
+from kubeflow.katib.models.v1beta1_experiment_queue_spec import V1beta1ExperimentQueueSpec
 from kubeflow.katib.models.v1beta1_experiment_spec import V1beta1ExperimentSpec
 from kubeflow.katib.models.v1beta1_experiment_status import V1beta1ExperimentStatus
 from kubeflow.katib.models.v1beta1_feasible_space import V1beta1FeasibleSpace
@@ -51,8 +59,13 @@ from kubeflow.katib.models.v1beta1_objective_spec import V1beta1ObjectiveSpec
 from kubeflow.katib.models.v1beta1_observation import V1beta1Observation
 from kubeflow.katib.models.v1beta1_operation import V1beta1Operation
 from kubeflow.katib.models.v1beta1_optimal_trial import V1beta1OptimalTrial
+from kubeflow.katib.models.v1beta1_parallelism_status import V1beta1ParallelismStatus
 from kubeflow.katib.models.v1beta1_parameter_assignment import V1beta1ParameterAssignment
+from kubeflow.katib.models.v1beta1_parameter_condition import V1beta1ParameterCondition
 from kubeflow.katib.models.v1beta1_parameter_spec import V1beta1ParameterSpec
+from kubeflow.katib.models.v1beta1_queueing_spec import V1beta1QueueingSpec
+from kubeflow.katib.models.v1beta1_resource_usage_spec import V1beta1ResourceUsageSpec
+from kubeflow.katib.models.v1beta1_retry_policy import V1beta1RetryPolicy
 from kubeflow.katib.models.v1beta1_source_spec import V1beta1SourceSpec
 from kubeflow.katib.models.v1beta1_suggestion import V1beta1Suggestion
 from kubeflow.katib.models.v1beta1_suggestion_condition import V1beta1SuggestionCondition
@@ -61,6 +74,8 @@ from kubeflow.katib.models.v1beta1_suggestion_spec import V1beta1SuggestionSpec
 from kubeflow.katib.models.v1beta1_suggestion_status import V1beta1SuggestionStatus
 from kubeflow.katib.models.v1beta1_trial import V1beta1Trial
 from kubeflow.katib.models.v1beta1_trial_assignment import V1beta1TrialAssignment
+from kubeflow.katib.models.v1beta1_trial_attempt import V1beta1TrialAttempt
+from kubeflow.katib.models.v1beta1_trial_cache_spec import V1beta1TrialCacheSpec
 from kubeflow.katib.models.v1beta1_trial_condition import V1beta1TrialCondition
 from kubeflow.katib.models.v1beta1_trial_list import V1beta1TrialList
 from kubeflow.katib.models.v1beta1_trial_parameter_spec import V1beta1TrialParameterSpec
@@ -68,6 +83,8 @@ from kubeflow.katib.models.v1beta1_trial_source import V1beta1TrialSource
 from kubeflow.katib.models.v1beta1_trial_spec import V1beta1TrialSpec
 from kubeflow.katib.models.v1beta1_trial_status import V1beta1TrialStatus
 from kubeflow.katib.models.v1beta1_trial_template import V1beta1TrialTemplate
+from kubeflow.katib.models.v1beta1_trials_config_map_source import V1beta1TrialsConfigMapSource
+from kubeflow.katib.models.v1beta1_warm_start_spec import V1beta1WarmStartSpec
 
 # Import Katib API client.
 from kubeflow.katib.api.katib_client import KatibClient
@@ -0,0 +1,176 @@
+# coding: utf-8
+
+"""
+    Katib
+
+    Swagger description for Katib  # noqa: E501
+
+    The version of the OpenAPI document: v1beta1-0.1
+    Generated by: https://openapi-generator.tech
+"""
+
+
+import pprint
+import re  # noqa: F401
+
+import six
+
+from kubeflow.katib.configuration import Configuration
+
+
+class V1beta1AdaptiveParallelismSpec(object):
+    """NOTE: This class is auto generated by OpenAPI Generator.
+    Ref: https://openapi-generator.tech
+
+    Do not edit the class manually.
+    """
+
+    """
+    Attributes:
+      openapi_types (dict): The key is attribute name
+                            and the value is attribute type.
+      attribute_map (dict): The key is attribute name
+                            and the value is json key in definition.
+    """
+    openapi_types = {
+        'max_parallel_trial_count': 'int',
+        'min_parallel_trial_count': 'int',
+        'pending_timeout': 'str'
+    }
+
+    attribute_map = {
+        'max_parallel_trial_count': 'maxParallelTrialCount',
+        'min_parallel_trial_count': 'minParallelTrialCount',
+        'pending_timeout': 'pendingTimeout'
+    }
+
+    def __init__(self, max_parallel_trial_count=None, min_parallel_trial_count=None, pending_timeout=None, local_vars_configuration=None):  # noqa: E501
+        """V1beta1AdaptiveParallelismSpec - a model defined in OpenAPI"""  # noqa: E501
+        if local_vars_configuration is None:
+            local_vars_configuration = Configuration()
+        self.local_vars_configuration = local_vars_configuration
+
+        self._max_parallel_trial_count = None
+        self._min_parallel_trial_count = None
+        self._pending_timeout = None
+        self.discriminator = None
+
+        if max_parallel_trial_count is not None:
+            self.max_parallel_trial_count = max_parallel_trial_count
+        if min_parallel_trial_count is not None:
+            self.min_parallel_trial_count = min_parallel_trial_count
+        if pending_timeout is not None:
+            self.pending_timeout = pending_timeout
+
+    @property
+    def max_parallel_trial_count(self):
+        """Gets the max_parallel_trial_count of this V1beta1AdaptiveParallelismSpec.  # noqa: E501
+
+        Max number of trials which run in parallel.  # noqa: E501
+
+        :return: The max_parallel_trial_count of this V1beta1AdaptiveParallelismSpec.  # noqa: E501
+        :rtype: int
+        """
+        return self._max_parallel_trial_count
+
+    @max_parallel_trial_count.setter
+    def max_parallel_trial_count(self, max_parallel_trial_count):
+        """Sets the max_parallel_trial_count of this V1beta1AdaptiveParallelismSpec.
+
+        Max number of trials which run in parallel.  # noqa: E501
+
+        :param max_parallel_trial_count: The max_parallel_trial_count of this V1beta1AdaptiveParallelismSpec.  # noqa: E501
+        :type: int
+        """
+
+        self._max_parallel_trial_count = max_parallel_trial_count
+
+    @property
+    def min_parallel_trial_count(self):
+        """Gets the min_parallel_trial_count of this V1beta1AdaptiveParallelismSpec.  # noqa: E501
+
+        Min number of trials which run in parallel.  # noqa: E501
+
+        :return: The min_parallel_trial_count of this V1beta1AdaptiveParallelismSpec.  # noqa: E501
+        :rtype: int
+        """
+        return self._min_parallel_trial_count
+
+    @min_parallel_trial_count.setter
+    def min_parallel_trial_count(self, min_parallel_trial_count):
+        """Sets the min_parallel_trial_count of this V1beta1AdaptiveParallelismSpec.
+
+        Min number of trials which run in parallel.  # noqa: E501
+
+        :param min_parallel_trial_count: The min_parallel_trial_count of this V1beta1AdaptiveParallelismSpec.  # noqa: E501
+        :type: int
+        """
+
+        self._min_parallel_trial_count = min_parallel_trial_count
+
+    @property
+    def pending_timeout(self):
+        """Gets the pending_timeout of this V1beta1AdaptiveParallelismSpec.  # noqa: E501
+
+
+        :return: The pending_timeout of this V1beta1AdaptiveParallelismSpec.  # noqa: E501
+        :rtype: str
+        """
+        return self._pending_timeout
+
+    @pending_timeout.setter
+    def pending_timeout(self, pending_timeout):
+        """Sets the pending_timeout of this V1beta1AdaptiveParallelismSpec.
+
+
+        :param pending_timeout: The pending_timeout of this V1beta1AdaptiveParallelismSpec.  # noqa: E501
+        :type: str
+        """
+
+        self._pending_timeout = pending_timeout
+
+    def to_dict(self):
+        """Returns the model properties as a dict"""
+        result = {}
+
+        for attr, _ in six.iteritems(self.openapi_types):
+            value = getattr(self, attr)
+            if isinstance(value, list):
+                result[attr] = list(map(
+                    lambda x: x.to_dict() if hasattr(x, "to_dict") else x,
+                    value
+                ))
+            elif hasattr(value, "to_dict"):
+                result[attr] = value.to_dict()
+            elif isinstance(value, dict):
+                result[attr] = dict(map(
+                    lambda item: (item[0], item[1].to_dict())
+                    if hasattr(item[1], "to_dict") else item,
+                    value.items()
+                ))
+            else:
+                result[attr] = value
+
+        return result
+
+    def to_str(self):
+        """Returns the string representation of the model"""
+        return pprint.pformat(self.to_dict())
+
+    def __repr__(self):
+        """For `print` and `pprint`"""
+        return self.to_str()
+
+    def __eq__(self, other):
+        """Returns true if both objects are equal"""
+        if not isinstance(other, V1beta1AdaptiveParallelismSpec):
+            return False
+
+        return self.to_dict() == other.to_dict()
+
+    def __ne__(self, other):
+        """Returns true if both objects are not equal"""
+        if not isinstance(other, V1beta1AdaptiveParallelismSpec):
+            return True
+
+        return self.to_dict() != other.to_dict()
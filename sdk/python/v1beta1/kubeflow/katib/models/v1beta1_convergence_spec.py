@@ -0,0 +1,150 @@
+# coding: utf-8
+
+"""
+    Katib
+
+    Swagger description for Katib  # noqa: E501
+
+    The version of the OpenAPI document: v1beta1-0.1
+    Generated by: https://openapi-generator.tech
+"""
+
+
+import pprint
+import re  # noqa: F401
+
+import six
+
+from kubeflow.katib.configuration import Configuration
+
+
+class V1beta1ConvergenceSpec(object):
+    """NOTE: This class is auto generated by OpenAPI Generator.
+    Ref: https://openapi-generator.tech
+
+    Do not edit the class manually.
+    """
+
+    """
+    Attributes:
+      openapi_types (dict): The key is attribute name
+                            and the value is attribute type.
+      attribute_map (dict): The key is attribute name
+                            and the value is json key in definition.
+    """
+    openapi_types = {
+        'min_delta': 'float',
+        'patience': 'int'
+    }
+
+    attribute_map = {
+        'min_delta': 'minDelta',
+        'patience': 'patience'
+    }
+
+    def __init__(self, min_delta=None, patience=None, local_vars_configuration=None):  # noqa: E501
+        """V1beta1ConvergenceSpec - a model defined in OpenAPI"""  # noqa: E501
+        if local_vars_configuration is None:
+            local_vars_configuration = Configuration()
+        self.local_vars_configuration = local_vars_configuration
+
+        self._min_delta = None
+        self._patience = None
+        self.discriminator = None
+
+        if min_delta is not None:
+            self.min_delta = min_delta
+        if patience is not None:
+            self.patience = patience
+
+    @property
+    def min_delta(self):
+        """Gets the min_delta of this V1beta1ConvergenceSpec.  # noqa: E501
+
+        Min change of the best objective value to qualify as the improvement. Defaults to 0, i.e. any better objective value is the improvement.  # noqa: E501
+
+        :return: The min_delta of this V1beta1ConvergenceSpec.  # noqa: E501
+        :rtype: float
+        """
+        return self._min_delta
+
+    @min_delta.setter
+    def min_delta(self, min_delta):
+        """Sets the min_delta of this V1beta1ConvergenceSpec.
+
+        Min change of the best objective value to qualify as the improvement. Defaults to 0, i.e. any better objective value is the improvement.  # noqa: E501
+
+        :param min_delta: The min_delta of this V1beta1ConvergenceSpec.  # noqa: E501
+        :type: float
+        """
+
+        self._min_delta = min_delta
+
+    @property
+    def patience(self):
+        """Gets the patience of this V1beta1ConvergenceSpec.  # noqa: E501
+
+        Number of the completed trials without improvement of the best objective value to mark experiment as succeeded.  # noqa: E501
+
+        :return: The patience of this V1beta1ConvergenceSpec.  # noqa: E501
+        :rtype: int
+        """
+        return self._patience
+
+    @patience.setter
+    def patience(self, patience):
+        """Sets the patience of this V1beta1ConvergenceSpec.
+
+        Number of the completed trials without improvement of the best objective value to mark experiment as succeeded.  # noqa: E501
+
+        :param patience: The patience of this V1beta1ConvergenceSpec.  # noqa: E501
+        :type: int
+        """
+
+        self._patience = patience
+
+    def to_dict(self):
+        """Returns the model properties as a dict"""
+        result = {}
+
+        for attr, _ in six.iteritems(self.openapi_types):
+            value = getattr(self, attr)
+            if isinstance(value, list):
+                result[attr] = list(map(
+                    lambda x: x.to_dict() if hasattr(x, "to_dict") else x,
+                    value
+                ))
+            elif hasattr(value, "to_dict"):
+                result[attr] = value.to_dict()
+            elif isinstance(value, dict):
+                result[attr] = dict(map(
+                    lambda item: (item[0], item[1].to_dict())
+                    if hasattr(item[1], "to_dict") else item,
+                    value.items()
+                ))
+            else:
+                result[attr] = value
+
+        return result
+
+    def to_str(self):
+        """Returns the string representation of the model"""
+        return pprint.pformat(self.to_dict())
+
+    def __repr__(self):
+        """For `print` and `pprint`"""
+        return self.to_str()
+
+    def __eq__(self, other):
+        """Returns true if both objects are equal"""
+        if not isinstance(other, V1beta1ConvergenceSpec):
+            return False
+
+        return self.to_dict() == other.to_dict()
+
+    def __ne__(self, other):
+        """Returns true if both objects are not equal"""
+        if not isinstance(other, V1beta1ConvergenceSpec):
+            return True
+
+        return self.to_dict() != other.to_dict()
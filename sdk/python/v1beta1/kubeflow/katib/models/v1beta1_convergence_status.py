@@ -0,0 +1,178 @@
+# coding: utf-8
+
+"""
+    Katib
+
+    Swagger description for Katib  # noqa: E501
+
+    The version of the OpenAPI document: v1beta1-0.1
+    Generated by: https://openapi-generator.tech
+"""
+
+
+import pprint
+import re  # noqa: F401
+
+import six
+
+from kubeflow.katib.configuration import Configuration
+
+
+class V1beta1ConvergenceStatus(object):
+    """NOTE: This class is auto generated by OpenAPI Generator.
+    Ref: https://openapi-generator.tech
+
+    Do not edit the class manually.
+    """
+
+    """
+    Attributes:
+      openapi_types (dict): The key is attribute name
+                            and the value is attribute type.
+      attribute_map (dict): The key is attribute name
+                            and the value is json key in definition.
+    """
+    openapi_types = {
+        'best_objective_value': 'str',
+        'best_trial_name': 'str',
+        'trials_without_improvement': 'int'
+    }
+
+    attribute_map = {
+        'best_objective_value': 'bestObjectiveValue',
+        'best_trial_name': 'bestTrialName',
+        'trials_without_improvement': 'trialsWithoutImprovement'
+    }
+
+    def __init__(self, best_objective_value=None, best_trial_name=None, trials_without_improvement=None, local_vars_configuration=None):  # noqa: E501
+        """V1beta1ConvergenceStatus - a model defined in OpenAPI"""  # noqa: E501
+        if local_vars_configuration is None:
+            local_vars_configuration = Configuration()
+        self.local_vars_configuration = local_vars_configuration
+
+        self._best_objective_value = None
+        self._best_trial_name = None
+        self._trials_without_improvement = None
+        self.discriminator = None
+
+        if best_objective_value is not None:
+            self.best_objective_value = best_objective_value
+        if best_trial_name is not None:
+            self.best_trial_name = best_trial_name
+        if trials_without_improvement is not None:
+            self.trials_without_improvement = trials_without_improvement
+
+    @property
+    def best_objective_value(self):
+        """Gets the best_objective_value of this V1beta1ConvergenceStatus.  # noqa: E501
+
+        Objective value of the trial which has improved the best objective value last.  # noqa: E501
+
+        :return: The best_objective_value of this V1beta1ConvergenceStatus.  # noqa: E501
+        :rtype: str
+        """
+        return self._best_objective_value
+
+    @best_objective_value.setter
+    def best_objective_value(self, best_objective_value):
+        """Sets the best_objective_value of this V1beta1ConvergenceStatus.
+
+        Objective value of the trial which has improved the best objective value last.  # noqa: E501
+
+        :param best_objective_value: The best_objective_value of this V1beta1ConvergenceStatus.  # noqa: E501
+        :type: str
+        """
+
+        self._best_objective_value = best_objective_value
+
+    @property
+    def best_trial_name(self):
+        """Gets the best_trial_name of this V1beta1ConvergenceStatus.  # noqa: E501
+
+        Name of the trial which has improved the best objective value last.  # noqa: E501
+
+        :return: The best_trial_name of this V1beta1ConvergenceStatus.  # noqa: E501
+        :rtype: str
+        """
+        return self._best_trial_name
+
+    @best_trial_name.setter
+    def best_trial_name(self, best_trial_name):
+        """Sets the best_trial_name of this V1beta1ConvergenceStatus.
+
+        Name of the trial which has improved the best objective value last.  # noqa: E501
+
+        :param best_trial_name: The best_trial_name of this V1beta1ConvergenceStatus.  # noqa: E501
+        :type: str
+        """
+
+        self._best_trial_name = best_trial_name
+
+    @property
+    def trials_without_improvement(self):
+        """Gets the trials_without_improvement of this V1beta1ConvergenceStatus.  # noqa: E501
+
+        How many trials have been completed since the last improvement of the best objective value.  # noqa: E501
+
+        :return: The trials_without_improvement of this V1beta1ConvergenceStatus.  # noqa: E501
+        :rtype: int
+        """
+        return self._trials_without_improvement
+
+    @trials_without_improvement.setter
+    def trials_without_improvement(self, trials_without_improvement):
+        """Sets the trials_without_improvement of this V1beta1ConvergenceStatus.
+
+        How many trials have been completed since the last improvement of the best objective value.  # noqa: E501
+
+        :param trials_without_improvement: The trials_without_improvement of this V1beta1ConvergenceStatus.  # noqa: E501
+        :type: int
+        """
+
+        self._trials_without_improvement = trials_without_improvement
+
+    def to_dict(self):
+        """Returns the model properties as a dict"""
+        result = {}
+
+        for attr, _ in six.iteritems(self.openapi_types):
+            value = getattr(self, attr)
+            if isinstance(value, list):
+                result[attr] = list(map(
+                    lambda x: x.to_dict() if hasattr(x, "to_dict") else x,
+                    value
+                ))
+            elif hasattr(value, "to_dict"):
+                result[attr] = value.to_dict()
+            elif isinstance(value, dict):
+                result[attr] = dict(map(
+                    lambda item: (item[0], item[1].to_dict())
+                    if hasattr(item[1], "to_dict") else item,
+                    value.items()
+                ))
+            else:
+                result[attr] = value
+
+        return result
+
+    def to_str(self):
+        """Returns the string representation of the model"""
+        return pprint.pformat(self.to_dict())
+
+    def __repr__(self):
+        """For `print` and `pprint`"""
+        return self.to_str()
+
+    def __eq__(self, other):
+        """Returns true if both objects are equal"""
+        if not isinstance(other, V1beta1ConvergenceStatus):
+            return False
+
+        return self.to_dict() == other.to_dict()
+
+    def __ne__(self, other):
+        """Returns true if both objects are not equal"""
+        if not isinstance(other, V1beta1ConvergenceStatus):
+            return True
+
+        return self.to_dict() != other.to_dict()
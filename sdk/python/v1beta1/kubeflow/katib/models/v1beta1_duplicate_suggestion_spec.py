@@ -0,0 +1,150 @@
+# coding: utf-8
+
+"""
+    Katib
+
+    Swagger description for Katib  # noqa: E501
+
+    The version of the OpenAPI document: v1beta1-0.1
+    Generated by: https://openapi-generator.tech
+"""
+
+
+import pprint
+import re  # noqa: F401
+
+import six
+
+from kubeflow.katib.configuration import Configuration
+
+
+class V1beta1DuplicateSuggestionSpec(object):
+    """NOTE: This class is auto generated by OpenAPI Generator.
+    Ref: https://openapi-generator.tech
+
+    Do not edit the class manually.
+    """
+
+    """
+    Attributes:
+      openapi_types (dict): The key is attribute name
+                            and the value is attribute type.
+      attribute_map (dict): The key is attribute name
+                            and the value is json key in definition.
+    """
+    openapi_types = {
+        'policy': 'str',
+        'tolerance': 'float'
+    }
+
+    attribute_map = {
+        'policy': 'policy',
+        'tolerance': 'tolerance'
+    }
+
+    def __init__(self, policy=None, tolerance=None, local_vars_configuration=None):  # noqa: E501
+        """V1beta1DuplicateSuggestionSpec - a model defined in OpenAPI"""  # noqa: E501
+        if local_vars_configuration is None:
+            local_vars_configuration = Configuration()
+        self.local_vars_configuration = local_vars_configuration
+
+        self._policy = None
+        self._tolerance = None
+        self.discriminator = None
+
+        if policy is not None:
+            self.policy = policy
+        if tolerance is not None:
+            self.tolerance = tolerance
+
+    @property
+    def policy(self):
+        """Gets the policy of this V1beta1DuplicateSuggestionSpec.  # noqa: E501
+
+        Policy for the parameter assignments which have already been suggested.  # noqa: E501
+
+        :return: The policy of this V1beta1DuplicateSuggestionSpec.  # noqa: E501
+        :rtype: str
+        """
+        return self._policy
+
+    @policy.setter
+    def policy(self, policy):
+        """Sets the policy of this V1beta1DuplicateSuggestionSpec.
+
+        Policy for the parameter assignments which have already been suggested.  # noqa: E501
+
+        :param policy: The policy of this V1beta1DuplicateSuggestionSpec.  # noqa: E501
+        :type: str
+        """
+
+        self._policy = policy
+
+    @property
+    def tolerance(self):
+        """Gets the tolerance of this V1beta1DuplicateSuggestionSpec.  # noqa: E501
+
+        Tolerance to compare the values of the int and double parameters, relative to the range of the feasible space. For example, with tolerance 0.01 and feasible space [0, 10], values 1.05 and 1.1 are the same. Values of the categorical and discrete parameters are compared exactly. Defaults to 0, i.e. assignments are duplicated only if all values are equal.  # noqa: E501
+
+        :return: The tolerance of this V1beta1DuplicateSuggestionSpec.  # noqa: E501
+        :rtype: float
+        """
+        return self._tolerance
+
+    @tolerance.setter
+    def tolerance(self, tolerance):
+        """Sets the tolerance of this V1beta1DuplicateSuggestionSpec.
+
+        Tolerance to compare the values of the int and double parameters, relative to the range of the feasible space. For example, with tolerance 0.01 and feasible space [0, 10], values 1.05 and 1.1 are the same. Values of the categorical and discrete parameters are compared exactly. Defaults to 0, i.e. assignments are duplicated only if all values are equal.  # noqa: E501
+
+        :param tolerance: The tolerance of this V1beta1DuplicateSuggestionSpec.  # noqa: E501
+        :type: float
+        """
+
+        self._tolerance = tolerance
+
+    def to_dict(self):
+        """Returns the model properties as a dict"""
+        result = {}
+
+        for attr, _ in six.iteritems(self.openapi_types):
+            value = getattr(self, attr)
+            if isinstance(value, list):
+                result[attr] = list(map(
+                    lambda x: x.to_dict() if hasattr(x, "to_dict") else x,
+                    value
+                ))
+            elif hasattr(value, "to_dict"):
+                result[attr] = value.to_dict()
+            elif isinstance(value, dict):
+                result[attr] = dict(map(
+                    lambda item: (item[0], item[1].to_dict())
+                    if hasattr(item[1], "to_dict") else item,
+                    value.items()
+                ))
+            else:
+                result[attr] = value
+
+        return result
+
+    def to_str(self):
+        """Returns the string representation of the model"""
+        return pprint.pformat(self.to_dict())
+
+    def __repr__(self):
+        """For `print` and `pprint`"""
+        return self.to_str()
+
+    def __eq__(self, other):
+        """Returns true if both objects are equal"""
+        if not isinstance(other, V1beta1DuplicateSuggestionSpec):
+            return False
+
+        return self.to_dict() == other.to_dict()
+
+    def __ne__(self, other):
+        """Returns true if both objects are not equal"""
+        if not isinstance(other, V1beta1DuplicateSuggestionSpec):
+            return True
+
+        return self.to_dict() != other.to_dict()
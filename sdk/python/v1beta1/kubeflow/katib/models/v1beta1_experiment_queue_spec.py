@@ -0,0 +1,123 @@
+# coding: utf-8
+
+"""
+    Katib
+
+    Swagger description for Katib  # noqa: E501
+
+    The version of the OpenAPI document: v1beta1-0.1
+    Generated by: https://openapi-generator.tech
+"""
+
+
+import pprint
+import re  # noqa: F401
+
+import six
+
+from kubeflow.katib.configuration import Configuration
+
+
+class V1beta1ExperimentQueueSpec(object):
+    """NOTE: This class is auto generated by OpenAPI Generator.
+    Ref: https://openapi-generator.tech
+
+    Do not edit the class manually.
+    """
+
+    """
+    Attributes:
+      openapi_types (dict): The key is attribute name
+                            and the value is attribute type.
+      attribute_map (dict): The key is attribute name
+                            and the value is json key in definition.
+    """
+    openapi_types = {
+        'max_active_trials': 'int'
+    }
+
+    attribute_map = {
+        'max_active_trials': 'maxActiveTrials'
+    }
+
+    def __init__(self, max_active_trials=0, local_vars_configuration=None):  # noqa: E501
+        """V1beta1ExperimentQueueSpec - a model defined in OpenAPI"""  # noqa: E501
+        if local_vars_configuration is None:
+            local_vars_configuration = Configuration()
+        self.local_vars_configuration = local_vars_configuration
+
+        self._max_active_trials = None
+        self.discriminator = None
+
+        self.max_active_trials = max_active_trials
+
+    @property
+    def max_active_trials(self):
+        """Gets the max_active_trials of this V1beta1ExperimentQueueSpec.  # noqa: E501
+
+        Max number of active trials of all Experiments in the namespace. The trial slots are given to the Experiments with higher priority first, and divided by weight between the Experiments with the same priority.  # noqa: E501
+
+        :return: The max_active_trials of this V1beta1ExperimentQueueSpec.  # noqa: E501
+        :rtype: int
+        """
+        return self._max_active_trials
+
+    @max_active_trials.setter
+    def max_active_trials(self, max_active_trials):
+        """Sets the max_active_trials of this V1beta1ExperimentQueueSpec.
+
+        Max number of active trials of all Experiments in the namespace. The trial slots are given to the Experiments with higher priority first, and divided by weight between the Experiments with the same priority.  # noqa: E501
+
+        :param max_active_trials: The max_active_trials of this V1beta1ExperimentQueueSpec.  # noqa: E501
+        :type: int
+        """
+        if self.local_vars_configuration.client_side_validation and max_active_trials is None:  # noqa: E501
+            raise ValueError("Invalid value for `max_active_trials`, must not be `None`")  # noqa: E501
+
+        self._max_active_trials = max_active_trials
+
+    def to_dict(self):
+        """Returns the model properties as a dict"""
+        result = {}
+
+        for attr, _ in six.iteritems(self.openapi_types):
+            value = getattr(self, attr)
+            if isinstance(value, list):
+                result[attr] = list(map(
+                    lambda x: x.to_dict() if hasattr(x, "to_dict") else x,
+                    value
+                ))
+            elif hasattr(value, "to_dict"):
+                result[attr] = value.to_dict()
+            elif isinstance(value, dict):
+                result[attr] = dict(map(
+                    lambda item: (item[0], item[1].to_dict())
+                    if hasattr(item[1], "to_dict") else item,
+                    value.items()
+                ))
+            else:
+                result[attr] = value
+
+        return result
+
+    def to_str(self):
+        """Returns the string representation of the model"""
+        return pprint.pformat(self.to_dict())
+
+    def __repr__(self):
+        """For `print` and `pprint`"""
+        return self.to_str()
+
+    def __eq__(self, other):
+        """Returns true if both objects are equal"""
+        if not isinstance(other, V1beta1ExperimentQueueSpec):
+            return False
+
+        return self.to_dict() == other.to_dict()
+
+    def __ne__(self, other):
+        """Returns true if both objects are not equal"""
+        if not isinstance(other, V1beta1ExperimentQueueSpec):
+            return True
+
+        return self.to_dict() != other.to_dict()
@@ -33,58 +33,106 @@ class V1beta1ExperimentSpec(object):
                             and the value is json key in definition.
     """
     openapi_types = {
+        'adaptive_parallelism': 'V1beta1AdaptiveParallelismSpec',
         'algorithm': 'V1beta1AlgorithmSpec',
+        'budget_policy': 'str',
+        'convergence': 'V1beta1ConvergenceSpec',
+        'duplicate_suggestion': 'V1beta1DuplicateSuggestionSpec',
         'early_stopping': 'V1beta1EarlyStoppingSpec',
+        'max_duration': 'str',
         'max_failed_trial_count': 'int',
+        'max_resource_usage': 'V1beta1ResourceUsageSpec',
         'max_trial_count': 'int',
         'metrics_collector_spec': 'V1beta1MetricsCollectorSpec',
         'nas_config': 'V1beta1NasConfig',
         'objective': 'V1beta1ObjectiveSpec',
         'parallel_trial_count': 'int',
+        'parameter_constraints': 'list[str]',
         'parameters': 'list[V1beta1ParameterSpec]',
+        'queueing': 'V1beta1QueueingSpec',
         'resume_policy': 'str',
-        'trial_template': 'V1beta1TrialTemplate'
+        'suspend': 'bool',
+        'suspend_policy': 'str',
+        'trial_cache': 'V1beta1TrialCacheSpec',
+        'trial_template': 'V1beta1TrialTemplate',
+        'warm_start': 'V1beta1WarmStartSpec'
     }
 
     attribute_map = {
+        'adaptive_parallelism': 'adaptiveParallelism',
         'algorithm': 'algorithm',
+        'budget_policy': 'budgetPolicy',
+        'convergence': 'convergence',
+        'duplicate_suggestion': 'duplicateSuggestion',
         'early_stopping': 'earlyStopping',
+        'max_duration': 'maxDuration',
         'max_failed_trial_count': 'maxFailedTrialCount',
+        'max_resource_usage': 'maxResourceUsage',
         'max_trial_count': 'maxTrialCount',
         'metrics_collector_spec': 'metricsCollectorSpec',
         'nas_config': 'nasConfig',
         'objective': 'objective',
         'parallel_trial_count': 'parallelTrialCount',
+        'parameter_constraints': 'parameterConstraints',
         'parameters': 'parameters',
+        'queueing': 'queueing',
         'resume_policy': 'resumePolicy',
-        'trial_template': 'trialTemplate'
+        'suspend': 'suspend',
+        'suspend_policy': 'suspendPolicy',
+        'trial_cache': 'trialCache',
+        'trial_template': 'trialTemplate',
+        'warm_start': 'warmStart'
     }
 
-    def __init__(self, algorithm=None, early_stopping=None, max_failed_trial_count=None, max_trial_count=None, metrics_collector_spec=None, nas_config=None, objective=None, parallel_trial_count=None, parameters=None, resume_policy=None, trial_template=None, local_vars_configuration=None):  # noqa: E501
+    def __init__(self, adaptive_parallelism=None, algorithm=None, budget_policy=None, convergence=None, duplicate_suggestion=None, early_stopping=None, max_duration=None, max_failed_trial_count=None, max_resource_usage=None, max_trial_count=None, metrics_collector_spec=None, nas_config=None, objective=None, parallel_trial_count=None, parameter_constraints=None, parameters=None, queueing=None, resume_policy=None, suspend=None, suspend_policy=None, trial_cache=None, trial_template=None, warm_start=None, local_vars_configuration=None):  # noqa: E501
         """V1beta1ExperimentSpec - a model defined in OpenAPI"""  # noqa: E501
         if local_vars_configuration is None:
             local_vars_configuration = Configuration()
         self.local_vars_configuration = local_vars_configuration
 
+        self._adaptive_parallelism = None
         self._algorithm = None
+        self._budget_policy = None
+        self._convergence = None
+        self._duplicate_suggestion = None
         self._early_stopping = None
+        self._max_duration = None
         self._max_failed_trial_count = None
+        self._max_resource_usage = None
         self._max_trial_count = None
         self._metrics_collector_spec = None
         self._nas_config = None
         self._objective = None
         self._parallel_trial_count = None
+        self._parameter_constraints = None
         self._parameters = None
+        self._queueing = None
         self._resume_policy = None
+        self._suspend = None
+        self._suspend_policy = None
+        self._trial_cache = None
         self._trial_template = None
+        self._warm_start = None
         self.discriminator = None
 
+        if adaptive_parallelism is not None:
+            self.adaptive_parallelism = adaptive_parallelism
         if algorithm is not None:
             self.algorithm = algorithm
+        if budget_policy is not None:
+            self.budget_policy = budget_policy
+        if convergence is not None:
+            self.convergence = convergence
+        if duplicate_suggestion is not None:
+            self.duplicate_suggestion = duplicate_suggestion
         if early_stopping is not None:
             self.early_stopping = early_stopping
+        if max_duration is not None:
+            self.max_duration = max_duration
         if max_failed_trial_count is not None:
             self.max_failed_trial_count = max_failed_trial_count
+        if max_resource_usage is not None:
+            self.max_resource_usage = max_resource_usage
         if max_trial_count is not None:
             self.max_trial_count = max_trial_count
         if metrics_collector_spec is not None:
@@ -95,12 +143,45 @@ class V1beta1ExperimentSpec(object):
             self.objective = objective
         if parallel_trial_count is not None:
             self.parallel_trial_count = parallel_trial_count
+        if parameter_constraints is not None:
+            self.parameter_constraints = parameter_constraints
         if parameters is not None:
             self.parameters = parameters
+        if queueing is not None:
+            self.queueing = queueing
         if resume_policy is not None:
             self.resume_policy = resume_policy
+        if suspend is not None:
+            self.suspend = suspend
+        if suspend_policy is not None:
+            self.suspend_policy = suspend_policy
+        if trial_cache is not None:
+            self.trial_cache = trial_cache
         if trial_template is not None:
             self.trial_template = trial_template
+        if warm_start is not None:
+            self.warm_start = warm_start
+
+    @property
+    def adaptive_parallelism(self):
+        """Gets the adaptive_parallelism of this V1beta1ExperimentSpec.  # noqa: E501
+
+
+        :return: The adaptive_parallelism of this V1beta1ExperimentSpec.  # noqa: E501
+        :rtype: V1beta1AdaptiveParallelismSpec
+        """
+        return self._adaptive_parallelism
+
+    @adaptive_parallelism.setter
+    def adaptive_parallelism(self, adaptive_parallelism):
+        """Sets the adaptive_parallelism of this V1beta1ExperimentSpec.
+
+
+        :param adaptive_parallelism: The adaptive_parallelism of this V1beta1ExperimentSpec.  # noqa: E501
+        :type: V1beta1AdaptiveParallelismSpec
+        """
+
+        self._adaptive_parallelism = adaptive_parallelism
 
     @property
     def algorithm(self):
@@ -123,6 +204,71 @@ class V1beta1ExperimentSpec(object):
 
         self._algorithm = algorithm
 
+    @property
+    def budget_policy(self):
+        """Gets the budget_policy of this V1beta1ExperimentSpec.  # noqa: E501
+
+        Describes what happens to the active trials once max duration or max resource usage is reached. Default value is Finish.  # noqa: E501
+
+        :return: The budget_policy of this V1beta1ExperimentSpec.  # noqa: E501
+        :rtype: str
+        """
+        return self._budget_policy
+
+    @budget_policy.setter
+    def budget_policy(self, budget_policy):
+        """Sets the budget_policy of this V1beta1ExperimentSpec.
+
+        Describes what happens to the active trials once max duration or max resource usage is reached. Default value is Finish.  # noqa: E501
+
+        :param budget_policy: The budget_policy of this V1beta1ExperimentSpec.  # noqa: E501
+        :type: str
+        """
+
+        self._budget_policy = budget_policy
+
+    @property
+    def convergence(self):
+        """Gets the convergence of this V1beta1ExperimentSpec.  # noqa: E501
+
+
+        :return: The convergence of this V1beta1ExperimentSpec.  # noqa: E501
+        :rtype: V1beta1ConvergenceSpec
+        """
+        return self._convergence
+
+    @convergence.setter
+    def convergence(self, convergence):
+        """Sets the convergence of this V1beta1ExperimentSpec.
+
+
+        :param convergence: The convergence of this V1beta1ExperimentSpec.  # noqa: E501
+        :type: V1beta1ConvergenceSpec
+        """
+
+        self._convergence = convergence
+
+    @property
+    def duplicate_suggestion(self):
+        """Gets the duplicate_suggestion of this V1beta1ExperimentSpec.  # noqa: E501
+
+
+        :return: The duplicate_suggestion of this V1beta1ExperimentSpec.  # noqa: E501
+        :rtype: V1beta1DuplicateSuggestionSpec
+        """
+        return self._duplicate_suggestion
+
+    @duplicate_suggestion.setter
+    def duplicate_suggestion(self, duplicate_suggestion):
+        """Sets the duplicate_suggestion of this V1beta1ExperimentSpec.
+
+
+        :param duplicate_suggestion: The duplicate_suggestion of this V1beta1ExperimentSpec.  # noqa: E501
+        :type: V1beta1DuplicateSuggestionSpec
+        """
+
+        self._duplicate_suggestion = duplicate_suggestion
+
     @property
     def early_stopping(self):
         """Gets the early_stopping of this V1beta1ExperimentSpec.  # noqa: E501
@@ -144,6 +290,27 @@ class V1beta1ExperimentSpec(object):
 
         self._early_stopping = early_stopping
 
+    @property
+    def max_duration(self):
+        """Gets the max_duration of this V1beta1ExperimentSpec.  # noqa: E501
+
+
+        :return: The max_duration of this V1beta1ExperimentSpec.  # noqa: E501
+        :rtype: str
+        """
+        return self._max_duration
+
+    @max_duration.setter
+    def max_duration(self, max_duration):
+        """Sets the max_duration of this V1beta1ExperimentSpec.
+
+
+        :param max_duration: The max_duration of this V1beta1ExperimentSpec.  # noqa: E501
+        :type: str
+        """
+
+        self._max_duration = max_duration
+
     @property
     def max_failed_trial_count(self):
         """Gets the max_failed_trial_count of this V1beta1ExperimentSpec.  # noqa: E501
@@ -167,6 +334,27 @@ class V1beta1ExperimentSpec(object):
 
         self._max_failed_trial_count = max_failed_trial_count
 
+    @property
+    def max_resource_usage(self):
+        """Gets the max_resource_usage of this V1beta1ExperimentSpec.  # noqa: E501
+
+
+        :return: The max_resource_usage of this V1beta1ExperimentSpec.  # noqa: E501
+        :rtype: V1beta1ResourceUsageSpec
+        """
+        return self._max_resource_usage
+
+    @max_resource_usage.setter
+    def max_resource_usage(self, max_resource_usage):
+        """Sets the max_resource_usage of this V1beta1ExperimentSpec.
+
+
+        :param max_resource_usage: The max_resource_usage of this V1beta1ExperimentSpec.  # noqa: E501
+        :type: V1beta1ResourceUsageSpec
+        """
+
+        self._max_resource_usage = max_resource_usage
+
     @property
     def max_trial_count(self):
         """Gets the max_trial_count of this V1beta1ExperimentSpec.  # noqa: E501
@@ -276,6 +464,29 @@ class V1beta1ExperimentSpec(object):
 
         self._parallel_trial_count = parallel_trial_count
 
+    @property
+    def parameter_constraints(self):
+        """Gets the parameter_constraints of this V1beta1ExperimentSpec.  # noqa: E501
+
+        List of constraints for the parameter assignments, for example \"batch_size * grad_accum <= 512\". Each constraint is the boolean expression in the Go syntax over the parameter names. Parameters which names are not valid identifiers can be referenced as params[\"num-layers\"]. Trials are not created for the assignments which violate any constraint.  # noqa: E501
+
+        :return: The parameter_constraints of this V1beta1ExperimentSpec.  # noqa: E501
+        :rtype: list[str]
+        """
+        return self._parameter_constraints
+
+    @parameter_constraints.setter
+    def parameter_constraints(self, parameter_constraints):
+        """Sets the parameter_constraints of this V1beta1ExperimentSpec.
+
+        List of constraints for the parameter assignments, for example \"batch_size * grad_accum <= 512\". Each constraint is the boolean expression in the Go syntax over the parameter names. Parameters which names are not valid identifiers can be referenced as params[\"num-layers\"]. Trials are not created for the assignments which violate any constraint.  # noqa: E501
+
+        :param parameter_constraints: The parameter_constraints of this V1beta1ExperimentSpec.  # noqa: E501
+        :type: list[str]
+        """
+
+        self._parameter_constraints = parameter_constraints
+
     @property
     def parameters(self):
         """Gets the parameters of this V1beta1ExperimentSpec.  # noqa: E501
@@ -299,6 +510,27 @@ class V1beta1ExperimentSpec(object):
 
         self._parameters = parameters
 
+    @property
+    def queueing(self):
+        """Gets the queueing of this V1beta1ExperimentSpec.  # noqa: E501
+
+
+        :return: The queueing of this V1beta1ExperimentSpec.  # noqa: E501
+        :rtype: V1beta1QueueingSpec
+        """
+        return self._queueing
+
+    @queueing.setter
+    def queueing(self, queueing):
+        """Sets the queueing of this V1beta1ExperimentSpec.
+
+
+        :param queueing: The queueing of this V1beta1ExperimentSpec.  # noqa: E501
+        :type: V1beta1QueueingSpec
+        """
+
+        self._queueing = queueing
+
     @property
     def resume_policy(self):
         """Gets the resume_policy of this V1beta1ExperimentSpec.  # noqa: E501
@@ -322,6 +554,73 @@ class V1beta1ExperimentSpec(object):
 
         self._resume_policy = resume_policy
 
+    @property
+    def suspend(self):
+        """Gets the suspend of this V1beta1ExperimentSpec.  # noqa: E501
+
+        Whether the Experiment is suspended. Suspended Experiment doesn't create new trials and its suggestion deployment is scaled down. Setting it back to false resumes the Experiment.  # noqa: E501
+
+        :return: The suspend of this V1beta1ExperimentSpec.  # noqa: E501
+        :rtype: bool
+        """
+        return self._suspend
+
+    @suspend.setter
+    def suspend(self, suspend):
+        """Sets the suspend of this V1beta1ExperimentSpec.
+
+        Whether the Experiment is suspended. Suspended Experiment doesn't create new trials and its suggestion deployment is scaled down. Setting it back to false resumes the Experiment.  # noqa: E501
+
+        :param suspend: The suspend of this V1beta1ExperimentSpec.  # noqa: E501
+        :type: bool
+        """
+
+        self._suspend = suspend
+
+    @property
+    def suspend_policy(self):
+        """Gets the suspend_policy of this V1beta1ExperimentSpec.  # noqa: E501
+
+        Describes what happens to the active trials once the Experiment is suspended. Default value is Finish.  # noqa: E501
+
+        :return: The suspend_policy of this V1beta1ExperimentSpec.  # noqa: E501
+        :rtype: str
+        """
+        return self._suspend_policy
+
+    @suspend_policy.setter
+    def suspend_policy(self, suspend_policy):
+        """Sets the suspend_policy of this V1beta1ExperimentSpec.
+
+        Describes what happens to the active trials once the Experiment is suspended. Default value is Finish.  # noqa: E501
+
+        :param suspend_policy: The suspend_policy of this V1beta1ExperimentSpec.  # noqa: E501
+        :type: str
+        """
+
+        self._suspend_policy = suspend_policy
+
+    @property
+    def trial_cache(self):
+        """Gets the trial_cache of this V1beta1ExperimentSpec.  # noqa: E501
+
+
+        :return: The trial_cache of this V1beta1ExperimentSpec.  # noqa: E501
+        :rtype: V1beta1TrialCacheSpec
+        """
+        return self._trial_cache
+
+    @trial_cache.setter
+    def trial_cache(self, trial_cache):
+        """Sets the trial_cache of this V1beta1ExperimentSpec.
+
+
+        :param trial_cache: The trial_cache of this V1beta1ExperimentSpec.  # noqa: E501
+        :type: V1beta1TrialCacheSpec
+        """
+
+        self._trial_cache = trial_cache
+
     @property
     def trial_template(self):
         """Gets the trial_template of this V1beta1ExperimentSpec.  # noqa: E501
@@ -343,6 +642,27 @@ class V1beta1ExperimentSpec(object):
 
         self._trial_template = trial_template
 
+    @property
+    def warm_start(self):
+        """Gets the warm_start of this V1beta1ExperimentSpec.  # noqa: E501
+
+
+        :return: The warm_start of this V1beta1ExperimentSpec.  # noqa: E501
+        :rtype: V1beta1WarmStartSpec
+        """
+        return self._warm_start
+
+    @warm_start.setter
+    def warm_start(self, warm_start):
+        """Sets the warm_start of this V1beta1ExperimentSpec.
+
+
+        :param warm_start: The warm_start of this V1beta1ExperimentSpec.  # noqa: E501
+        :type: V1beta1WarmStartSpec
+        """
+
+        self._warm_start = warm_start
+
     def to_dict(self):
         """Returns the model properties as a dict"""
         result = {}
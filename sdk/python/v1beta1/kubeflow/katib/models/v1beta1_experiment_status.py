@@ -35,13 +35,17 @@ class V1beta1ExperimentStatus(object):
     openapi_types = {
         'completion_time': 'datetime',
         'conditions': 'list[V1beta1ExperimentCondition]',
+        'convergence': 'V1beta1ConvergenceStatus',
         'current_optimal_trial': 'V1beta1OptimalTrial',
         'early_stopped_trial_list': 'list[str]',
         'failed_trial_list': 'list[str]',
         'killed_trial_list': 'list[str]',
         'last_reconcile_time': 'datetime',
         'metrics_unavailable_trial_list': 'list[str]',
+        'parallelism': 'V1beta1ParallelismStatus',
+        'pareto_optimal_trials': 'list[V1beta1OptimalTrial]',
         'pending_trial_list': 'list[str]',
+        'resource_usage': 'float',
         'running_trial_list': 'list[str]',
         'start_time': 'datetime',
         'succeeded_trial_list': 'list[str]',
@@ -51,6 +55,7 @@ class V1beta1ExperimentStatus(object):
         'trials_failed': 'int',
         'trials_killed': 'int',
         'trials_pending': 'int',
+        'trials_queued': 'int',
         'trials_running': 'int',
         'trials_succeeded': 'int'
     }
@@ -58,13 +63,17 @@ class V1beta1ExperimentStatus(object):
     attribute_map = {
         'completion_time': 'completionTime',
         'conditions': 'conditions',
+        'convergence': 'convergence',
         'current_optimal_trial': 'currentOptimalTrial',
         'early_stopped_trial_list': 'earlyStoppedTrialList',
         'failed_trial_list': 'failedTrialList',
         'killed_trial_list': 'killedTrialList',
         'last_reconcile_time': 'lastReconcileTime',
         'metrics_unavailable_trial_list': 'metricsUnavailableTrialList',
+        'parallelism': 'parallelism',
+        'pareto_optimal_trials': 'paretoOptimalTrials',
         'pending_trial_list': 'pendingTrialList',
+        'resource_usage': 'resourceUsage',
         'running_trial_list': 'runningTrialList',
         'start_time': 'startTime',
         'succeeded_trial_list': 'succeededTrialList',
@@ -74,11 +83,12 @@ class V1beta1ExperimentStatus(object):
         'trials_failed': 'trialsFailed',
         'trials_killed': 'trialsKilled',
         'trials_pending': 'trialsPending',
+        'trials_queued': 'trialsQueued',
         'trials_running': 'trialsRunning',
         'trials_succeeded': 'trialsSucceeded'
     }
 
-    def __init__(self, completion_time=None, conditions=None, current_optimal_trial=None, early_stopped_trial_list=None, failed_trial_list=None, killed_trial_list=None, last_reconcile_time=None, metrics_unavailable_trial_list=None, pending_trial_list=None, running_trial_list=None, start_time=None, succeeded_trial_list=None, trial_metrics_unavailable=None, trials=None, trials_early_stopped=None, trials_failed=None, trials_killed=None, trials_pending=None, trials_running=None, trials_succeeded=None, local_vars_configuration=None):  # noqa: E501
+    def __init__(self, completion_time=None, conditions=None, convergence=None, current_optimal_trial=None, early_stopped_trial_list=None, failed_trial_list=None, killed_trial_list=None, last_reconcile_time=None, metrics_unavailable_trial_list=None, parallelism=None, pareto_optimal_trials=None, pending_trial_list=None, resource_usage=None, running_trial_list=None, start_time=None, succeeded_trial_list=None, trial_metrics_unavailable=None, trials=None, trials_early_stopped=None, trials_failed=None, trials_killed=None, trials_pending=None, trials_queued=None, trials_running=None, trials_succeeded=None, local_vars_configuration=None):  # noqa: E501
         """V1beta1ExperimentStatus - a model defined in OpenAPI"""  # noqa: E501
         if local_vars_configuration is None:
             local_vars_configuration = Configuration()
@@ -86,13 +96,17 @@ class V1beta1ExperimentStatus(object):
 
         self._completion_time = None
         self._conditions = None
+        self._convergence = None
         self._current_optimal_trial = None
         self._early_stopped_trial_list = None
         self._failed_trial_list = None
         self._killed_trial_list = None
         self._last_reconcile_time = None
         self._metrics_unavailable_trial_list = None
+        self._parallelism = None
+        self._pareto_optimal_trials = None
         self._pending_trial_list = None
+        self._resource_usage = None
         self._running_trial_list = None
         self._start_time = None
         self._succeeded_trial_list = None
@@ -102,6 +116,7 @@ class V1beta1ExperimentStatus(object):
         self._trials_failed = None
         self._trials_killed = None
         self._trials_pending = None
+        self._trials_queued = None
         self._trials_running = None
         self._trials_succeeded = None
         self.discriminator = None
@@ -110,6 +125,8 @@ class V1beta1ExperimentStatus(object):
             self.completion_time = completion_time
         if conditions is not None:
             self.conditions = conditions
+        if convergence is not None:
+            self.convergence = convergence
         if current_optimal_trial is not None:
             self.current_optimal_trial = current_optimal_trial
         if early_stopped_trial_list is not None:
@@ -122,8 +139,14 @@ class V1beta1ExperimentStatus(object):
             self.last_reconcile_time = last_reconcile_time
         if metrics_unavailable_trial_list is not None:
             self.metrics_unavailable_trial_list = metrics_unavailable_trial_list
+        if parallelism is not None:
+            self.parallelism = parallelism
+        if pareto_optimal_trials is not None:
+            self.pareto_optimal_trials = pareto_optimal_trials
         if pending_trial_list is not None:
             self.pending_trial_list = pending_trial_list
+        if resource_usage is not None:
+            self.resource_usage = resource_usage
         if running_trial_list is not None:
             self.running_trial_list = running_trial_list
         if start_time is not None:
@@ -142,6 +165,8 @@ class V1beta1ExperimentStatus(object):
             self.trials_killed = trials_killed
         if trials_pending is not None:
             self.trials_pending = trials_pending
+        if trials_queued is not None:
+            self.trials_queued = trials_queued
         if trials_running is not None:
             self.trials_running = trials_running
         if trials_succeeded is not None:
@@ -191,6 +216,27 @@ class V1beta1ExperimentStatus(object):
 
         self._conditions = conditions
 
+    @property
+    def convergence(self):
+        """Gets the convergence of this V1beta1ExperimentStatus.  # noqa: E501
+
+
+        :return: The convergence of this V1beta1ExperimentStatus.  # noqa: E501
+        :rtype: V1beta1ConvergenceStatus
+        """
+        return self._convergence
+
+    @convergence.setter
+    def convergence(self, convergence):
+        """Sets the convergence of this V1beta1ExperimentStatus.
+
+
+        :param convergence: The convergence of this V1beta1ExperimentStatus.  # noqa: E501
+        :type: V1beta1ConvergenceStatus
+        """
+
+        self._convergence = convergence
+
     @property
     def current_optimal_trial(self):
         """Gets the current_optimal_trial of this V1beta1ExperimentStatus.  # noqa: E501
@@ -325,6 +371,50 @@ class V1beta1ExperimentStatus(object):
 
         self._metrics_unavailable_trial_list = metrics_unavailable_trial_list
 
+    @property
+    def parallelism(self):
+        """Gets the parallelism of this V1beta1ExperimentStatus.  # noqa: E501
+
+
+        :return: The parallelism of this V1beta1ExperimentStatus.  # noqa: E501
+        :rtype: V1beta1ParallelismStatus
+        """
+        return self._parallelism
+
+    @parallelism.setter
+    def parallelism(self, parallelism):
+        """Sets the parallelism of this V1beta1ExperimentStatus.
+
+
+        :param parallelism: The parallelism of this V1beta1ExperimentStatus.  # noqa: E501
+        :type: V1beta1ParallelismStatus
+        """
+
+        self._parallelism = parallelism
+
+    @property
+    def pareto_optimal_trials(self):
+        """Gets the pareto_optimal_trials of this V1beta1ExperimentStatus.  # noqa: E501
+
+        Trials on the Pareto front of the multi-objective Experiment. Trial is on the Pareto front if no other Trial is better in all objectives. It is set only if spec.objective.additionalObjectives is not empty.  # noqa: E501
+
+        :return: The pareto_optimal_trials of this V1beta1ExperimentStatus.  # noqa: E501
+        :rtype: list[V1beta1OptimalTrial]
+        """
+        return self._pareto_optimal_trials
+
+    @pareto_optimal_trials.setter
+    def pareto_optimal_trials(self, pareto_optimal_trials):
+        """Sets the pareto_optimal_trials of this V1beta1ExperimentStatus.
+
+        Trials on the Pareto front of the multi-objective Experiment. Trial is on the Pareto front if no other Trial is better in all objectives. It is set only if spec.objective.additionalObjectives is not empty.  # noqa: E501
+
+        :param pareto_optimal_trials: The pareto_optimal_trials of this V1beta1ExperimentStatus.  # noqa: E501
+        :type: list[V1beta1OptimalTrial]
+        """
+
+        self._pareto_optimal_trials = pareto_optimal_trials
+
     @property
     def pending_trial_list(self):
         """Gets the pending_trial_list of this V1beta1ExperimentStatus.  # noqa: E501
@@ -348,6 +438,29 @@ class V1beta1ExperimentStatus(object):
 
         self._pending_trial_list = pending_trial_list
 
+    @property
+    def resource_usage(self):
+        """Gets the resource_usage of this V1beta1ExperimentStatus.  # noqa: E501
+
+        Cumulative resource usage of the trials in resource-hours. It is set only if spec.maxResourceUsage is specified.  # noqa: E501
+
+        :return: The resource_usage of this V1beta1ExperimentStatus.  # noqa: E501
+        :rtype: float
+        """
+        return self._resource_usage
+
+    @resource_usage.setter
+    def resource_usage(self, resource_usage):
+        """Sets the resource_usage of this V1beta1ExperimentStatus.
+
+        Cumulative resource usage of the trials in resource-hours. It is set only if spec.maxResourceUsage is specified.  # noqa: E501
+
+        :param resource_usage: The resource_usage of this V1beta1ExperimentStatus.  # noqa: E501
+        :type: float
+        """
+
+        self._resource_usage = resource_usage
+
     @property
     def running_trial_list(self):
         """Gets the running_trial_list of this V1beta1ExperimentStatus.  # noqa: E501
@@ -553,6 +666,29 @@ class V1beta1ExperimentStatus(object):
 
         self._trials_pending = trials_pending
 
+    @property
+    def trials_queued(self):
+        """Gets the trials_queued of this V1beta1ExperimentStatus.  # noqa: E501
+
+        How many trials are waiting for the free trial slots of the ExperimentQueues.  # noqa: E501
+
+        :return: The trials_queued of this V1beta1ExperimentStatus.  # noqa: E501
+        :rtype: int
+        """
+        return self._trials_queued
+
+    @trials_queued.setter
+    def trials_queued(self, trials_queued):
+        """Sets the trials_queued of this V1beta1ExperimentStatus.
+
+        How many trials are waiting for the free trial slots of the ExperimentQueues.  # noqa: E501
+
+        :param trials_queued: The trials_queued of this V1beta1ExperimentStatus.  # noqa: E501
+        :type: int
+        """
+
+        self._trials_queued = trials_queued
+
     @property
     def trials_running(self):
         """Gets the trials_running of this V1beta1ExperimentStatus.  # noqa: E501
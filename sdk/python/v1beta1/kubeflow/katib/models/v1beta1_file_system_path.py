@@ -33,34 +33,77 @@ class V1beta1FileSystemPath(object):
                             and the value is json key in definition.
     """
     openapi_types = {
+        'aggregation': 'str',
         'format': 'str',
         'kind': 'str',
-        'path': 'str'
+        'path': 'str',
+        'pattern': 'str',
+        'timestamp_format': 'str',
+        'timestamp_key': 'str'
     }
 
     attribute_map = {
+        'aggregation': 'aggregation',
         'format': 'format',
         'kind': 'kind',
-        'path': 'path'
+        'path': 'path',
+        'pattern': 'pattern',
+        'timestamp_format': 'timestampFormat',
+        'timestamp_key': 'timestampKey'
     }
 
-    def __init__(self, format=None, kind=None, path=None, local_vars_configuration=None):  # noqa: E501
+    def __init__(self, aggregation=None, format=None, kind=None, path=None, pattern=None, timestamp_format=None, timestamp_key=None, local_vars_configuration=None):  # noqa: E501
         """V1beta1FileSystemPath - a model defined in OpenAPI"""  # noqa: E501
         if local_vars_configuration is None:
             local_vars_configuration = Configuration()
         self.local_vars_configuration = local_vars_configuration
 
+        self._aggregation = None
         self._format = None
         self._kind = None
         self._path = None
+        self._pattern = None
+        self._timestamp_format = None
+        self._timestamp_key = None
         self.discriminator = None
 
+        if aggregation is not None:
+            self.aggregation = aggregation
         if format is not None:
             self.format = format
         if kind is not None:
             self.kind = kind
         if path is not None:
             self.path = path
+        if pattern is not None:
+            self.pattern = pattern
+        if timestamp_format is not None:
+            self.timestamp_format = timestamp_format
+        if timestamp_key is not None:
+            self.timestamp_key = timestamp_key
+
+    @property
+    def aggregation(self):
+        """Gets the aggregation of this V1beta1FileSystemPath.  # noqa: E501
+
+        Aggregation of the metrics across the metrics files which match the pattern. Aggregated metrics are reported only when the training is completed, not periodically during the training, and the aggregation other than None can't be used with early stopping. Defaults to None  # noqa: E501
+
+        :return: The aggregation of this V1beta1FileSystemPath.  # noqa: E501
+        :rtype: str
+        """
+        return self._aggregation
+
+    @aggregation.setter
+    def aggregation(self, aggregation):
+        """Sets the aggregation of this V1beta1FileSystemPath.
+
+        Aggregation of the metrics across the metrics files which match the pattern. Aggregated metrics are reported only when the training is completed, not periodically during the training, and the aggregation other than None can't be used with early stopping. Defaults to None  # noqa: E501
+
+        :param aggregation: The aggregation of this V1beta1FileSystemPath.  # noqa: E501
+        :type: str
+        """
+
+        self._aggregation = aggregation
 
     @property
     def format(self):
@@ -125,6 +168,75 @@ class V1beta1FileSystemPath(object):
 
         self._path = path
 
+    @property
+    def pattern(self):
+        """Gets the pattern of this V1beta1FileSystemPath.  # noqa: E501
+
+        Glob pattern of the metrics files in the directory when the kind is Directory, e.g. \"metrics-*.log\". Files which are created after the training starts are collected as well. Each metric is tagged with the name of its file as the source  # noqa: E501
+
+        :return: The pattern of this V1beta1FileSystemPath.  # noqa: E501
+        :rtype: str
+        """
+        return self._pattern
+
+    @pattern.setter
+    def pattern(self, pattern):
+        """Sets the pattern of this V1beta1FileSystemPath.
+
+        Glob pattern of the metrics files in the directory when the kind is Directory, e.g. \"metrics-*.log\". Files which are created after the training starts are collected as well. Each metric is tagged with the name of its file as the source  # noqa: E501
+
+        :param pattern: The pattern of this V1beta1FileSystemPath.  # noqa: E501
+        :type: str
+        """
+
+        self._pattern = pattern
+
+    @property
+    def timestamp_format(self):
+        """Gets the timestamp_format of this V1beta1FileSystemPath.  # noqa: E501
+
+        Go layout of the metrics timestamp string when the format is JSON, e.g. \"2006-01-02 15:04:05\". Defaults to RFC3339. Numeric timestamps are parsed as Unix time in seconds  # noqa: E501
+
+        :return: The timestamp_format of this V1beta1FileSystemPath.  # noqa: E501
+        :rtype: str
+        """
+        return self._timestamp_format
+
+    @timestamp_format.setter
+    def timestamp_format(self, timestamp_format):
+        """Sets the timestamp_format of this V1beta1FileSystemPath.
+
+        Go layout of the metrics timestamp string when the format is JSON, e.g. \"2006-01-02 15:04:05\". Defaults to RFC3339. Numeric timestamps are parsed as Unix time in seconds  # noqa: E501
+
+        :param timestamp_format: The timestamp_format of this V1beta1FileSystemPath.  # noqa: E501
+        :type: str
+        """
+
+        self._timestamp_format = timestamp_format
+
+    @property
+    def timestamp_key(self):
+        """Gets the timestamp_key of this V1beta1FileSystemPath.  # noqa: E501
+
+        Key of the metrics timestamp when the format is JSON, nested keys are separated by dots, e.g. \"meta.time\". Defaults to \"timestamp\"  # noqa: E501
+
+        :return: The timestamp_key of this V1beta1FileSystemPath.  # noqa: E501
+        :rtype: str
+        """
+        return self._timestamp_key
+
+    @timestamp_key.setter
+    def timestamp_key(self, timestamp_key):
+        """Sets the timestamp_key of this V1beta1FileSystemPath.
+
+        Key of the metrics timestamp when the format is JSON, nested keys are separated by dots, e.g. \"meta.time\". Defaults to \"timestamp\"  # noqa: E501
+
+        :param timestamp_key: The timestamp_key of this V1beta1FileSystemPath.  # noqa: E501
+        :type: str
+        """
+
+        self._timestamp_key = timestamp_key
+
     def to_dict(self):
         """Returns the model properties as a dict"""
         result = {}
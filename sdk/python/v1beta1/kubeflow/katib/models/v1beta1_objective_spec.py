@@ -34,6 +34,7 @@ class V1beta1ObjectiveSpec(object):
     """
     openapi_types = {
         'additional_metric_names': 'list[str]',
+        'additional_objectives': 'list[V1beta1AdditionalObjective]',
         'goal': 'float',
         'metric_strategies': 'list[V1beta1MetricStrategy]',
         'objective_metric_name': 'str',
@@ -42,19 +43,21 @@ class V1beta1ObjectiveSpec(object):
 
     attribute_map = {
         'additional_metric_names': 'additionalMetricNames',
+        'additional_objectives': 'additionalObjectives',
         'goal': 'goal',
         'metric_strategies': 'metricStrategies',
         'objective_metric_name': 'objectiveMetricName',
         'type': 'type'
     }
 
-    def __init__(self, additional_metric_names=None, goal=None, metric_strategies=None, objective_metric_name=None, type=None, local_vars_configuration=None):  # noqa: E501
+    def __init__(self, additional_metric_names=None, additional_objectives=None, goal=None, metric_strategies=None, objective_metric_name=None, type=None, local_vars_configuration=None):  # noqa: E501
         """V1beta1ObjectiveSpec - a model defined in OpenAPI"""  # noqa: E501
         if local_vars_configuration is None:
             local_vars_configuration = Configuration()
         self.local_vars_configuration = local_vars_configuration
 
         self._additional_metric_names = None
+        self._additional_objectives = None
         self._goal = None
         self._metric_strategies = None
         self._objective_metric_name = None
@@ -63,6 +66,8 @@ class V1beta1ObjectiveSpec(object):
 
         if additional_metric_names is not None:
             self.additional_metric_names = additional_metric_names
+        if additional_objectives is not None:
+            self.additional_objectives = additional_objectives
         if goal is not None:
             self.goal = goal
         if metric_strategies is not None:
@@ -95,6 +100,29 @@ class V1beta1ObjectiveSpec(object):
 
         self._additional_metric_names = additional_metric_names
 
+    @property
+    def additional_objectives(self):
+        """Gets the additional_objectives of this V1beta1ObjectiveSpec.  # noqa: E501
+
+        AdditionalObjectives represents metrics that are optimized together with the objective metric in the multi-objective Experiment. Each metric has its own optimization type. Additional objectives are collected from Trials as the additional metrics.  # noqa: E501
+
+        :return: The additional_objectives of this V1beta1ObjectiveSpec.  # noqa: E501
+        :rtype: list[V1beta1AdditionalObjective]
+        """
+        return self._additional_objectives
+
+    @additional_objectives.setter
+    def additional_objectives(self, additional_objectives):
+        """Sets the additional_objectives of this V1beta1ObjectiveSpec.
+
+        AdditionalObjectives represents metrics that are optimized together with the objective metric in the multi-objective Experiment. Each metric has its own optimization type. Additional objectives are collected from Trials as the additional metrics.  # noqa: E501
+
+        :param additional_objectives: The additional_objectives of this V1beta1ObjectiveSpec.  # noqa: E501
+        :type: list[V1beta1AdditionalObjective]
+        """
+
+        self._additional_objectives = additional_objectives
+
     @property
     def goal(self):
         """Gets the goal of this V1beta1ObjectiveSpec.  # noqa: E501
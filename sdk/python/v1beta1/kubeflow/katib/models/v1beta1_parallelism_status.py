@@ -0,0 +1,174 @@
+# coding: utf-8
+
+"""
+    Katib
+
+    Swagger description for Katib  # noqa: E501
+
+    The version of the OpenAPI document: v1beta1-0.1
+    Generated by: https://openapi-generator.tech
+"""
+
+
+import pprint
+import re  # noqa: F401
+
+import six
+
+from kubeflow.katib.configuration import Configuration
+
+
+class V1beta1ParallelismStatus(object):
+    """NOTE: This class is auto generated by OpenAPI Generator.
+    Ref: https://openapi-generator.tech
+
+    Do not edit the class manually.
+    """
+
+    """
+    Attributes:
+      openapi_types (dict): The key is attribute name
+                            and the value is attribute type.
+      attribute_map (dict): The key is attribute name
+                            and the value is json key in definition.
+    """
+    openapi_types = {
+        'effective_parallel_trial_count': 'int',
+        'last_transition_time': 'datetime',
+        'pending_duration': 'str'
+    }
+
+    attribute_map = {
+        'effective_parallel_trial_count': 'effectiveParallelTrialCount',
+        'last_transition_time': 'lastTransitionTime',
+        'pending_duration': 'pendingDuration'
+    }
+
+    def __init__(self, effective_parallel_trial_count=None, last_transition_time=None, pending_duration=None, local_vars_configuration=None):  # noqa: E501
+        """V1beta1ParallelismStatus - a model defined in OpenAPI"""  # noqa: E501
+        if local_vars_configuration is None:
+            local_vars_configuration = Configuration()
+        self.local_vars_configuration = local_vars_configuration
+
+        self._effective_parallel_trial_count = None
+        self._last_transition_time = None
+        self._pending_duration = None
+        self.discriminator = None
+
+        if effective_parallel_trial_count is not None:
+            self.effective_parallel_trial_count = effective_parallel_trial_count
+        if last_transition_time is not None:
+            self.last_transition_time = last_transition_time
+        if pending_duration is not None:
+            self.pending_duration = pending_duration
+
+    @property
+    def effective_parallel_trial_count(self):
+        """Gets the effective_parallel_trial_count of this V1beta1ParallelismStatus.  # noqa: E501
+
+        Number of trials which currently run in parallel.  # noqa: E501
+
+        :return: The effective_parallel_trial_count of this V1beta1ParallelismStatus.  # noqa: E501
+        :rtype: int
+        """
+        return self._effective_parallel_trial_count
+
+    @effective_parallel_trial_count.setter
+    def effective_parallel_trial_count(self, effective_parallel_trial_count):
+        """Sets the effective_parallel_trial_count of this V1beta1ParallelismStatus.
+
+        Number of trials which currently run in parallel.  # noqa: E501
+
+        :param effective_parallel_trial_count: The effective_parallel_trial_count of this V1beta1ParallelismStatus.  # noqa: E501
+        :type: int
+        """
+
+        self._effective_parallel_trial_count = effective_parallel_trial_count
+
+    @property
+    def last_transition_time(self):
+        """Gets the last_transition_time of this V1beta1ParallelismStatus.  # noqa: E501
+
+
+        :return: The last_transition_time of this V1beta1ParallelismStatus.  # noqa: E501
+        :rtype: datetime
+        """
+        return self._last_transition_time
+
+    @last_transition_time.setter
+    def last_transition_time(self, last_transition_time):
+        """Sets the last_transition_time of this V1beta1ParallelismStatus.
+
+
+        :param last_transition_time: The last_transition_time of this V1beta1ParallelismStatus.  # noqa: E501
+        :type: datetime
+        """
+
+        self._last_transition_time = last_transition_time
+
+    @property
+    def pending_duration(self):
+        """Gets the pending_duration of this V1beta1ParallelismStatus.  # noqa: E501
+
+
+        :return: The pending_duration of this V1beta1ParallelismStatus.  # noqa: E501
+        :rtype: str
+        """
+        return self._pending_duration
+
+    @pending_duration.setter
+    def pending_duration(self, pending_duration):
+        """Sets the pending_duration of this V1beta1ParallelismStatus.
+
+
+        :param pending_duration: The pending_duration of this V1beta1ParallelismStatus.  # noqa: E501
+        :type: str
+        """
+
+        self._pending_duration = pending_duration
+
+    def to_dict(self):
+        """Returns the model properties as a dict"""
+        result = {}
+
+        for attr, _ in six.iteritems(self.openapi_types):
+            value = getattr(self, attr)
+            if isinstance(value, list):
+                result[attr] = list(map(
+                    lambda x: x.to_dict() if hasattr(x, "to_dict") else x,
+                    value
+                ))
+            elif hasattr(value, "to_dict"):
+                result[attr] = value.to_dict()
+            elif isinstance(value, dict):
+                result[attr] = dict(map(
+                    lambda item: (item[0], item[1].to_dict())
+                    if hasattr(item[1], "to_dict") else item,
+                    value.items()
+                ))
+            else:
+                result[attr] = value
+
+        return result
+
+    def to_str(self):
+        """Returns the string representation of the model"""
+        return pprint.pformat(self.to_dict())
+
+    def __repr__(self):
+        """For `print` and `pprint`"""
+        return self.to_str()
+
+    def __eq__(self, other):
+        """Returns true if both objects are equal"""
+        if not isinstance(other, V1beta1ParallelismStatus):
+            return False
+
+        return self.to_dict() == other.to_dict()
+
+    def __ne__(self, other):
+        """Returns true if both objects are not equal"""
+        if not isinstance(other, V1beta1ParallelismStatus):
+            return True
+
+        return self.to_dict() != other.to_dict()
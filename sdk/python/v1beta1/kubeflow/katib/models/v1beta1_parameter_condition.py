@@ -0,0 +1,150 @@
+# coding: utf-8
+
+"""
+    Katib
+
+    Swagger description for Katib  # noqa: E501
+
+    The version of the OpenAPI document: v1beta1-0.1
+    Generated by: https://openapi-generator.tech
+"""
+
+
+import pprint
+import re  # noqa: F401
+
+import six
+
+from kubeflow.katib.configuration import Configuration
+
+
+class V1beta1ParameterCondition(object):
+    """NOTE: This class is auto generated by OpenAPI Generator.
+    Ref: https://openapi-generator.tech
+
+    Do not edit the class manually.
+    """
+
+    """
+    Attributes:
+      openapi_types (dict): The key is attribute name
+                            and the value is attribute type.
+      attribute_map (dict): The key is attribute name
+                            and the value is json key in definition.
+    """
+    openapi_types = {
+        'parent': 'str',
+        'values': 'list[str]'
+    }
+
+    attribute_map = {
+        'parent': 'parent',
+        'values': 'values'
+    }
+
+    def __init__(self, parent=None, values=None, local_vars_configuration=None):  # noqa: E501
+        """V1beta1ParameterCondition - a model defined in OpenAPI"""  # noqa: E501
+        if local_vars_configuration is None:
+            local_vars_configuration = Configuration()
+        self.local_vars_configuration = local_vars_configuration
+
+        self._parent = None
+        self._values = None
+        self.discriminator = None
+
+        if parent is not None:
+            self.parent = parent
+        if values is not None:
+            self.values = values
+
+    @property
+    def parent(self):
+        """Gets the parent of this V1beta1ParameterCondition.  # noqa: E501
+
+        Name of the categorical parameter which activates this parameter. Parent parameter must be defined before this parameter in the list of parameters.  # noqa: E501
+
+        :return: The parent of this V1beta1ParameterCondition.  # noqa: E501
+        :rtype: str
+        """
+        return self._parent
+
+    @parent.setter
+    def parent(self, parent):
+        """Sets the parent of this V1beta1ParameterCondition.
+
+        Name of the categorical parameter which activates this parameter. Parent parameter must be defined before this parameter in the list of parameters.  # noqa: E501
+
+        :param parent: The parent of this V1beta1ParameterCondition.  # noqa: E501
+        :type: str
+        """
+
+        self._parent = parent
+
+    @property
+    def values(self):
+        """Gets the values of this V1beta1ParameterCondition.  # noqa: E501
+
+        Values of the parent parameter which activate this parameter.  # noqa: E501
+
+        :return: The values of this V1beta1ParameterCondition.  # noqa: E501
+        :rtype: list[str]
+        """
+        return self._values
+
+    @values.setter
+    def values(self, values):
+        """Sets the values of this V1beta1ParameterCondition.
+
+        Values of the parent parameter which activate this parameter.  # noqa: E501
+
+        :param values: The values of this V1beta1ParameterCondition.  # noqa: E501
+        :type: list[str]
+        """
+
+        self._values = values
+
+    def to_dict(self):
+        """Returns the model properties as a dict"""
+        result = {}
+
+        for attr, _ in six.iteritems(self.openapi_types):
+            value = getattr(self, attr)
+            if isinstance(value, list):
+                result[attr] = list(map(
+                    lambda x: x.to_dict() if hasattr(x, "to_dict") else x,
+                    value
+                ))
+            elif hasattr(value, "to_dict"):
+                result[attr] = value.to_dict()
+            elif isinstance(value, dict):
+                result[attr] = dict(map(
+                    lambda item: (item[0], item[1].to_dict())
+                    if hasattr(item[1], "to_dict") else item,
+                    value.items()
+                ))
+            else:
+                result[attr] = value
+
+        return result
+
+    def to_str(self):
+        """Returns the string representation of the model"""
+        return pprint.pformat(self.to_dict())
+
+    def __repr__(self):
+        """For `print` and `pprint`"""
+        return self.to_str()
+
+    def __eq__(self, other):
+        """Returns true if both objects are equal"""
+        if not isinstance(other, V1beta1ParameterCondition):
+            return False
+
+        return self.to_dict() == other.to_dict()
+
+    def __ne__(self, other):
+        """Returns true if both objects are not equal"""
+        if not isinstance(other, V1beta1ParameterCondition):
+            return True
+
+        return self.to_dict() != other.to_dict()
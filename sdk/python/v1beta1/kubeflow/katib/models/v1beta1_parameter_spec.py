@@ -33,28 +33,33 @@ class V1beta1ParameterSpec(object):
                             and the value is json key in definition.
     """
     openapi_types = {
+        'condition': 'V1beta1ParameterCondition',
         'feasible_space': 'V1beta1FeasibleSpace',
         'name': 'str',
         'parameter_type': 'str'
     }
 
     attribute_map = {
+        'condition': 'condition',
         'feasible_space': 'feasibleSpace',
         'name': 'name',
         'parameter_type': 'parameterType'
     }
 
-    def __init__(self, feasible_space=None, name=None, parameter_type=None, local_vars_configuration=None):  # noqa: E501
+    def __init__(self, condition=None, feasible_space=None, name=None, parameter_type=None, local_vars_configuration=None):  # noqa: E501
         """V1beta1ParameterSpec - a model defined in OpenAPI"""  # noqa: E501
         if local_vars_configuration is None:
             local_vars_configuration = Configuration()
         self.local_vars_configuration = local_vars_configuration
 
+        self._condition = None
         self._feasible_space = None
         self._name = None
         self._parameter_type = None
         self.discriminator = None
 
+        if condition is not None:
+            self.condition = condition
         if feasible_space is not None:
             self.feasible_space = feasible_space
         if name is not None:
@@ -62,6 +67,27 @@ class V1beta1ParameterSpec(object):
         if parameter_type is not None:
             self.parameter_type = parameter_type
 
+    @property
+    def condition(self):
+        """Gets the condition of this V1beta1ParameterSpec.  # noqa: E501
+
+
+        :return: The condition of this V1beta1ParameterSpec.  # noqa: E501
+        :rtype: V1beta1ParameterCondition
+        """
+        return self._condition
+
+    @condition.setter
+    def condition(self, condition):
+        """Sets the condition of this V1beta1ParameterSpec.
+
+
+        :param condition: The condition of this V1beta1ParameterSpec.  # noqa: E501
+        :type: V1beta1ParameterCondition
+        """
+
+        self._condition = condition
+
     @property
     def feasible_space(self):
         """Gets the feasible_space of this V1beta1ParameterSpec.  # noqa: E501
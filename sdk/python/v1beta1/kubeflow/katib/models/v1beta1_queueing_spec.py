@@ -0,0 +1,150 @@
+# coding: utf-8
+
+"""
+    Katib
+
+    Swagger description for Katib  # noqa: E501
+
+    The version of the OpenAPI document: v1beta1-0.1
+    Generated by: https://openapi-generator.tech
+"""
+
+
+import pprint
+import re  # noqa: F401
+
+import six
+
+from kubeflow.katib.configuration import Configuration
+
+
+class V1beta1QueueingSpec(object):
+    """NOTE: This class is auto generated by OpenAPI Generator.
+    Ref: https://openapi-generator.tech
+
+    Do not edit the class manually.
+    """
+
+    """
+    Attributes:
+      openapi_types (dict): The key is attribute name
+                            and the value is attribute type.
+      attribute_map (dict): The key is attribute name
+                            and the value is json key in definition.
+    """
+    openapi_types = {
+        'priority': 'int',
+        'weight': 'int'
+    }
+
+    attribute_map = {
+        'priority': 'priority',
+        'weight': 'weight'
+    }
+
+    def __init__(self, priority=None, weight=None, local_vars_configuration=None):  # noqa: E501
+        """V1beta1QueueingSpec - a model defined in OpenAPI"""  # noqa: E501
+        if local_vars_configuration is None:
+            local_vars_configuration = Configuration()
+        self.local_vars_configuration = local_vars_configuration
+
+        self._priority = None
+        self._weight = None
+        self.discriminator = None
+
+        if priority is not None:
+            self.priority = priority
+        if weight is not None:
+            self.weight = weight
+
+    @property
+    def priority(self):
+        """Gets the priority of this V1beta1QueueingSpec.  # noqa: E501
+
+        Experiments with higher priority get the trial slots first. Default value is 0.  # noqa: E501
+
+        :return: The priority of this V1beta1QueueingSpec.  # noqa: E501
+        :rtype: int
+        """
+        return self._priority
+
+    @priority.setter
+    def priority(self, priority):
+        """Sets the priority of this V1beta1QueueingSpec.
+
+        Experiments with higher priority get the trial slots first. Default value is 0.  # noqa: E501
+
+        :param priority: The priority of this V1beta1QueueingSpec.  # noqa: E501
+        :type: int
+        """
+
+        self._priority = priority
+
+    @property
+    def weight(self):
+        """Gets the weight of this V1beta1QueueingSpec.  # noqa: E501
+
+        Share of the trial slots between the Experiments with the same priority. Default value is 1.  # noqa: E501
+
+        :return: The weight of this V1beta1QueueingSpec.  # noqa: E501
+        :rtype: int
+        """
+        return self._weight
+
+    @weight.setter
+    def weight(self, weight):
+        """Sets the weight of this V1beta1QueueingSpec.
+
+        Share of the trial slots between the Experiments with the same priority. Default value is 1.  # noqa: E501
+
+        :param weight: The weight of this V1beta1QueueingSpec.  # noqa: E501
+        :type: int
+        """
+
+        self._weight = weight
+
+    def to_dict(self):
+        """Returns the model properties as a dict"""
+        result = {}
+
+        for attr, _ in six.iteritems(self.openapi_types):
+            value = getattr(self, attr)
+            if isinstance(value, list):
+                result[attr] = list(map(
+                    lambda x: x.to_dict() if hasattr(x, "to_dict") else x,
+                    value
+                ))
+            elif hasattr(value, "to_dict"):
+                result[attr] = value.to_dict()
+            elif isinstance(value, dict):
+                result[attr] = dict(map(
+                    lambda item: (item[0], item[1].to_dict())
+                    if hasattr(item[1], "to_dict") else item,
+                    value.items()
+                ))
+            else:
+                result[attr] = value
+
+        return result
+
+    def to_str(self):
+        """Returns the string representation of the model"""
+        return pprint.pformat(self.to_dict())
+
+    def __repr__(self):
+        """For `print` and `pprint`"""
+        return self.to_str()
+
+    def __eq__(self, other):
+        """Returns true if both objects are equal"""
+        if not isinstance(other, V1beta1QueueingSpec):
+            return False
+
+        return self.to_dict() == other.to_dict()
+
+    def __ne__(self, other):
+        """Returns true if both objects are not equal"""
+        if not isinstance(other, V1beta1QueueingSpec):
+            return True
+
+        return self.to_dict() != other.to_dict()
@@ -0,0 +1,150 @@
+# coding: utf-8
+
+"""
+    Katib
+
+    Swagger description for Katib  # noqa: E501
+
+    The version of the OpenAPI document: v1beta1-0.1
+    Generated by: https://openapi-generator.tech
+"""
+
+
+import pprint
+import re  # noqa: F401
+
+import six
+
+from kubeflow.katib.configuration import Configuration
+
+
+class V1beta1ResourceUsageSpec(object):
+    """NOTE: This class is auto generated by OpenAPI Generator.
+    Ref: https://openapi-generator.tech
+
+    Do not edit the class manually.
+    """
+
+    """
+    Attributes:
+      openapi_types (dict): The key is attribute name
+                            and the value is attribute type.
+      attribute_map (dict): The key is attribute name
+                            and the value is json key in definition.
+    """
+    openapi_types = {
+        'hours': 'float',
+        'resource_name': 'str'
+    }
+
+    attribute_map = {
+        'hours': 'hours',
+        'resource_name': 'resourceName'
+    }
+
+    def __init__(self, hours=None, resource_name=None, local_vars_configuration=None):  # noqa: E501
+        """V1beta1ResourceUsageSpec - a model defined in OpenAPI"""  # noqa: E501
+        if local_vars_configuration is None:
+            local_vars_configuration = Configuration()
+        self.local_vars_configuration = local_vars_configuration
+
+        self._hours = None
+        self._resource_name = None
+        self.discriminator = None
+
+        if hours is not None:
+            self.hours = hours
+        if resource_name is not None:
+            self.resource_name = resource_name
+
+    @property
+    def hours(self):
+        """Gets the hours of this V1beta1ResourceUsageSpec.  # noqa: E501
+
+        Max usage of the resource in resource-hours.  # noqa: E501
+
+        :return: The hours of this V1beta1ResourceUsageSpec.  # noqa: E501
+        :rtype: float
+        """
+        return self._hours
+
+    @hours.setter
+    def hours(self, hours):
+        """Sets the hours of this V1beta1ResourceUsageSpec.
+
+        Max usage of the resource in resource-hours.  # noqa: E501
+
+        :param hours: The hours of this V1beta1ResourceUsageSpec.  # noqa: E501
+        :type: float
+        """
+
+        self._hours = hours
+
+    @property
+    def resource_name(self):
+        """Gets the resource_name of this V1beta1ResourceUsageSpec.  # noqa: E501
+
+        Name of the resource, for example nvidia.com/gpu or cpu.  # noqa: E501
+
+        :return: The resource_name of this V1beta1ResourceUsageSpec.  # noqa: E501
+        :rtype: str
+        """
+        return self._resource_name
+
+    @resource_name.setter
+    def resource_name(self, resource_name):
+        """Sets the resource_name of this V1beta1ResourceUsageSpec.
+
+        Name of the resource, for example nvidia.com/gpu or cpu.  # noqa: E501
+
+        :param resource_name: The resource_name of this V1beta1ResourceUsageSpec.  # noqa: E501
+        :type: str
+        """
+
+        self._resource_name = resource_name
+
+    def to_dict(self):
+        """Returns the model properties as a dict"""
+        result = {}
+
+        for attr, _ in six.iteritems(self.openapi_types):
+            value = getattr(self, attr)
+            if isinstance(value, list):
+                result[attr] = list(map(
+                    lambda x: x.to_dict() if hasattr(x, "to_dict") else x,
+                    value
+                ))
+            elif hasattr(value, "to_dict"):
+                result[attr] = value.to_dict()
+            elif isinstance(value, dict):
+                result[attr] = dict(map(
+                    lambda item: (item[0], item[1].to_dict())
+                    if hasattr(item[1], "to_dict") else item,
+                    value.items()
+                ))
+            else:
+                result[attr] = value
+
+        return result
+
+    def to_str(self):
+        """Returns the string representation of the model"""
+        return pprint.pformat(self.to_dict())
+
+    def __repr__(self):
+        """For `print` and `pprint`"""
+        return self.to_str()
+
+    def __eq__(self, other):
+        """Returns true if both objects are equal"""
+        if not isinstance(other, V1beta1ResourceUsageSpec):
+            return False
+
+        return self.to_dict() == other.to_dict()
+
+    def __ne__(self, other):
+        """Returns true if both objects are not equal"""
+        if not isinstance(other, V1beta1ResourceUsageSpec):
+            return True
+
+        return self.to_dict() != other.to_dict()
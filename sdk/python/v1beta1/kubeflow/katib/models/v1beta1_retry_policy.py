@@ -0,0 +1,204 @@
+# coding: utf-8
+
+"""
+    Katib
+
+    Swagger description for Katib  # noqa: E501
+
+    The version of the OpenAPI document: v1beta1-0.1
+    Generated by: https://openapi-generator.tech
+"""
+
+
+import pprint
+import re  # noqa: F401
+
+import six
+
+from kubeflow.katib.configuration import Configuration
+
+
+class V1beta1RetryPolicy(object):
+    """NOTE: This class is auto generated by OpenAPI Generator.
+    Ref: https://openapi-generator.tech
+
+    Do not edit the class manually.
+    """
+
+    """
+    Attributes:
+      openapi_types (dict): The key is attribute name
+                            and the value is attribute type.
+      attribute_map (dict): The key is attribute name
+                            and the value is json key in definition.
+    """
+    openapi_types = {
+        'backoff': 'str',
+        'max_retries': 'int',
+        'on_messages': 'list[str]',
+        'on_reasons': 'list[str]'
+    }
+
+    attribute_map = {
+        'backoff': 'backoff',
+        'max_retries': 'maxRetries',
+        'on_messages': 'onMessages',
+        'on_reasons': 'onReasons'
+    }
+
+    def __init__(self, backoff=None, max_retries=None, on_messages=None, on_reasons=None, local_vars_configuration=None):  # noqa: E501
+        """V1beta1RetryPolicy - a model defined in OpenAPI"""  # noqa: E501
+        if local_vars_configuration is None:
+            local_vars_configuration = Configuration()
+        self.local_vars_configuration = local_vars_configuration
+
+        self._backoff = None
+        self._max_retries = None
+        self._on_messages = None
+        self._on_reasons = None
+        self.discriminator = None
+
+        if backoff is not None:
+            self.backoff = backoff
+        if max_retries is not None:
+            self.max_retries = max_retries
+        if on_messages is not None:
+            self.on_messages = on_messages
+        if on_reasons is not None:
+            self.on_reasons = on_reasons
+
+    @property
+    def backoff(self):
+        """Gets the backoff of this V1beta1RetryPolicy.  # noqa: E501
+
+
+        :return: The backoff of this V1beta1RetryPolicy.  # noqa: E501
+        :rtype: str
+        """
+        return self._backoff
+
+    @backoff.setter
+    def backoff(self, backoff):
+        """Sets the backoff of this V1beta1RetryPolicy.
+
+
+        :param backoff: The backoff of this V1beta1RetryPolicy.  # noqa: E501
+        :type: str
+        """
+
+        self._backoff = backoff
+
+    @property
+    def max_retries(self):
+        """Gets the max_retries of this V1beta1RetryPolicy.  # noqa: E501
+
+        Max number of retries of the failed Trial run.  # noqa: E501
+
+        :return: The max_retries of this V1beta1RetryPolicy.  # noqa: E501
+        :rtype: int
+        """
+        return self._max_retries
+
+    @max_retries.setter
+    def max_retries(self, max_retries):
+        """Sets the max_retries of this V1beta1RetryPolicy.
+
+        Max number of retries of the failed Trial run.  # noqa: E501
+
+        :param max_retries: The max_retries of this V1beta1RetryPolicy.  # noqa: E501
+        :type: int
+        """
+
+        self._max_retries = max_retries
+
+    @property
+    def on_messages(self):
+        """Gets the on_messages of this V1beta1RetryPolicy.  # noqa: E501
+
+        Regular expressions for the failure message of the Trial run.  # noqa: E501
+
+        :return: The on_messages of this V1beta1RetryPolicy.  # noqa: E501
+        :rtype: list[str]
+        """
+        return self._on_messages
+
+    @on_messages.setter
+    def on_messages(self, on_messages):
+        """Sets the on_messages of this V1beta1RetryPolicy.
+
+        Regular expressions for the failure message of the Trial run.  # noqa: E501
+
+        :param on_messages: The on_messages of this V1beta1RetryPolicy.  # noqa: E501
+        :type: list[str]
+        """
+
+        self._on_messages = on_messages
+
+    @property
+    def on_reasons(self):
+        """Gets the on_reasons of this V1beta1RetryPolicy.  # noqa: E501
+
+        Regular expressions for the failure reason of the Trial run. If any of onReasons and onMessages is set, the run is retried only if its failure reason or message matches one of them.  # noqa: E501
+
+        :return: The on_reasons of this V1beta1RetryPolicy.  # noqa: E501
+        :rtype: list[str]
+        """
+        return self._on_reasons
+
+    @on_reasons.setter
+    def on_reasons(self, on_reasons):
+        """Sets the on_reasons of this V1beta1RetryPolicy.
+
+        Regular expressions for the failure reason of the Trial run. If any of onReasons and onMessages is set, the run is retried only if its failure reason or message matches one of them.  # noqa: E501
+
+        :param on_reasons: The on_reasons of this V1beta1RetryPolicy.  # noqa: E501
+        :type: list[str]
+        """
+
+        self._on_reasons = on_reasons
+
+    def to_dict(self):
+        """Returns the model properties as a dict"""
+        result = {}
+
+        for attr, _ in six.iteritems(self.openapi_types):
+            value = getattr(self, attr)
+            if isinstance(value, list):
+                result[attr] = list(map(
+                    lambda x: x.to_dict() if hasattr(x, "to_dict") else x,
+                    value
+                ))
+            elif hasattr(value, "to_dict"):
+                result[attr] = value.to_dict()
+            elif isinstance(value, dict):
+                result[attr] = dict(map(
+                    lambda item: (item[0], item[1].to_dict())
+                    if hasattr(item[1], "to_dict") else item,
+                    value.items()
+                ))
+            else:
+                result[attr] = value
+
+        return result
+
+    def to_str(self):
+        """Returns the string representation of the model"""
+        return pprint.pformat(self.to_dict())
+
+    def __repr__(self):
+        """For `print` and `pprint`"""
+        return self.to_str()
+
+    def __eq__(self, other):
+        """Returns true if both objects are equal"""
+        if not isinstance(other, V1beta1RetryPolicy):
+            return False
+
+        return self.to_dict() == other.to_dict()
+
+    def __ne__(self, other):
+        """Returns true if both objects are not equal"""
+        if not isinstance(other, V1beta1RetryPolicy):
+            return True
+
+        return self.to_dict() != other.to_dict()
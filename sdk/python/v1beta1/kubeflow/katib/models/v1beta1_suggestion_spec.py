@@ -36,17 +36,19 @@ class V1beta1SuggestionSpec(object):
         'algorithm': 'V1beta1AlgorithmSpec',
         'early_stopping': 'V1beta1EarlyStoppingSpec',
         'requests': 'int',
-        'resume_policy': 'str'
+        'resume_policy': 'str',
+        'suspend': 'bool'
     }
 
     attribute_map = {
         'algorithm': 'algorithm',
         'early_stopping': 'earlyStopping',
         'requests': 'requests',
-        'resume_policy': 'resumePolicy'
+        'resume_policy': 'resumePolicy',
+        'suspend': 'suspend'
     }
 
-    def __init__(self, algorithm=None, early_stopping=None, requests=None, resume_policy=None, local_vars_configuration=None):  # noqa: E501
+    def __init__(self, algorithm=None, early_stopping=None, requests=None, resume_policy=None, suspend=None, local_vars_configuration=None):  # noqa: E501
         """V1beta1SuggestionSpec - a model defined in OpenAPI"""  # noqa: E501
         if local_vars_configuration is None:
             local_vars_configuration = Configuration()
@@ -56,6 +58,7 @@ class V1beta1SuggestionSpec(object):
         self._early_stopping = None
         self._requests = None
         self._resume_policy = None
+        self._suspend = None
         self.discriminator = None
 
         if algorithm is not None:
@@ -66,6 +69,8 @@ class V1beta1SuggestionSpec(object):
             self.requests = requests
         if resume_policy is not None:
             self.resume_policy = resume_policy
+        if suspend is not None:
+            self.suspend = suspend
 
     @property
     def algorithm(self):
@@ -155,6 +160,29 @@ class V1beta1SuggestionSpec(object):
 
         self._resume_policy = resume_policy
 
+    @property
+    def suspend(self):
+        """Gets the suspend of this V1beta1SuggestionSpec.  # noqa: E501
+
+        Suspend indicates that the Experiment is suspended. Suggestion deployment is scaled down and no assignments are requested while it is true.  # noqa: E501
+
+        :return: The suspend of this V1beta1SuggestionSpec.  # noqa: E501
+        :rtype: bool
+        """
+        return self._suspend
+
+    @suspend.setter
+    def suspend(self, suspend):
+        """Sets the suspend of this V1beta1SuggestionSpec.
+
+        Suspend indicates that the Experiment is suspended. Suggestion deployment is scaled down and no assignments are requested while it is true.  # noqa: E501
+
+        :param suspend: The suspend of this V1beta1SuggestionSpec.  # noqa: E501
+        :type: bool
+        """
+
+        self._suspend = suspend
+
     def to_dict(self):
         """Returns the model properties as a dict"""
         result = {}
@@ -37,6 +37,8 @@ class V1beta1SuggestionStatus(object):
         'completion_time': 'datetime',
         'conditions': 'list[V1beta1SuggestionCondition]',
         'last_reconcile_time': 'datetime',
+        'rejected_round_count': 'int',
+        'rejected_suggestion_count': 'int',
         'start_time': 'datetime',
         'suggestion_count': 'int',
         'suggestions': 'list[V1beta1TrialAssignment]'
@@ -47,12 +49,14 @@ class V1beta1SuggestionStatus(object):
         'completion_time': 'completionTime',
         'conditions': 'conditions',
         'last_reconcile_time': 'lastReconcileTime',
+        'rejected_round_count': 'rejectedRoundCount',
+        'rejected_suggestion_count': 'rejectedSuggestionCount',
         'start_time': 'startTime',
         'suggestion_count': 'suggestionCount',
         'suggestions': 'suggestions'
     }
 
-    def __init__(self, algorithm_settings=None, completion_time=None, conditions=None, last_reconcile_time=None, start_time=None, suggestion_count=None, suggestions=None, local_vars_configuration=None):  # noqa: E501
+    def __init__(self, algorithm_settings=None, completion_time=None, conditions=None, last_reconcile_time=None, rejected_round_count=None, rejected_suggestion_count=None, start_time=None, suggestion_count=None, suggestions=None, local_vars_configuration=None):  # noqa: E501
         """V1beta1SuggestionStatus - a model defined in OpenAPI"""  # noqa: E501
         if local_vars_configuration is None:
             local_vars_configuration = Configuration()
@@ -62,6 +66,8 @@ class V1beta1SuggestionStatus(object):
         self._completion_time = None
         self._conditions = None
         self._last_reconcile_time = None
+        self._rejected_round_count = None
+        self._rejected_suggestion_count = None
         self._start_time = None
         self._suggestion_count = None
         self._suggestions = None
@@ -75,6 +81,10 @@ class V1beta1SuggestionStatus(object):
             self.conditions = conditions
         if last_reconcile_time is not None:
             self.last_reconcile_time = last_reconcile_time
+        if rejected_round_count is not None:
+            self.rejected_round_count = rejected_round_count
+        if rejected_suggestion_count is not None:
+            self.rejected_suggestion_count = rejected_suggestion_count
         if start_time is not None:
             self.start_time = start_time
         if suggestion_count is not None:
@@ -170,6 +180,52 @@ class V1beta1SuggestionStatus(object):
 
         self._last_reconcile_time = last_reconcile_time
 
+    @property
+    def rejected_round_count(self):
+        """Gets the rejected_round_count of this V1beta1SuggestionStatus.  # noqa: E501
+
+        Number of consecutive rounds in which all suggested parameter assignments were rejected. Suggestion is exhausted when it reaches the limit, since the algorithm may never report the exhausted search space, e.g. random with the small discrete space.  # noqa: E501
+
+        :return: The rejected_round_count of this V1beta1SuggestionStatus.  # noqa: E501
+        :rtype: int
+        """
+        return self._rejected_round_count
+
+    @rejected_round_count.setter
+    def rejected_round_count(self, rejected_round_count):
+        """Sets the rejected_round_count of this V1beta1SuggestionStatus.
+
+        Number of consecutive rounds in which all suggested parameter assignments were rejected. Suggestion is exhausted when it reaches the limit, since the algorithm may never report the exhausted search space, e.g. random with the small discrete space.  # noqa: E501
+
+        :param rejected_round_count: The rejected_round_count of this V1beta1SuggestionStatus.  # noqa: E501
+        :type: int
+        """
+
+        self._rejected_round_count = rejected_round_count
+
+    @property
+    def rejected_suggestion_count(self):
+        """Gets the rejected_suggestion_count of this V1beta1SuggestionStatus.  # noqa: E501
+
+        Number of suggested parameter assignments which were rejected, because they violate the parameter constraints or duplicate the earlier assignments. They are counted in the total request number, so algorithms which enumerate the search space, e.g. grid, don't suggest them again.  # noqa: E501
+
+        :return: The rejected_suggestion_count of this V1beta1SuggestionStatus.  # noqa: E501
+        :rtype: int
+        """
+        return self._rejected_suggestion_count
+
+    @rejected_suggestion_count.setter
+    def rejected_suggestion_count(self, rejected_suggestion_count):
+        """Sets the rejected_suggestion_count of this V1beta1SuggestionStatus.
+
+        Number of suggested parameter assignments which were rejected, because they violate the parameter constraints or duplicate the earlier assignments. They are counted in the total request number, so algorithms which enumerate the search space, e.g. grid, don't suggest them again.  # noqa: E501
+
+        :param rejected_suggestion_count: The rejected_suggestion_count of this V1beta1SuggestionStatus.  # noqa: E501
+        :type: int
+        """
+
+        self._rejected_suggestion_count = rejected_suggestion_count
+
     @property
     def start_time(self):
         """Gets the start_time of this V1beta1SuggestionStatus.  # noqa: E501
@@ -33,6 +33,8 @@ class V1beta1TrialAssignment(object):
                             and the value is json key in definition.
     """
     openapi_types = {
+        'budget': 'str',
+        'duplicate_of': 'str',
         'early_stopping_rules': 'list[V1beta1EarlyStoppingRule]',
         'labels': 'dict(str, str)',
         'name': 'str',
@@ -40,24 +42,32 @@ class V1beta1TrialAssignment(object):
     }
 
     attribute_map = {
+        'budget': 'budget',
+        'duplicate_of': 'duplicateOf',
         'early_stopping_rules': 'earlyStoppingRules',
         'labels': 'labels',
         'name': 'name',
         'parameter_assignments': 'parameterAssignments'
     }
 
-    def __init__(self, early_stopping_rules=None, labels=None, name=None, parameter_assignments=None, local_vars_configuration=None):  # noqa: E501
+    def __init__(self, budget=None, duplicate_of=None, early_stopping_rules=None, labels=None, name=None, parameter_assignments=None, local_vars_configuration=None):  # noqa: E501
         """V1beta1TrialAssignment - a model defined in OpenAPI"""  # noqa: E501
         if local_vars_configuration is None:
             local_vars_configuration = Configuration()
         self.local_vars_configuration = local_vars_configuration
 
+        self._budget = None
+        self._duplicate_of = None
         self._early_stopping_rules = None
         self._labels = None
         self._name = None
         self._parameter_assignments = None
         self.discriminator = None
 
+        if budget is not None:
+            self.budget = budget
+        if duplicate_of is not None:
+            self.duplicate_of = duplicate_of
         if early_stopping_rules is not None:
             self.early_stopping_rules = early_stopping_rules
         if labels is not None:
@@ -67,6 +77,52 @@ class V1beta1TrialAssignment(object):
         if parameter_assignments is not None:
             self.parameter_assignments = parameter_assignments
 
+    @property
+    def budget(self):
+        """Gets the budget of this V1beta1TrialAssignment.  # noqa: E501
+
+        Budget of the Trial for multi-fidelity algorithms, e.g. number of epochs or dataset fraction. It is not a hyperparameter and it is referenced in the Trial template by ${trialSpec.Budget}.  # noqa: E501
+
+        :return: The budget of this V1beta1TrialAssignment.  # noqa: E501
+        :rtype: str
+        """
+        return self._budget
+
+    @budget.setter
+    def budget(self, budget):
+        """Sets the budget of this V1beta1TrialAssignment.
+
+        Budget of the Trial for multi-fidelity algorithms, e.g. number of epochs or dataset fraction. It is not a hyperparameter and it is referenced in the Trial template by ${trialSpec.Budget}.  # noqa: E501
+
+        :param budget: The budget of this V1beta1TrialAssignment.  # noqa: E501
+        :type: str
+        """
+
+        self._budget = budget
+
+    @property
+    def duplicate_of(self):
+        """Gets the duplicate_of of this V1beta1TrialAssignment.  # noqa: E501
+
+        Name of the earlier suggestion with the same parameter assignments. It is set only if the duplicated assignments are reused, see Experiment spec.duplicateSuggestion.  # noqa: E501
+
+        :return: The duplicate_of of this V1beta1TrialAssignment.  # noqa: E501
+        :rtype: str
+        """
+        return self._duplicate_of
+
+    @duplicate_of.setter
+    def duplicate_of(self, duplicate_of):
+        """Sets the duplicate_of of this V1beta1TrialAssignment.
+
+        Name of the earlier suggestion with the same parameter assignments. It is set only if the duplicated assignments are reused, see Experiment spec.duplicateSuggestion.  # noqa: E501
+
+        :param duplicate_of: The duplicate_of of this V1beta1TrialAssignment.  # noqa: E501
+        :type: str
+        """
+
+        self._duplicate_of = duplicate_of
+
     @property
     def early_stopping_rules(self):
         """Gets the early_stopping_rules of this V1beta1TrialAssignment.  # noqa: E501
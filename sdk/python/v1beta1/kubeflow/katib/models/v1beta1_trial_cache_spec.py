@@ -0,0 +1,122 @@
+# coding: utf-8
+
+"""
+    Katib
+
+    Swagger description for Katib  # noqa: E501
+
+    The version of the OpenAPI document: v1beta1-0.1
+    Generated by: https://openapi-generator.tech
+"""
+
+
+import pprint
+import re  # noqa: F401
+
+import six
+
+from kubeflow.katib.configuration import Configuration
+
+
+class V1beta1TrialCacheSpec(object):
+    """NOTE: This class is auto generated by OpenAPI Generator.
+    Ref: https://openapi-generator.tech
+
+    Do not edit the class manually.
+    """
+
+    """
+    Attributes:
+      openapi_types (dict): The key is attribute name
+                            and the value is attribute type.
+      attribute_map (dict): The key is attribute name
+                            and the value is json key in definition.
+    """
+    openapi_types = {
+        'scope': 'str'
+    }
+
+    attribute_map = {
+        'scope': 'scope'
+    }
+
+    def __init__(self, scope=None, local_vars_configuration=None):  # noqa: E501
+        """V1beta1TrialCacheSpec - a model defined in OpenAPI"""  # noqa: E501
+        if local_vars_configuration is None:
+            local_vars_configuration = Configuration()
+        self.local_vars_configuration = local_vars_configuration
+
+        self._scope = None
+        self.discriminator = None
+
+        if scope is not None:
+            self.scope = scope
+
+    @property
+    def scope(self):
+        """Gets the scope of this V1beta1TrialCacheSpec.  # noqa: E501
+
+        Scope of the Trials which results are reused. Defaults to Namespace. Only Namespace is supported, so the Trials of the other namespaces are never reused.  # noqa: E501
+
+        :return: The scope of this V1beta1TrialCacheSpec.  # noqa: E501
+        :rtype: str
+        """
+        return self._scope
+
+    @scope.setter
+    def scope(self, scope):
+        """Sets the scope of this V1beta1TrialCacheSpec.
+
+        Scope of the Trials which results are reused. Defaults to Namespace. Only Namespace is supported, so the Trials of the other namespaces are never reused.  # noqa: E501
+
+        :param scope: The scope of this V1beta1TrialCacheSpec.  # noqa: E501
+        :type: str
+        """
+
+        self._scope = scope
+
+    def to_dict(self):
+        """Returns the model properties as a dict"""
+        result = {}
+
+        for attr, _ in six.iteritems(self.openapi_types):
+            value = getattr(self, attr)
+            if isinstance(value, list):
+                result[attr] = list(map(
+                    lambda x: x.to_dict() if hasattr(x, "to_dict") else x,
+                    value
+                ))
+            elif hasattr(value, "to_dict"):
+                result[attr] = value.to_dict()
+            elif isinstance(value, dict):
+                result[attr] = dict(map(
+                    lambda item: (item[0], item[1].to_dict())
+                    if hasattr(item[1], "to_dict") else item,
+                    value.items()
+                ))
+            else:
+                result[attr] = value
+
+        return result
+
+    def to_str(self):
+        """Returns the string representation of the model"""
+        return pprint.pformat(self.to_dict())
+
+    def __repr__(self):
+        """For `print` and `pprint`"""
+        return self.to_str()
+
+    def __eq__(self, other):
+        """Returns true if both objects are equal"""
+        if not isinstance(other, V1beta1TrialCacheSpec):
+            return False
+
+        return self.to_dict() == other.to_dict()
+
+    def __ne__(self, other):
+        """Returns true if both objects are not equal"""
+        if not isinstance(other, V1beta1TrialCacheSpec):
+            return True
+
+        return self.to_dict() != other.to_dict()
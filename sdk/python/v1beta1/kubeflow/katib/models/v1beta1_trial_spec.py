@@ -33,52 +33,76 @@ class V1beta1TrialSpec(object):
                             and the value is json key in definition.
     """
     openapi_types = {
+        'active_deadline_seconds': 'int',
+        'budget': 'str',
+        'cache': 'V1beta1TrialCacheSpec',
         'early_stopping_rules': 'list[V1beta1EarlyStoppingRule]',
         'failure_condition': 'str',
         'labels': 'dict(str, str)',
         'metrics_collector': 'V1beta1MetricsCollectorSpec',
         'objective': 'V1beta1ObjectiveSpec',
         'parameter_assignments': 'list[V1beta1ParameterAssignment]',
+        'pending_deadline_seconds': 'int',
         'primary_container_name': 'str',
         'primary_pod_labels': 'dict(str, str)',
         'retain_run': 'bool',
+        'retry_policy': 'V1beta1RetryPolicy',
+        'reuse_observation_from': 'str',
         'run_spec': 'object',
         'success_condition': 'str'
     }
 
     attribute_map = {
+        'active_deadline_seconds': 'activeDeadlineSeconds',
+        'budget': 'budget',
+        'cache': 'cache',
         'early_stopping_rules': 'earlyStoppingRules',
         'failure_condition': 'failureCondition',
         'labels': 'labels',
         'metrics_collector': 'metricsCollector',
         'objective': 'objective',
         'parameter_assignments': 'parameterAssignments',
+        'pending_deadline_seconds': 'pendingDeadlineSeconds',
         'primary_container_name': 'primaryContainerName',
         'primary_pod_labels': 'primaryPodLabels',
         'retain_run': 'retainRun',
+        'retry_policy': 'retryPolicy',
+        'reuse_observation_from': 'reuseObservationFrom',
         'run_spec': 'runSpec',
         'success_condition': 'successCondition'
     }
 
-    def __init__(self, early_stopping_rules=None, failure_condition=None, labels=None, metrics_collector=None, objective=None, parameter_assignments=None, primary_container_name=None, primary_pod_labels=None, retain_run=None, run_spec=None, success_condition=None, local_vars_configuration=None):  # noqa: E501
+    def __init__(self, active_deadline_seconds=None, budget=None, cache=None, early_stopping_rules=None, failure_condition=None, labels=None, metrics_collector=None, objective=None, parameter_assignments=None, pending_deadline_seconds=None, primary_container_name=None, primary_pod_labels=None, retain_run=None, retry_policy=None, reuse_observation_from=None, run_spec=None, success_condition=None, local_vars_configuration=None):  # noqa: E501
         """V1beta1TrialSpec - a model defined in OpenAPI"""  # noqa: E501
         if local_vars_configuration is None:
             local_vars_configuration = Configuration()
         self.local_vars_configuration = local_vars_configuration
 
+        self._active_deadline_seconds = None
+        self._budget = None
+        self._cache = None
         self._early_stopping_rules = None
         self._failure_condition = None
         self._labels = None
         self._metrics_collector = None
         self._objective = None
         self._parameter_assignments = None
+        self._pending_deadline_seconds = None
         self._primary_container_name = None
         self._primary_pod_labels = None
         self._retain_run = None
+        self._retry_policy = None
+        self._reuse_observation_from = None
         self._run_spec = None
         self._success_condition = None
         self.discriminator = None
 
+        if active_deadline_seconds is not None:
+            self.active_deadline_seconds = active_deadline_seconds
+        if budget is not None:
+            self.budget = budget
+        if cache is not None:
+            self.cache = cache
         if early_stopping_rules is not None:
             self.early_stopping_rules = early_stopping_rules
         if failure_condition is not None:
@@ -91,17 +115,90 @@ class V1beta1TrialSpec(object):
             self.objective = objective
         if parameter_assignments is not None:
             self.parameter_assignments = parameter_assignments
+        if pending_deadline_seconds is not None:
+            self.pending_deadline_seconds = pending_deadline_seconds
         if primary_container_name is not None:
             self.primary_container_name = primary_container_name
         if primary_pod_labels is not None:
             self.primary_pod_labels = primary_pod_labels
         if retain_run is not None:
             self.retain_run = retain_run
+        if retry_policy is not None:
+            self.retry_policy = retry_policy
+        if reuse_observation_from is not None:
+            self.reuse_observation_from = reuse_observation_from
         if run_spec is not None:
             self.run_spec = run_spec
         if success_condition is not None:
             self.success_condition = success_condition
 
+    @property
+    def active_deadline_seconds(self):
+        """Gets the active_deadline_seconds of this V1beta1TrialSpec.  # noqa: E501
+
+        Duration in seconds relative to the Trial run creation time that the run may be active.  # noqa: E501
+
+        :return: The active_deadline_seconds of this V1beta1TrialSpec.  # noqa: E501
+        :rtype: int
+        """
+        return self._active_deadline_seconds
+
+    @active_deadline_seconds.setter
+    def active_deadline_seconds(self, active_deadline_seconds):
+        """Sets the active_deadline_seconds of this V1beta1TrialSpec.
+
+        Duration in seconds relative to the Trial run creation time that the run may be active.  # noqa: E501
+
+        :param active_deadline_seconds: The active_deadline_seconds of this V1beta1TrialSpec.  # noqa: E501
+        :type: int
+        """
+
+        self._active_deadline_seconds = active_deadline_seconds
+
+    @property
+    def budget(self):
+        """Gets the budget of this V1beta1TrialSpec.  # noqa: E501
+
+        Budget of the Trial for multi-fidelity algorithms, e.g. number of epochs or dataset fraction.  # noqa: E501
+
+        :return: The budget of this V1beta1TrialSpec.  # noqa: E501
+        :rtype: str
+        """
+        return self._budget
+
+    @budget.setter
+    def budget(self, budget):
+        """Sets the budget of this V1beta1TrialSpec.
+
+        Budget of the Trial for multi-fidelity algorithms, e.g. number of epochs or dataset fraction.  # noqa: E501
+
+        :param budget: The budget of this V1beta1TrialSpec.  # noqa: E501
+        :type: str
+        """
+
+        self._budget = budget
+
+    @property
+    def cache(self):
+        """Gets the cache of this V1beta1TrialSpec.  # noqa: E501
+
+
+        :return: The cache of this V1beta1TrialSpec.  # noqa: E501
+        :rtype: V1beta1TrialCacheSpec
+        """
+        return self._cache
+
+    @cache.setter
+    def cache(self, cache):
+        """Sets the cache of this V1beta1TrialSpec.
+
+
+        :param cache: The cache of this V1beta1TrialSpec.  # noqa: E501
+        :type: V1beta1TrialCacheSpec
+        """
+
+        self._cache = cache
+
     @property
     def early_stopping_rules(self):
         """Gets the early_stopping_rules of this V1beta1TrialSpec.  # noqa: E501
@@ -236,6 +333,29 @@ class V1beta1TrialSpec(object):
 
         self._parameter_assignments = parameter_assignments
 
+    @property
+    def pending_deadline_seconds(self):
+        """Gets the pending_deadline_seconds of this V1beta1TrialSpec.  # noqa: E501
+
+        Duration in seconds relative to the Trial run creation time that the run may wait until any of its pods is running.  # noqa: E501
+
+        :return: The pending_deadline_seconds of this V1beta1TrialSpec.  # noqa: E501
+        :rtype: int
+        """
+        return self._pending_deadline_seconds
+
+    @pending_deadline_seconds.setter
+    def pending_deadline_seconds(self, pending_deadline_seconds):
+        """Sets the pending_deadline_seconds of this V1beta1TrialSpec.
+
+        Duration in seconds relative to the Trial run creation time that the run may wait until any of its pods is running.  # noqa: E501
+
+        :param pending_deadline_seconds: The pending_deadline_seconds of this V1beta1TrialSpec.  # noqa: E501
+        :type: int
+        """
+
+        self._pending_deadline_seconds = pending_deadline_seconds
+
     @property
     def primary_container_name(self):
         """Gets the primary_container_name of this V1beta1TrialSpec.  # noqa: E501
@@ -305,6 +425,50 @@ class V1beta1TrialSpec(object):
 
         self._retain_run = retain_run
 
+    @property
+    def retry_policy(self):
+        """Gets the retry_policy of this V1beta1TrialSpec.  # noqa: E501
+
+
+        :return: The retry_policy of this V1beta1TrialSpec.  # noqa: E501
+        :rtype: V1beta1RetryPolicy
+        """
+        return self._retry_policy
+
+    @retry_policy.setter
+    def retry_policy(self, retry_policy):
+        """Sets the retry_policy of this V1beta1TrialSpec.
+
+
+        :param retry_policy: The retry_policy of this V1beta1TrialSpec.  # noqa: E501
+        :type: V1beta1RetryPolicy
+        """
+
+        self._retry_policy = retry_policy
+
+    @property
+    def reuse_observation_from(self):
+        """Gets the reuse_observation_from of this V1beta1TrialSpec.  # noqa: E501
+
+        Name of the Trial in the same namespace which results are reused. If it is set, the Trial run is not created and the Trial is completed with the observation of the referenced Trial once it is completed.  # noqa: E501
+
+        :return: The reuse_observation_from of this V1beta1TrialSpec.  # noqa: E501
+        :rtype: str
+        """
+        return self._reuse_observation_from
+
+    @reuse_observation_from.setter
+    def reuse_observation_from(self, reuse_observation_from):
+        """Sets the reuse_observation_from of this V1beta1TrialSpec.
+
+        Name of the Trial in the same namespace which results are reused. If it is set, the Trial run is not created and the Trial is completed with the observation of the referenced Trial once it is completed.  # noqa: E501
+
+        :param reuse_observation_from: The reuse_observation_from of this V1beta1TrialSpec.  # noqa: E501
+        :type: str
+        """
+
+        self._reuse_observation_from = reuse_observation_from
+
     @property
     def run_spec(self):
         """Gets the run_spec of this V1beta1TrialSpec.  # noqa: E501
@@ -33,6 +33,7 @@ class V1beta1TrialStatus(object):
                             and the value is json key in definition.
     """
     openapi_types = {
+        'attempts': 'list[V1beta1TrialAttempt]',
         'completion_time': 'datetime',
         'conditions': 'list[V1beta1TrialCondition]',
         'last_reconcile_time': 'datetime',
@@ -41,6 +42,7 @@ class V1beta1TrialStatus(object):
     }
 
     attribute_map = {
+        'attempts': 'attempts',
         'completion_time': 'completionTime',
         'conditions': 'conditions',
         'last_reconcile_time': 'lastReconcileTime',
@@ -48,12 +50,13 @@ class V1beta1TrialStatus(object):
         'start_time': 'startTime'
     }
 
-    def __init__(self, completion_time=None, conditions=None, last_reconcile_time=None, observation=None, start_time=None, local_vars_configuration=None):  # noqa: E501
+    def __init__(self, attempts=None, completion_time=None, conditions=None, last_reconcile_time=None, observation=None, start_time=None, local_vars_configuration=None):  # noqa: E501
         """V1beta1TrialStatus - a model defined in OpenAPI"""  # noqa: E501
         if local_vars_configuration is None:
             local_vars_configuration = Configuration()
         self.local_vars_configuration = local_vars_configuration
 
+        self._attempts = None
         self._completion_time = None
         self._conditions = None
         self._last_reconcile_time = None
@@ -61,6 +64,8 @@ class V1beta1TrialStatus(object):
         self._start_time = None
         self.discriminator = None
 
+        if attempts is not None:
+            self.attempts = attempts
         if completion_time is not None:
             self.completion_time = completion_time
         if conditions is not None:
@@ -72,6 +77,29 @@ class V1beta1TrialStatus(object):
         if start_time is not None:
             self.start_time = start_time
 
+    @property
+    def attempts(self):
+        """Gets the attempts of this V1beta1TrialStatus.  # noqa: E501
+
+        History of the failed Trial runs which have been retried.  # noqa: E501
+
+        :return: The attempts of this V1beta1TrialStatus.  # noqa: E501
+        :rtype: list[V1beta1TrialAttempt]
+        """
+        return self._attempts
+
+    @attempts.setter
+    def attempts(self, attempts):
+        """Sets the attempts of this V1beta1TrialStatus.
+
+        History of the failed Trial runs which have been retried.  # noqa: E501
+
+        :param attempts: The attempts of this V1beta1TrialStatus.  # noqa: E501
+        :type: list[V1beta1TrialAttempt]
+        """
+
+        self._attempts = attempts
+
     @property
     def completion_time(self):
         """Gets the completion_time of this V1beta1TrialStatus.  # noqa: E501
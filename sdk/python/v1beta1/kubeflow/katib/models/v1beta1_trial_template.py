@@ -33,53 +33,68 @@ class V1beta1TrialTemplate(object):
                             and the value is json key in definition.
     """
     openapi_types = {
+        'active_deadline_seconds': 'int',
         'config_map': 'V1beta1ConfigMapSource',
         'failure_condition': 'str',
+        'pending_deadline_seconds': 'int',
         'primary_container_name': 'str',
         'primary_pod_labels': 'dict(str, str)',
         'retain': 'bool',
+        'retry_policy': 'V1beta1RetryPolicy',
         'success_condition': 'str',
         'trial_parameters': 'list[V1beta1TrialParameterSpec]',
         'trial_spec': 'object'
     }
 
     attribute_map = {
+        'active_deadline_seconds': 'activeDeadlineSeconds',
         'config_map': 'configMap',
         'failure_condition': 'failureCondition',
+        'pending_deadline_seconds': 'pendingDeadlineSeconds',
         'primary_container_name': 'primaryContainerName',
         'primary_pod_labels': 'primaryPodLabels',
         'retain': 'retain',
+        'retry_policy': 'retryPolicy',
         'success_condition': 'successCondition',
         'trial_parameters': 'trialParameters',
         'trial_spec': 'trialSpec'
     }
 
-    def __init__(self, config_map=None, failure_condition=None, primary_container_name=None, primary_pod_labels=None, retain=None, success_condition=None, trial_parameters=None, trial_spec=None, local_vars_configuration=None):  # noqa: E501
+    def __init__(self, active_deadline_seconds=None, config_map=None, failure_condition=None, pending_deadline_seconds=None, primary_container_name=None, primary_pod_labels=None, retain=None, retry_policy=None, success_condition=None, trial_parameters=None, trial_spec=None, local_vars_configuration=None):  # noqa: E501
         """V1beta1TrialTemplate - a model defined in OpenAPI"""  # noqa: E501
         if local_vars_configuration is None:
             local_vars_configuration = Configuration()
         self.local_vars_configuration = local_vars_configuration
 
+        self._active_deadline_seconds = None
         self._config_map = None
         self._failure_condition = None
+        self._pending_deadline_seconds = None
         self._primary_container_name = None
         self._primary_pod_labels = None
         self._retain = None
+        self._retry_policy = None
         self._success_condition = None
         self._trial_parameters = None
         self._trial_spec = None
         self.discriminator = None
 
+        if active_deadline_seconds is not None:
+            self.active_deadline_seconds = active_deadline_seconds
         if config_map is not None:
             self.config_map = config_map
         if failure_condition is not None:
             self.failure_condition = failure_condition
+        if pending_deadline_seconds is not None:
+            self.pending_deadline_seconds = pending_deadline_seconds
         if primary_container_name is not None:
             self.primary_container_name = primary_container_name
         if primary_pod_labels is not None:
             self.primary_pod_labels = primary_pod_labels
         if retain is not None:
             self.retain = retain
+        if retry_policy is not None:
+            self.retry_policy = retry_policy
         if success_condition is not None:
             self.success_condition = success_condition
         if trial_parameters is not None:
@@ -87,6 +102,29 @@ class V1beta1TrialTemplate(object):
         if trial_spec is not None:
             self.trial_spec = trial_spec
 
+    @property
+    def active_deadline_seconds(self):
+        """Gets the active_deadline_seconds of this V1beta1TrialTemplate.  # noqa: E501
+
+        Duration in seconds relative to the trial run creation time that the run may be active. Once it is exceeded, the run is deleted and the trial is failed with the DeadlineExceeded reason.  # noqa: E501
+
+        :return: The active_deadline_seconds of this V1beta1TrialTemplate.  # noqa: E501
+        :rtype: int
+        """
+        return self._active_deadline_seconds
+
+    @active_deadline_seconds.setter
+    def active_deadline_seconds(self, active_deadline_seconds):
+        """Sets the active_deadline_seconds of this V1beta1TrialTemplate.
+
+        Duration in seconds relative to the trial run creation time that the run may be active. Once it is exceeded, the run is deleted and the trial is failed with the DeadlineExceeded reason.  # noqa: E501
+
+        :param active_deadline_seconds: The active_deadline_seconds of this V1beta1TrialTemplate.  # noqa: E501
+        :type: int
+        """
+
+        self._active_deadline_seconds = active_deadline_seconds
+
     @property
     def config_map(self):
         """Gets the config_map of this V1beta1TrialTemplate.  # noqa: E501
@@ -131,6 +169,29 @@ class V1beta1TrialTemplate(object):
 
         self._failure_condition = failure_condition
 
+    @property
+    def pending_deadline_seconds(self):
+        """Gets the pending_deadline_seconds of this V1beta1TrialTemplate.  # noqa: E501
+
+        Duration in seconds relative to the trial run creation time that the run may wait until any of its pods is running. Once it is exceeded, the run is deleted and the trial is failed with the DeadlineExceeded reason.  # noqa: E501
+
+        :return: The pending_deadline_seconds of this V1beta1TrialTemplate.  # noqa: E501
+        :rtype: int
+        """
+        return self._pending_deadline_seconds
+
+    @pending_deadline_seconds.setter
+    def pending_deadline_seconds(self, pending_deadline_seconds):
+        """Sets the pending_deadline_seconds of this V1beta1TrialTemplate.
+
+        Duration in seconds relative to the trial run creation time that the run may wait until any of its pods is running. Once it is exceeded, the run is deleted and the trial is failed with the DeadlineExceeded reason.  # noqa: E501
+
+        :param pending_deadline_seconds: The pending_deadline_seconds of this V1beta1TrialTemplate.  # noqa: E501
+        :type: int
+        """
+
+        self._pending_deadline_seconds = pending_deadline_seconds
+
     @property
     def primary_container_name(self):
         """Gets the primary_container_name of this V1beta1TrialTemplate.  # noqa: E501
@@ -200,6 +261,27 @@ class V1beta1TrialTemplate(object):
 
         self._retain = retain
 
+    @property
+    def retry_policy(self):
+        """Gets the retry_policy of this V1beta1TrialTemplate.  # noqa: E501
+
+
+        :return: The retry_policy of this V1beta1TrialTemplate.  # noqa: E501
+        :rtype: V1beta1RetryPolicy
+        """
+        return self._retry_policy
+
+    @retry_policy.setter
+    def retry_policy(self, retry_policy):
+        """Sets the retry_policy of this V1beta1TrialTemplate.
+
+
+        :param retry_policy: The retry_policy of this V1beta1TrialTemplate.  # noqa: E501
+        :type: V1beta1RetryPolicy
+        """
+
+        self._retry_policy = retry_policy
+
     @property
     def success_condition(self):
         """Gets the success_condition of this V1beta1TrialTemplate.  # noqa: E501
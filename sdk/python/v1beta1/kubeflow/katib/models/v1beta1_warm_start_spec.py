@@ -0,0 +1,148 @@
+# coding: utf-8
+
+"""
+    Katib
+
+    Swagger description for Katib  # noqa: E501
+
+    The version of the OpenAPI document: v1beta1-0.1
+    Generated by: https://openapi-generator.tech
+"""
+
+
+import pprint
+import re  # noqa: F401
+
+import six
+
+from kubeflow.katib.configuration import Configuration
+
+
+class V1beta1WarmStartSpec(object):
+    """NOTE: This class is auto generated by OpenAPI Generator.
+    Ref: https://openapi-generator.tech
+
+    Do not edit the class manually.
+    """
+
+    """
+    Attributes:
+      openapi_types (dict): The key is attribute name
+                            and the value is attribute type.
+      attribute_map (dict): The key is attribute name
+                            and the value is json key in definition.
+    """
+    openapi_types = {
+        'source_experiments': 'list[str]',
+        'trials_config_map': 'V1beta1TrialsConfigMapSource'
+    }
+
+    attribute_map = {
+        'source_experiments': 'sourceExperiments',
+        'trials_config_map': 'trialsConfigMap'
+    }
+
+    def __init__(self, source_experiments=None, trials_config_map=None, local_vars_configuration=None):  # noqa: E501
+        """V1beta1WarmStartSpec - a model defined in OpenAPI"""  # noqa: E501
+        if local_vars_configuration is None:
+            local_vars_configuration = Configuration()
+        self.local_vars_configuration = local_vars_configuration
+
+        self._source_experiments = None
+        self._trials_config_map = None
+        self.discriminator = None
+
+        if source_experiments is not None:
+            self.source_experiments = source_experiments
+        if trials_config_map is not None:
+            self.trials_config_map = trials_config_map
+
+    @property
+    def source_experiments(self):
+        """Gets the source_experiments of this V1beta1WarmStartSpec.  # noqa: E501
+
+        Names of the Experiments in the Experiment namespace which Trials are used as the prior history  # noqa: E501
+
+        :return: The source_experiments of this V1beta1WarmStartSpec.  # noqa: E501
+        :rtype: list[str]
+        """
+        return self._source_experiments
+
+    @source_experiments.setter
+    def source_experiments(self, source_experiments):
+        """Sets the source_experiments of this V1beta1WarmStartSpec.
+
+        Names of the Experiments in the Experiment namespace which Trials are used as the prior history  # noqa: E501
+
+        :param source_experiments: The source_experiments of this V1beta1WarmStartSpec.  # noqa: E501
+        :type: list[str]
+        """
+
+        self._source_experiments = source_experiments
+
+    @property
+    def trials_config_map(self):
+        """Gets the trials_config_map of this V1beta1WarmStartSpec.  # noqa: E501
+
+
+        :return: The trials_config_map of this V1beta1WarmStartSpec.  # noqa: E501
+        :rtype: V1beta1TrialsConfigMapSource
+        """
+        return self._trials_config_map
+
+    @trials_config_map.setter
+    def trials_config_map(self, trials_config_map):
+        """Sets the trials_config_map of this V1beta1WarmStartSpec.
+
+
+        :param trials_config_map: The trials_config_map of this V1beta1WarmStartSpec.  # noqa: E501
+        :type: V1beta1TrialsConfigMapSource
+        """
+
+        self._trials_config_map = trials_config_map
+
+    def to_dict(self):
+        """Returns the model properties as a dict"""
+        result = {}
+
+        for attr, _ in six.iteritems(self.openapi_types):
+            value = getattr(self, attr)
+            if isinstance(value, list):
+                result[attr] = list(map(
+                    lambda x: x.to_dict() if hasattr(x, "to_dict") else x,
+                    value
+                ))
+            elif hasattr(value, "to_dict"):
+                result[attr] = value.to_dict()
+            elif isinstance(value, dict):
+                result[attr] = dict(map(
+                    lambda item: (item[0], item[1].to_dict())
+                    if hasattr(item[1], "to_dict") else item,
+                    value.items()
+                ))
+            else:
+                result[attr] = value
+
+        return result
+
+    def to_str(self):
+        """Returns the string representation of the model"""
+        return pprint.pformat(self.to_dict())
+
+    def __repr__(self):
+        """For `print` and `pprint`"""
+        return self.to_str()
+
+    def __eq__(self, other):
+        """Returns true if both objects are equal"""
+        if not isinstance(other, V1beta1WarmStartSpec):
+            return False
+
+        return self.to_dict() == other.to_dict()
+
+    def __ne__(self, other):
+        """Returns true if both objects are not equal"""
+        if not isinstance(other, V1beta1WarmStartSpec):
+            return True
+
+        return self.to_dict() != other.to_dict()
@@ -45,6 +45,8 @@ KATIB_IMAGES = {
     "katib-ui":                      "cmd/ui/v1beta1/Dockerfile",
     "file-metrics-collector":        "cmd/metricscollector/v1beta1/file-metricscollector/Dockerfile",
     "tfevent-metrics-collector":     "cmd/metricscollector/v1beta1/tfevent-metricscollector/Dockerfile",
+    "tfevent-metrics-collector-go":  "cmd/metricscollector/v1beta1/tfevent-metricscollector-go/Dockerfile",
+    "prometheus-metrics-collector":  "cmd/metricscollector/v1beta1/prometheus-metricscollector/Dockerfile",
     "suggestion-hyperopt":           "cmd/suggestion/hyperopt/v1beta1/Dockerfile",
     "suggestion-skopt":              "cmd/suggestion/skopt/v1beta1/Dockerfile",
     "suggestion-hyperband":          "cmd/suggestion/hyperband/v1beta1/Dockerfile",
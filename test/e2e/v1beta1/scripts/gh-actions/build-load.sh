@@ -141,6 +141,8 @@ fi
 
 run "file-metrics-collector" "$CMD_PREFIX/metricscollector/$VERSION/file-metricscollector/Dockerfile"
 run "tfevent-metrics-collector" "$CMD_PREFIX/metricscollector/$VERSION/tfevent-metricscollector/Dockerfile"
+run "tfevent-metrics-collector-go" "$CMD_PREFIX/metricscollector/$VERSION/tfevent-metricscollector-go/Dockerfile"
+run "prometheus-metrics-collector" "$CMD_PREFIX/metricscollector/$VERSION/prometheus-metricscollector/Dockerfile"
 
 # Suggestion images
 echo -e "\nBuilding suggestion images..."